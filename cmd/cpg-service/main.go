@@ -9,6 +9,7 @@ import (
 	"cpg/pkg/ent/database"
 	"cpg/pkg/proto"
 	"cpg/pkg/ratelimit"
+	"crypto/tls"
 	"github.com/itsabgr/fak"
 	"github.com/itsabgr/ge"
 	_ "github.com/joho/godotenv/autoload"
@@ -18,17 +19,68 @@ import (
 	"google.golang.org/grpc/reflection"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
+	"strings"
 	"time"
 )
 
 type env struct {
 	AssetsConfig  string `env:"ASSETS_CONFIG,notEmpty"`
-	SaltKeyring   string `env:"SALT_KEYRING,notEmpty"`
-	BackupKeyring string `env:"BACKUP_KEYRING,notEmpty"`
+	BackupKeyring string `env:"BACKUP_KEYRING"`
 	GRPCServer    string `env:"GRPC_SERVER,notEmpty"`
 	PostgresURI   string `env:"PG_URI,notEmpty"`
 	RateLimiter   string `env:"RATE_LIMITER,notEmpty"`
+
+	// BackupKeyringShares are share files or tty to combine the backup keyring from
+	BackupKeyringShares []string `env:"BACKUP_KEYRING_SHARES"`
+	// LegacyBackupKeyring opens backups sealed by the salt keyring
+	LegacyBackupKeyring string `env:"LEGACY_BACKUP_KEYRING"`
+
+	APIKeys []string `env:"API_KEYS"`
+	// AdminAPIKeys are required by the admin rpcs
+	AdminAPIKeys []string `env:"ADMIN_API_KEYS"`
+	// RescueAllowlist are extra addresses token rescues may send to
+	RescueAllowlist []string `env:"RESCUE_ALLOWLIST"`
+
+	CheckoutServer    string `env:"CHECKOUT_SERVER"`
+	CheckoutTemplates string `env:"CHECKOUT_TEMPLATES"`
+
+	// Signer is the cpg-signer target, unix:/path or host:port
+	Signer       string `env:"SIGNER"`
+	SignerAPIKey string `env:"SIGNER_API_KEY"`
+	// SignerCAFile verifies a tcp signer, SignerCertFile and SignerKeyFile make it mutual tls
+	SignerCAFile   string `env:"SIGNER_CA_FILE"`
+	SignerCertFile string `env:"SIGNER_CERT_FILE"`
+	SignerKeyFile  string `env:"SIGNER_KEY_FILE"`
+
+	// ColdSigning is the json file of per asset cold signing thresholds
+	ColdSigning string `env:"COLD_SIGNING"`
+
+	// ApprovalPolicy is the json file of per asset approval thresholds
+	ApprovalPolicy string `env:"APPROVAL_POLICY"`
+	// ApproverKeys are name=key entries
+	ApproverKeys []string `env:"APPROVER_KEYS"`
+	// ArbiterKeys are name=key entries
+	ArbiterKeys []string `env:"ARBITER_KEYS"`
+
+	// FeePolicy is the json file of per asset and per merchant platform fees
+	FeePolicy string `env:"FEE_POLICY"`
+
+	// Treasury is the json file of the per asset treasury mode
+	Treasury string `env:"TREASURY"`
+	// TreasuryPeriod is how often merchant balances are settled
+	TreasuryPeriod time.Duration `env:"TREASURY_PERIOD" envDefault:"1m"`
+
+	// PayoutPolicy is the json file of per asset payout policies
+	PayoutPolicy string `env:"PAYOUT_POLICY"`
+	// PayoutPeriod is how often sent payouts are tracked
+	PayoutPeriod time.Duration `env:"PAYOUT_PERIOD" envDefault:"30s"`
+	// PayoutWebhookURL receives every payout status
+	PayoutWebhookURL    string `env:"PAYOUT_WEBHOOK_URL"`
+	PayoutWebhookSecret string `env:"PAYOUT_WEBHOOK_SECRET"`
+
+	crypto.SaltBackendConfig
 }
 
 func main() {
@@ -79,17 +131,109 @@ func main() {
 		ge.Throw(dbClient.Schema.Create(ctx))
 
 		slog.Debug("connected to postgres")
-		saltKR := ge.Must(crypto.LoadKeyRingFromFile(config.SaltKeyring))
-		backupKR := ge.Must(crypto.LoadKeyRingFromFile(config.BackupKeyring))
+		ge.Assert(config.BackupKeyring != "" || len(config.BackupKeyringShares) > 0, ge.New("no backup keyring"))
+		backupKR := ge.Must(crypto.LoadKeyRing(config.BackupKeyring, config.BackupKeyringShares))
+		slog.Debug("keyring loaded", slog.Int("backup", backupKR.Size()))
+		if config.LegacyBackupKeyring != "" {
+			backupKR = backupKR.Merge(ge.Must(crypto.LoadKeyRingFromFile(config.LegacyBackupKeyring)))
+		}
+
+		var saltCipher crypto.Cipher
+		var signer cpg.Signer
+		var err error
+		if config.Signer != "" {
+			var signerTLS *tls.Config
+			if !strings.HasPrefix(config.Signer, "unix:") {
+				signerTLS = ge.Must(cpg.LoadSignerClientTLS(config.SignerCAFile, config.SignerCertFile, config.SignerKeyFile))
+			}
+			var signerConn *grpc.ClientConn
+			signer, signerConn, err = cpg.DialSigner(config.Signer, config.SignerAPIKey, signerTLS)
+			ge.Throw(err)
+			defer func() { _ = signerConn.Close() }()
+			slog.Info("salts and signing are left to the signer", slog.String("signer", config.Signer))
+		} else if !assets.UsesSalt() {
+			slog.Info("no asset derives wallets from a salt, no salt backend loaded")
+		} else {
+			var saltKR *crypto.KeyRing
+			saltCipher, saltKR, err = config.SaltBackendConfig.Open(ctx)
+			ge.Throw(err)
+			if saltKR != nil && saltKR.Contains(backupKR) {
+				slog.Warn("keyrings have at least one match key")
+			}
+			// older salts are sealed by the backup keyring and older backups by the salt keyring
+			saltCipher = crypto.NewFallbackCipher(saltCipher, crypto.NewKeyRingCipher(backupKR))
+			if saltKR != nil {
+				backupKR = backupKR.Merge(saltKR)
+			}
+			slog.Info("salt backend loaded", slog.String("backend", config.Backend))
+		}
+
+		var serverOptions []grpc.ServerOption
+
+		if len(config.APIKeys) > 0 {
+			unaryAuth, streamAuth := cpg.NewAPIKeyInterceptors(config.APIKeys)
+			serverOptions = append(serverOptions, grpc.ChainUnaryInterceptor(unaryAuth), grpc.ChainStreamInterceptor(streamAuth))
+			slog.Info("api key authentication enabled", slog.Int("keys", len(config.APIKeys)))
+		} else {
+			slog.Warn("no api key authentication")
+		}
 
-		if saltKR.Contains(backupKR) {
-			slog.Warn("keyrings have at least one match key")
+		unaryAdmin, streamAdmin := cpg.NewAdminKeyInterceptors(config.AdminAPIKeys)
+		serverOptions = append(serverOptions, grpc.ChainUnaryInterceptor(unaryAdmin), grpc.ChainStreamInterceptor(streamAdmin))
+		if len(config.AdminAPIKeys) == 0 {
+			slog.Warn("no admin api key, admin rpcs are disabled")
 		}
-		slog.Debug("keyring loaded", slog.Int("salt", saltKR.Size()), slog.Int("backup", backupKR.Size()))
 
-		grpcServer := grpc.NewServer()
+		grpcServer := grpc.NewServer(serverOptions...)
+
+		cpgInstance := cpg.NewCPG(assets, cpg.NewDB(dbClient), backupKR, saltCipher)
+		if signer != nil {
+			cpgInstance.UseSigner(signer)
+		}
+		cpgInstance.UseRescueAllowlist(config.RescueAllowlist)
+		if config.ColdSigning != "" {
+			coldSigning := ge.Must(cpg.ParseColdSigning(ge.Must(os.ReadFile(config.ColdSigning))))
+			cpgInstance.UseColdSigning(coldSigning)
+			slog.Info("cold signing enabled", slog.Int("assets", len(coldSigning)))
+		}
+		if config.ApprovalPolicy != "" {
+			approvalPolicy := ge.Must(cpg.ParseApprovalPolicy(ge.Must(os.ReadFile(config.ApprovalPolicy))))
+			ge.Throw(cpgInstance.UseApproval(approvalPolicy, ge.Must(cpg.ParseApprovers(config.ApproverKeys))))
+			slog.Info("checkout approval enabled", slog.Int("assets", len(approvalPolicy)), slog.Int("approvers", len(config.ApproverKeys)))
+		}
+		cpgInstance.UseArbiters(ge.Must(cpg.ParseApprovers(config.ArbiterKeys)))
+		if len(config.ArbiterKeys) == 0 {
+			slog.Warn("no arbiter keys, only buyers can release and dispute escrow invoices")
+		}
+		if config.FeePolicy != "" {
+			feePolicy := ge.Must(cpg.ParseFeePolicy(ge.Must(os.ReadFile(config.FeePolicy))))
+			cpgInstance.UseFees(feePolicy)
+			slog.Info("platform fees enabled", slog.Int("assets", len(feePolicy)))
+		}
+		if config.Treasury != "" {
+			treasury := ge.Must(cpg.ParseTreasuryConfig(ge.Must(os.ReadFile(config.Treasury))))
+			ge.Throw(cpgInstance.UseTreasury(treasury))
+			go cpgInstance.RunTreasury(ctx, config.TreasuryPeriod)
+			slog.Info("treasury mode enabled", slog.Int("assets", len(treasury)), slog.Duration("period", config.TreasuryPeriod))
+		}
+		if config.PayoutPolicy != "" {
+			payoutPolicy := ge.Must(cpg.ParsePayoutPolicy(ge.Must(os.ReadFile(config.PayoutPolicy))))
+			var webhook *cpg.Webhook
+			if config.PayoutWebhookURL != "" {
+				webhook = ge.Must(cpg.NewWebhook(config.PayoutWebhookURL, config.PayoutWebhookSecret))
+			} else {
+				slog.Warn("no payout webhook")
+			}
+			ge.Throw(cpgInstance.UsePayouts(payoutPolicy, webhook))
+			go cpgInstance.RunPayouts(ctx, config.PayoutPeriod)
+			slog.Info("payouts enabled", slog.Int("assets", len(payoutPolicy)), slog.Duration("period", config.PayoutPeriod))
+		}
 
-		proto.RegisterCPGServer(grpcServer, cpg.NewGRPCServer(cpg.NewCPG(assets, cpg.NewDB(dbClient), saltKR, backupKR), ratelimiter))
+		proto.RegisterCPGServer(grpcServer, cpg.NewGRPCServer(cpgInstance, ratelimiter))
+
+		if config.CheckoutServer != "" {
+			serveCheckout(ctx, config, cpgInstance, ratelimiter)
+		}
 
 		if daemon.Debug() {
 			reflection.Register(grpcServer)
@@ -108,6 +252,31 @@ func main() {
 	})
 }
 
+func serveCheckout(ctx context.Context, config env, cpgInstance *cpg.CPG, ratelimiter *ratelimit.RateLimit) {
+	handler := ge.Must(cpg.NewCheckoutServer(cpgInstance, ratelimiter, config.CheckoutTemplates))
+
+	httpServer := &http.Server{
+		Addr:              config.CheckoutServer,
+		Handler:           handler,
+		ReadHeaderTimeout: time.Second * 5,
+	}
+
+	go func() {
+		<-ctx.Done()
+		slog.Info("stopping checkout server")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+
+	go func() {
+		slog.Info("checkout server listening", slog.String("addr", config.CheckoutServer))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			slog.Error("checkout server failed", slog.String("error", err.Error()))
+		}
+	}()
+}
+
 func prepareAssets(ctx context.Context, configFilePath string) *cpg.Assets {
 	cpg.RegisterAssetFactory(eth.Factory{})
 	configData := ge.Must(os.ReadFile(configFilePath))
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"cpg/internal/assets/eth"
+	"cpg/internal/daemon"
+	"cpg/pkg/cpg"
+	"cpg/pkg/crypto"
+	"cpg/pkg/ent/database"
+	"cpg/pkg/proto"
+	"github.com/itsabgr/ge"
+	_ "github.com/joho/godotenv/autoload"
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"log/slog"
+	"net"
+	"os"
+	"strings"
+)
+
+type env struct {
+	AssetsConfig string `env:"ASSETS_CONFIG,notEmpty"`
+	// PostgresURI should be a role which can only read invoices, the signer checks sweeps against its own view of them
+	PostgresURI string `env:"PG_URI,notEmpty"`
+	// Listen is unix:/path for a socket only the service user can open, or host:port which then needs API_KEYS and tls
+	Listen string `env:"SIGNER_LISTEN,notEmpty"`
+	// TLSCertFile and TLSKeyFile serve a tcp signer over tls, with TLSClientCAFile it requires client certificates
+	TLSCertFile     string `env:"SIGNER_TLS_CERT_FILE"`
+	TLSKeyFile      string `env:"SIGNER_TLS_KEY_FILE"`
+	TLSClientCAFile string `env:"SIGNER_TLS_CLIENT_CA_FILE"`
+	// LegacySaltKeyring is the backup keyring file of the service, it opens the salts it sealed before the keyrings were unswapped
+	LegacySaltKeyring string `env:"LEGACY_SALT_KEYRING"`
+	// MACKeyring is the keyring file the signer sums the terms of the invoices it prepares with
+	MACKeyring string `env:"SIGNER_MAC_KEYRING,notEmpty"`
+	// Policy is the json file of per asset limits, assets without one are never signed for
+	Policy string `env:"SIGNER_POLICY,notEmpty"`
+	// StateFile keeps the amounts signed today so a restart does not reset the daily limits
+	StateFile string `env:"SIGNER_STATE_FILE"`
+
+	APIKeys []string `env:"API_KEYS"`
+
+	crypto.SaltBackendConfig
+}
+
+func main() {
+	daemon.Run(func(ctx context.Context, config env) {
+		defer slog.Info("bye")
+
+		cpg.RegisterAssetFactory(eth.Factory{})
+		assets := ge.Must(cpg.ParseAssetsConfig(ctx, ge.Must(os.ReadFile(config.AssetsConfig))))
+		ge.Assert(assets.Count() > 0, ge.New("no asset loaded"))
+
+		policy := ge.Must(cpg.ParseSignerPolicy(ge.Must(os.ReadFile(config.Policy))))
+		slog.Info("signer policy loaded", slog.Int("assets", len(policy)))
+
+		dbClient := ge.Must(database.Open("postgres", config.PostgresURI))
+		defer func() { _ = dbClient.Close() }()
+		if daemon.Debug() {
+			dbClient = dbClient.Debug()
+		}
+
+		saltCipher, _, err := config.SaltBackendConfig.Open(ctx)
+		ge.Throw(err)
+		if config.LegacySaltKeyring != "" {
+			saltCipher = crypto.NewFallbackCipher(saltCipher, crypto.NewKeyRingCipher(ge.Must(crypto.LoadKeyRingFromFile(config.LegacySaltKeyring))))
+		}
+		slog.Info("salt backend loaded", slog.String("backend", config.Backend))
+
+		macKR := ge.Must(crypto.LoadKeyRingFromFile(config.MACKeyring))
+		slog.Debug("mac keyring loaded", slog.Int("keys", macKR.Size()))
+
+		var ln net.Listener
+		var serverOptions []grpc.ServerOption
+		if path, ok := strings.CutPrefix(config.Listen, "unix:"); ok {
+			_ = os.Remove(path)
+			ln = ge.Must(net.Listen("unix", path))
+			ge.Throw(os.Chmod(path, 0600))
+		} else {
+			ge.Assert(len(config.APIKeys) > 0, ge.New("a tcp signer needs API_KEYS"))
+			ge.Assert(config.TLSCertFile != "", ge.New("a tcp signer needs SIGNER_TLS_CERT_FILE"))
+			tlsConfig := ge.Must(cpg.LoadSignerServerTLS(config.TLSCertFile, config.TLSKeyFile, config.TLSClientCAFile))
+			serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+			ln = ge.Must(net.Listen("tcp", config.Listen))
+		}
+		defer func() { _ = ln.Close() }()
+		if len(config.APIKeys) > 0 {
+			unaryAuth, streamAuth := cpg.NewAPIKeyInterceptors(config.APIKeys)
+			serverOptions = append(serverOptions, grpc.ChainUnaryInterceptor(unaryAuth), grpc.ChainStreamInterceptor(streamAuth))
+			slog.Info("api key authentication enabled", slog.Int("keys", len(config.APIKeys)))
+		}
+
+		signer := cpg.NewLocalSigner(assets, cpg.NewDB(dbClient), saltCipher, macKR, policy)
+		if config.StateFile != "" {
+			ge.Throw(signer.UseStateFile(config.StateFile))
+		} else {
+			slog.Warn("no SIGNER_STATE_FILE, a restart resets the daily limits")
+		}
+
+		grpcServer := grpc.NewServer(serverOptions...)
+		proto.RegisterCPGSignerServer(grpcServer, cpg.NewSignerGRPCServer(signer))
+
+		go func() {
+			<-ctx.Done()
+			slog.Info("stopping signer")
+			grpcServer.GracefulStop()
+		}()
+
+		slog.Info("signer listening", slog.String("addr", ln.Addr().String()))
+		ge.Throw(grpcServer.Serve(ln))
+	})
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/cpg"
+	"cpg/pkg/crypto"
+	"github.com/itsabgr/ge"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+)
+
+func init() {
+	register("export", "stream every invoice, or the ones changed since a time, into an archive sealed by the backup keyring", exportInvoices)
+	register("import", "restore an invoice archive with its lifecycle timestamps", importInvoices)
+}
+
+func loadBackupKeyring(path string, shares []string) *crypto.KeyRing {
+	ge.Assert(path != "" || len(shares) > 0, ge.New("no backup keyring, use -backup-keyring or BACKUP_KEYRING"))
+	return ge.Must(crypto.LoadKeyRing(path, shares))
+}
+
+func exportInvoices(ctx context.Context, args []string) {
+	flags := newFlagSet("export")
+	var shares stringsFlag
+	var (
+		pgURI         = flags.String("pg", os.Getenv("PG_URI"), "postgres uri")
+		backupKeyring = flags.String("backup-keyring", os.Getenv("BACKUP_KEYRING"), "backup keyring file")
+		out           = flags.String("out", "-", "archive file to write, - writes to stdout")
+		since         = flags.String("since", "", "only export invoices created or changed since this rfc3339 time, e.g. the create at of the previous archive")
+		batchSize     = flags.Int("batch", 500, "invoices per archive frame")
+	)
+	flags.Var(&shares, "backup-keyring-share", "backup keyring share file or tty to combine the keyring from, repeatable")
+	ge.Throw(flags.Parse(args))
+
+	var sinceTime time.Time
+	if *since != "" {
+		sinceTime = ge.Must(time.Parse(time.RFC3339Nano, *since))
+	}
+
+	keyring := loadBackupKeyring(*backupKeyring, shares)
+
+	dbClient := openDB(ctx, *pgURI)
+	defer func() { _ = dbClient.Close() }()
+
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		file := ge.Must(os.OpenFile(*out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600))
+		defer func() { ge.Throw(file.Close()) }()
+		w = file
+	}
+
+	result, err := cpg.ExportInvoices(ctx, cpg.NewDB(dbClient), keyring, w, cpg.ExportInvoicesParams{
+		Since:     sinceTime,
+		BatchSize: *batchSize,
+		Progress: func(exported int) {
+			slog.Info("progress", slog.Int("exported", exported))
+		},
+	})
+	ge.Throw(err)
+
+	slog.Info("archive written",
+		slog.String("archive", result.Header.ArchiveID),
+		slog.Int("invoices", result.Invoices),
+		slog.Bool("incremental", result.Header.Incremental()),
+		slog.String("next_since", result.Header.CreateAt.Format(time.RFC3339Nano)),
+	)
+}
+
+func importInvoices(ctx context.Context, args []string) {
+	flags := newFlagSet("import")
+	var shares stringsFlag
+	var (
+		pgURI         = flags.String("pg", os.Getenv("PG_URI"), "postgres uri")
+		backupKeyring = flags.String("backup-keyring", os.Getenv("BACKUP_KEYRING"), "backup keyring file")
+		in            = flags.String("in", "-", "archive file to read, - reads from stdin")
+	)
+	flags.Var(&shares, "backup-keyring-share", "backup keyring share file or tty to combine the keyring from, repeatable")
+	ge.Throw(flags.Parse(args))
+
+	keyring := loadBackupKeyring(*backupKeyring, shares)
+
+	dbClient := openDB(ctx, *pgURI)
+	defer func() { _ = dbClient.Close() }()
+
+	var r io.Reader = os.Stdin
+	if *in != "-" {
+		file := ge.Must(os.Open(*in))
+		defer func() { _ = file.Close() }()
+		r = file
+	}
+
+	result, err := cpg.ImportInvoices(ctx, cpg.NewDB(dbClient), keyring, r, cpg.ImportInvoicesParams{
+		Progress: func(progress cpg.ImportInvoicesResult) {
+			slog.Info("progress", slog.Int("inserted", progress.Inserted), slog.Int("updated", progress.Updated))
+		},
+	})
+	if err != nil && result.Inserted+result.Updated > 0 {
+		slog.Error("import stopped part way, the committed invoices stay in the database",
+			slog.Int("inserted", result.Inserted),
+			slog.Int("updated", result.Updated),
+		)
+	}
+	ge.Throw(err)
+
+	slog.Info("archive imported",
+		slog.String("archive", result.Header.ArchiveID),
+		slog.Bool("incremental", result.Header.Incremental()),
+		slog.Int("inserted", result.Inserted),
+		slog.Int("updated", result.Updated),
+	)
+}
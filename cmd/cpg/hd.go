@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"cpg/internal/assets/eth"
+	"cpg/pkg/hdwallet"
+	"fmt"
+	"github.com/itsabgr/ge"
+	"os"
+	"strings"
+)
+
+func init() {
+	register("xpub", "print the hd account xpub of a seed for the hd_xpub of a watch-only asset", xpub)
+}
+
+func xpub(ctx context.Context, args []string) {
+	flags := newFlagSet("xpub")
+	var (
+		seedFile       = flags.String("seed-file", "", "bip39 mnemonic or hex seed file, the hd_seed_file of the signing asset")
+		passphraseFile = flags.String("passphrase-file", "", "optional bip39 passphrase file")
+		path           = flags.String("path", hdwallet.DefaultEthereumPath, "account path, the hd_path of the signing asset")
+	)
+	ge.Throw(flags.Parse(args))
+	ge.Assert(*seedFile != "", ge.New("no seed file, use -seed-file"))
+
+	seed := ge.Must(os.ReadFile(*seedFile))
+	defer clear(seed)
+	passphrase := ""
+	if *passphraseFile != "" {
+		passphrase = strings.TrimRight(string(ge.Must(os.ReadFile(*passphraseFile))), "\r\n")
+	}
+
+	account := ge.Must(eth.HDAccount(string(seed), passphrase, *path))
+	ge.Must(fmt.Println(account.Neuter().String()))
+}
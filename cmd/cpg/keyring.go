@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/cpg"
+	"cpg/pkg/crypto"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/itsabgr/ge"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func init() {
+	register("keyring generate", "print a new random keyring line", keyringGenerate)
+	register("keyring rotate", "re-encrypt every invoice salt under the primary salt key", keyringRotate)
+	register("keyring split", "split a keyring file into n-of-m shamir shares", keyringSplit)
+	register("keyring combine", "combine a quorum of shares back into a keyring file", keyringCombine)
+}
+
+func keyringSplit(ctx context.Context, args []string) {
+	flags := newFlagSet("keyring split")
+	var (
+		in        = flags.String("in", "", "keyring file to split")
+		outDir    = flags.String("out", ".", "directory to write share-<n>.txt files into, - prints them")
+		shares    = flags.Int("n", 5, "number of shares")
+		threshold = flags.Int("k", 3, "shares needed to combine")
+	)
+	ge.Throw(flags.Parse(args))
+
+	data := ge.Must(os.ReadFile(*in))
+	defer clear(data)
+
+	// refuse to split something which would not load as a keyring afterwards
+	ge.Must(crypto.ParseKeyRing(data))
+
+	split := ge.Must(crypto.SplitSecret(data, *shares, *threshold))
+
+	for _, share := range split {
+		if *outDir == "-" {
+			ge.Must(fmt.Println(share.String()))
+			continue
+		}
+		path := filepath.Join(*outDir, fmt.Sprintf("share-%d.txt", share.X))
+		content := fmt.Sprintf("# cpg keyring share %d of %d, any %d combine the keyring\n%s\n", share.X, *shares, *threshold, share.String())
+		ge.Throw(os.WriteFile(path, []byte(content), 0600))
+		slog.Info("share written", slog.String("path", path))
+	}
+
+	slog.Warn("hand each share to a different custodian and remove the keyring file", slog.String("keyring", *in))
+}
+
+func keyringCombine(ctx context.Context, args []string) {
+	flags := newFlagSet("keyring combine")
+	out := flags.String("out", "", "keyring file to write, required")
+	ge.Throw(flags.Parse(args))
+	ge.Assert(*out != "", ge.New("no output file, use -out"))
+
+	sources := flags.Args()
+	if len(sources) == 0 {
+		sources = []string{crypto.ShareSourceTTY}
+	}
+
+	shares := ge.Must(crypto.CollectShares(sources))
+	data := ge.Must(crypto.CombineShares(shares))
+	defer clear(data)
+
+	keyring := ge.Must(crypto.ParseKeyRing(data))
+
+	ge.Throw(os.WriteFile(*out, data, 0600))
+	slog.Info("keyring combined", slog.String("path", *out), slog.Int("keys", keyring.Size()), slog.String("primary", keyring.PrimaryID().String()))
+}
+
+func keyringGenerate(ctx context.Context, args []string) {
+	flags := newFlagSet("keyring generate")
+	ge.Throw(flags.Parse(args))
+	ge.Must(fmt.Println(crypto.HKDFPrefix + hex.EncodeToString(crypto.ReadN(nil, 32))))
+}
+
+func keyringRotate(ctx context.Context, args []string) {
+	flags := newFlagSet("keyring rotate")
+	var oldKeyrings stringsFlag
+	var (
+		pgURI       = flags.String("pg", os.Getenv("PG_URI"), "postgres uri")
+		saltKeyring = flags.String("salt-keyring", os.Getenv("SALT_KEYRING"), "salt keyring file, its first key is the new primary key")
+		after       = flags.String("after", "", "resume after this invoice id, overrides the state file")
+		stateFile   = flags.String("state", "keyring-rotate.state", "file to keep the last rotated invoice id in")
+		batchSize   = flags.Int("batch", 500, "invoices per batch")
+		verifyOnly  = flags.Bool("verify", false, "only report the invoices which are not under the primary key")
+	)
+	flags.Var(&oldKeyrings, "old-keyring", "extra keyring file to decrypt with, repeatable")
+	ge.Throw(flags.Parse(args))
+
+	keyring := ge.Must(crypto.LoadKeyRingFromFile(*saltKeyring))
+	for _, path := range oldKeyrings {
+		keyring = keyring.Merge(ge.Must(crypto.LoadKeyRingFromFile(path)))
+	}
+
+	dbClient := openDB(ctx, *pgURI)
+	defer func() { _ = dbClient.Close() }()
+	db := cpg.NewDB(dbClient)
+
+	resumeAfter := *after
+	if resumeAfter == "" && !*verifyOnly {
+		data, err := os.ReadFile(*stateFile)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			ge.Throw(err)
+		}
+		resumeAfter = strings.TrimSpace(string(data))
+	}
+	if resumeAfter != "" {
+		slog.Info("resuming rotation", slog.String("after", resumeAfter))
+	}
+
+	progress, err := cpg.RotateSaltKeyring(ctx, db, keyring, cpg.RotateSaltKeyringParams{
+		After:      resumeAfter,
+		BatchSize:  *batchSize,
+		VerifyOnly: *verifyOnly,
+		Progress: func(progress cpg.RotateSaltKeyringProgress) {
+			if !*verifyOnly {
+				ge.Throw(os.WriteFile(*stateFile, []byte(progress.LastID), 0600))
+			}
+			slog.Info("progress",
+				slog.Int("processed", progress.Processed),
+				slog.Int("total", progress.Total),
+				slog.Int("rotated", progress.Rotated),
+				slog.Int("current", progress.Current),
+				slog.Int("stale", progress.Stale),
+				slog.Int("undecryptable", progress.Undecryptable),
+			)
+		},
+	})
+	ge.Throw(err)
+
+	if *verifyOnly {
+		slog.Info("every invoice salt is under the primary key", slog.Int("invoices", progress.Processed))
+		return
+	}
+
+	slog.Info("rotation done, verifying", slog.Int("rotated", progress.Rotated))
+
+	// a salt inserted under an old key while rotating is caught here
+	progress, err = cpg.RotateSaltKeyring(ctx, db, keyring, cpg.RotateSaltKeyringParams{BatchSize: *batchSize, VerifyOnly: true})
+	ge.Throw(err)
+
+	_ = os.Remove(*stateFile)
+	slog.Info("every invoice salt is under the primary key, old keys can be retired", slog.Int("invoices", progress.Processed))
+}
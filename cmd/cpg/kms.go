@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/crypto"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+)
+
+func init() {
+	register("kms standin", "serve a local vault transit stand-in backed by a keyring file", kmsStandIn)
+}
+
+func kmsStandIn(ctx context.Context, args []string) {
+	flags := newFlagSet("kms standin")
+	var (
+		listen  = flags.String("listen", "127.0.0.1:8200", "listen address")
+		keyring = flags.String("keyring", os.Getenv("SALT_KEYRING"), "keyring file the stand-in encrypts with")
+		mount   = flags.String("mount", "transit", "transit mount path")
+		token   = flags.String("token", os.Getenv("VAULT_TOKEN"), "token clients must send")
+	)
+	ge.Throw(flags.Parse(args))
+	ge.Assert(*token != "", ge.New("empty token, use -token or VAULT_TOKEN"))
+
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           crypto.NewTransitStandIn(ge.Must(crypto.LoadKeyRingFromFile(*keyring)), *mount, *token),
+		ReadHeaderTimeout: time.Second * 5,
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+
+	slog.Warn("serving a transit stand-in, do not use it in production", slog.String("addr", *listen))
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		ge.Throw(err)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/cpg"
+	"github.com/itsabgr/ge"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+)
+
+func init() {
+	register("ledger-export", "write the ledger entries of an asset as csv or json lines for an accounting system", exportLedger)
+}
+
+func exportLedger(ctx context.Context, args []string) {
+	flags := newFlagSet("ledger-export")
+	var (
+		pgURI     = flags.String("pg", os.Getenv("PG_URI"), "postgres uri")
+		asset     = flags.String("asset", "", "asset name, or asset name/token contract for rescued tokens")
+		since     = flags.String("since", "", "only export entries posted since this rfc3339 time")
+		until     = flags.String("until", "", "only export entries posted before this rfc3339 time")
+		format    = flags.String("format", cpg.LedgerExportCSV, "csv or json")
+		out       = flags.String("out", "-", "file to write, - writes to stdout")
+		batchSize = flags.Int("batch", 500, "entries per query")
+	)
+	ge.Throw(flags.Parse(args))
+
+	var sinceTime, untilTime time.Time
+	if *since != "" {
+		sinceTime = ge.Must(time.Parse(time.RFC3339Nano, *since))
+	}
+	if *until != "" {
+		untilTime = ge.Must(time.Parse(time.RFC3339Nano, *until))
+	}
+
+	dbClient := openDB(ctx, *pgURI)
+	defer func() { _ = dbClient.Close() }()
+
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		file := ge.Must(os.OpenFile(*out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600))
+		defer func() { ge.Throw(file.Close()) }()
+		w = file
+	}
+
+	exported, err := cpg.ExportLedger(ctx, cpg.NewDB(dbClient), w, cpg.ExportLedgerParams{
+		Asset:     *asset,
+		Since:     sinceTime,
+		Until:     untilTime,
+		Format:    *format,
+		BatchSize: *batchSize,
+		Progress: func(exported int) {
+			slog.Info("progress", slog.Int("exported", exported))
+		},
+	})
+	ge.Throw(err)
+
+	slog.Info("ledger exported", slog.String("asset", *asset), slog.Int("entries", exported))
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"cpg/internal/daemon"
+	"cpg/pkg/ent/database"
+	"flag"
+	"fmt"
+	"github.com/itsabgr/ge"
+	_ "github.com/lib/pq"
+	"os"
+	"slices"
+	"strings"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, args []string)
+}
+
+var commands []command
+
+func register(name, usage string, run func(ctx context.Context, args []string)) {
+	commands = append(commands, command{name: name, usage: usage, run: run})
+}
+
+func usage() {
+	_, _ = fmt.Fprintf(os.Stderr, "usage: cpg <command> [flags]\n\ncommands:\n")
+	for _, cmd := range commands {
+		_, _ = fmt.Fprintf(os.Stderr, "  %-20s %s\n", cmd.name, cmd.usage)
+	}
+}
+
+func main() {
+	daemon.Run(func(ctx context.Context, _ struct{}) {
+		args := os.Args[1:]
+		for n := min(len(args), 2); n > 0; n-- {
+			name := strings.Join(args[:n], " ")
+			if idx := slices.IndexFunc(commands, func(cmd command) bool { return cmd.name == name }); idx >= 0 {
+				commands[idx].run(ctx, args[n:])
+				return
+			}
+		}
+		usage()
+		os.Exit(2)
+	})
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet("cpg "+name, flag.ExitOnError)
+}
+
+func openDB(ctx context.Context, uri string) *database.Client {
+	ge.Assert(uri != "", ge.New("no postgres uri, use -pg or PG_URI"))
+	client := ge.Must(database.Open("postgres", uri))
+	if daemon.Debug() {
+		client = client.Debug()
+	}
+	ge.Throw(client.Schema.Create(ctx))
+	return client
+}
+
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/backupcode"
+	"cpg/pkg/cpg"
+	"encoding/json"
+	"github.com/itsabgr/ge"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+func init() {
+	register("sign", "sign a sweep bundle of a cold checkout on an air-gapped host, the assets config needs no eth endpoint", sign)
+}
+
+func sign(ctx context.Context, args []string) {
+	flags := newFlagSet("sign")
+	var saltKeyrings, backupShares stringsFlag
+	var (
+		assetsConfig  = flags.String("assets", os.Getenv("ASSETS_CONFIG"), "assets config file")
+		backupKeyring = flags.String("backup-keyring", os.Getenv("BACKUP_KEYRING"), "backup keyring file")
+		in            = flags.String("in", "-", "sweep bundle json or qr part texts file, - reads from stdin")
+		out           = flags.String("out", "-", "signed bundle file to write, - writes to stdout")
+		format        = flags.String("format", "json", "json or qr, qr writes the bundle as qr part texts, one per line")
+		partSize      = flags.Int("part-size", backupcode.DefaultQRPartSize, "bundle bytes per qr part")
+		dryRun        = flags.Bool("dry-run", false, "check and sign the bundle without writing it")
+	)
+	flags.Var(&saltKeyrings, "salt-keyring", "salt keyring file, repeatable, the salt backend configured by env is used without any")
+	flags.Var(&backupShares, "backup-keyring-share", "backup keyring share file or tty to combine the keyring from, repeatable")
+	ge.Throw(flags.Parse(args))
+	ge.Assert(*format == "json" || *format == "qr", ge.Detail(ge.New("unknown bundle format"), ge.D{"format": *format}))
+
+	var data []byte
+	if *in == "-" {
+		data = ge.Must(io.ReadAll(os.Stdin))
+	} else {
+		data = ge.Must(os.ReadFile(*in))
+	}
+
+	assets := loadAssets(ctx, *assetsConfig)
+	saltCipher, saltKeyring := loadSaltCipher(ctx, saltKeyrings)
+	keyring, saltCipher := withLegacyKeys(loadBackupKeyring(*backupKeyring, backupShares), saltCipher, saltKeyring)
+
+	bundle, err := cpg.SignSweepBundle(ctx, assets, keyring, saltCipher, data)
+	ge.Throw(err)
+
+	slog.Info("sweep bundle signed",
+		slog.String("invoice", bundle.InvoiceID),
+		slog.String("asset", bundle.Asset),
+		slog.String("wallet", bundle.WalletAddress),
+		slog.String("to", bundle.To),
+		slog.String("amount", amountString(bundle.Amount)),
+		slog.String("fee", amountString(bundle.Fee)),
+		slog.String("fee_to", bundle.FeeTo),
+		slog.String("platform_fee", amountString(bundle.PlatformFee)),
+	)
+	for _, leg := range bundle.SplitLegs {
+		slog.Info("split leg signed", slog.String("invoice", bundle.InvoiceID), slog.Int("share", leg.Share), slog.String("to", leg.To), slog.String("amount", amountString(leg.Amount)))
+	}
+	if *dryRun {
+		slog.Info("dry run, the signed bundle is not written")
+		return
+	}
+
+	signed := ge.Must(json.Marshal(bundle))
+	if *format == "qr" {
+		signed = []byte(strings.Join(backupcode.EncodeQR(signed, *partSize), "\n") + "\n")
+	}
+
+	if *out == "-" {
+		ge.Must(os.Stdout.Write(signed))
+		return
+	}
+	ge.Throw(os.WriteFile(*out, signed, 0600))
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/cpg"
+	"cpg/pkg/crypto"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"os"
+)
+
+func init() {
+	register("signer mac", "sum the signer mac of the invoices prepared before the signer or recovered from backups taken before it", signerMAC)
+}
+
+func signerMAC(ctx context.Context, args []string) {
+	flags := newFlagSet("signer mac")
+	var saltKeyrings stringsFlag
+	var (
+		pgURI             = flags.String("pg", os.Getenv("PG_URI"), "postgres uri of a role which can update invoices")
+		assetsConfig      = flags.String("assets", os.Getenv("ASSETS_CONFIG"), "assets config file")
+		macKeyring        = flags.String("mac-keyring", os.Getenv("SIGNER_MAC_KEYRING"), "signer mac keyring file")
+		legacySaltKeyring = flags.String("legacy-salt-keyring", os.Getenv("LEGACY_SALT_KEYRING"), "backup keyring file of the service, it opens the salts it sealed before the keyrings were unswapped")
+		after             = flags.String("after", "", "resume after this invoice id")
+		batchSize         = flags.Int("batch", 500, "invoices per batch")
+	)
+	flags.Var(&saltKeyrings, "salt-keyring", "salt keyring file, repeatable, the salt backend configured by env is used without any")
+	ge.Throw(flags.Parse(args))
+	ge.Assert(*macKeyring != "", ge.New("no mac keyring, use -mac-keyring or SIGNER_MAC_KEYRING"))
+
+	assets := loadAssets(ctx, *assetsConfig)
+	saltCipher, _ := loadSaltCipher(ctx, saltKeyrings)
+	if *legacySaltKeyring != "" {
+		saltCipher = crypto.NewFallbackCipher(saltCipher, crypto.NewKeyRingCipher(ge.Must(crypto.LoadKeyRingFromFile(*legacySaltKeyring))))
+	}
+	macKR := ge.Must(crypto.LoadKeyRingFromFile(*macKeyring))
+
+	dbClient := openDB(ctx, *pgURI)
+	defer func() { _ = dbClient.Close() }()
+
+	signer := cpg.NewLocalSigner(assets, cpg.NewDB(dbClient), saltCipher, macKR, nil)
+	progress, err := signer.BackfillSignerMACs(ctx, cpg.BackfillSignerMACsParams{
+		After:     *after,
+		BatchSize: *batchSize,
+		Progress: func(progress cpg.BackfillSignerMACsProgress) {
+			slog.Info("progress",
+				slog.Int("processed", progress.Processed),
+				slog.Int("summed", progress.Summed),
+				slog.Int("mismatched", progress.Mismatched),
+				slog.String("last", progress.LastID),
+			)
+		},
+	})
+	ge.Throw(err)
+
+	slog.Info("every invoice has a signer mac", slog.Int("summed", progress.Summed))
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"cpg/internal/assets/eth"
+	"cpg/pkg/cpg"
+	"cpg/pkg/crypto"
+	"github.com/caarlos0/env/v11"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+)
+
+func init() {
+	register("sweep", "move invoice wallet balances from backups without the database, use -dry-run first", sweep)
+}
+
+func loadAssets(ctx context.Context, path string) *cpg.Assets {
+	ge.Assert(path != "", ge.New("no assets config, use -assets or ASSETS_CONFIG"))
+	cpg.RegisterAssetFactory(eth.Factory{})
+	return ge.Must(cpg.ParseAssetsConfig(ctx, ge.Must(os.ReadFile(path))))
+}
+
+// loadSaltCipher merges the given salt keyring files, without any it opens the salt backend configured by env like the service does,
+// the salt keyring is nil for kms backends
+func loadSaltCipher(ctx context.Context, paths []string) (crypto.Cipher, *crypto.KeyRing) {
+	if len(paths) == 0 {
+		var config crypto.SaltBackendConfig
+		ge.Throw(env.Parse(&config))
+		cipher, keyring, err := config.Open(ctx)
+		ge.Throw(err)
+		return cipher, keyring
+	}
+	keyring := ge.Must(crypto.LoadKeyRingFromFile(paths[0]))
+	for _, path := range paths[1:] {
+		keyring = keyring.Merge(ge.Must(crypto.LoadKeyRingFromFile(path)))
+	}
+	return crypto.NewKeyRingCipher(keyring), keyring
+}
+
+// withLegacyKeys opens what was sealed before the keyrings were unswapped, backups by the salt keys and salts by the backup keys
+func withLegacyKeys(backupKeyring *crypto.KeyRing, saltCipher crypto.Cipher, saltKeyring *crypto.KeyRing) (*crypto.KeyRing, crypto.Cipher) {
+	saltCipher = crypto.NewFallbackCipher(saltCipher, crypto.NewKeyRingCipher(backupKeyring))
+	if saltKeyring != nil {
+		backupKeyring = backupKeyring.Merge(saltKeyring)
+	}
+	return backupKeyring, saltCipher
+}
+
+func sweep(ctx context.Context, args []string) {
+	flags := newFlagSet("sweep")
+	var saltKeyrings, backupShares stringsFlag
+	var (
+		assetsConfig  = flags.String("assets", os.Getenv("ASSETS_CONFIG"), "assets config file")
+		backupKeyring = flags.String("backup-keyring", os.Getenv("BACKUP_KEYRING"), "backup keyring file")
+		dir           = flags.String("dir", "", "directory of invoice backup files, in addition to the backup files given as arguments")
+		to            = flags.String("to", "", "safe address to sweep every wallet to instead of each invoice destination")
+		dryRun        = flags.Bool("dry-run", false, "show balances and sign the sweep txs without sending them")
+	)
+	flags.Var(&saltKeyrings, "salt-keyring", "salt keyring file, repeatable, the salt backend configured by env is used without any")
+	flags.Var(&backupShares, "backup-keyring-share", "backup keyring share file or tty to combine the keyring from, repeatable")
+	ge.Throw(flags.Parse(args))
+
+	paths := flags.Args()
+	if *dir != "" {
+		entries := ge.Must(os.ReadDir(*dir))
+		for _, entry := range entries {
+			if entry.Type().IsRegular() {
+				paths = append(paths, filepath.Join(*dir, entry.Name()))
+			}
+		}
+	}
+	ge.Assert(len(paths) > 0, ge.New("no backup file"))
+
+	assets := loadAssets(ctx, *assetsConfig)
+	saltCipher, saltKeyring := loadSaltCipher(ctx, saltKeyrings)
+	keyring, saltCipher := withLegacyKeys(loadBackupKeyring(*backupKeyring, backupShares), saltCipher, saltKeyring)
+
+	if *dryRun {
+		slog.Info("dry run, no tx is sent")
+	}
+
+	failed := 0
+	for _, path := range paths {
+		if ctx.Err() != nil {
+			break
+		}
+		backup, err := os.ReadFile(path)
+		var result cpg.EmergencySweepResult
+		if err == nil {
+			result, err = cpg.EmergencySweep(ctx, assets, keyring, saltCipher, backup, cpg.EmergencySweepParams{
+				To:     *to,
+				DryRun: *dryRun,
+			})
+		}
+		attrs := []any{
+			slog.String("backup", path),
+			slog.String("invoice", result.InvoiceID),
+			slog.String("asset", result.Asset),
+			slog.String("wallet", result.WalletAddress),
+			slog.String("status", result.Status.String()),
+			slog.Bool("inferred_fill", result.InferredFill),
+			slog.String("balance", amountString(result.Sweep.Balance)),
+			slog.String("to", result.Destination),
+			slog.String("amount", amountString(result.Sweep.Amount)),
+			slog.String("fee", amountString(result.Sweep.Fee)),
+			slog.String("tx", result.Sweep.TxHash),
+			slog.String("platform_fee", amountString(result.Sweep.PlatformFee)),
+			slog.String("fee_tx", result.Sweep.FeeTxHash),
+		}
+		if err != nil {
+			failed++
+			slog.Error("sweep failed", append(attrs, slog.String("error", err.Error()))...)
+			continue
+		}
+		slog.Info("swept", attrs...)
+		for _, leg := range result.Sweep.Split {
+			slog.Info("split leg", slog.String("invoice", result.InvoiceID), slog.Int("share", leg.Share), slog.String("to", leg.To), slog.String("amount", amountString(leg.Amount)), slog.String("tx", leg.TxHash))
+		}
+	}
+
+	ge.Assert(failed == 0, ge.Detail(ge.New("some backups failed"), ge.D{"failed": failed, "total": len(paths)}))
+}
+
+func amountString(amount *big.Int) string {
+	if amount == nil {
+		return ""
+	}
+	return amount.String()
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"cpg/pkg/backupcode"
+	"cpg/pkg/proto"
+	"encoding/base64"
+	"fmt"
+	"github.com/itsabgr/ge"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func cmdBackupPrint(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("backup-print")
+	backupFile := fs.String("backup", "", "raw, base64, words or qr text invoice backup file")
+	format := fs.String("format", "words", "words or qr")
+	pngDir := fs.String("png", "", "write qr parts as png images into this directory instead of printing them")
+	partSize := fs.Int("part-size", backupcode.DefaultQRPartSize, "backup bytes per qr part")
+	ge.Throw(fs.Parse(args))
+
+	data, err := os.ReadFile(*backupFile)
+	if err != nil {
+		return err
+	}
+	backup, err := decodeBackup(data)
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "words":
+		_, err = fmt.Fprint(a.out.w, backupcode.EncodeWords(backup))
+		return err
+	case "qr":
+	default:
+		return ge.Detail(ge.New("unknown backup format"), ge.D{"format": *format})
+	}
+
+	parts := backupcode.EncodeQR(backup, *partSize)
+	for i, part := range parts {
+		if *pngDir != "" {
+			image, err := backupcode.QRPNG(part, 512)
+			if err != nil {
+				return err
+			}
+			path := filepath.Join(*pngDir, fmt.Sprintf("backup-%02d-of-%02d.png", i+1, len(parts)))
+			if err = os.WriteFile(path, image, 0600); err != nil {
+				return err
+			}
+			a.out.print(record{{"part", i + 1}, {"parts", len(parts)}, {"path", path}})
+			continue
+		}
+		code, err := backupcode.QRTerminal(part)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(a.out.w, "part %d of %d\n%s\n", i+1, len(parts), code)
+	}
+	return nil
+}
+
+// cmdBackupScan joins a word list or the texts of scanned qr parts, e.g. from zbarimg --raw, back into the raw backup
+func cmdBackupScan(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("backup-scan")
+	out := fs.String("out", "", "write the raw backup to this file instead of printing it as base64")
+	ge.Throw(fs.Parse(args))
+
+	var text []byte
+	if fs.NArg() == 0 || (fs.NArg() == 1 && fs.Arg(0) == "-") {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		text = data
+	} else {
+		for _, path := range fs.Args() {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			text = append(append(text, data...), '\n')
+		}
+	}
+
+	backup, err := backupcode.Decode(text)
+	if err != nil {
+		return err
+	}
+
+	if *out != "" {
+		return os.WriteFile(*out, backup, 0600)
+	}
+	a.out.print(record{{"invoice_backup", base64.StdEncoding.EncodeToString(backup)}})
+	return nil
+}
+
+type backupItem struct {
+	id     string
+	backup []byte
+	err    error
+}
+
+// readBackupItems reads backup files named after their invoice id from dir, or lines of invoice id and backup from stdin
+func readBackupItems(dir string) ([]backupItem, error) {
+	var items []backupItem
+	if dir != "" {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			item := backupItem{id: strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))}
+			data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+			if err == nil {
+				item.backup, err = decodeBackup(data)
+			}
+			item.err = err
+			items = append(items, item)
+		}
+		return items, nil
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, backup, ok := strings.Cut(line, " ")
+		item := backupItem{id: id, err: ge.New("expected a line of invoice id and base64 backup")}
+		if ok {
+			item.backup, item.err = decodeBackup([]byte(backup))
+		}
+		items = append(items, item)
+	}
+	return items, scanner.Err()
+}
+
+func cmdRecoverBatch(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("recover-batch")
+	dir := fs.String("dir", "", "directory of backup files named <invoice id>.<any extension>, stdin lines of invoice id and backup otherwise")
+	ge.Throw(fs.Parse(args))
+
+	items, err := readBackupItems(*dir)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	var valid []backupItem
+	for _, item := range items {
+		if item.err != nil {
+			failed++
+			a.out.print(record{{"invoice_id", item.id}, {"result", "failed"}, {"error", item.err.Error()}})
+			continue
+		}
+		valid = append(valid, item)
+	}
+
+	// the stream is not bound by the per call timeout as it lasts as long as the batch
+	stream, err := a.client.RecoverInvoices(ctx)
+	if err != nil {
+		return err
+	}
+
+	sendErr := make(chan error, 1)
+	go func() {
+		for _, item := range valid {
+			if err := stream.Send(&proto.RecoverInvoiceInput{InvoiceId: item.id, InvoiceBackup: item.backup}); err != nil {
+				sendErr <- err
+				return
+			}
+		}
+		sendErr <- stream.CloseSend()
+	}()
+
+	for range valid {
+		out, err := stream.Recv()
+		if err != nil {
+			return ge.Join(err, <-sendErr)
+		}
+		rec := record{
+			{"invoice_id", out.GetInvoiceId()},
+			{"result", recoverOutcomeName(out.GetOutcome())},
+			{"wallet_address", out.GetWalletAddress()},
+		}
+		if out.GetOutcome() != proto.RecoverOutcome_RECOVER_OUTCOME_FAILED {
+			rec = append(rec, field{"status", statusName(out.GetStatus())})
+		}
+		if out.GetError() != "" {
+			failed++
+			rec = append(rec, field{"error", out.GetError()})
+		}
+		a.out.print(rec)
+	}
+	if err = <-sendErr; err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return ge.Detail(ge.New("some items failed"), ge.D{"failed": failed, "total": len(items)})
+	}
+	return nil
+}
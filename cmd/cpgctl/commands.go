@@ -0,0 +1,528 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/backupcode"
+	"cpg/pkg/cpg"
+	"cpg/pkg/proto"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/itsabgr/ge"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		for _, cmd := range commands {
+			if cmd.name == name {
+				_, _ = fmt.Fprintf(fs.Output(), "usage: cpgctl %s\n", cmd.usage)
+			}
+		}
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func cmdPing(ctx context.Context, a *app, args []string) error {
+	ctx, cancel := a.call(ctx)
+	defer cancel()
+	start := time.Now()
+	pong, err := a.client.Ping(ctx, &proto.PingInput{Ping: "cpgctl"})
+	if err != nil {
+		return err
+	}
+	a.out.print(record{
+		{"server_time", timestampValue(pong.GetNow())},
+		{"rtt", time.Since(start).String()},
+	})
+	return nil
+}
+
+func cmdListAssets(ctx context.Context, a *app, args []string) error {
+	ctx, cancel := a.call(ctx)
+	defer cancel()
+	list, err := a.client.ListAssets(ctx, &empty.Empty{})
+	if err != nil {
+		return err
+	}
+	a.assets = list.GetAssets()
+	for name, info := range list.GetAssets() {
+		a.out.print(record{
+			{"asset", name},
+			{"symbol", info.GetSymbol()},
+			{"decimals", info.GetDecimals()},
+			{"min_delay", info.GetMinDelay().AsDuration().String()},
+			{"watch_only", info.GetWatchOnly()},
+		})
+	}
+	return nil
+}
+
+func parseDeadline(str string) (time.Time, error) {
+	if d, err := time.ParseDuration(str); err == nil {
+		return time.Now().Add(d), nil
+	}
+	return time.Parse(time.RFC3339, str)
+}
+
+func cmdCreate(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("create")
+	var (
+		asset        = fs.String("asset", "", "asset name")
+		amount       = fs.String("amount", "", "min amount in asset units, e.g. 1.25")
+		amountRaw    = fs.String("amount-raw", "", "min amount in the smallest asset unit")
+		recipient    = fs.String("recipient", "", "recipient address")
+		beneficiary  = fs.String("beneficiary", "", "beneficiary address")
+		deadline     = fs.String("deadline", "24h", "deadline as a duration from now or rfc3339 time")
+		metadata     = fs.String("metadata", "", "invoice metadata")
+		autoCheckout = fs.Bool("auto-checkout", false, "checkout automatically once filled, canceled or expired")
+		backupOut    = fs.String("backup-out", "", "write the raw invoice backup to this file")
+		escrow       = fs.Duration("escrow", 0, "hold the filled invoice in escrow until released, refunded or this period passed")
+		split        = fs.String("split", "", "split the payment as address=basis_points,..., the first address is the recipient")
+		splitGas     = fs.String("split-gas", "", "who bears the tx fees of a split: proportional, each or first")
+	)
+	ge.Throw(fs.Parse(args))
+
+	at, err := parseDeadline(*deadline)
+	if err != nil {
+		return ge.Wrap(ge.New("invalid deadline"), err)
+	}
+
+	minAmount, err := a.rawAmount(ctx, *asset, *amount, *amountRaw)
+	if err != nil {
+		return err
+	}
+
+	callCtx, cancel := a.call(ctx)
+	defer cancel()
+	input := &proto.CreateInvoiceInput{
+		AssetName:    *asset,
+		Metadata:     *metadata,
+		Recipient:    *recipient,
+		Beneficiary:  *beneficiary,
+		AutoCheckout: *autoCheckout,
+		MinAmount:    minAmount,
+		Deadline:     timestamppb.New(at),
+	}
+	if *escrow > 0 {
+		input.EscrowPeriod = durationpb.New(*escrow)
+	}
+	if *split != "" {
+		if input.Split, err = parseSplit(*split); err != nil {
+			return err
+		}
+		input.SplitGasRule = *splitGas
+	}
+	created, err := a.client.CreateInvoice(callCtx, input)
+	if err != nil {
+		return err
+	}
+
+	if *backupOut != "" {
+		if err = os.WriteFile(*backupOut, created.GetInvoiceBackup(), 0600); err != nil {
+			return ge.Wrap(ge.New("failed to write backup"), err)
+		}
+	}
+
+	rec, err := a.getInvoice(ctx, created.GetInvoiceId())
+	if err != nil {
+		rec = record{{"invoice_id", created.GetInvoiceId()}}
+	}
+	rec = append(rec, field{"invoice_backup", base64.StdEncoding.EncodeToString(created.GetInvoiceBackup())})
+	if created.GetEscrowBuyerKey() != "" {
+		rec = append(rec, field{"escrow_buyer_key", created.GetEscrowBuyerKey()})
+	}
+	a.out.print(rec)
+	return nil
+}
+
+func (a *app) getInvoice(ctx context.Context, id string) (record, error) {
+	ctx, cancel := a.call(ctx)
+	defer cancel()
+	inv, err := a.client.GetInvoice(ctx, &proto.GetInvoiceInput{InvoiceId: id})
+	if err != nil {
+		return nil, err
+	}
+	return record{
+		{"invoice_id", id},
+		{"status", statusName(inv.GetStatus())},
+		{"asset", inv.GetAsset()},
+		{"amount", a.formatAmount(ctx, inv.GetAsset(), inv.GetMinAmount())},
+		{"min_amount", inv.GetMinAmount()},
+		{"wallet_address", inv.GetWalletAddress()},
+		{"recipient", inv.GetRecipient()},
+		{"beneficiary", inv.GetBeneficiary()},
+		{"metadata", inv.GetMetadata()},
+		{"auto_checkout", inv.GetAutoCheckout()},
+		{"create_at", timestampValue(inv.GetCreateAt())},
+		{"deadline", timestampValue(inv.GetDeadline())},
+		{"fill_at", timestampValue(inv.GetFillAt())},
+		{"cancel_at", timestampValue(inv.GetCancelAt())},
+		{"checkout_request_at", timestampValue(inv.GetCheckoutRequestAt())},
+		{"last_checkout_at", timestampValue(inv.GetLastCheckoutAt())},
+		{"approval_request_at", timestampValue(inv.GetApprovalRequestAt())},
+		{"approve_at", timestampValue(inv.GetApproveAt())},
+		{"escrow_period", inv.GetEscrowPeriod().AsDuration().String()},
+		{"auto_release_at", timestampValue(inv.GetAutoReleaseAt())},
+		{"release_at", timestampValue(inv.GetReleaseAt())},
+		{"refund_at", timestampValue(inv.GetRefundAt())},
+		{"dispute_at", timestampValue(inv.GetDisputeAt())},
+		{"fee_address", inv.GetFeeAddress()},
+		{"fee_basis_points", inv.GetFeeBasisPoints()},
+		{"fee_flat", inv.GetFeeFlat()},
+		{"fee_amount", inv.GetFeeAmount()},
+		{"fee_tx_hash", inv.GetFeeTxHash()},
+		{"split", splitValue(inv.GetSplit())},
+		{"split_gas_rule", inv.GetSplitGasRule()},
+		{"treasury_address", inv.GetTreasuryAddress()},
+	}, nil
+}
+
+func parseSplit(str string) ([]*proto.SplitShare, error) {
+	var split []*proto.SplitShare
+	for _, entry := range strings.Split(str, ",") {
+		address, bps, ok := strings.Cut(entry, "=")
+		basisPoints, err := strconv.ParseInt(bps, 10, 64)
+		if !ok || err != nil {
+			return nil, ge.Detail(ge.New("split entry must be address=basis_points"), ge.D{"entry": entry})
+		}
+		split = append(split, &proto.SplitShare{Address: address, BasisPoints: basisPoints})
+	}
+	return split, nil
+}
+
+// splitValue lists the split recipients as address=basis_points, followed by :amount:tx_hash of their leg once planned
+func splitValue(split []*proto.SplitShare) string {
+	entries := make([]string, len(split))
+	for i, share := range split {
+		entries[i] = share.GetAddress() + "=" + strconv.FormatInt(share.GetBasisPoints(), 10)
+		if share.GetAmount() != "" {
+			entries[i] += ":" + share.GetAmount() + ":" + share.GetTxHash()
+		}
+	}
+	return strings.Join(entries, ",")
+}
+
+func cmdGet(ctx context.Context, a *app, args []string) error {
+	return a.forEach(ctx, args, a.getInvoice)
+}
+
+func cmdCheck(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("check")
+	wallet := fs.String("wallet", "", "check by wallet address instead of invoice id")
+	ge.Throw(fs.Parse(args))
+
+	if *wallet != "" {
+		args = append(fs.Args(), "")
+	} else {
+		args = fs.Args()
+	}
+
+	return a.forEach(ctx, args, func(ctx context.Context, id string) (record, error) {
+		ctx, cancel := a.call(ctx)
+		defer cancel()
+		checked, err := a.client.CheckInvoice(ctx, &proto.CheckInvoiceInput{InvoiceId: id, WalletAddress: *wallet})
+		if err != nil {
+			return nil, err
+		}
+		return record{{"invoice_id", id}, {"wallet_address", *wallet}, {"status", statusName(checked.GetInvoiceStatus())}}, nil
+	})
+}
+
+func cmdCancel(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("cancel")
+	wallet := fs.String("wallet", "", "wallet address of the invoice, looked up when empty")
+	ge.Throw(fs.Parse(args))
+
+	return a.forEach(ctx, fs.Args(), func(ctx context.Context, id string) (record, error) {
+		walletAddress := *wallet
+		if walletAddress == "" {
+			callCtx, cancel := a.call(ctx)
+			inv, err := a.client.GetInvoice(callCtx, &proto.GetInvoiceInput{InvoiceId: id})
+			cancel()
+			if err != nil {
+				return nil, err
+			}
+			walletAddress = inv.GetWalletAddress()
+		}
+		callCtx, cancel := a.call(ctx)
+		defer cancel()
+		if _, err := a.client.CancelInvoice(callCtx, &proto.CancelInvoiceInput{InvoiceId: id, WalletAddress: walletAddress}); err != nil {
+			return nil, err
+		}
+		return record{{"invoice_id", id}, {"result", "canceled"}}, nil
+	})
+}
+
+func cmdRequestCheckout(ctx context.Context, a *app, args []string) error {
+	return a.forEach(ctx, args, func(ctx context.Context, id string) (record, error) {
+		ctx, cancel := a.call(ctx)
+		defer cancel()
+		if _, err := a.client.RequestCheckout(ctx, &proto.RequestCheckoutInput{InvoiceId: id}); err != nil {
+			return nil, err
+		}
+		return record{{"invoice_id", id}, {"result", "checkout requested"}}, nil
+	})
+}
+
+func cmdTryCheckout(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("try-checkout")
+	bundleDir := fs.String("bundle-dir", ".", "directory to write the sweep bundles of cold signed checkouts into")
+	ge.Throw(fs.Parse(args))
+
+	return a.forEach(ctx, fs.Args(), func(ctx context.Context, id string) (record, error) {
+		ctx, cancel := a.call(ctx)
+		defer cancel()
+		out, err := a.client.TryCheckoutInvoice(ctx, &proto.TryCheckoutInvoiceInput{InvoiceId: id})
+		if err != nil {
+			return nil, err
+		}
+		if len(out.GetSweepBundle()) == 0 {
+			return record{{"invoice_id", id}, {"result", "checked out"}}, nil
+		}
+		path := filepath.Join(*bundleDir, id+".sweep.json")
+		if err = os.WriteFile(path, out.GetSweepBundle(), 0600); err != nil {
+			return nil, err
+		}
+		return record{{"invoice_id", id}, {"result", "sweep bundle exported"}, {"bundle", path}}, nil
+	})
+}
+
+func cmdSubmitSweep(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("submit-sweep")
+	id := fs.String("id", "", "invoice id")
+	bundleFile := fs.String("bundle", "", "signed sweep bundle json or qr text file")
+	ge.Throw(fs.Parse(args))
+
+	bundle, err := os.ReadFile(*bundleFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.call(ctx)
+	defer cancel()
+	out, err := a.client.SubmitSweepBundle(ctx, &proto.SubmitSweepBundleInput{InvoiceId: *id, Bundle: bundle})
+	if err != nil {
+		return err
+	}
+
+	a.out.print(record{{"invoice_id", out.GetInvoiceId()}, {"result", "sweep sent"}, {"tx_hash", out.GetTxHash()}})
+	return nil
+}
+
+// keyContext adds the key read from a file to the outgoing calls under the header
+func keyContext(ctx context.Context, header, keyFile string) (context.Context, error) {
+	if keyFile == "" {
+		return nil, ge.Detail(ge.New("no key file"), ge.D{"header": header})
+	}
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.AppendToOutgoingContext(ctx, header, strings.TrimSpace(string(data))), nil
+}
+
+func cmdApprove(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("approve")
+	keyFile := fs.String("approver-key-file", os.Getenv("CPGCTL_APPROVER_KEY_FILE"), "file of the approver key")
+	note := fs.String("note", "", "note recorded with the approval")
+	ge.Throw(fs.Parse(args))
+
+	ctx, err := keyContext(ctx, cpg.ApproverKeyHeader, *keyFile)
+	if err != nil {
+		return err
+	}
+
+	return a.forEach(ctx, fs.Args(), func(ctx context.Context, id string) (record, error) {
+		ctx, cancel := a.call(ctx)
+		defer cancel()
+		out, err := a.client.ApproveCheckout(ctx, &proto.ApproveCheckoutInput{InvoiceId: id, Note: *note})
+		if err != nil {
+			return nil, err
+		}
+		return record{
+			{"invoice_id", id},
+			{"approver", out.GetApprover()},
+			{"approvals", out.GetApprovals()},
+			{"required", out.GetRequired()},
+			{"approved", out.GetApproved()},
+		}, nil
+	})
+}
+
+func cmdReject(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("reject")
+	keyFile := fs.String("approver-key-file", os.Getenv("CPGCTL_APPROVER_KEY_FILE"), "file of the approver key")
+	reason := fs.String("reason", "", "reason recorded with the rejection")
+	ge.Throw(fs.Parse(args))
+
+	ctx, err := keyContext(ctx, cpg.ApproverKeyHeader, *keyFile)
+	if err != nil {
+		return err
+	}
+
+	return a.forEach(ctx, fs.Args(), func(ctx context.Context, id string) (record, error) {
+		ctx, cancel := a.call(ctx)
+		defer cancel()
+		out, err := a.client.RejectCheckout(ctx, &proto.RejectCheckoutInput{InvoiceId: id, Reason: *reason})
+		if err != nil {
+			return nil, err
+		}
+		return record{{"invoice_id", id}, {"approver", out.GetApprover()}, {"result", "rejected"}}, nil
+	})
+}
+
+// cmdEscrow acts as an arbiter, or as the buyer when byBuyer and a buyer key file is given
+func cmdEscrow(name, result string, byBuyer bool, call func(ctx context.Context, client proto.CPGClient, input *proto.EscrowActionInput) error) func(ctx context.Context, a *app, args []string) error {
+	return func(ctx context.Context, a *app, args []string) error {
+		fs := newFlagSet(name)
+		keyFile := fs.String("arbiter-key-file", os.Getenv("CPGCTL_ARBITER_KEY_FILE"), "file of the arbiter key")
+		buyerKeyFile := new(string)
+		if byBuyer {
+			buyerKeyFile = fs.String("buyer-key-file", "", "file of the escrow buyer key, acts as the buyer instead of an arbiter")
+		}
+		reason := fs.String("reason", "", "reason recorded in the audit trail")
+		ge.Throw(fs.Parse(args))
+
+		var buyerKey string
+		if *buyerKeyFile != "" {
+			data, err := os.ReadFile(*buyerKeyFile)
+			if err != nil {
+				return err
+			}
+			buyerKey = strings.TrimSpace(string(data))
+		} else {
+			var err error
+			if ctx, err = keyContext(ctx, cpg.ArbiterKeyHeader, *keyFile); err != nil {
+				return err
+			}
+		}
+
+		return a.forEach(ctx, fs.Args(), func(ctx context.Context, id string) (record, error) {
+			ctx, cancel := a.call(ctx)
+			defer cancel()
+			if err := call(ctx, a.client, &proto.EscrowActionInput{InvoiceId: id, BuyerKey: buyerKey, Reason: *reason}); err != nil {
+				return nil, err
+			}
+			return record{{"invoice_id", id}, {"result", result}}, nil
+		})
+	}
+}
+
+var (
+	cmdRelease = cmdEscrow("release", "released", true, func(ctx context.Context, client proto.CPGClient, input *proto.EscrowActionInput) error {
+		_, err := client.ReleaseEscrow(ctx, input)
+		return err
+	})
+	cmdRefund = cmdEscrow("refund", "refunded", false, func(ctx context.Context, client proto.CPGClient, input *proto.EscrowActionInput) error {
+		_, err := client.RefundEscrow(ctx, input)
+		return err
+	})
+	cmdDispute = cmdEscrow("dispute", "disputed", true, func(ctx context.Context, client proto.CPGClient, input *proto.EscrowActionInput) error {
+		_, err := client.DisputeEscrow(ctx, input)
+		return err
+	})
+)
+
+// decodeBackup accepts a backup as raw bytes, base64, a word list or qr part texts
+func decodeBackup(data []byte) ([]byte, error) {
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data))); err == nil {
+		return decoded, nil
+	}
+	return backupcode.Decode(data)
+}
+
+func cmdRecover(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("recover")
+	id := fs.String("id", "", "invoice id")
+	backupFile := fs.String("backup", "", "raw, base64, words or qr text invoice backup file")
+	ge.Throw(fs.Parse(args))
+
+	recoverOne := func(ctx context.Context, id string, backup []byte) (record, error) {
+		ctx, cancel := a.call(ctx)
+		defer cancel()
+		if _, err := a.client.RecoverInvoice(ctx, &proto.RecoverInvoiceInput{InvoiceId: id, InvoiceBackup: backup}); err != nil {
+			return nil, err
+		}
+		return record{{"invoice_id", id}, {"result", "recovered"}}, nil
+	}
+
+	if *id != "" || *backupFile != "" {
+		data, err := os.ReadFile(*backupFile)
+		if err != nil {
+			return err
+		}
+		backup, err := decodeBackup(data)
+		if err != nil {
+			return err
+		}
+		rec, err := recoverOne(ctx, *id, backup)
+		if err != nil {
+			return err
+		}
+		a.out.print(rec)
+		return nil
+	}
+
+	return a.forEach(ctx, fs.Args(), func(ctx context.Context, line string) (record, error) {
+		id, backup, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, ge.New("expected a line of invoice id and base64 backup")
+		}
+		decoded, err := decodeBackup([]byte(backup))
+		if err != nil {
+			return nil, err
+		}
+		return recoverOne(ctx, id, decoded)
+	})
+}
+
+func cmdVerifyBackup(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("verify-backup")
+	id := fs.String("id", "", "invoice id")
+	backupFile := fs.String("backup", "", "raw, base64, words or qr text invoice backup file")
+	ge.Throw(fs.Parse(args))
+
+	data, err := os.ReadFile(*backupFile)
+	if err != nil {
+		return err
+	}
+
+	backup, err := decodeBackup(data)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.call(ctx)
+	defer cancel()
+	out, err := a.client.VerifyBackup(ctx, &proto.VerifyBackupInput{InvoiceId: *id, InvoiceBackup: backup})
+	if err != nil {
+		return err
+	}
+
+	a.out.print(record{
+		{"invoice_id", *id},
+		{"format", out.GetFormat()},
+		{"key_id", out.GetKeyId()},
+		{"asset", out.GetAsset()},
+		{"min_amount", a.formatAmount(ctx, out.GetAsset(), out.GetMinAmount())},
+		{"recipient", out.GetRecipient()},
+		{"beneficiary", out.GetBeneficiary()},
+		{"create_at", timestampValue(out.GetCreateAt())},
+		{"deadline", timestampValue(out.GetDeadline())},
+		{"wallet_address", out.GetWalletAddress()},
+		{"address_matches", out.GetAddressMatches()},
+		{"exists", out.GetExists()},
+	})
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/proto"
+	"github.com/itsabgr/ge"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func cmdLedgerBalances(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("ledger-balances")
+	var (
+		asset  = fs.String("asset", "", "asset name, or asset name/token contract for rescued tokens")
+		at     = fs.String("at", "", "balances at this rfc3339 time, default now")
+		prefix = fs.String("prefix", "", "account name prefix, e.g. wallet or recipient:0x...")
+	)
+	ge.Throw(fs.Parse(args))
+
+	input := &proto.GetLedgerBalancesInput{Asset: *asset, Prefix: *prefix}
+	if *at != "" {
+		t, err := time.Parse(time.RFC3339, *at)
+		if err != nil {
+			return ge.Wrap(ge.New("invalid at time"), err)
+		}
+		input.At = timestamppb.New(t)
+	}
+
+	callCtx, cancel := a.call(ctx)
+	defer cancel()
+	out, err := a.client.GetLedgerBalances(callCtx, input)
+	if err != nil {
+		return err
+	}
+	for _, balance := range out.GetBalances() {
+		a.out.print(record{
+			{"account", balance.GetAccount()},
+			{"kind", balance.GetKind()},
+			{"debits", a.formatAmount(ctx, *asset, balance.GetDebits())},
+			{"credits", a.formatAmount(ctx, *asset, balance.GetCredits())},
+			{"balance", a.formatAmount(ctx, *asset, balance.GetBalance())},
+		})
+	}
+	return nil
+}
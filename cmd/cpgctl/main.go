@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/proto"
+	"flag"
+	"fmt"
+	"github.com/itsabgr/ge"
+	"os"
+	"os/signal"
+	"slices"
+	"time"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, app *app, args []string) error
+	// offline commands do not connect to the server
+	offline bool
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{"ping", "ping", cmdPing, false},
+		{"list-assets", "list-assets", cmdListAssets, false},
+		{"create", "create -asset NAME -amount AMOUNT -recipient ADDR -beneficiary ADDR -deadline 24h [-metadata STR] [-auto-checkout] [-escrow 72h] [-split ADDR=BPS,...] [-split-gas RULE] [-backup-out FILE]", cmdCreate, false},
+		{"get", "get [INVOICE_ID...|-]", cmdGet, false},
+		{"check", "check [-wallet ADDR] [INVOICE_ID...|-]", cmdCheck, false},
+		{"cancel", "cancel [-wallet ADDR] [INVOICE_ID...|-]", cmdCancel, false},
+		{"request-checkout", "request-checkout [INVOICE_ID...|-]", cmdRequestCheckout, false},
+		{"try-checkout", "try-checkout [-bundle-dir DIR] [INVOICE_ID...|-]", cmdTryCheckout, false},
+		{"submit-sweep", "submit-sweep -id INVOICE_ID -bundle FILE", cmdSubmitSweep, false},
+		{"approve", "approve [-approver-key-file FILE] [-note STR] [INVOICE_ID...|-]", cmdApprove, false},
+		{"reject", "reject [-approver-key-file FILE] [-reason STR] [INVOICE_ID...|-]", cmdReject, false},
+		{"release", "release [-arbiter-key-file FILE|-buyer-key-file FILE] [-reason STR] [INVOICE_ID...|-]", cmdRelease, false},
+		{"refund", "refund [-arbiter-key-file FILE] [-reason STR] [INVOICE_ID...|-]", cmdRefund, false},
+		{"dispute", "dispute [-arbiter-key-file FILE|-buyer-key-file FILE] [-reason STR] [INVOICE_ID...|-]", cmdDispute, false},
+		{"recover-cross-chain", "recover-cross-chain [-dry-run] [INVOICE_ID...|-]", cmdRecoverCrossChain, false},
+		{"rescue-token", "rescue-token -id INVOICE_ID -token CONTRACT [-to ADDR] [-fund-gas] [-dry-run]", cmdRescueToken, false},
+		{"events", "events [INVOICE_ID...|-]", cmdEvents, false},
+		{"balance", "balance -asset NAME [ACCOUNT...|-]", cmdBalance, false},
+		{"settle", "settle -asset NAME [-force]", cmdSettle, false},
+		{"payout", "payout -asset NAME -to ADDR -amount AMOUNT -key IDEMPOTENCY_KEY [-reference STR]", cmdPayout, false},
+		{"get-payout", "get-payout [PAYOUT_ID...|-]", cmdGetPayout, false},
+		{"payouts", "payouts [-asset NAME] [-status STATUS] [-before TIME] [-limit N]", cmdPayouts, false},
+		{"ledger-balances", "ledger-balances -asset NAME [-at TIME] [-prefix STR]", cmdLedgerBalances, false},
+		{"recover", "recover -id INVOICE_ID -backup FILE | recover < LINES_OF_ID_AND_BASE64_BACKUP", cmdRecover, false},
+		{"recover-batch", "recover-batch -dir DIR | recover-batch < LINES_OF_ID_AND_BACKUP", cmdRecoverBatch, false},
+		{"verify-backup", "verify-backup -id INVOICE_ID -backup FILE", cmdVerifyBackup, false},
+		{"backup-print", "backup-print -backup FILE [-format words|qr] [-png DIR] [-part-size N]", cmdBackupPrint, true},
+		{"backup-scan", "backup-scan [-out FILE] [FILE...|-]", cmdBackupScan, true},
+	}
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	_, _ = fmt.Fprintf(out, "usage: cpgctl [global flags] <command> [flags] [args]\n\ncommands:\n")
+	for _, cmd := range commands {
+		_, _ = fmt.Fprintf(out, "  %s\n", cmd.usage)
+	}
+	_, _ = fmt.Fprintf(out, "\ninvoice ids are read from stdin, one per line, when no id or - is given\n\nglobal flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		configPath  = flag.String("config", defaultProfilesPath(), "connection profiles file")
+		profileName = flag.String("profile", os.Getenv("CPGCTL_PROFILE"), "connection profile name")
+		address     = flag.String("addr", "", "server address, overrides the profile")
+		useTLS      = flag.Bool("tls", false, "use tls, overrides the profile")
+		caFile      = flag.String("ca", "", "tls ca certificate file, overrides the profile")
+		apiKey      = flag.String("api-key", os.Getenv("CPGCTL_API_KEY"), "api key, overrides the profile")
+		adminKey    = flag.String("admin-key", os.Getenv("CPGCTL_ADMIN_KEY"), "admin key of the admin only commands, overrides the profile")
+		jsonOutput  = flag.Bool("json", false, "print json lines instead of human readable output")
+		timeout     = flag.Duration("timeout", time.Second*30, "timeout of each call")
+	)
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	idx := slices.IndexFunc(commands, func(cmd command) bool { return cmd.name == flag.Arg(0) })
+	if idx < 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	err := func() error {
+		a := &app{
+			out:     output{json: *jsonOutput, w: os.Stdout},
+			timeout: *timeout,
+		}
+		if commands[idx].offline {
+			return commands[idx].run(ctx, a, flag.Args()[1:])
+		}
+
+		prof, err := loadProfile(*configPath, *profileName)
+		if err != nil {
+			return err
+		}
+		flag.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "addr":
+				prof.Address = *address
+			case "tls":
+				prof.TLS = *useTLS
+			case "ca":
+				prof.CAFile = *caFile
+			case "api-key":
+				prof.APIKey, prof.APIKeyFile = *apiKey, ""
+			case "admin-key":
+				prof.AdminKey, prof.AdminKeyFile = *adminKey, ""
+			}
+		})
+		if prof.APIKey == "" && prof.APIKeyFile == "" {
+			prof.APIKey = *apiKey
+		}
+		if prof.AdminKey == "" && prof.AdminKeyFile == "" {
+			prof.AdminKey = *adminKey
+		}
+
+		conn, err := prof.dial()
+		if err != nil {
+			return ge.Wrap(ge.New("failed to connect"), err)
+		}
+		defer func() { _ = conn.Close() }()
+
+		a.client = proto.NewCPGClient(conn)
+		return commands[idx].run(ctx, a, flag.Args()[1:])
+	}()
+
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "cpgctl: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"cpg/pkg/cpg"
+	"cpg/pkg/proto"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/itsabgr/ge"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+type field struct {
+	key   string
+	value any
+}
+
+type record []field
+
+type output struct {
+	json bool
+	w    io.Writer
+}
+
+func (out output) print(rec record) {
+	if out.json {
+		obj := make(map[string]any, len(rec))
+		for _, f := range rec {
+			obj[f.key] = f.value
+		}
+		ge.Must(out.w.Write(append(ge.Must(json.Marshal(obj)), '\n')))
+		return
+	}
+	width := 0
+	for _, f := range rec {
+		width = max(width, len(f.key))
+	}
+	for _, f := range rec {
+		_, _ = fmt.Fprintf(out.w, "%-*s  %v\n", width, f.key+":", f.value)
+	}
+	_, _ = fmt.Fprintln(out.w)
+}
+
+type app struct {
+	client  proto.CPGClient
+	out     output
+	timeout time.Duration
+	assets  map[string]*proto.AssetInfo
+}
+
+func (a *app) call(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, a.timeout)
+}
+
+func (a *app) assetInfo(ctx context.Context, name string) (*proto.AssetInfo, error) {
+	if a.assets == nil {
+		ctx, cancel := a.call(ctx)
+		defer cancel()
+		list, err := a.client.ListAssets(ctx, &empty.Empty{})
+		if err != nil {
+			return nil, ge.Wrap(ge.New("failed to list assets"), err)
+		}
+		a.assets = list.GetAssets()
+	}
+	info, ok := a.assets[name]
+	if !ok {
+		return nil, ge.Detail(ge.New("unknown asset"), ge.D{"asset": name})
+	}
+	return info, nil
+}
+
+// formatAmount renders a raw amount with the asset decimals and symbol, falling back to the raw amount
+func (a *app) formatAmount(ctx context.Context, asset, raw string) string {
+	amount, ok := (&big.Int{}).SetString(raw, 10)
+	if !ok {
+		return raw
+	}
+	info, err := a.assetInfo(ctx, asset)
+	if err != nil {
+		return raw
+	}
+	return strings.TrimSpace(cpg.FormatAmount(amount, uint8(info.GetDecimals())) + " " + info.GetSymbol())
+}
+
+// rawAmount returns the amount in asset units as the smallest asset unit, or raw when amount is empty
+func (a *app) rawAmount(ctx context.Context, asset, amount, raw string) (string, error) {
+	if amount == "" {
+		return raw, nil
+	}
+	info, err := a.assetInfo(ctx, asset)
+	if err != nil {
+		return "", err
+	}
+	parsed, ok := cpg.ParseAmount(amount, uint8(info.GetDecimals()))
+	if !ok {
+		return "", ge.Detail(ge.New("invalid amount"), ge.D{"amount": amount, "decimals": info.GetDecimals()})
+	}
+	return parsed.String(), nil
+}
+
+// forEach runs fn for every argument, or every non-empty stdin line when there is no argument or it is -,
+// failures are printed as records and do not stop the batch
+func (a *app) forEach(ctx context.Context, args []string, fn func(ctx context.Context, arg string) (record, error)) error {
+	items := args
+	if len(args) == 0 || (len(args) == 1 && args[0] == "-") {
+		items = nil
+		scanner := bufio.NewScanner(os.Stdin)
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			items = append(items, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+	}
+
+	failed := 0
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rec, err := fn(ctx, item)
+		if err != nil {
+			failed++
+			id, _, _ := strings.Cut(item, " ")
+			rec = record{{"invoice_id", id}, {"error", err.Error()}}
+		}
+		a.out.print(rec)
+	}
+
+	if failed > 0 {
+		return ge.Detail(ge.New("some items failed"), ge.D{"failed": failed, "total": len(items)})
+	}
+	return nil
+}
+
+func statusName(status proto.InvoiceStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "INVOICE_STATUS_"))
+}
+
+func recoverOutcomeName(outcome proto.RecoverOutcome) string {
+	return strings.ToLower(strings.TrimPrefix(outcome.String(), "RECOVER_OUTCOME_"))
+}
+
+func timestampValue(ts *timestamppb.Timestamp) any {
+	if ts == nil {
+		return nil
+	}
+	return ts.AsTime().Local().Format(time.RFC3339)
+}
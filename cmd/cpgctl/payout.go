@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/proto"
+	"github.com/itsabgr/ge"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func (a *app) payoutRecord(ctx context.Context, payout *proto.Payout) record {
+	return record{
+		{"payout_id", payout.GetId()},
+		{"idempotency_key", payout.GetIdempotencyKey()},
+		{"asset", payout.GetAsset()},
+		{"to", payout.GetTo()},
+		{"amount", a.formatAmount(ctx, payout.GetAsset(), payout.GetAmount())},
+		{"reference", payout.GetReference()},
+		{"status", payout.GetStatus()},
+		{"fee", payout.GetFee()},
+		{"tx", payout.GetTxHash()},
+		{"error", payout.GetError()},
+		{"create_at", timestampValue(payout.GetCreateAt())},
+		{"send_at", timestampValue(payout.GetSendAt())},
+		{"confirm_at", timestampValue(payout.GetConfirmAt())},
+	}
+}
+
+func cmdPayout(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("payout")
+	var (
+		asset     = fs.String("asset", "", "asset name")
+		to        = fs.String("to", "", "allowlisted address to pay")
+		amount    = fs.String("amount", "", "amount in asset units, e.g. 1.25")
+		amountRaw = fs.String("amount-raw", "", "amount in the smallest asset unit")
+		key       = fs.String("key", "", "idempotency key, a retry with the same key returns the first payout")
+		reference = fs.String("reference", "", "payout reference, e.g. the supplier invoice number")
+	)
+	ge.Throw(fs.Parse(args))
+
+	raw, err := a.rawAmount(ctx, *asset, *amount, *amountRaw)
+	if err != nil {
+		return err
+	}
+
+	callCtx, cancel := a.call(ctx)
+	defer cancel()
+	out, err := a.client.CreatePayout(callCtx, &proto.CreatePayoutInput{
+		Asset:          *asset,
+		To:             *to,
+		Amount:         raw,
+		IdempotencyKey: *key,
+		Reference:      *reference,
+	})
+	if err != nil {
+		return err
+	}
+	a.out.print(append(a.payoutRecord(ctx, out.GetPayout()), field{"replayed", out.GetReplayed()}))
+	return nil
+}
+
+func cmdGetPayout(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("get-payout")
+	ge.Throw(fs.Parse(args))
+
+	return a.forEach(ctx, fs.Args(), func(ctx context.Context, id string) (record, error) {
+		callCtx, cancel := a.call(ctx)
+		defer cancel()
+		payout, err := a.client.GetPayout(callCtx, &proto.GetPayoutInput{PayoutId: id})
+		if err != nil {
+			return nil, err
+		}
+		return a.payoutRecord(ctx, payout), nil
+	})
+}
+
+func cmdPayouts(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("payouts")
+	var (
+		asset  = fs.String("asset", "", "asset name")
+		status = fs.String("status", "", "pending, sent, confirmed or failed")
+		before = fs.String("before", "", "list payouts created before this rfc3339 time")
+		limit  = fs.Int("limit", 100, "max payouts")
+	)
+	ge.Throw(fs.Parse(args))
+
+	input := &proto.ListPayoutsInput{Asset: *asset, Status: *status, Limit: int32(*limit)}
+	if *before != "" {
+		at, err := time.Parse(time.RFC3339, *before)
+		if err != nil {
+			return ge.Wrap(ge.New("invalid before time"), err)
+		}
+		input.Before = timestamppb.New(at)
+	}
+
+	callCtx, cancel := a.call(ctx)
+	defer cancel()
+	out, err := a.client.ListPayouts(callCtx, input)
+	if err != nil {
+		return err
+	}
+	for _, payout := range out.GetPayouts() {
+		a.out.print(a.payoutRecord(ctx, payout))
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/cpg"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"github.com/itsabgr/ge"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type profile struct {
+	Address    string `json:"address"`
+	TLS        bool   `json:"tls"`
+	CAFile     string `json:"ca_file,omitempty"`
+	ServerName string `json:"server_name,omitempty"`
+	APIKey     string `json:"api_key,omitempty"`
+	APIKeyFile string `json:"api_key_file,omitempty"`
+	// AdminKey is sent along with the api key for the admin only commands
+	AdminKey     string `json:"admin_key,omitempty"`
+	AdminKeyFile string `json:"admin_key_file,omitempty"`
+}
+
+type profiles struct {
+	Default  string             `json:"default"`
+	Profiles map[string]profile `json:"profiles"`
+}
+
+func defaultProfilesPath() string {
+	if path := os.Getenv("CPGCTL_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "cpgctl.json"
+	}
+	return filepath.Join(dir, "cpgctl", "profiles.json")
+}
+
+func loadProfile(path, name string) (profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) && name == "" {
+			return profile{}, nil
+		}
+		return profile{}, err
+	}
+	var conf profiles
+	if err = json.Unmarshal(data, &conf); err != nil {
+		return profile{}, ge.Wrap(ge.Detail(ge.New("failed to parse profiles"), ge.D{"path": path}), err)
+	}
+	if name == "" {
+		name = conf.Default
+	}
+	if name == "" {
+		return profile{}, nil
+	}
+	prof, ok := conf.Profiles[name]
+	if !ok {
+		return profile{}, ge.Detail(ge.New("profile not found"), ge.D{"profile": name, "path": path})
+	}
+	return prof, nil
+}
+
+func (prof profile) dial() (*grpc.ClientConn, error) {
+	if prof.Address == "" {
+		return nil, ge.New("no server address, use -addr or a profile")
+	}
+
+	transport := insecure.NewCredentials()
+
+	if prof.TLS {
+		tlsConfig := &tls.Config{ServerName: prof.ServerName}
+		if prof.CAFile != "" {
+			pem, err := os.ReadFile(prof.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, ge.Detail(ge.New("no certificate found"), ge.D{"ca_file": prof.CAFile})
+			}
+		}
+		transport = credentials.NewTLS(tlsConfig)
+	}
+
+	var headers []string
+	for _, key := range []struct{ header, value, file string }{
+		{cpg.APIKeyHeader, prof.APIKey, prof.APIKeyFile},
+		{cpg.AdminKeyHeader, prof.AdminKey, prof.AdminKeyFile},
+	} {
+		if key.file != "" {
+			data, err := os.ReadFile(key.file)
+			if err != nil {
+				return nil, err
+			}
+			key.value = strings.TrimSpace(string(data))
+		}
+		if key.value != "" {
+			headers = append(headers, key.header, key.value)
+		}
+	}
+
+	options := []grpc.DialOption{grpc.WithTransportCredentials(transport)}
+
+	if len(headers) > 0 {
+		options = append(options,
+			grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+				return invoker(metadata.AppendToOutgoingContext(ctx, headers...), method, req, reply, cc, opts...)
+			}),
+			grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				return streamer(metadata.AppendToOutgoingContext(ctx, headers...), desc, cc, method, opts...)
+			}),
+		)
+	}
+
+	return grpc.NewClient(prof.Address, options...)
+}
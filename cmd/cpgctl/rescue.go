@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/proto"
+	"github.com/itsabgr/ge"
+	"maps"
+	"slices"
+)
+
+func (a *app) sweepRecord(ctx context.Context, invoiceID string, sweep *proto.AssetSweep) record {
+	rec := record{
+		{"invoice_id", invoiceID},
+		{"asset", sweep.GetAsset()},
+		{"balance", a.formatAmount(ctx, sweep.GetAsset(), sweep.GetBalance())},
+	}
+	if sweep.GetTxHash() != "" {
+		rec = append(rec,
+			field{"to", sweep.GetDestination()},
+			field{"amount", a.formatAmount(ctx, sweep.GetAsset(), sweep.GetAmount())},
+			field{"fee", a.formatAmount(ctx, sweep.GetAsset(), sweep.GetFee())},
+			field{"tx", sweep.GetTxHash()},
+		)
+	}
+	if sweep.GetError() != "" {
+		rec = append(rec, field{"error", sweep.GetError()})
+	}
+	return rec
+}
+
+func cmdRecoverCrossChain(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("recover-cross-chain")
+	dryRun := fs.Bool("dry-run", false, "show the balances found and sign the sweeps without sending them")
+	ge.Throw(fs.Parse(args))
+
+	return a.forEach(ctx, fs.Args(), func(ctx context.Context, id string) (record, error) {
+		callCtx, cancel := a.call(ctx)
+		defer cancel()
+		out, err := a.client.RecoverCrossChain(callCtx, &proto.RecoverCrossChainInput{InvoiceId: id, DryRun: *dryRun})
+		if err != nil {
+			return nil, err
+		}
+		failed := 0
+		for _, sweep := range out.GetSweeps() {
+			if sweep.GetError() != "" {
+				failed++
+			}
+			a.out.print(a.sweepRecord(ctx, id, sweep))
+		}
+		if failed > 0 {
+			return nil, ge.Detail(ge.New("some sweeps failed"), ge.D{"failed": failed})
+		}
+		return record{{"invoice_id", id}, {"result", "done"}, {"assets", len(out.GetSweeps())}}, nil
+	})
+}
+
+func cmdRescueToken(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("rescue-token")
+	var (
+		id      = fs.String("id", "", "invoice id")
+		token   = fs.String("token", "", "token contract address")
+		to      = fs.String("to", "", "address to send the tokens to, the invoice destination by default")
+		fundGas = fs.Bool("fund-gas", false, "top the wallet up with the transfer fee from the asset gas funder")
+		dryRun  = fs.Bool("dry-run", false, "show the token balance and fee and sign the txs without sending them")
+	)
+	ge.Throw(fs.Parse(args))
+
+	callCtx, cancel := a.call(ctx)
+	defer cancel()
+	out, err := a.client.RescueToken(callCtx, &proto.RescueTokenInput{
+		InvoiceId: *id,
+		Token:     *token,
+		To:        *to,
+		FundGas:   *fundGas,
+		DryRun:    *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	a.out.print(record{
+		{"invoice_id", *id},
+		{"token", *token},
+		{"token_balance", out.GetTokenBalance()},
+		{"fee", out.GetFee()},
+		{"funding_amount", out.GetFundingAmount()},
+		{"funding_tx", out.GetFundingTxHash()},
+		{"tx", out.GetTxHash()},
+	})
+	return nil
+}
+
+func cmdEvents(ctx context.Context, a *app, args []string) error {
+	return a.forEach(ctx, args, func(ctx context.Context, id string) (record, error) {
+		ctx, cancel := a.call(ctx)
+		defer cancel()
+		out, err := a.client.ListInvoiceEvents(ctx, &proto.ListInvoiceEventsInput{InvoiceId: id})
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range out.GetEvents() {
+			rec := record{
+				{"invoice_id", id},
+				{"at", timestampValue(event.GetCreateAt())},
+				{"kind", event.GetKind()},
+				{"asset", event.GetAsset()},
+				{"tx", event.GetTxHash()},
+			}
+			keys := slices.Sorted(maps.Keys(event.GetDetail()))
+			for _, key := range keys {
+				rec = append(rec, field{key, event.GetDetail()[key]})
+			}
+			a.out.print(rec)
+		}
+		return record{{"invoice_id", id}, {"events", len(out.GetEvents())}}, nil
+	})
+}
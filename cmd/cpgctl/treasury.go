@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"cpg/pkg/proto"
+	"github.com/itsabgr/ge"
+)
+
+func (a *app) treasuryTransferRecord(ctx context.Context, asset string, transfer *proto.TreasuryTransfer) record {
+	return record{
+		{"transfer_id", transfer.GetId()},
+		{"asset", asset},
+		{"kind", transfer.GetKind()},
+		{"to", transfer.GetTo()},
+		{"amount", a.formatAmount(ctx, asset, transfer.GetAmount())},
+		{"fee", transfer.GetFee()},
+		{"tx", transfer.GetTxHash()},
+		{"error", transfer.GetError()},
+		{"create_at", timestampValue(transfer.GetCreateAt())},
+		{"send_at", timestampValue(transfer.GetSendAt())},
+	}
+}
+
+func cmdBalance(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("balance")
+	asset := fs.String("asset", "", "asset name")
+	ge.Throw(fs.Parse(args))
+
+	return a.forEach(ctx, fs.Args(), func(ctx context.Context, account string) (record, error) {
+		ctx, cancel := a.call(ctx)
+		defer cancel()
+		out, err := a.client.GetMerchantBalance(ctx, &proto.GetMerchantBalanceInput{Asset: *asset, Account: account})
+		if err != nil {
+			return nil, err
+		}
+		for _, transfer := range out.GetTransfers() {
+			a.out.print(a.treasuryTransferRecord(ctx, *asset, transfer))
+		}
+		return record{
+			{"account", account},
+			{"asset", *asset},
+			{"balance", a.formatAmount(ctx, *asset, out.GetBalance())},
+			{"credits", out.GetCredits()},
+			{"oldest_credit_at", timestampValue(out.GetOldestCreditAt())},
+		}, nil
+	})
+}
+
+func cmdSettle(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("settle")
+	var (
+		asset = fs.String("asset", "", "asset name")
+		force = fs.Bool("force", false, "settle every balance whatever the threshold and interval")
+	)
+	ge.Throw(fs.Parse(args))
+
+	callCtx, cancel := a.call(ctx)
+	defer cancel()
+	out, err := a.client.SettleTreasury(callCtx, &proto.SettleTreasuryInput{Asset: *asset, Force: *force})
+	if err != nil {
+		return err
+	}
+	for _, transfer := range out.GetTransfers() {
+		a.out.print(a.treasuryTransferRecord(ctx, *asset, transfer))
+	}
+	a.out.print(record{{"asset", *asset}, {"transfers", len(out.GetTransfers())}})
+	return nil
+}
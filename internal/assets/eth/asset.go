@@ -1,11 +1,12 @@
 package eth
 
 import (
-	"bytes"
 	"context"
 	"cpg/pkg/cpg"
+	"cpg/pkg/hdwallet"
 	"crypto/ecdsa"
 	"errors"
+	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -13,18 +14,36 @@ import (
 	"github.com/itsabgr/fak"
 	"github.com/itsabgr/ge"
 	"math/big"
+	"sync"
 	"time"
 )
 
 const SaltSize = 32
 
+// errOffline is returned by an asset without an eth endpoint for anything but signing
+var errOffline = ge.New("offline asset, it has no eth endpoint")
+
 type Config struct {
+	// EthClient is nil for an offline asset, which only inspects and signs sweeps on an air-gapped host
 	EthClient          *ethclient.Client
 	MinDelay           time.Duration
 	TxGasLimit         uint64
 	ChainID            *big.Int
 	MinAllowedAmount   *big.Int
 	MaxAllowedGasPrice *big.Int
+	Decimals           uint8
+	Symbol             string
+	// TokenGasLimit is the gas limit of token rescue transfers
+	TokenGasLimit uint64
+	// GasFunder pays the fee of token rescues from wallets without native coin, token rescues can not fund gas without it
+	GasFunder *ecdsa.PrivateKey
+	// Treasury is the key of the treasury wallet, the asset is a cpg.Treasurer with it
+	Treasury *ecdsa.PrivateKey
+	// Payout is the key of the funding wallet, the asset is a cpg.Payer with it
+	Payout *ecdsa.PrivateKey
+	// HDAccount switches new invoices to hd wallets, it is the account key whose children are the invoice wallets,
+	// a public account key makes the asset watch-only
+	HDAccount *hdwallet.Key
 }
 
 func New(ctx context.Context, config Config) (cpg.Asset, error) {
@@ -40,22 +59,35 @@ func New(ctx context.Context, config Config) (cpg.Asset, error) {
 	if config.MaxAllowedGasPrice.Cmp(big.NewInt(0)) <= 0 {
 		panic(ge.New("non-positive max fee"))
 	}
-
-	chainId, err := func() (*big.Int, error) {
-		timeout, cancel := context.WithTimeout(ctx, time.Second*2)
-		defer cancel()
-		return config.EthClient.ChainID(timeout)
-	}()
-
-	if err != nil {
-		return nil, ge.Wrap(ge.New("failed to get chain id"), err)
+	if config.TokenGasLimit == 0 {
+		config.TokenGasLimit = 100000
+	}
+	derivation := DerivationCurrent
+	if config.HDAccount != nil {
+		derivation = DerivationHD
+	}
+	watchOnly := config.HDAccount != nil && !config.HDAccount.IsPrivate()
+	if watchOnly && (config.GasFunder != nil || config.Treasury != nil || config.Payout != nil) {
+		panic(ge.New("watch-only asset with a gas funder, treasury or payout key"))
 	}
 
-	if chainId.Cmp(config.ChainID) != 0 {
-		return nil, ge.Detail(ge.New("mismatched chain id"), ge.D{
-			"endpoint": chainId.String(),
-			"config":   config.ChainID.String(),
-		})
+	if config.EthClient != nil {
+		chainId, err := func() (*big.Int, error) {
+			timeout, cancel := context.WithTimeout(ctx, time.Second*2)
+			defer cancel()
+			return config.EthClient.ChainID(timeout)
+		}()
+
+		if err != nil {
+			return nil, ge.Wrap(ge.New("failed to get chain id"), err)
+		}
+
+		if chainId.Cmp(config.ChainID) != 0 {
+			return nil, ge.Detail(ge.New("mismatched chain id"), ge.D{
+				"endpoint": chainId.String(),
+				"config":   config.ChainID.String(),
+			})
+		}
 	}
 
 	return &asset{
@@ -64,9 +96,19 @@ func New(ctx context.Context, config Config) (cpg.Asset, error) {
 		minAllowedAmount:   *(&big.Int{}).Set(config.MinAllowedAmount),
 		maxAllowedGasPrice: *(&big.Int{}).Set(config.MaxAllowedGasPrice),
 		chainID:            *(&big.Int{}).Set(config.ChainID),
+		tokenGasLimit:      config.TokenGasLimit,
+		gasFunder:          config.GasFunder,
+		treasury:           config.Treasury,
+		payout:             config.Payout,
+		hdAccount:          config.HDAccount,
 		info: cpg.AssetInfo{
 			MinDelay:   config.MinDelay,
 			SaltLength: SaltSize,
+			Decimals:   config.Decimals,
+			Symbol:     config.Symbol,
+
+			DerivationVersion: derivation,
+			WatchOnly:         watchOnly,
 		},
 	}, nil
 }
@@ -77,10 +119,22 @@ type asset struct {
 	minAllowedAmount   big.Int
 	chainID            big.Int
 	maxAllowedGasPrice big.Int
+	tokenGasLimit      uint64
+	gasFunder          *ecdsa.PrivateKey
+	treasury           *ecdsa.PrivateKey
+	payout             *ecdsa.PrivateKey
+	hdAccount          *hdwallet.Key
 	info               cpg.AssetInfo
+	// treasuryLock serializes treasury transfers so their pending nonces do not race
+	treasuryLock sync.Mutex
+	// payoutLock serializes payouts so their pending nonces do not race
+	payoutLock sync.Mutex
 }
 
 func (ass *asset) GetBalance(ctx context.Context, invoice *cpg.Invoice) (*big.Int, error) {
+	if ass.ethClient == nil {
+		return nil, errOffline
+	}
 	walletAddress := common.HexToAddress(invoice.WalletAddress)
 	timeout, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
@@ -105,23 +159,86 @@ func (ass *asset) PrepareInvoice(ctx context.Context, invoice *cpg.Invoice) erro
 		return ge.New("invalid beneficiary")
 	}
 
-	salt := invoice.DecryptSalt()
-
-	if len(salt) != SaltSize {
-		return ge.New("invalid salt size")
+	if invoice.Fee != nil && !validateAddress(invoice.Fee.Address) {
+		return ge.New("invalid fee address")
 	}
 
-	walletPrivateKey := ge.Must(ecdsa.GenerateKey(crypto.S256(), bytes.NewReader(salt)))
+	for _, share := range invoice.Split {
+		if !validateAddress(share.Address) {
+			return ge.Detail(ge.New("invalid split recipient"), ge.D{"address": share.Address})
+		}
+	}
 
-	walletAddress := crypto.PubkeyToAddress(*walletPrivateKey.Public().(*ecdsa.PublicKey)).Hex()
+	walletPublicKey, err := ass.walletPublicKey(ctx, invoice)
+	if err != nil {
+		return err
+	}
 
-	invoice.WalletAddress = walletAddress
+	invoice.WalletAddress = crypto.PubkeyToAddress(*walletPublicKey).Hex()
 
 	return nil
 
 }
 
+// walletKey derives the invoice wallet key from its salt by the derivation version of the invoice,
+// legacy invoices take the salt as the private scalar, which is what ecdsa.GenerateKey(crypto.S256(), bytes.NewReader(salt))
+// returned whenever it did not fail on the extra byte randutil.MaybeReadByte may consume, so every existing wallet keeps its address
+// and hd invoices take the child of the configured account at their wallet index
+func (ass *asset) walletKey(ctx context.Context, invoice *cpg.Invoice) (*ecdsa.PrivateKey, error) {
+	if ass.info.WatchOnly {
+		return nil, cpg.ErrWatchOnly
+	}
+	if invoice.DerivationVersion == DerivationHD {
+		child, err := ass.hdChild(invoice)
+		if err != nil {
+			return nil, err
+		}
+		return child.ECDSA()
+	}
+	return deriveKey(invoice.DerivationVersion, invoice.ID, invoice.DecryptSalt(ctx))
+}
+
+// walletPublicKey derives only the public key of the invoice wallet
+func (ass *asset) walletPublicKey(ctx context.Context, invoice *cpg.Invoice) (*ecdsa.PublicKey, error) {
+	if invoice.DerivationVersion == DerivationHD {
+		child, err := ass.hdChild(invoice)
+		if err != nil {
+			return nil, err
+		}
+		return child.PublicKey()
+	}
+	if ass.info.WatchOnly {
+		return nil, ge.Wrap(ge.New("only hd invoices have a public derivation"), cpg.ErrWatchOnly)
+	}
+	key, err := ass.walletKey(ctx, invoice)
+	if err != nil {
+		return nil, err
+	}
+	return &key.PublicKey, nil
+}
+
+func (ass *asset) hdChild(invoice *cpg.Invoice) (*hdwallet.Key, error) {
+	if ass.hdAccount == nil {
+		return nil, ge.New("asset has no hd wallet account")
+	}
+	if invoice.WalletIndex == nil || *invoice.WalletIndex < 0 || *invoice.WalletIndex >= int64(hdwallet.Hardened) {
+		return nil, ge.New("invalid invoice wallet index")
+	}
+	return ass.hdAccount.Child(uint32(*invoice.WalletIndex))
+}
+
+// WalletAccount is the account xpub in hd mode
+func (ass *asset) WalletAccount() string {
+	if ass.hdAccount == nil {
+		return ""
+	}
+	return ass.hdAccount.Neuter().String()
+}
+
 func (ass *asset) checkGasPrice(ctx context.Context) (*big.Int, error) {
+	if ass.ethClient == nil {
+		return nil, errOffline
+	}
 	timeout, cancel := context.WithTimeout(ctx, time.Second*2)
 	defer cancel()
 	gasPrice, err := ass.ethClient.SuggestGasPrice(timeout)
@@ -135,63 +252,284 @@ func (ass *asset) checkGasPrice(ctx context.Context) (*big.Int, error) {
 }
 
 func (ass *asset) TryFlush(ctx context.Context, invoice *cpg.Invoice) error {
+	to := invoice.Destination()
+	result, err := ass.Sweep(ctx, invoice, to, false)
+	if err != nil {
+		// only the legs sent before the failed sweep are reported
+		result.TxHash = ""
+	}
+	invoice.ReportSweep(to, result)
+	return err
+}
+
+func (ass *asset) Sweep(ctx context.Context, invoice *cpg.Invoice, to string, dryRun bool) (result cpg.SweepResult, err error) {
+
+	if ass.info.WatchOnly {
+		return result, cpg.ErrWatchOnly
+	}
+
+	unsigned, err := ass.BuildSweep(ctx, invoice, to)
+	result = unsigned.Sweep
+	if err != nil {
+		return result, err
+	}
+
+	var signedFee []byte
+	if unsigned.FeeTx != nil {
+		if signedFee, err = ass.SignSweep(ctx, invoice, unsigned.FeeTx); err != nil {
+			return result, err
+		}
+	}
+
+	signedSplit := make([][]byte, len(unsigned.SplitTxs))
+	for i := range unsigned.SplitTxs {
+		if signedSplit[i], err = ass.SignSweep(ctx, invoice, unsigned.SplitTxs[i]); err != nil {
+			return result, err
+		}
+	}
+
+	signed, err := ass.SignSweep(ctx, invoice, unsigned.Tx)
+	if err != nil {
+		return result, err
+	}
+
+	if dryRun {
+		if signedFee != nil {
+			result.FeeTxHash = ge.Must(decodeTx(signedFee)).Hash().Hex()
+		}
+		for i := range signedSplit {
+			result.Split[i].TxHash = ge.Must(decodeTx(signedSplit[i])).Hash().Hex()
+		}
+		result.TxHash = ge.Must(decodeTx(signed)).Hash().Hex()
+		return result, nil
+	}
+
+	if signedFee != nil {
+		feeTxHash, err := ass.SendSweep(ctx, signedFee)
+		if err != nil {
+			return result, ge.Wrap(ge.New("failed to send fee leg"), err)
+		}
+		result.FeeTxHash = feeTxHash
+	}
+
+	for i := range signedSplit {
+		txHash, err := ass.SendSweep(ctx, signedSplit[i])
+		if err != nil {
+			return result, ge.Wrap(ge.Detail(ge.New("failed to send split leg"), ge.D{"to": result.Split[i].To}), err)
+		}
+		result.Split[i].TxHash = txHash
+	}
+
+	result.TxHash, err = ass.SendSweep(ctx, signed)
+	return result, err
+
+}
+
+// BuildSweep makes the unsigned legacy tx of the wallet balance minus the fee, it needs no key,
+// the fee leg and split legs are transfers of their own taking the nonces before the sweep and their own tx fees
+func (ass *asset) BuildSweep(ctx context.Context, invoice *cpg.Invoice, to string) (result cpg.UnsignedSweep, err error) {
+
+	if !validateAddress(to) {
+		return result, ge.Detail(ge.New("invalid sweep destination"), ge.D{"to": to})
+	}
 
 	gasPrice, err := ass.checkGasPrice(ctx)
 	if err != nil {
-		return ge.Wrap(ge.New("failed to check gas price"), err)
+		return result, ge.Wrap(ge.New("failed to check gas price"), err)
 	}
 
 	walletBalance, err := ass.GetBalance(ctx, invoice)
 	if err != nil {
-		return ge.Wrap(ge.New("failed to get wallet balance"), err)
+		return result, ge.Wrap(ge.New("failed to get wallet balance"), err)
 	}
+	result.Sweep.Balance = walletBalance
 
 	if walletBalance.Cmp(&ass.minAllowedAmount) < 0 {
-		return ge.Detail(ge.New("too less wallet balance"), ge.D{"balance": walletBalance})
+		return result, ge.Detail(ge.New("too less wallet balance"), ge.D{"balance": walletBalance})
 	}
 
 	txFee := big.NewInt(0).Mul(gasPrice, &ass.txGasLimit)
+	result.Sweep.Fee = new(big.Int).Set(txFee)
+	result.Sweep.TxFee = txFee
+
+	platformFee := invoice.FeeLeg(to, walletBalance)
+	result.Sweep.PlatformFee = platformFee
+
+	if result.Sweep.Split, err = invoice.SplitLegs(to, walletBalance, platformFee, txFee); err != nil {
+		return result, err
+	}
+
+	legs := new(big.Int)
+	if platformFee != nil {
+		result.Sweep.Fee.Add(result.Sweep.Fee, txFee)
+		legs.Add(legs, platformFee)
+	}
+	for _, leg := range result.Sweep.Split {
+		result.Sweep.Fee.Add(result.Sweep.Fee, txFee)
+		legs.Add(legs, leg.Amount)
+	}
+	spent := new(big.Int).Add(result.Sweep.Fee, legs)
 
-	if txFee.Cmp(walletBalance) >= 0 {
-		return ge.New("tx fee overcomes the wallet balance")
+	if spent.Cmp(walletBalance) >= 0 {
+		if legs.Sign() > 0 {
+			return result, ge.Detail(ge.New("tx fees and legs overcome the wallet balance"), ge.D{"balance": walletBalance, "legs": legs})
+		}
+		return result, ge.New("tx fee overcomes the wallet balance")
 	}
+	result.Sweep.Amount = (&big.Int{}).Sub(walletBalance, spent)
 
 	walletPendingNonce, err := ass.ethClient.PendingNonceAt(ctx, common.HexToAddress(invoice.WalletAddress))
 	if err != nil {
-		return ge.Wrap(ge.New("failed to get wallet pending nonce"), err)
+		return result, ge.Wrap(ge.New("failed to get wallet pending nonce"), err)
 	}
 
-	salt := invoice.DecryptSalt()
-	if len(salt) != SaltSize {
-		return ge.New("invalid salt size")
+	if platformFee != nil {
+		if result.FeeTx, err = ass.transferTx(walletPendingNonce, gasPrice, invoice.Fee.Address, platformFee); err != nil {
+			return result, err
+		}
+		walletPendingNonce++
 	}
 
-	walletPrivateKey := ge.Must(ecdsa.GenerateKey(crypto.S256(), bytes.NewReader(salt)))
+	for _, leg := range result.Sweep.Split {
+		tx, err := ass.transferTx(walletPendingNonce, gasPrice, leg.To, leg.Amount)
+		if err != nil {
+			return result, err
+		}
+		result.SplitTxs = append(result.SplitTxs, tx)
+		walletPendingNonce++
+	}
+
+	result.Tx, err = ass.transferTx(walletPendingNonce, gasPrice, to, result.Sweep.Amount)
+	return result, err
+}
 
-	signedTx, err := types.SignTx(types.NewTx(&types.LegacyTx{
-		Nonce:    walletPendingNonce,
+func (ass *asset) transferTx(nonce uint64, gasPrice *big.Int, to string, value *big.Int) ([]byte, error) {
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
 		GasPrice: gasPrice,
 		Gas:      ass.txGasLimit.Uint64(),
-		To:       fak.Ptr(common.HexToAddress(invoice.Destination())),
-		Value:    (&big.Int{}).Sub(walletBalance, txFee),
-	}), types.NewEIP155Signer(&ass.chainID), walletPrivateKey)
+		To:       fak.Ptr(common.HexToAddress(to)),
+		Value:    value,
+	}).MarshalBinary()
+}
+
+func decodeTx(data []byte) (*types.Transaction, error) {
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return nil, ge.Wrap(ge.New("invalid tx encoding"), err)
+	}
+	return tx, nil
+}
+
+// InspectSweep decodes an unsigned sweep tx and checks it is a plain transfer this asset would build
+func (ass *asset) InspectSweep(unsigned []byte) (result cpg.SweepTx, err error) {
+	tx, err := decodeTx(unsigned)
 	if err != nil {
-		return ge.Wrap(ge.New("failed to sign tx"), err)
+		return result, err
+	}
+	if v, r, s := tx.RawSignatureValues(); v.Sign() != 0 || r.Sign() != 0 || s.Sign() != 0 {
+		return result, ge.New("sweep tx is already signed")
+	}
+	switch {
+	case tx.Type() != types.LegacyTxType:
+		return result, ge.New("sweep tx is not a legacy tx")
+	case tx.To() == nil:
+		return result, ge.New("sweep tx creates a contract")
+	case len(tx.Data()) != 0:
+		return result, ge.New("sweep tx has call data")
+	case tx.Gas() != ass.txGasLimit.Uint64():
+		return result, ge.Detail(ge.New("sweep tx gas limit is not the asset one"), ge.D{"gas": tx.Gas()})
+	case tx.GasPrice().Cmp(&ass.maxAllowedGasPrice) > 0:
+		return result, ge.Detail(ge.New("sweep tx gas price is too high"), ge.D{"gas_price": tx.GasPrice()})
+	case tx.Value().Sign() <= 0:
+		return result, ge.New("sweep tx moves nothing")
 	}
+	return cpg.SweepTx{
+		To:     tx.To().Hex(),
+		Amount: tx.Value(),
+		Fee:    new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas())),
+	}, nil
+}
+
+// SignSweep signs an unsigned sweep tx with the invoice wallet key
+func (ass *asset) SignSweep(ctx context.Context, invoice *cpg.Invoice, unsigned []byte) ([]byte, error) {
 
-	err = ass.ethClient.SendTransaction(ctx, signedTx)
+	if _, err := ass.InspectSweep(unsigned); err != nil {
+		return nil, err
+	}
+	tx := ge.Must(decodeTx(unsigned))
+
+	walletPrivateKey, err := ass.walletKey(ctx, invoice)
 	if err != nil {
-		return ge.Wrap(ge.New("failed to send tx"), err)
+		return nil, err
+	}
+	if crypto.PubkeyToAddress(walletPrivateKey.PublicKey).Hex() != invoice.WalletAddress {
+		return nil, ge.New("derived wallet key does not match the invoice wallet address")
 	}
 
-	return nil
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(&ass.chainID), walletPrivateKey)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to sign tx"), err)
+	}
+	return signedTx.MarshalBinary()
+}
 
+// OpenSignedSweep returns the unsigned tx and the signer address of a signed sweep tx
+func (ass *asset) OpenSignedSweep(signed []byte) ([]byte, string, error) {
+	tx, err := decodeTx(signed)
+	if err != nil {
+		return nil, "", err
+	}
+	from, err := types.Sender(types.NewEIP155Signer(&ass.chainID), tx)
+	if err != nil {
+		return nil, "", ge.Wrap(ge.New("sweep tx is not signed for this chain"), err)
+	}
+	if tx.Type() != types.LegacyTxType {
+		return nil, "", ge.New("sweep tx is not a legacy tx")
+	}
+	unsigned, err := types.NewTx(&types.LegacyTx{
+		Nonce:    tx.Nonce(),
+		GasPrice: tx.GasPrice(),
+		Gas:      tx.Gas(),
+		To:       tx.To(),
+		Value:    tx.Value(),
+		Data:     tx.Data(),
+	}).MarshalBinary()
+	return unsigned, from.Hex(), err
+}
+
+// SendSweep broadcasts a signed sweep tx
+func (ass *asset) SendSweep(ctx context.Context, signed []byte) (string, error) {
+	if ass.ethClient == nil {
+		return "", errOffline
+	}
+	tx, err := decodeTx(signed)
+	if err != nil {
+		return "", err
+	}
+	if _, err = types.Sender(types.NewEIP155Signer(&ass.chainID), tx); err != nil {
+		return "", ge.Wrap(ge.New("sweep tx is not signed for this chain"), err)
+	}
+	if err = ass.ethClient.SendTransaction(ctx, tx); err != nil {
+		return tx.Hash().Hex(), ge.Wrap(ge.New("failed to send tx"), err)
+	}
+	return tx.Hash().Hex(), nil
+}
+
+// WalletFamily is evm, the invoice wallet address is the same on every evm chain
+func (ass *asset) WalletFamily() string {
+	return "evm"
 }
 
 func (ass *asset) Info() cpg.AssetInfo {
 	return ass.info
 }
 
+// PaymentURI returns an EIP-681 payment request for the invoice min amount
+func (ass *asset) PaymentURI(invoice *cpg.Invoice) string {
+	return fmt.Sprintf("ethereum:%s@%s?value=%s", invoice.WalletAddress, ass.chainID.String(), invoice.MinAmount.String())
+}
+
 func validateAddress(address string) bool {
 	return len(address) == 42 && common.HexToAddress(address).String() == address
 }
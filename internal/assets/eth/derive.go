@@ -0,0 +1,70 @@
+package eth
+
+import (
+	"cpg/pkg/hdwallet"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/itsabgr/ge"
+	"golang.org/x/crypto/hkdf"
+	"io"
+)
+
+const (
+	// DerivationLegacy takes the salt as the private scalar, invoices created before derivation versions keep it
+	DerivationLegacy = 0
+	// DerivationHKDF expands the salt with HKDF-SHA256 bound to the invoice id
+	DerivationHKDF = 1
+	// DerivationHD takes the child of a bip32 account at the invoice wallet index, the salt is not used
+	DerivationHD = 2
+	// DerivationCurrent is the version of new invoices of assets without a hd account
+	DerivationCurrent = DerivationHKDF
+)
+
+const hkdfWalletInfo = "cpg/eth/wallet/v1"
+
+// deriveKey derives the wallet key of an invoice by its derivation version, a version must never change once released
+func deriveKey(version int, invoiceID string, salt []byte) (*ecdsa.PrivateKey, error) {
+	if len(salt) != SaltSize {
+		return nil, ge.New("invalid salt size")
+	}
+	switch version {
+	case DerivationLegacy:
+		return crypto.ToECDSA(salt)
+	case DerivationHKDF:
+		if invoiceID == "" {
+			return nil, ge.New("hkdf derivation needs the invoice id")
+		}
+		kdf := hkdf.New(sha256.New, salt, []byte(invoiceID), []byte(hkdfWalletInfo))
+		scalar := make([]byte, 32)
+		// an output out of the curve order is skipped for the next one, it practically never happens
+		for range 8 {
+			if _, err := io.ReadFull(kdf, scalar); err != nil {
+				return nil, err
+			}
+			if key, err := crypto.ToECDSA(scalar); err == nil {
+				return key, nil
+			}
+		}
+		return nil, ge.New("hkdf derived no valid key")
+	default:
+		return nil, ge.Detail(ge.New("unknown derivation version"), ge.D{"version": version})
+	}
+}
+
+// HDAccount derives the account key of a path from a mnemonic or hex seed
+func HDAccount(seed, passphrase, path string) (*hdwallet.Key, error) {
+	seedBytes, err := hdwallet.ParseSeed(seed, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := hdwallet.ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	master, err := hdwallet.NewMaster(seedBytes)
+	if err != nil {
+		return nil, err
+	}
+	return master.Derive(indexes)
+}
@@ -0,0 +1,58 @@
+package eth
+
+import (
+	"encoding/hex"
+	"github.com/ethereum/go-ethereum/crypto"
+	"testing"
+)
+
+// derivationVectors pin the derived addresses, a change to them moves every wallet of that version
+var derivationVectors = []struct {
+	version   int
+	invoiceID string
+	salt      string
+	address   string
+}{
+	{DerivationLegacy, "", "0707070707070707070707070707070707070707070707070707070707070707", "0x4a62316623ad457F02cDC5D997deD67a383EC569"},
+	{DerivationLegacy, "", "0000000000000000000000000000000000000000000000000000000000000001", "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"},
+	{DerivationHKDF, "00000000-0000-0000-0000-000000000000", "0707070707070707070707070707070707070707070707070707070707070707", "0x4173E66bC031eb8A9A47A2249e97DceB27f8472f"},
+	{DerivationHKDF, "5b6f3c1e-8d2a-4f7b-9c0e-1a2b3c4d5e6f", "0707070707070707070707070707070707070707070707070707070707070707", "0xE66962beb7dFa3D8e169b88cc945a961bd6f3e5E"},
+	{DerivationHKDF, "5b6f3c1e-8d2a-4f7b-9c0e-1a2b3c4d5e6f", "ff00ff00ff00ff00ff00ff00ff00ff00ff00ff00ff00ff00ff00ff00ff00ff00", "0xfE3dA5E08c62eCa5DB94B21771bc9FD0ED9D049B"},
+}
+
+func TestDeriveKeyVectors(t *testing.T) {
+	for _, vector := range derivationVectors {
+		salt, err := hex.DecodeString(vector.salt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := deriveKey(vector.version, vector.invoiceID, salt)
+		if err != nil {
+			t.Fatalf("version %d invoice %q: %v", vector.version, vector.invoiceID, err)
+		}
+		if address := crypto.PubkeyToAddress(key.PublicKey).Hex(); address != vector.address {
+			t.Errorf("version %d invoice %q: got %s want %s", vector.version, vector.invoiceID, address, vector.address)
+		}
+	}
+}
+
+func TestDeriveKeyErrors(t *testing.T) {
+	salt := make([]byte, SaltSize)
+	for _, tc := range []struct {
+		name      string
+		version   int
+		invoiceID string
+		salt      []byte
+	}{
+		{"short salt", DerivationHKDF, "id", salt[1:]},
+		{"hkdf without invoice", DerivationHKDF, "", salt},
+		{"hd", DerivationHD, "id", salt},
+		{"unknown", 99, "id", salt},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := deriveKey(tc.version, tc.invoiceID, tc.salt); err == nil {
+				t.Fatal("no error")
+			}
+		})
+	}
+}
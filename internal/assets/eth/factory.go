@@ -3,9 +3,14 @@ package eth
 import (
 	"context"
 	"cpg/pkg/cpg"
+	"cpg/pkg/hdwallet"
+	"crypto/ecdsa"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/itsabgr/ge"
 	"math/big"
+	"os"
+	"strings"
 	"time"
 )
 
@@ -14,12 +19,30 @@ var _ cpg.AssetFactory = Factory{}
 type Factory struct{}
 
 type FactoryConfig struct {
+	// EthClientEndpoint is left empty on an air-gapped host, the asset can then only sign sweep bundles
 	EthClientEndpoint  string   `json:"eth_client_endpoint"`
 	TxGasLimit         uint64   `json:"tx_gas_limit"`
 	MinDelaySeconds    uint16   `json:"min_delay_seconds"`
 	ChainID            *big.Int `json:"chain_id"`
 	MinAllowedAmount   *big.Int `json:"min_allowed_amount"`
 	MaxAllowedGasPrice *big.Int `json:"max_allowed_gas_price"`
+	Decimals           *uint8   `json:"decimals"`
+	Symbol             string   `json:"symbol"`
+	TokenGasLimit      uint64   `json:"token_gas_limit"`
+	// GasFunderKeyFile is a hex private key file of the account funding gas of token rescues
+	GasFunderKeyFile string `json:"gas_funder_key_file"`
+	// TreasuryKeyFile is a hex private key file of the treasury wallet treasury mode invoices sweep into
+	TreasuryKeyFile string `json:"treasury_key_file"`
+	// PayoutKeyFile is a hex private key file of the funding wallet outgoing payouts are paid from
+	PayoutKeyFile string `json:"payout_key_file"`
+	// HDSeedFile is a bip39 mnemonic or hex seed file, with it new invoice wallets are children of HDPath at allocated indexes
+	HDSeedFile string `json:"hd_seed_file"`
+	// HDSeedPassphraseFile is the optional bip39 passphrase file of the mnemonic
+	HDSeedPassphraseFile string `json:"hd_seed_passphrase_file"`
+	// HDPath is the account path, the invoice index is appended to it, m/44'/60'/0'/0 by default
+	HDPath string `json:"hd_path"`
+	// HDXPub is the account xpub at HDPath, see cpg xpub, with it the asset is watch-only and holds no keys
+	HDXPub string `json:"hd_xpub"`
 }
 
 func (Factory) Name() string {
@@ -32,9 +55,56 @@ func (Factory) Config() any {
 
 func (fac Factory) New(ctx context.Context, config any) (cpg.Asset, error) {
 	conf := config.(*FactoryConfig)
-	ethClient, err := ethclient.Dial(conf.EthClientEndpoint)
-	if err != nil {
-		return nil, ge.Wrap(ge.New("failed to dial eth endpoint"), err)
+	decimals := uint8(18)
+	if conf.Decimals != nil {
+		decimals = *conf.Decimals
+	}
+	var gasFunder *ecdsa.PrivateKey
+	if conf.GasFunderKeyFile != "" {
+		var err error
+		if gasFunder, err = crypto.LoadECDSA(conf.GasFunderKeyFile); err != nil {
+			return nil, ge.Wrap(ge.New("failed to load gas funder key"), err)
+		}
+	}
+	var treasury *ecdsa.PrivateKey
+	if conf.TreasuryKeyFile != "" {
+		var err error
+		if treasury, err = crypto.LoadECDSA(conf.TreasuryKeyFile); err != nil {
+			return nil, ge.Wrap(ge.New("failed to load treasury key"), err)
+		}
+	}
+	var payout *ecdsa.PrivateKey
+	if conf.PayoutKeyFile != "" {
+		var err error
+		if payout, err = crypto.LoadECDSA(conf.PayoutKeyFile); err != nil {
+			return nil, ge.Wrap(ge.New("failed to load payout key"), err)
+		}
+	}
+	if conf.HDXPub != "" && (conf.HDSeedFile != "" || conf.GasFunderKeyFile != "" || conf.TreasuryKeyFile != "" || conf.PayoutKeyFile != "") {
+		return nil, ge.New("watch-only asset config with keys, hd_xpub excludes hd_seed_file, gas_funder_key_file, treasury_key_file and payout_key_file")
+	}
+	var hdAccount *hdwallet.Key
+	if conf.HDSeedFile != "" {
+		var err error
+		if hdAccount, err = loadHDAccount(conf); err != nil {
+			return nil, ge.Wrap(ge.New("failed to load hd wallet seed"), err)
+		}
+	}
+	if conf.HDXPub != "" {
+		var err error
+		if hdAccount, err = hdwallet.Parse(conf.HDXPub); err != nil {
+			return nil, ge.Wrap(ge.New("invalid hd xpub"), err)
+		}
+		if hdAccount.IsPrivate() {
+			return nil, ge.New("hd_xpub is a private key, give the xpub")
+		}
+	}
+	var ethClient *ethclient.Client
+	if conf.EthClientEndpoint != "" {
+		var err error
+		if ethClient, err = ethclient.Dial(conf.EthClientEndpoint); err != nil {
+			return nil, ge.Wrap(ge.New("failed to dial eth endpoint"), err)
+		}
 	}
 	return New(ctx, Config{
 		EthClient:          ethClient,
@@ -43,5 +113,32 @@ func (fac Factory) New(ctx context.Context, config any) (cpg.Asset, error) {
 		MinAllowedAmount:   conf.MinAllowedAmount,
 		MinDelay:           time.Duration(conf.MinDelaySeconds) * time.Second,
 		MaxAllowedGasPrice: conf.MaxAllowedGasPrice,
+		Decimals:           decimals,
+		Symbol:             conf.Symbol,
+		TokenGasLimit:      conf.TokenGasLimit,
+		GasFunder:          gasFunder,
+		Treasury:           treasury,
+		Payout:             payout,
+		HDAccount:          hdAccount,
 	})
 }
+
+func loadHDAccount(conf *FactoryConfig) (*hdwallet.Key, error) {
+	seed, err := os.ReadFile(conf.HDSeedFile)
+	if err != nil {
+		return nil, err
+	}
+	passphrase := ""
+	if conf.HDSeedPassphraseFile != "" {
+		data, err := os.ReadFile(conf.HDSeedPassphraseFile)
+		if err != nil {
+			return nil, err
+		}
+		passphrase = strings.TrimRight(string(data), "\r\n")
+	}
+	path := conf.HDPath
+	if path == "" {
+		path = hdwallet.DefaultEthereumPath
+	}
+	return HDAccount(string(seed), passphrase, path)
+}
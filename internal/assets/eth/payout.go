@@ -0,0 +1,87 @@
+package eth
+
+import (
+	"context"
+	"cpg/pkg/cpg"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/itsabgr/ge"
+	"math/big"
+	"time"
+)
+
+var _ cpg.Payer = (*asset)(nil)
+
+// PayoutAddress is the address of the payout key, empty without one
+func (ass *asset) PayoutAddress() string {
+	if ass.payout == nil {
+		return ""
+	}
+	return crypto.PubkeyToAddress(ass.payout.PublicKey).Hex()
+}
+
+// SendPayout sends the amount from the payout wallet, the fee is paid on top of it
+func (ass *asset) SendPayout(ctx context.Context, to string, amount *big.Int, signed func(cpg.SweepResult) error) (result cpg.SweepResult, err error) {
+
+	if ass.payout == nil {
+		return result, ge.New("asset has no payout key")
+	}
+	if ass.ethClient == nil {
+		return result, errOffline
+	}
+	if !validateAddress(to) {
+		return result, ge.Detail(ge.New("invalid payout destination"), ge.D{"to": to})
+	}
+
+	ass.payoutLock.Lock()
+	defer ass.payoutLock.Unlock()
+
+	gasPrice, err := ass.checkGasPrice(ctx)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to check gas price"), err)
+	}
+	result.Fee = new(big.Int).Mul(gasPrice, &ass.txGasLimit)
+	result.Amount = new(big.Int).Set(amount)
+
+	signedTx, err := ass.signFunding(ctx, ass.payout, common.HexToAddress(to), gasPrice, result.Amount)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to sign payout"), err)
+	}
+	result.TxHash = signedTx.Hash().Hex()
+	if err = signed(result); err != nil {
+		return result, err
+	}
+	if err = ass.ethClient.SendTransaction(ctx, signedTx); err != nil {
+		return result, ge.Wrap(ge.New("failed to send payout"), err)
+	}
+	return result, nil
+}
+
+// TxConfirmations counts the block of the tx and the blocks after it
+func (ass *asset) TxConfirmations(ctx context.Context, txHash string) (uint64, error) {
+	if ass.ethClient == nil {
+		return 0, errOffline
+	}
+	timeout, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+	receipt, err := ass.ethClient.TransactionReceipt(timeout, common.HexToHash(txHash))
+	if err == ethereum.NotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return 0, cpg.ErrTxReverted
+	}
+	head, err := ass.ethClient.BlockNumber(timeout)
+	if err != nil {
+		return 0, err
+	}
+	if head < receipt.BlockNumber.Uint64() {
+		return 0, nil
+	}
+	return head - receipt.BlockNumber.Uint64() + 1, nil
+}
@@ -0,0 +1,177 @@
+package eth
+
+import (
+	"context"
+	"cpg/pkg/cpg"
+	"crypto/ecdsa"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/itsabgr/fak"
+	"github.com/itsabgr/ge"
+	"math/big"
+	"time"
+)
+
+var (
+	erc20BalanceOf = crypto.Keccak256([]byte("balanceOf(address)"))[:4]
+	erc20Transfer  = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+)
+
+func (ass *asset) tokenBalance(ctx context.Context, token, wallet common.Address) (*big.Int, error) {
+	timeout, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+	out, err := ass.ethClient.CallContract(timeout, ethereum.CallMsg{
+		To:   &token,
+		Data: append(append([]byte{}, erc20BalanceOf...), common.LeftPadBytes(wallet.Bytes(), 32)...),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(out) != 32 {
+		return nil, ge.New("token balanceOf returned no uint256, is it an erc-20 contract")
+	}
+	return (&big.Int{}).SetBytes(out), nil
+}
+
+// waitMined polls the receipt of tx until it is mined successfully
+func (ass *asset) waitMined(ctx context.Context, hash common.Hash) error {
+	ticker := time.NewTicker(time.Second * 2)
+	defer ticker.Stop()
+	for {
+		receipt, err := ass.ethClient.TransactionReceipt(ctx, hash)
+		if err == nil {
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return ge.Detail(ge.New("tx reverted"), ge.D{"tx": hash.Hex()})
+			}
+			return nil
+		}
+		if err != ethereum.NotFound {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ass *asset) RescueToken(ctx context.Context, invoice *cpg.Invoice, params cpg.TokenRescueParams) (result cpg.TokenRescueResult, err error) {
+
+	if ass.info.WatchOnly {
+		return result, cpg.ErrWatchOnly
+	}
+	if ass.ethClient == nil {
+		return result, errOffline
+	}
+
+	if !validateAddress(params.Token) {
+		return result, ge.Detail(ge.New("invalid token address"), ge.D{"token": params.Token})
+	}
+	if !validateAddress(params.To) {
+		return result, ge.Detail(ge.New("invalid rescue destination"), ge.D{"to": params.To})
+	}
+
+	walletPrivateKey, err := ass.walletKey(ctx, invoice)
+	if err != nil {
+		return result, err
+	}
+	wallet := crypto.PubkeyToAddress(walletPrivateKey.PublicKey)
+	if wallet.Hex() != invoice.WalletAddress {
+		return result, ge.New("derived wallet key does not match the invoice wallet address")
+	}
+	token := common.HexToAddress(params.Token)
+
+	if result.TokenBalance, err = ass.tokenBalance(ctx, token, wallet); err != nil {
+		return result, ge.Wrap(ge.New("failed to get token balance"), err)
+	}
+	if result.TokenBalance.Sign() <= 0 {
+		return result, ge.New("no token balance")
+	}
+
+	gasPrice, err := ass.checkGasPrice(ctx)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to check gas price"), err)
+	}
+	result.Fee = (&big.Int{}).Mul(gasPrice, (&big.Int{}).SetUint64(ass.tokenGasLimit))
+
+	walletBalance, err := ass.GetBalance(ctx, invoice)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to get wallet balance"), err)
+	}
+
+	var fundingTx *types.Transaction
+	if walletBalance.Cmp(result.Fee) < 0 {
+		result.FundingAmount = (&big.Int{}).Sub(result.Fee, walletBalance)
+		if !params.FundGas {
+			return result, ge.Detail(ge.New("wallet can not pay the token transfer fee, allow gas funding"), ge.D{"missing": result.FundingAmount})
+		}
+		if ass.gasFunder == nil {
+			return result, ge.New("no gas funder is configured for the asset")
+		}
+		if fundingTx, err = ass.signFunding(ctx, ass.gasFunder, wallet, gasPrice, result.FundingAmount); err != nil {
+			return result, ge.Wrap(ge.New("failed to sign gas funding tx"), err)
+		}
+		result.FundingTxHash = fundingTx.Hash().Hex()
+	}
+
+	walletNonce, err := ass.ethClient.PendingNonceAt(ctx, wallet)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to get wallet pending nonce"), err)
+	}
+
+	data := append([]byte{}, erc20Transfer...)
+	data = append(data, common.LeftPadBytes(common.HexToAddress(params.To).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(result.TokenBalance.Bytes(), 32)...)
+
+	signedTx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    walletNonce,
+		GasPrice: gasPrice,
+		Gas:      ass.tokenGasLimit,
+		To:       &token,
+		Value:    big.NewInt(0),
+		Data:     data,
+	}), types.NewEIP155Signer(&ass.chainID), walletPrivateKey)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to sign token transfer tx"), err)
+	}
+	result.TxHash = signedTx.Hash().Hex()
+
+	if params.DryRun {
+		return result, nil
+	}
+
+	if fundingTx != nil {
+		if err = ass.ethClient.SendTransaction(ctx, fundingTx); err != nil {
+			result.FundingTxHash = ""
+			return result, ge.Wrap(ge.New("failed to send gas funding tx"), err)
+		}
+		// nodes refuse the transfer until the funding is in the wallet balance
+		if err = ass.waitMined(ctx, fundingTx.Hash()); err != nil {
+			return result, ge.Wrap(ge.New("gas funding tx is not mined"), err)
+		}
+	}
+
+	if err = ass.ethClient.SendTransaction(ctx, signedTx); err != nil {
+		result.TxHash = ""
+		return result, ge.Wrap(ge.New("failed to send token transfer tx"), err)
+	}
+
+	return result, nil
+}
+
+func (ass *asset) signFunding(ctx context.Context, funder *ecdsa.PrivateKey, to common.Address, gasPrice, amount *big.Int) (*types.Transaction, error) {
+	nonce, err := ass.ethClient.PendingNonceAt(ctx, crypto.PubkeyToAddress(funder.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+	return types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      ass.txGasLimit.Uint64(),
+		To:       fak.Ptr(to),
+		Value:    amount,
+	}), types.NewEIP155Signer(&ass.chainID), funder)
+}
@@ -0,0 +1,74 @@
+package eth
+
+import (
+	"context"
+	"cpg/pkg/cpg"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/itsabgr/ge"
+	"math/big"
+	"time"
+)
+
+var _ cpg.Treasurer = (*asset)(nil)
+
+// TreasuryAddress is the address of the treasury key, empty without one
+func (ass *asset) TreasuryAddress() string {
+	if ass.treasury == nil {
+		return ""
+	}
+	return crypto.PubkeyToAddress(ass.treasury.PublicKey).Hex()
+}
+
+// TreasuryBalance is the pending balance, so settlements sent in the same pass are already taken off
+func (ass *asset) TreasuryBalance(ctx context.Context) (*big.Int, error) {
+	if ass.treasury == nil {
+		return nil, ge.New("asset has no treasury key")
+	}
+	if ass.ethClient == nil {
+		return nil, errOffline
+	}
+	timeout, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+	return ass.ethClient.PendingBalanceAt(timeout, crypto.PubkeyToAddress(ass.treasury.PublicKey))
+}
+
+// TreasuryTransfer sends the amount minus the tx fee from the treasury wallet
+func (ass *asset) TreasuryTransfer(ctx context.Context, to string, amount *big.Int, signed func(cpg.SweepResult) error) (result cpg.SweepResult, err error) {
+
+	if ass.treasury == nil {
+		return result, ge.New("asset has no treasury key")
+	}
+	if ass.ethClient == nil {
+		return result, errOffline
+	}
+	if !validateAddress(to) {
+		return result, ge.Detail(ge.New("invalid treasury transfer destination"), ge.D{"to": to})
+	}
+
+	ass.treasuryLock.Lock()
+	defer ass.treasuryLock.Unlock()
+
+	gasPrice, err := ass.checkGasPrice(ctx)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to check gas price"), err)
+	}
+	result.Fee = new(big.Int).Mul(gasPrice, &ass.txGasLimit)
+	if amount.Cmp(result.Fee) <= 0 {
+		return result, ge.Detail(ge.New("tx fee overcomes the transfer amount"), ge.D{"amount": amount, "fee": result.Fee})
+	}
+	result.Amount = new(big.Int).Sub(amount, result.Fee)
+
+	signedTx, err := ass.signFunding(ctx, ass.treasury, common.HexToAddress(to), gasPrice, result.Amount)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to sign treasury transfer"), err)
+	}
+	result.TxHash = signedTx.Hash().Hex()
+	if err = signed(result); err != nil {
+		return result, err
+	}
+	if err = ass.ethClient.SendTransaction(ctx, signedTx); err != nil {
+		return result, ge.Wrap(ge.New("failed to send treasury transfer"), err)
+	}
+	return result, nil
+}
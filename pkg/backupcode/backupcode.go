@@ -0,0 +1,255 @@
+// Package backupcode encodes opaque invoice backups for paper, as a checksummed word list or a sequence of qr codes
+package backupcode
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"github.com/itsabgr/ge"
+	"github.com/skip2/go-qrcode"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	wordBits     = 11
+	wordsPerLine = 8
+	// QRPrefix starts the text of every qr part
+	QRPrefix = "cpgqr1:"
+	// DefaultQRPartSize keeps each part small enough to scan from paper at a low error correction level
+	DefaultQRPartSize = 256
+)
+
+var (
+	words       = wordlists.English
+	wordIndex   = map[string]int{}
+	prefixIndex = map[string]int{}
+)
+
+func init() {
+	for i, word := range words {
+		wordIndex[word] = i
+		// the first four letters of every word are unique, so they are accepted as an abbreviation
+		if len(word) >= 4 {
+			prefixIndex[word[:4]] = i
+		}
+	}
+}
+
+func checksum(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:4]
+}
+
+// EncodeWords encodes data with its length and checksum as numbered lines of words from the bip39 english list
+func EncodeWords(data []byte) string {
+	ge.Assert(len(data) <= 0xffff, ge.New("data is too long to encode as words"))
+
+	payload := binary.BigEndian.AppendUint16(nil, uint16(len(data)))
+	payload = append(payload, data...)
+	payload = append(payload, checksum(data)...)
+
+	var out strings.Builder
+	var acc uint32
+	accBits := 0
+	n := 0
+	emit := func(index int) {
+		if n%wordsPerLine == 0 {
+			if n > 0 {
+				out.WriteByte('\n')
+			}
+			_, _ = fmt.Fprintf(&out, "%03d", n/wordsPerLine+1)
+		}
+		out.WriteByte(' ')
+		out.WriteString(words[index])
+		n++
+	}
+	for _, b := range payload {
+		acc = acc<<8 | uint32(b)
+		accBits += 8
+		for accBits >= wordBits {
+			accBits -= wordBits
+			emit(int(acc>>accBits) & (1<<wordBits - 1))
+		}
+	}
+	if accBits > 0 {
+		emit(int(acc<<(wordBits-accBits)) & (1<<wordBits - 1))
+	}
+	out.WriteByte('\n')
+	return out.String()
+}
+
+func lookupWord(token string) (int, bool) {
+	token = strings.ToLower(token)
+	if index, ok := wordIndex[token]; ok {
+		return index, true
+	}
+	if len(token) == 4 {
+		index, ok := prefixIndex[token]
+		return index, ok
+	}
+	return 0, false
+}
+
+// isLineNumber reports tokens like 007 or 7: or 7. written in front of word lines
+func isLineNumber(token string) bool {
+	_, err := strconv.Atoi(strings.TrimRight(token, ":."))
+	return err == nil
+}
+
+// DecodeWords decodes the text made by EncodeWords, line numbers are ignored and words may be abbreviated to their first four letters
+func DecodeWords(text string) ([]byte, error) {
+	var payload []byte
+	var acc uint32
+	accBits := 0
+	for i, token := range strings.Fields(text) {
+		if isLineNumber(token) {
+			continue
+		}
+		index, ok := lookupWord(token)
+		if !ok {
+			return nil, ge.Detail(ge.New("unknown backup word"), ge.D{"word": token, "position": i + 1})
+		}
+		acc = acc<<wordBits | uint32(index)
+		accBits += wordBits
+		for accBits >= 8 {
+			accBits -= 8
+			payload = append(payload, byte(acc>>accBits))
+		}
+	}
+	if len(payload) < 2+4 {
+		return nil, ge.New("too few backup words")
+	}
+	n := int(binary.BigEndian.Uint16(payload))
+	if len(payload) < 2+n+4 || len(payload)-(2+n+4) > 1 {
+		return nil, ge.New("backup words length mismatch, a word may be missing or repeated")
+	}
+	data, sum := payload[2:2+n], payload[2+n:2+n+4]
+	if !bytes.Equal(sum, checksum(data)) {
+		return nil, ge.New("backup words checksum mismatch, check for typos")
+	}
+	return data, nil
+}
+
+// EncodeQR splits data into the texts of a qr sequence, each part carries its position, the parts count and the whole data checksum
+func EncodeQR(data []byte, partSize int) []string {
+	if partSize <= 0 {
+		partSize = DefaultQRPartSize
+	}
+	count := (len(data) + partSize - 1) / partSize
+	if count == 0 {
+		count = 1
+	}
+	sum := hex.EncodeToString(checksum(data))
+	parts := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		chunk := data[i*partSize : min((i+1)*partSize, len(data))]
+		parts = append(parts, fmt.Sprintf("%s%d:%d:%s:%s", QRPrefix, i+1, count, sum, base64.RawURLEncoding.EncodeToString(chunk)))
+	}
+	return parts
+}
+
+// DecodeQR joins scanned qr part texts given in any order, duplicates are ignored
+func DecodeQR(parts []string) ([]byte, error) {
+	var (
+		count  int
+		sum    string
+		chunks = map[int][]byte{}
+	)
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		rest, ok := strings.CutPrefix(part, QRPrefix)
+		if !ok {
+			return nil, ge.New("not a backup qr part")
+		}
+		fields := strings.SplitN(rest, ":", 4)
+		if len(fields) != 4 {
+			return nil, ge.New("invalid backup qr part")
+		}
+		index, err1 := strconv.Atoi(fields[0])
+		n, err2 := strconv.Atoi(fields[1])
+		chunk, err3 := base64.RawURLEncoding.DecodeString(fields[3])
+		if err1 != nil || err2 != nil || err3 != nil || index < 1 || index > n {
+			return nil, ge.New("invalid backup qr part")
+		}
+		if count == 0 {
+			count, sum = n, fields[2]
+		} else if n != count || fields[2] != sum {
+			return nil, ge.New("backup qr parts belong to different backups")
+		}
+		chunks[index] = chunk
+	}
+	if count == 0 {
+		return nil, ge.New("no backup qr part")
+	}
+	var data []byte
+	var missing []int
+	for i := 1; i <= count; i++ {
+		chunk, ok := chunks[i]
+		if !ok {
+			missing = append(missing, i)
+		}
+		data = append(data, chunk...)
+	}
+	if len(missing) > 0 {
+		return nil, ge.Detail(ge.New("missing backup qr parts"), ge.D{"missing": missing, "count": count})
+	}
+	if hex.EncodeToString(checksum(data)) != sum {
+		return nil, ge.New("backup qr checksum mismatch")
+	}
+	return data, nil
+}
+
+// QRPNG renders a part text as a png image
+func QRPNG(part string, size int) ([]byte, error) {
+	return qrcode.Encode(part, qrcode.Low, size)
+}
+
+// QRTerminal renders a part text with unicode half blocks to print on a terminal
+func QRTerminal(part string) (string, error) {
+	code, err := qrcode.New(part, qrcode.Low)
+	if err != nil {
+		return "", err
+	}
+	return code.ToSmallString(false), nil
+}
+
+func isText(data []byte) bool {
+	if !utf8.Valid(data) {
+		return false
+	}
+	return !slices.ContainsFunc([]rune(string(data)), func(r rune) bool {
+		return !unicode.IsPrint(r) && !unicode.IsSpace(r)
+	})
+}
+
+// Decode returns the raw backup of data which is either raw, a word list or newline separated qr part texts
+func Decode(data []byte) ([]byte, error) {
+	if !isText(data) {
+		return data, nil
+	}
+	text := strings.TrimSpace(string(data))
+	if strings.HasPrefix(text, QRPrefix) {
+		return DecodeQR(strings.Split(text, "\n"))
+	}
+	for _, token := range strings.Fields(text) {
+		if isLineNumber(token) {
+			continue
+		}
+		if _, ok := lookupWord(token); ok {
+			return DecodeWords(text)
+		}
+		break
+	}
+	return data, nil
+}
@@ -0,0 +1,163 @@
+package backupcode
+
+import (
+	"bytes"
+	"crypto/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func randomData(t *testing.T, n int) []byte {
+	t.Helper()
+	data := make([]byte, n)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestWordsRoundTrip(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 10, 11, 100, 333} {
+		data := randomData(t, n)
+		text := EncodeWords(data)
+		decoded, err := DecodeWords(text)
+		if err != nil || !bytes.Equal(decoded, data) {
+			t.Fatalf("%d bytes: decoded %x: %v", n, decoded, err)
+		}
+
+		// words abbreviated to four letters, upper case and other line numbers
+		var abbreviated []string
+		for _, token := range strings.Fields(text) {
+			switch {
+			case isLineNumber(token):
+				abbreviated = append(abbreviated, strings.TrimLeft(token, "0")+":")
+			case len(token) > 4:
+				abbreviated = append(abbreviated, strings.ToUpper(token[:4]))
+			default:
+				abbreviated = append(abbreviated, token)
+			}
+		}
+		if decoded, err = DecodeWords(strings.Join(abbreviated, " ")); err != nil || !bytes.Equal(decoded, data) {
+			t.Fatalf("%d bytes abbreviated: decoded %x: %v", n, decoded, err)
+		}
+	}
+}
+
+func TestWordsDamaged(t *testing.T) {
+	wordsOf := func(text string) []string {
+		return slices.DeleteFunc(strings.Fields(text), isLineNumber)
+	}
+	data := randomData(t, 40)
+	valid := wordsOf(EncodeWords(data))
+	replaced := slices.Clone(valid)
+	if replaced[10] == words[0] {
+		replaced[10] = words[1]
+	} else {
+		replaced[10] = words[0]
+	}
+
+	for _, tc := range []struct {
+		name  string
+		words []string
+	}{
+		{"bad checksum", replaced},
+		{"missing word", slices.Delete(slices.Clone(valid), 10, 11)},
+		{"repeated word", slices.Insert(slices.Clone(valid), 10, valid[10])},
+		{"swapped words", func() []string {
+			swapped := slices.Clone(valid)
+			swapped[10], swapped[11] = swapped[11], swapped[10]
+			if swapped[10] == swapped[11] {
+				swapped[10], swapped[12] = swapped[12], swapped[10]
+			}
+			return swapped
+		}()},
+		{"unknown word", slices.Replace(slices.Clone(valid), 10, 11, "notaword")},
+		{"too few words", valid[:3]},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if decoded, err := DecodeWords(strings.Join(tc.words, " ")); err == nil {
+				t.Fatalf("decoded %x", decoded)
+			}
+		})
+	}
+}
+
+func TestQRRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		size     int
+		partSize int
+		parts    int
+	}{
+		{"empty", 0, 10, 1},
+		{"one part", 10, 10, 1},
+		{"parts", 95, 10, 10},
+		{"default part size", 1000, 0, 4},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data := randomData(t, tc.size)
+			parts := EncodeQR(data, tc.partSize)
+			if len(parts) != tc.parts {
+				t.Fatalf("%d parts, not %d", len(parts), tc.parts)
+			}
+			// scanned in reverse order, one part twice
+			scanned := slices.Clone(parts)
+			slices.Reverse(scanned)
+			scanned = append(scanned, parts[0], "")
+			decoded, err := DecodeQR(scanned)
+			if err != nil || !bytes.Equal(decoded, data) {
+				t.Fatalf("decoded %x: %v", decoded, err)
+			}
+		})
+	}
+}
+
+func TestQRDamaged(t *testing.T) {
+	parts := EncodeQR(randomData(t, 50), 10)
+	other := EncodeQR(randomData(t, 50), 10)
+	tampered := slices.Clone(parts)
+	// a part whose chunk is another one keeps a valid encoding but breaks the checksum
+	fields := strings.SplitN(parts[1], ":", 5)
+	tampered[2] = strings.Join(append(strings.SplitN(tampered[2], ":", 5)[:4], fields[4]), ":")
+
+	for _, tc := range []struct {
+		name  string
+		parts []string
+	}{
+		{"missing part", slices.Delete(slices.Clone(parts), 2, 3)},
+		{"no part", nil},
+		{"parts of another backup", append(slices.Clone(parts[:2]), other[2:]...)},
+		{"bad checksum", tampered},
+		{"not a qr part", append(slices.Clone(parts), "hello")},
+		{"part out of range", append(slices.Clone(parts), QRPrefix+"9:5:00000000:AA")},
+		{"invalid part", append(slices.Clone(parts), QRPrefix+"1:5")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if decoded, err := DecodeQR(tc.parts); err == nil {
+				t.Fatalf("decoded %x", decoded)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	data := append([]byte{0, 0xff}, randomData(t, 60)...)
+	for _, tc := range []struct {
+		name string
+		in   []byte
+		want []byte
+	}{
+		{"raw", data, data},
+		{"words", []byte(EncodeWords(data)), data},
+		{"qr parts", []byte(strings.Join(EncodeQR(data, 16), "\n") + "\n"), data},
+		{"json", []byte(`{"format":2}`), []byte(`{"format":2}`)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, err := Decode(tc.in)
+			if err != nil || !bytes.Equal(decoded, tc.want) {
+				t.Fatalf("decoded %x: %v", decoded, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package cpg
+
+import (
+	"math/big"
+	"strings"
+)
+
+// FormatAmount renders an amount of the smallest asset unit as a decimal string with the given decimals
+func FormatAmount(amount *big.Int, decimals uint8) string {
+	if amount == nil {
+		return "0"
+	}
+	sign := ""
+	digits := amount.Text(10)
+	if amount.Sign() < 0 {
+		sign = "-"
+		digits = digits[1:]
+	}
+	if decimals == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	integer, fraction := digits[:len(digits)-int(decimals)], strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+	if fraction == "" {
+		return sign + integer
+	}
+	return sign + integer + "." + fraction
+}
+
+// ParseAmount is the inverse of FormatAmount for positive amounts
+func ParseAmount(str string, decimals uint8) (*big.Int, bool) {
+	str = strings.TrimSpace(str)
+	integer, fraction, _ := strings.Cut(str, ".")
+	if len(fraction) > int(decimals) || (integer == "" && fraction == "") || !isDigits(integer) || !isDigits(fraction) {
+		return nil, false
+	}
+	fraction += strings.Repeat("0", int(decimals)-len(fraction))
+	amount, ok := (&big.Int{}).SetString(integer+fraction, 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil, false
+	}
+	return amount, true
+}
+
+// isDigits rejects the signs big.Int accepts
+func isDigits(str string) bool {
+	for _, c := range str {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
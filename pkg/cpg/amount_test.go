@@ -0,0 +1,82 @@
+package cpg
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFormatAmount(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		amount   *big.Int
+		decimals uint8
+		want     string
+	}{
+		{"no decimals", big.NewInt(1234), 0, "1234"},
+		{"decimals", big.NewInt(1234500), 6, "1.2345"},
+		{"whole", big.NewInt(2000000), 6, "2"},
+		{"under one", big.NewInt(5), 6, "0.000005"},
+		{"as many digits as decimals", big.NewInt(123456), 6, "0.123456"},
+		{"eighteen decimals", new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil), 18, "1"},
+		{"zero", big.NewInt(0), 6, "0"},
+		{"nil", nil, 6, "0"},
+		{"negative", big.NewInt(-1500000), 6, "-1.5"},
+		{"negative under one", big.NewInt(-5), 2, "-0.05"},
+		{"negative no decimals", big.NewInt(-7), 0, "-7"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := FormatAmount(tc.amount, tc.decimals); got != tc.want {
+				t.Fatalf("formatted %q, not %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		str      string
+		decimals uint8
+		want     int64
+	}{
+		{"integer", "12", 6, 12000000},
+		{"decimals", "1.2345", 6, 1234500},
+		{"full precision", "0.000001", 6, 1},
+		{"no integer", ".5", 2, 50},
+		{"no fraction", "5.", 2, 500},
+		{"spaces", " 1.5 ", 1, 15},
+		{"no decimals", "42", 0, 42},
+		{"precision overflow", "0.0000001", 6, 0},
+		{"fraction without decimals", "1.5", 0, 0},
+		{"zero", "0", 6, 0},
+		{"zero fraction", "0.000", 6, 0},
+		{"negative", "-1", 6, 0},
+		{"negative fraction", "-0.5", 6, 0},
+		{"plus sign", "+1", 6, 0},
+		{"plus sign fraction", ".+5", 6, 0},
+		{"sign in fraction", "1.-5", 6, 0},
+		{"empty", "", 6, 0},
+		{"dot", ".", 6, 0},
+		{"two dots", "1.2.3", 6, 0},
+		{"letters", "1e6", 6, 0},
+		{"underscore", "1_000", 6, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			amount, ok := ParseAmount(tc.str, tc.decimals)
+			if ok != (tc.want != 0) || ok && amount.Cmp(big.NewInt(tc.want)) != 0 {
+				t.Fatalf("parsed %v %v, not %d", amount, ok, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountRoundTrip(t *testing.T) {
+	for _, decimals := range []uint8{0, 1, 6, 18} {
+		for _, n := range []int64{1, 9, 10, 123456789, 1000000000000} {
+			amount, ok := ParseAmount(FormatAmount(big.NewInt(n), decimals), decimals)
+			if !ok || amount.Cmp(big.NewInt(n)) != 0 {
+				t.Fatalf("%d with %d decimals round tripped to %v", n, decimals, amount)
+			}
+		}
+	}
+}
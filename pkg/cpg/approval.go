@@ -0,0 +1,293 @@
+package cpg
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ApproverKeyHeader carries the key of the approver calling the approval rpcs
+const ApproverKeyHeader = "x-approver-key"
+
+// ErrAwaitingApproval is returned for a checkout held until its approvers approve it
+var ErrAwaitingApproval = ge.New("checkout is awaiting approval")
+
+// ApprovalRule holds checkouts of at least Threshold until Approvers distinct approvers approve them
+type ApprovalRule struct {
+	Threshold *big.Int `json:"threshold"`
+	Approvers int      `json:"approvers"`
+}
+
+// ApprovalPolicy maps asset names to their approval rule
+type ApprovalPolicy map[string]ApprovalRule
+
+func ParseApprovalPolicy(data []byte) (ApprovalPolicy, error) {
+	policy := ApprovalPolicy{}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, err
+	}
+	for asset, rule := range policy {
+		if rule.Threshold == nil || rule.Threshold.Sign() < 0 {
+			return nil, ge.Detail(ge.New("approval threshold must not be negative"), ge.D{"asset": asset})
+		}
+		if rule.Approvers < 1 {
+			return nil, ge.Detail(ge.New("approval rule needs at least one approver"), ge.D{"asset": asset})
+		}
+	}
+	return policy, nil
+}
+
+type approver struct {
+	name string
+	key  []byte
+}
+
+// Approvers are the named keys allowed to approve and reject checkouts
+type Approvers []approver
+
+// ParseApprovers reads name=key entries, names and keys must be distinct
+func ParseApprovers(entries []string) (Approvers, error) {
+	approvers := make(Approvers, 0, len(entries))
+	names, keys := map[string]bool{}, map[string]bool{}
+	for _, entry := range entries {
+		name, key, ok := strings.Cut(entry, "=")
+		if !ok || name == "" || len(key) < 16 {
+			return nil, ge.Detail(ge.New("approver entry must be name=key with a key of at least 16 characters"), ge.D{"name": name})
+		}
+		if names[name] || keys[key] {
+			return nil, ge.Detail(ge.New("duplicate approver name or key"), ge.D{"name": name})
+		}
+		names[name], keys[key] = true, true
+		approvers = append(approvers, approver{name: name, key: []byte(key)})
+	}
+	return approvers, nil
+}
+
+func (approvers Approvers) lookup(key string) (string, bool) {
+	found := ""
+	for _, approver := range approvers {
+		if subtle.ConstantTimeCompare([]byte(key), approver.key) == 1 {
+			found = approver.name
+		}
+	}
+	return found, found != ""
+}
+
+// UseApproval holds checkouts over the asset thresholds until enough approvers approve them
+func (cpg *CPG) UseApproval(policy ApprovalPolicy, approvers Approvers) error {
+	for asset, rule := range policy {
+		if rule.Approvers > len(approvers) {
+			return ge.Detail(ge.New("approval rule needs more approvers than configured"), ge.D{"asset": asset, "approvers": rule.Approvers})
+		}
+	}
+	cpg.approvalPolicy = policy
+	cpg.approvers = approvers
+	return nil
+}
+
+// checkApproval returns ErrAwaitingApproval and opens an approval request for a checkout over the asset threshold
+func (cpg *CPG) checkApproval(ctx context.Context, inv *Invoice, asset Asset) error {
+
+	rule, ok := cpg.approvalPolicy[inv.Asset]
+	if !ok {
+		return nil
+	}
+
+	balance, err := asset.GetBalance(ctx, inv)
+	if err != nil {
+		return ge.Wrap(ge.New("failed to get wallet balance"), err)
+	}
+	if balance.Cmp(rule.Threshold) < 0 {
+		return nil
+	}
+
+	if inv.ApproveAt != nil {
+		request, _, err := cpg.approvalRequest(ctx, inv.ID)
+		if err != nil {
+			return err
+		}
+		if request != nil {
+			if approved, ok := new(big.Int).SetString(request.Detail["amount"], 10); ok && balance.Cmp(approved) <= 0 {
+				return nil
+			}
+		}
+	}
+
+	if err = cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+		InvoiceID: inv.ID,
+		Kind:      InvoiceEventApprovalRequest,
+		Asset:     inv.Asset,
+		Detail: map[string]string{
+			"amount":    balance.String(),
+			"approvers": strconv.Itoa(rule.Approvers),
+		},
+	}); err != nil {
+		return ge.Wrap(ge.New("failed to record approval request"), err)
+	}
+	if err = cpg.db.RequestInvoiceApproval(ctx, inv.ID); err != nil {
+		return ge.Wrap(ge.New("failed to request approval"), err)
+	}
+
+	slog.Info("checkout awaiting approval", slog.String("invoice", inv.ID), slog.String("amount", balance.String()), slog.Int("approvers", rule.Approvers))
+	return ErrAwaitingApproval
+}
+
+// clearApproval consumes the approval of a swept checkout
+func (cpg *CPG) clearApproval(ctx context.Context, inv *Invoice) {
+	if inv.ApprovalRequestAt == nil {
+		return
+	}
+	if err := cpg.db.ClearInvoiceApproval(ctx, inv.ID); err != nil {
+		slog.Error("failed to clear checkout approval", slog.String("invoice", inv.ID), slog.String("error", err.Error()))
+	}
+}
+
+// approvalRequest returns the open approval request of an invoice and its approvers so far
+func (cpg *CPG) approvalRequest(ctx context.Context, invoiceID string) (*InvoiceEvent, map[string]bool, error) {
+	events, err := cpg.db.ListInvoiceEvents(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, ge.Wrap(ge.New("failed to list invoice events"), err)
+	}
+	var request *InvoiceEvent
+	approved := map[string]bool{}
+	for i := range events {
+		switch events[i].Kind {
+		case InvoiceEventApprovalRequest:
+			request = &events[i]
+			clear(approved)
+		case InvoiceEventCheckoutApproval:
+			approved[events[i].Detail["approver"]] = true
+		case InvoiceEventCheckoutRejection:
+			request = nil
+			clear(approved)
+		}
+	}
+	return request, approved, nil
+}
+
+type ApproveCheckoutParams struct {
+	InvoiceID   string
+	ApproverKey string
+	Note        string
+}
+
+type ApproveCheckoutResult struct {
+	Approver  string
+	Approvals int
+	Required  int
+	// Approved is set by the approval which completes the required count
+	Approved bool
+}
+
+func (cpg *CPG) ApproveCheckout(ctx context.Context, params ApproveCheckoutParams) (result ApproveCheckoutResult, err error) {
+
+	inv, request, approved, err := cpg.openApproval(ctx, params.InvoiceID, params.ApproverKey, &result.Approver)
+	if err != nil {
+		return result, err
+	}
+	if approved[result.Approver] {
+		return result, ge.New("approver already approved the checkout")
+	}
+	if result.Required, err = strconv.Atoi(request.Detail["approvers"]); err != nil {
+		return result, ge.Wrap(ge.New("invalid approval request"), err)
+	}
+
+	if err = cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+		InvoiceID: inv.ID,
+		Kind:      InvoiceEventCheckoutApproval,
+		Asset:     inv.Asset,
+		Detail: map[string]string{
+			"approver": result.Approver,
+			"amount":   request.Detail["amount"],
+			"note":     params.Note,
+		},
+	}); err != nil {
+		return result, ge.Wrap(ge.New("failed to record approval"), err)
+	}
+
+	// counted again after recording so concurrent approvals see each other
+	if _, approved, err = cpg.approvalRequest(ctx, inv.ID); err != nil {
+		return result, err
+	}
+	result.Approvals = len(approved)
+
+	if result.Approvals >= result.Required {
+		if err = cpg.db.ApproveInvoice(ctx, inv.ID, *inv.ApprovalRequestAt); err != nil {
+			return result, ge.Wrap(ge.New("failed to approve checkout"), err)
+		}
+		result.Approved = true
+		slog.Info("checkout approved", slog.String("invoice", inv.ID), slog.Int("approvals", result.Approvals))
+	}
+
+	return result, nil
+}
+
+type RejectCheckoutParams struct {
+	InvoiceID   string
+	ApproverKey string
+	Reason      string
+}
+
+// RejectCheckout drops the approval request along with the checkout request
+func (cpg *CPG) RejectCheckout(ctx context.Context, params RejectCheckoutParams) (approver string, err error) {
+
+	inv, request, _, err := cpg.openApproval(ctx, params.InvoiceID, params.ApproverKey, &approver)
+	if err != nil {
+		return approver, err
+	}
+
+	if err = cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+		InvoiceID: inv.ID,
+		Kind:      InvoiceEventCheckoutRejection,
+		Asset:     inv.Asset,
+		Detail: map[string]string{
+			"approver": approver,
+			"amount":   request.Detail["amount"],
+			"reason":   params.Reason,
+		},
+	}); err != nil {
+		return approver, ge.Wrap(ge.New("failed to record rejection"), err)
+	}
+
+	if err = cpg.db.RejectInvoiceApproval(ctx, inv.ID, *inv.ApprovalRequestAt); err != nil {
+		return approver, ge.Wrap(ge.New("failed to reject checkout"), err)
+	}
+
+	slog.Info("checkout rejected", slog.String("invoice", inv.ID), slog.String("approver", approver))
+	return approver, nil
+}
+
+// openApproval resolves the approver and returns an invoice awaiting approval
+func (cpg *CPG) openApproval(ctx context.Context, invoiceID, approverKey string, approver *string) (*Invoice, *InvoiceEvent, map[string]bool, error) {
+
+	name, ok := cpg.approvers.lookup(approverKey)
+	if !ok {
+		return nil, nil, nil, ge.New("unknown approver key")
+	}
+	*approver = name
+
+	inv, err := cpg.db.GetInvoice(ctx, invoiceID, "", false)
+	if err != nil {
+		return nil, nil, nil, ge.Wrap(ge.New("failed to get invoice"), err)
+	}
+	if inv == nil {
+		return nil, nil, nil, ge.New("invoice not found")
+	}
+	if inv.Status() != InvoiceStatusAwaitingApproval {
+		return nil, nil, nil, ge.New("invoice checkout is not awaiting approval")
+	}
+
+	request, approved, err := cpg.approvalRequest(ctx, inv.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if request == nil {
+		return nil, nil, nil, ge.New("invoice has no open approval request")
+	}
+	return inv, request, approved, nil
+}
@@ -0,0 +1,378 @@
+package cpg
+
+import (
+	"bufio"
+	"context"
+	"cpg/pkg/crypto"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/itsabgr/ge"
+	"io"
+	"math/big"
+	"time"
+)
+
+// ArchiveFormat is the current invoice archive format version
+const ArchiveFormat = 1
+
+var archiveMagic = []byte("CPGX")
+
+// maxArchiveFrame bounds a single sealed frame so a corrupt length can not exhaust memory
+const maxArchiveFrame = 64 << 20
+
+type ArchiveHeader struct {
+	Format    int       `json:"format"`
+	ArchiveID string    `json:"archive_id"`
+	KeyID     string    `json:"key_id"`
+	CreateAt  time.Time `json:"create_at"`
+	// Since is set on incremental archives
+	Since *time.Time `json:"since,omitempty"`
+}
+
+// Incremental reports whether the archive holds only the changes since a time
+func (header ArchiveHeader) Incremental() bool {
+	return header.Since != nil
+}
+
+// archivedInvoice is the explicit archive schema of an invoice, lifecycle timestamps included
+type archivedInvoice struct {
+	ID                string       `json:"id"`
+	MinAmount         string       `json:"min_amount"`
+	Recipient         string       `json:"recipient"`
+	Beneficiary       string       `json:"beneficiary"`
+	Asset             string       `json:"asset"`
+	Metadata          string       `json:"metadata"`
+	CreateAt          time.Time    `json:"create_at"`
+	Deadline          time.Time    `json:"deadline"`
+	FillAt            *time.Time   `json:"fill_at,omitempty"`
+	LastCheckoutAt    *time.Time   `json:"last_checkout_at,omitempty"`
+	CheckoutRequestAt *time.Time   `json:"checkout_request_at,omitempty"`
+	CancelAt          *time.Time   `json:"cancel_at,omitempty"`
+	AutoCheckout      bool         `json:"auto_checkout"`
+	WalletAddress     string       `json:"wallet_address"`
+	EncryptedSalt     []byte       `json:"encrypted_salt"`
+	SignerMAC         []byte       `json:"signer_mac,omitempty"`
+	DerivationVersion int          `json:"derivation_version,omitempty"`
+	WalletIndex       *int64       `json:"wallet_index,omitempty"`
+	EscrowPeriod      int64        `json:"escrow_period,omitempty"`
+	BuyerKeyHash      []byte       `json:"buyer_key_hash,omitempty"`
+	ReleaseAt         *time.Time   `json:"release_at,omitempty"`
+	RefundAt          *time.Time   `json:"refund_at,omitempty"`
+	DisputeAt         *time.Time   `json:"dispute_at,omitempty"`
+	Fee               *PlatformFee `json:"fee,omitempty"`
+	FeeAmount         string       `json:"fee_amount,omitempty"`
+	FeeTxHash         string       `json:"fee_tx_hash,omitempty"`
+	Split             []SplitShare `json:"split,omitempty"`
+	SplitGasRule      SplitGasRule `json:"split_gas_rule,omitempty"`
+	TreasuryAddress   string       `json:"treasury_address,omitempty"`
+}
+
+type archiveTrailer struct {
+	Invoices int `json:"invoices"`
+}
+
+// archiveFrame is sealed on its own, archive id and sequence number keep frames in order
+type archiveFrame struct {
+	ArchiveID string            `json:"archive_id"`
+	Seq       int               `json:"seq"`
+	Header    *ArchiveHeader    `json:"header,omitempty"`
+	Invoices  []archivedInvoice `json:"invoices,omitempty"`
+	Trailer   *archiveTrailer   `json:"trailer,omitempty"`
+}
+
+func archiveInvoice(inv *Invoice) archivedInvoice {
+	archived := archivedInvoice{
+		ID:                inv.ID,
+		MinAmount:         inv.MinAmount.String(),
+		Recipient:         inv.Recipient,
+		Beneficiary:       inv.Beneficiary,
+		Asset:             inv.Asset,
+		Metadata:          inv.Metadata,
+		CreateAt:          inv.CreateAt,
+		Deadline:          inv.Deadline,
+		FillAt:            inv.FillAt,
+		LastCheckoutAt:    inv.LastCheckoutAt,
+		CheckoutRequestAt: inv.CheckoutRequestAt,
+		CancelAt:          inv.CancelAt,
+		AutoCheckout:      inv.AuthCheckout,
+		WalletAddress:     inv.WalletAddress,
+		EncryptedSalt:     inv.EncryptedSalt,
+		SignerMAC:         inv.SignerMAC,
+		DerivationVersion: inv.DerivationVersion,
+		WalletIndex:       inv.WalletIndex,
+		EscrowPeriod:      int64(inv.EscrowPeriod / time.Second),
+		BuyerKeyHash:      inv.BuyerKeyHash,
+		ReleaseAt:         inv.ReleaseAt,
+		RefundAt:          inv.RefundAt,
+		DisputeAt:         inv.DisputeAt,
+		Fee:               inv.Fee,
+		FeeTxHash:         inv.FeeTxHash,
+		Split:             inv.Split,
+		SplitGasRule:      inv.SplitGasRule,
+		TreasuryAddress:   inv.TreasuryAddress,
+	}
+	if inv.FeeTxHash != "" {
+		archived.FeeAmount = inv.FeeAmount.String()
+	}
+	return archived
+}
+
+func (archived archivedInvoice) invoice() (*Invoice, error) {
+	inv := &Invoice{
+		ID:                archived.ID,
+		Recipient:         archived.Recipient,
+		Beneficiary:       archived.Beneficiary,
+		Asset:             archived.Asset,
+		Metadata:          archived.Metadata,
+		CreateAt:          archived.CreateAt,
+		Deadline:          archived.Deadline,
+		FillAt:            archived.FillAt,
+		LastCheckoutAt:    archived.LastCheckoutAt,
+		CheckoutRequestAt: archived.CheckoutRequestAt,
+		CancelAt:          archived.CancelAt,
+		AuthCheckout:      archived.AutoCheckout,
+		WalletAddress:     archived.WalletAddress,
+		EncryptedSalt:     archived.EncryptedSalt,
+		SignerMAC:         archived.SignerMAC,
+		DerivationVersion: archived.DerivationVersion,
+		WalletIndex:       archived.WalletIndex,
+		EscrowPeriod:      time.Duration(archived.EscrowPeriod) * time.Second,
+		BuyerKeyHash:      archived.BuyerKeyHash,
+		ReleaseAt:         archived.ReleaseAt,
+		RefundAt:          archived.RefundAt,
+		DisputeAt:         archived.DisputeAt,
+		Fee:               archived.Fee,
+		FeeTxHash:         archived.FeeTxHash,
+		Split:             archived.Split,
+		SplitGasRule:      archived.SplitGasRule,
+		TreasuryAddress:   archived.TreasuryAddress,
+	}
+	if inv.Fee != nil {
+		if err := inv.Fee.validate(); err != nil {
+			return nil, ge.Wrap(ge.Detail(ge.New("invalid archived invoice fee"), ge.D{"invoice": archived.ID}), err)
+		}
+	}
+	if inv.Split != nil {
+		if err := validateSplit(inv.Split, inv.Beneficiary); err != nil {
+			return nil, ge.Wrap(ge.Detail(ge.New("invalid archived invoice split"), ge.D{"invoice": archived.ID}), err)
+		}
+		if !inv.SplitGasRule.valid() || inv.Split[0].Address != inv.Recipient {
+			return nil, ge.Detail(ge.New("invalid archived invoice split"), ge.D{"invoice": archived.ID})
+		}
+	}
+	if inv.FeeTxHash != "" {
+		var ok bool
+		if inv.FeeAmount, ok = new(big.Int).SetString(archived.FeeAmount, 10); !ok {
+			return nil, ge.Detail(ge.New("invalid archived invoice fee amount"), ge.D{"invoice": archived.ID})
+		}
+	}
+	if _, ok := inv.MinAmount.SetString(archived.MinAmount, 10); !ok || inv.MinAmount.Cmp(big.NewInt(0)) <= 0 {
+		return nil, ge.Detail(ge.New("invalid archived invoice min amount"), ge.D{"invoice": archived.ID})
+	}
+	if inv.ID == "" || inv.WalletAddress == "" || (len(inv.EncryptedSalt) == 0 && inv.WalletIndex == nil) {
+		return nil, ge.Detail(ge.New("incomplete archived invoice"), ge.D{"invoice": archived.ID})
+	}
+	return inv, nil
+}
+
+type archiveWriter struct {
+	w       io.Writer
+	keyring *crypto.KeyRing
+	id      string
+	seq     int
+}
+
+func (aw *archiveWriter) write(frame archiveFrame) error {
+	frame.ArchiveID = aw.id
+	frame.Seq = aw.seq
+	aw.seq++
+	sealed := aw.keyring.Encrypt(ge.Must(json.Marshal(frame)), (*[24]byte)(crypto.ReadN(nil, 24)))
+	if _, err := aw.w.Write(binary.BigEndian.AppendUint32(nil, uint32(len(sealed)))); err != nil {
+		return err
+	}
+	_, err := aw.w.Write(sealed)
+	return err
+}
+
+type archiveReader struct {
+	r       *bufio.Reader
+	keyring *crypto.KeyRing
+	id      string
+	seq     int
+}
+
+func (ar *archiveReader) read() (frame archiveFrame, err error) {
+	var size [4]byte
+	if _, err = io.ReadFull(ar.r, size[:]); err != nil {
+		if err == io.EOF {
+			err = ge.New("archive is truncated, no trailer")
+		}
+		return frame, err
+	}
+	n := binary.BigEndian.Uint32(size[:])
+	if n > maxArchiveFrame {
+		return frame, ge.Detail(ge.New("archive frame is too big"), ge.D{"size": n})
+	}
+	sealed := make([]byte, n)
+	if _, err = io.ReadFull(ar.r, sealed); err != nil {
+		return frame, ge.Wrap(ge.New("archive is truncated"), err)
+	}
+	plain, _ := ar.keyring.Decrypt(sealed)
+	if plain == nil {
+		return frame, ge.Detail(ge.New("no key opens the archive frame"), ge.D{"seq": ar.seq})
+	}
+	if err = json.Unmarshal(plain, &frame); err != nil {
+		return frame, ge.Wrap(ge.New("invalid archive frame"), err)
+	}
+	if ar.seq == 0 {
+		ar.id = frame.ArchiveID
+	}
+	if frame.ArchiveID != ar.id || frame.Seq != ar.seq {
+		return frame, ge.Detail(ge.New("archive frames are out of order or mixed"), ge.D{"seq": ar.seq})
+	}
+	ar.seq++
+	return frame, nil
+}
+
+type ExportInvoicesParams struct {
+	// Since makes an incremental archive, salt rotations do not count as a change
+	Since     time.Time
+	BatchSize int
+	// Progress is called after each written batch with the count of exported invoices
+	Progress func(exported int)
+}
+
+type ExportInvoicesResult struct {
+	Header   ArchiveHeader
+	Invoices int
+}
+
+// ExportInvoices streams the invoices in id order into an archive sealed under the backup keyring
+func ExportInvoices(ctx context.Context, db *DB, keyring *crypto.KeyRing, w io.Writer, params ExportInvoicesParams) (result ExportInvoicesResult, err error) {
+
+	if params.BatchSize <= 0 {
+		params.BatchSize = 500
+	}
+
+	result.Header = ArchiveHeader{
+		Format:    ArchiveFormat,
+		ArchiveID: uuid.NewString(),
+		KeyID:     keyring.PrimaryID().String(),
+		CreateAt:  time.Now().UTC(),
+	}
+	if !params.Since.IsZero() {
+		since := params.Since.UTC()
+		result.Header.Since = &since
+	}
+
+	if _, err = w.Write(append(append([]byte{}, archiveMagic...), ArchiveFormat)); err != nil {
+		return result, err
+	}
+	aw := &archiveWriter{w: w, keyring: keyring, id: result.Header.ArchiveID}
+	if err = aw.write(archiveFrame{Header: &result.Header}); err != nil {
+		return result, err
+	}
+
+	lastID := ""
+	for {
+		if err = ctx.Err(); err != nil {
+			return result, err
+		}
+		var batch []*Invoice
+		if batch, err = db.ListInvoices(ctx, lastID, params.Since, params.BatchSize); err != nil {
+			return result, ge.Wrap(ge.New("failed to list invoices"), err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+		archived := make([]archivedInvoice, len(batch))
+		for i, inv := range batch {
+			archived[i] = archiveInvoice(inv)
+		}
+		if err = aw.write(archiveFrame{Invoices: archived}); err != nil {
+			return result, err
+		}
+		result.Invoices += len(batch)
+		lastID = batch[len(batch)-1].ID
+		if params.Progress != nil {
+			params.Progress(result.Invoices)
+		}
+	}
+
+	return result, aw.write(archiveFrame{Trailer: &archiveTrailer{Invoices: result.Invoices}})
+}
+
+type ImportInvoicesParams struct {
+	// Progress is called after each committed batch
+	Progress func(ImportInvoicesResult)
+}
+
+type ImportInvoicesResult struct {
+	Header   ArchiveHeader
+	Inserted int
+	Updated  int
+}
+
+// ImportInvoices restores an archive made by ExportInvoices, a full one only into an empty database
+func ImportInvoices(ctx context.Context, db *DB, keyring *crypto.KeyRing, r io.Reader, params ImportInvoicesParams) (result ImportInvoicesResult, err error) {
+
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(archiveMagic)+1)
+	if _, err = io.ReadFull(br, magic); err != nil || string(magic[:len(archiveMagic)]) != string(archiveMagic) {
+		return result, ge.New("not an invoice archive")
+	}
+	if magic[len(archiveMagic)] != ArchiveFormat {
+		return result, ge.Detail(ge.New("unsupported archive format"), ge.D{"format": magic[len(archiveMagic)]})
+	}
+
+	ar := &archiveReader{r: br, keyring: keyring}
+	frame, err := ar.read()
+	if err != nil {
+		return result, err
+	}
+	if frame.Header == nil || frame.Header.ArchiveID != frame.ArchiveID {
+		return result, ge.New("archive does not start with a header")
+	}
+	result.Header = *frame.Header
+
+	if !result.Header.Incremental() {
+		count, err := db.CountInvoices(ctx)
+		if err != nil {
+			return result, ge.Wrap(ge.New("failed to count invoices"), err)
+		}
+		if count > 0 {
+			return result, ge.Detail(ge.New("a full archive is only imported into an empty database"), ge.D{"invoices": count})
+		}
+	}
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return result, err
+		}
+		if frame, err = ar.read(); err != nil {
+			return result, err
+		}
+		if frame.Trailer != nil {
+			if imported := result.Inserted + result.Updated; frame.Trailer.Invoices != imported {
+				return result, ge.Detail(ge.New("archive trailer count mismatch"), ge.D{"trailer": frame.Trailer.Invoices, "imported": imported})
+			}
+			return result, nil
+		}
+		invs := make([]*Invoice, len(frame.Invoices))
+		for i, archived := range frame.Invoices {
+			if invs[i], err = archived.invoice(); err != nil {
+				return result, err
+			}
+		}
+		inserted, updated, err := db.RestoreInvoices(ctx, invs, result.Header.Incremental())
+		if err != nil {
+			return result, ge.Wrap(ge.New("failed to restore invoices"), err)
+		}
+		result.Inserted += inserted
+		result.Updated += updated
+		if params.Progress != nil {
+			params.Progress(result)
+		}
+	}
+}
@@ -22,13 +22,134 @@ type AssetFactory interface {
 type AssetInfo struct {
 	MinDelay   time.Duration
 	SaltLength int
+	Decimals   uint8
+	Symbol     string
+	// DerivationVersion is the wallet derivation version of new invoices
+	DerivationVersion int
+	// WatchOnly assets hold no keys
+	WatchOnly bool
 }
 
+// ErrWatchOnly is returned by watch-only assets for anything which has to sign
+var ErrWatchOnly = ge.New("asset is watch-only, it can not sign")
+
 type Asset interface {
 	Info() AssetInfo
 	PrepareInvoice(ctx context.Context, invoice *Invoice) error
 	GetBalance(ctx context.Context, invoice *Invoice) (*big.Int, error)
+	// TryFlush sweeps the wallet to the invoice destination
 	TryFlush(ctx context.Context, invoice *Invoice) error
+	PaymentURI(invoice *Invoice) string
+}
+
+// WalletIndexer is implemented by assets deriving invoice wallets from a hd wallet
+type WalletIndexer interface {
+	// WalletAccount identifies the hd account, empty when unused
+	WalletAccount() string
+}
+
+// Sweeper is implemented by assets which can move a whole wallet balance to any address
+type Sweeper interface {
+	// Sweep sends the wallet balance minus the fee, a dry run does not send
+	Sweep(ctx context.Context, invoice *Invoice, to string, dryRun bool) (SweepResult, error)
+}
+
+type SweepResult struct {
+	Balance *big.Int
+	Fee     *big.Int
+	Amount  *big.Int
+	TxHash  string
+	// TxFee is the fee of each tx without a fee of its own, Fee is their sum
+	TxFee *big.Int
+	// PlatformFee is the fee leg amount, nil without one
+	PlatformFee *big.Int
+	FeeTxHash   string
+	// FeeTxFee is the fee of the fee leg tx, TxFee when nil
+	FeeTxFee *big.Int
+	// Split are the legs of the split recipients after the first
+	Split []SplitLeg
+}
+
+// SweepSigner is implemented by assets whose sweeps are built, signed and sent in separate steps
+type SweepSigner interface {
+	// BuildSweep makes the unsigned sweep tx and its leg txs, it needs no key
+	BuildSweep(ctx context.Context, invoice *Invoice, to string) (UnsignedSweep, error)
+	// InspectSweep rejects anything but a plain transfer
+	InspectSweep(unsigned []byte) (SweepTx, error)
+	SignSweep(ctx context.Context, invoice *Invoice, unsigned []byte) ([]byte, error)
+	// OpenSignedSweep returns the unsigned tx and the signer address
+	OpenSignedSweep(signed []byte) ([]byte, string, error)
+	// SendSweep broadcasts a signed sweep tx and returns its hash
+	SendSweep(ctx context.Context, signed []byte) (string, error)
+}
+
+type UnsignedSweep struct {
+	Tx []byte
+	// FeeTx is the unsigned fee leg tx, it must be sent before Tx
+	FeeTx []byte
+	// SplitTxs must be sent after FeeTx and before Tx
+	SplitTxs [][]byte
+	Sweep    SweepResult
+}
+
+type SweepTx struct {
+	To     string
+	Amount *big.Int
+	Fee    *big.Int
+}
+
+// TokenRescuer is implemented by assets which can move tokens sent by mistake to an invoice wallet
+type TokenRescuer interface {
+	RescueToken(ctx context.Context, invoice *Invoice, params TokenRescueParams) (TokenRescueResult, error)
+}
+
+type TokenRescueParams struct {
+	Token string
+	To    string
+	// FundGas tops the wallet up with the gas from the asset gas funder
+	FundGas bool
+	DryRun  bool
+}
+
+type TokenRescueResult struct {
+	TokenBalance  *big.Int
+	Fee           *big.Int
+	FundingAmount *big.Int
+	FundingTxHash string
+	TxHash        string
+}
+
+// Treasurer is implemented by assets holding the key of a treasury wallet
+type Treasurer interface {
+	TxConfirmer
+	// TreasuryAddress is empty when the asset has no treasury key
+	TreasuryAddress() string
+	TreasuryBalance(ctx context.Context) (*big.Int, error)
+	// TreasuryTransfer sends the amount minus the tx fee, signed is called before the send and its error aborts it
+	TreasuryTransfer(ctx context.Context, to string, amount *big.Int, signed func(SweepResult) error) (SweepResult, error)
+}
+
+// ErrTxReverted is returned for a tx which was mined but failed
+var ErrTxReverted = ge.New("tx reverted")
+
+// TxConfirmer is implemented by assets which look up the txs they sent
+type TxConfirmer interface {
+	// TxConfirmations is 0 for a tx not mined yet
+	TxConfirmations(ctx context.Context, txHash string) (uint64, error)
+}
+
+// Payer is implemented by assets holding the key of a payout funding wallet
+type Payer interface {
+	TxConfirmer
+	// PayoutAddress is empty when the asset has no payout key
+	PayoutAddress() string
+	// SendPayout sends exactly the amount, signed is called before the send and its error aborts it
+	SendPayout(ctx context.Context, to string, amount *big.Int, signed func(SweepResult) error) (SweepResult, error)
+}
+
+// WalletFamily is implemented by assets whose wallets are valid on every asset of the same family
+type WalletFamily interface {
+	WalletFamily() string
 }
 
 type Assets struct {
@@ -78,6 +199,16 @@ func (a *Assets) Infos() iter.Seq2[string, AssetInfo] {
 	}
 }
 
+// UsesSalt reports whether any asset derives its invoice wallets from a salt
+func (a *Assets) UsesSalt() bool {
+	for _, asset := range a.map_ {
+		if usesSalt(asset) {
+			return true
+		}
+	}
+	return false
+}
+
 func AssetFactories() iter.Seq2[string, AssetFactory] {
 	return func(yield func(string, AssetFactory) bool) {
 		for name, factory := range assetFactories {
@@ -0,0 +1,265 @@
+package cpg
+
+import (
+	"bytes"
+	"cpg/pkg/crypto"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/itsabgr/ge"
+	"math/big"
+	"time"
+)
+
+// BackupFormat is the current invoice backup format version
+const BackupFormat = 2
+
+var backupMagic = []byte("CPGB")
+
+// BackupHeader is stored in clear in front of the sealed backup payload
+type BackupHeader struct {
+	Format   int       `json:"format"`
+	KeyID    string    `json:"key_id"`
+	Asset    string    `json:"asset"`
+	CreateAt time.Time `json:"create_at"`
+}
+
+// backupPayload is the explicit backup schema, its json names must never change
+type backupPayload struct {
+	Header        BackupHeader `json:"header"`
+	ID            string       `json:"id"`
+	MinAmount     string       `json:"min_amount"`
+	Recipient     string       `json:"recipient"`
+	Beneficiary   string       `json:"beneficiary"`
+	Asset         string       `json:"asset"`
+	Metadata      string       `json:"metadata"`
+	CreateAt      time.Time    `json:"create_at"`
+	Deadline      time.Time    `json:"deadline"`
+	AutoCheckout  bool         `json:"auto_checkout"`
+	WalletAddress string       `json:"wallet_address"`
+	EncryptedSalt []byte       `json:"encrypted_salt"`
+	// DerivationVersion is omitted for version 0
+	DerivationVersion int    `json:"derivation_version,omitempty"`
+	WalletIndex       *int64 `json:"wallet_index,omitempty"`
+	// EscrowPeriod is in seconds
+	EscrowPeriod int64  `json:"escrow_period,omitempty"`
+	BuyerKeyHash []byte `json:"buyer_key_hash,omitempty"`
+	// Fee carries the platform fee terms
+	Fee *PlatformFee `json:"fee,omitempty"`
+	// Split carries the split recipients with their shares
+	Split        []SplitShare `json:"split,omitempty"`
+	SplitGasRule SplitGasRule `json:"split_gas_rule,omitempty"`
+	// TreasuryAddress is set for invoices of treasury mode
+	TreasuryAddress string `json:"treasury_address,omitempty"`
+	// SignerMAC lets a recovered invoice keep the salt of a signer
+	SignerMAC []byte `json:"signer_mac,omitempty"`
+}
+
+type sealedBackup struct {
+	Payload  json.RawMessage `json:"payload"`
+	Checksum string          `json:"checksum"`
+}
+
+// legacyBackupV1 mirrors the unversioned backups json.Marshal made of the Invoice struct
+type legacyBackupV1 struct {
+	ID            string
+	MinAmount     big.Int
+	Recipient     string
+	Beneficiary   string
+	Asset         string
+	Metadata      string
+	CreateAt      time.Time
+	Deadline      time.Time
+	AuthCheckout  bool
+	WalletAddress string
+	EncryptedSalt []byte
+}
+
+func backupChecksum(header, payload []byte) string {
+	h := sha256.New()
+	h.Write(header)
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Encrypt seals the invoice into a versioned backup
+func (inv *Invoice) Encrypt(keyring *crypto.KeyRing) []byte {
+	header := BackupHeader{
+		Format:   BackupFormat,
+		KeyID:    keyring.PrimaryID().String(),
+		Asset:    inv.Asset,
+		CreateAt: inv.CreateAt.UTC(),
+	}
+	headerData := ge.Must(json.Marshal(header))
+
+	payload := ge.Must(json.Marshal(backupPayload{
+		Header:        header,
+		ID:            inv.ID,
+		MinAmount:     inv.MinAmount.String(),
+		Recipient:     inv.Recipient,
+		Beneficiary:   inv.Beneficiary,
+		Asset:         inv.Asset,
+		Metadata:      inv.Metadata,
+		CreateAt:      inv.CreateAt.UTC(),
+		Deadline:      inv.Deadline.UTC(),
+		AutoCheckout:  inv.AuthCheckout,
+		WalletAddress: inv.WalletAddress,
+		EncryptedSalt: inv.EncryptedSalt,
+
+		DerivationVersion: inv.DerivationVersion,
+		WalletIndex:       inv.WalletIndex,
+		EscrowPeriod:      int64(inv.EscrowPeriod / time.Second),
+		BuyerKeyHash:      inv.BuyerKeyHash,
+		Fee:               inv.Fee,
+		Split:             splitTerms(inv.Split),
+		SplitGasRule:      inv.SplitGasRule,
+		TreasuryAddress:   inv.TreasuryAddress,
+		SignerMAC:         inv.SignerMAC,
+	}))
+
+	sealed := keyring.Encrypt(ge.Must(json.Marshal(sealedBackup{
+		Payload:  payload,
+		Checksum: backupChecksum(headerData, payload),
+	})), (*[24]byte)(crypto.ReadN(nil, 24)))
+
+	out := make([]byte, 0, len(backupMagic)+1+2+len(headerData)+len(sealed))
+	out = append(out, backupMagic...)
+	out = append(out, BackupFormat)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(headerData)))
+	out = append(out, headerData...)
+	return append(out, sealed...)
+}
+
+// ReadBackupHeader returns the clear header of a backup, legacy backups report format 1
+func ReadBackupHeader(data []byte) (BackupHeader, []byte, []byte, error) {
+	if !bytes.HasPrefix(data, backupMagic) {
+		return BackupHeader{Format: 1}, nil, data, nil
+	}
+	data = data[len(backupMagic):]
+	if len(data) < 3 {
+		return BackupHeader{}, nil, nil, ge.New("truncated backup header")
+	}
+	format := int(data[0])
+	headerLen := int(binary.BigEndian.Uint16(data[1:3]))
+	data = data[3:]
+	if len(data) < headerLen {
+		return BackupHeader{}, nil, nil, ge.New("truncated backup header")
+	}
+	headerData, sealed := data[:headerLen], data[headerLen:]
+	var header BackupHeader
+	if err := json.Unmarshal(headerData, &header); err != nil {
+		return BackupHeader{}, nil, nil, ge.Wrap(ge.New("invalid backup header"), err)
+	}
+	if header.Format != format {
+		return BackupHeader{}, nil, nil, ge.New("backup format mismatch")
+	}
+	return header, headerData, sealed, nil
+}
+
+// OpenBackup decrypts a backup of any known format, the returned invoice is never nil
+func OpenBackup(keyring *crypto.KeyRing, data []byte) (*Invoice, BackupHeader, error) {
+	inv := &Invoice{}
+
+	header, headerData, sealed, err := ReadBackupHeader(data)
+	if err != nil {
+		return inv, header, err
+	}
+
+	plain, _ := keyring.Decrypt(sealed)
+	if plain == nil {
+		return inv, header, ge.New("no key opens the backup")
+	}
+
+	switch header.Format {
+	case 1:
+		return inv, header, migrateLegacyBackup(inv, &header, plain)
+	case 2:
+		return inv, header, decodeBackupV2(inv, header, headerData, plain)
+	default:
+		return inv, header, ge.Detail(ge.New("unsupported backup format"), ge.D{"format": header.Format})
+	}
+}
+
+func decodeBackupV2(inv *Invoice, header BackupHeader, headerData, plain []byte) error {
+	var sealed sealedBackup
+	if err := json.Unmarshal(plain, &sealed); err != nil {
+		return ge.Wrap(ge.New("invalid backup"), err)
+	}
+	if sealed.Checksum != backupChecksum(headerData, sealed.Payload) {
+		return ge.New("backup checksum mismatch")
+	}
+	var payload backupPayload
+	if err := json.Unmarshal(sealed.Payload, &payload); err != nil {
+		return ge.Wrap(ge.New("invalid backup payload"), err)
+	}
+	if payload.Header.Format != header.Format ||
+		payload.Header.KeyID != header.KeyID ||
+		payload.Header.Asset != header.Asset ||
+		!payload.Header.CreateAt.Equal(header.CreateAt) ||
+		payload.Asset != header.Asset {
+		return ge.New("backup header does not match its payload")
+	}
+	if _, ok := inv.MinAmount.SetString(payload.MinAmount, 10); !ok {
+		return ge.New("invalid backup min amount")
+	}
+	inv.ID = payload.ID
+	inv.Recipient = payload.Recipient
+	inv.Beneficiary = payload.Beneficiary
+	inv.Asset = payload.Asset
+	inv.Metadata = payload.Metadata
+	inv.CreateAt = payload.CreateAt
+	inv.Deadline = payload.Deadline
+	inv.AuthCheckout = payload.AutoCheckout
+	inv.WalletAddress = payload.WalletAddress
+	inv.EncryptedSalt = payload.EncryptedSalt
+	inv.DerivationVersion = payload.DerivationVersion
+	inv.WalletIndex = payload.WalletIndex
+	inv.EscrowPeriod = time.Duration(payload.EscrowPeriod) * time.Second
+	inv.BuyerKeyHash = payload.BuyerKeyHash
+	inv.TreasuryAddress = payload.TreasuryAddress
+	inv.SignerMAC = payload.SignerMAC
+	if payload.Fee != nil {
+		if err := payload.Fee.validate(); err != nil {
+			return ge.Wrap(ge.New("invalid backup fee"), err)
+		}
+		inv.Fee = payload.Fee
+	}
+	if payload.Split != nil {
+		if err := validateSplit(payload.Split, payload.Beneficiary); err != nil {
+			return ge.Wrap(ge.New("invalid backup split"), err)
+		}
+		if !payload.SplitGasRule.valid() || payload.Split[0].Address != payload.Recipient {
+			return ge.New("invalid backup split")
+		}
+		inv.Split = splitTerms(payload.Split)
+		inv.SplitGasRule = payload.SplitGasRule
+	}
+	return nil
+}
+
+func migrateLegacyBackup(inv *Invoice, header *BackupHeader, plain []byte) error {
+	var legacy legacyBackupV1
+	if err := json.Unmarshal(plain, &legacy); err != nil {
+		return ge.Wrap(ge.New("invalid legacy backup"), err)
+	}
+	inv.ID = legacy.ID
+	inv.MinAmount.Set(&legacy.MinAmount)
+	inv.Recipient = legacy.Recipient
+	inv.Beneficiary = legacy.Beneficiary
+	inv.Asset = legacy.Asset
+	inv.Metadata = legacy.Metadata
+	inv.CreateAt = legacy.CreateAt
+	inv.Deadline = legacy.Deadline
+	inv.AuthCheckout = legacy.AuthCheckout
+	inv.WalletAddress = legacy.WalletAddress
+	inv.EncryptedSalt = legacy.EncryptedSalt
+	header.Asset = legacy.Asset
+	header.CreateAt = legacy.CreateAt
+	return nil
+}
+
+func DecryptInvoice(keyring *crypto.KeyRing, encrypted []byte) (*Invoice, error) {
+	inv, _, err := OpenBackup(keyring, encrypted)
+	return inv, err
+}
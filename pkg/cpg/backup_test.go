@@ -0,0 +1,183 @@
+package cpg
+
+import (
+	"bytes"
+	"cpg/pkg/crypto"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testBackupKeyring = crypto.NewKeyRing(crypto.HKDFPrefix + strings.Repeat("0c", 32))
+
+func testBackupInvoice() *Invoice {
+	index := int64(7)
+	inv := &Invoice{
+		ID:                "inv",
+		Recipient:         "0xRecipient",
+		Beneficiary:       "0xBeneficiary",
+		Asset:             "eth",
+		Metadata:          "order 1",
+		CreateAt:          time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Deadline:          time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		AuthCheckout:      true,
+		WalletAddress:     "0xWallet",
+		EncryptedSalt:     []byte("salt"),
+		DerivationVersion: 1,
+		WalletIndex:       &index,
+		EscrowPeriod:      time.Hour,
+		BuyerKeyHash:      []byte("buyer"),
+		Fee:               &PlatformFee{Address: "0xFee", BasisPoints: 100, Flat: big.NewInt(5)},
+		Split:             []SplitShare{{Address: "0xRecipient", BasisPoints: 6000}, {Address: "0xSplit", BasisPoints: 4000}},
+		SplitGasRule:      SplitGasEach,
+		TreasuryAddress:   "0xTreasury",
+		SignerMAC:         []byte("mac"),
+	}
+	inv.MinAmount.SetInt64(1000)
+	return inv
+}
+
+// sealTestBackup frames a format 2 backup of the given clear header and sealed payload
+func sealTestBackup(headerData []byte, sealed sealedBackup) []byte {
+	out := append([]byte{}, backupMagic...)
+	out = append(out, BackupFormat)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(headerData)))
+	out = append(out, headerData...)
+	plain, _ := json.Marshal(sealed)
+	return append(out, testBackupKeyring.Encrypt(plain, (*[24]byte)(crypto.ReadN(nil, 24)))...)
+}
+
+func TestBackupRoundTrip(t *testing.T) {
+	inv := testBackupInvoice()
+	opened, header, err := OpenBackup(testBackupKeyring, inv.Encrypt(testBackupKeyring))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Format != BackupFormat || header.KeyID != testBackupKeyring.PrimaryID().String() || header.Asset != inv.Asset || !header.CreateAt.Equal(inv.CreateAt) {
+		t.Fatalf("header %+v", header)
+	}
+	if !reflect.DeepEqual(opened, inv) {
+		t.Fatalf("opened %+v\nnot %+v", opened, inv)
+	}
+
+	other := crypto.NewKeyRing(crypto.HKDFPrefix + strings.Repeat("0d", 32))
+	if _, _, err = OpenBackup(other, inv.Encrypt(testBackupKeyring)); err == nil {
+		t.Fatal("another keyring opened the backup")
+	}
+}
+
+func TestBackupLegacyV1(t *testing.T) {
+	legacy := legacyBackupV1{
+		ID:            "inv",
+		Recipient:     "0xRecipient",
+		Beneficiary:   "0xBeneficiary",
+		Asset:         "eth",
+		Metadata:      "order 1",
+		CreateAt:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Deadline:      time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		AuthCheckout:  true,
+		WalletAddress: "0xWallet",
+		EncryptedSalt: []byte("salt"),
+	}
+	legacy.MinAmount.SetInt64(1000)
+	// legacy backups marshaled an *Invoice, so MinAmount is a json number
+	plain, err := json.Marshal(&legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inv, header, err := OpenBackup(testBackupKeyring, testBackupKeyring.Encrypt(plain, (*[24]byte)(crypto.ReadN(nil, 24))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Format != 1 || header.Asset != "eth" || !header.CreateAt.Equal(legacy.CreateAt) {
+		t.Fatalf("header %+v", header)
+	}
+	if inv.ID != legacy.ID || inv.MinAmount.Cmp(&legacy.MinAmount) != 0 || inv.Recipient != legacy.Recipient ||
+		inv.Beneficiary != legacy.Beneficiary || inv.Metadata != legacy.Metadata || !inv.Deadline.Equal(legacy.Deadline) ||
+		!inv.AuthCheckout || inv.WalletAddress != legacy.WalletAddress || !bytes.Equal(inv.EncryptedSalt, legacy.EncryptedSalt) {
+		t.Fatalf("migrated %+v", inv)
+	}
+}
+
+func TestBackupTampered(t *testing.T) {
+	inv := testBackupInvoice()
+	header := BackupHeader{Format: BackupFormat, KeyID: testBackupKeyring.PrimaryID().String(), Asset: inv.Asset, CreateAt: inv.CreateAt}
+	headerData, _ := json.Marshal(header)
+	payload := func(edit func(payload *backupPayload)) json.RawMessage {
+		p := backupPayload{Header: header, ID: inv.ID, MinAmount: "1000", Recipient: inv.Recipient, Beneficiary: inv.Beneficiary, Asset: inv.Asset, CreateAt: inv.CreateAt, Deadline: inv.Deadline}
+		edit(&p)
+		data, _ := json.Marshal(p)
+		return data
+	}
+	valid := payload(func(*backupPayload) {})
+	otherHeader, _ := json.Marshal(BackupHeader{Format: BackupFormat, KeyID: header.KeyID, Asset: "btc", CreateAt: inv.CreateAt})
+
+	for _, tc := range []struct {
+		name   string
+		backup []byte
+		valid  bool
+	}{
+		{"valid", sealTestBackup(headerData, sealedBackup{Payload: valid, Checksum: backupChecksum(headerData, valid)}), true},
+		{"checksum mismatch", sealTestBackup(headerData, sealedBackup{Payload: valid, Checksum: backupChecksum(headerData, valid[1:])}), false},
+		{"clear header swapped", sealTestBackup(otherHeader, sealedBackup{Payload: valid, Checksum: backupChecksum(headerData, valid)}), false},
+		{"payload asset not the header asset", func() []byte {
+			other := payload(func(p *backupPayload) { p.Asset = "btc" })
+			return sealTestBackup(headerData, sealedBackup{Payload: other, Checksum: backupChecksum(headerData, other)})
+		}(), false},
+		{"payload header not the clear header", func() []byte {
+			other := payload(func(p *backupPayload) { p.Header.KeyID = "other" })
+			return sealTestBackup(headerData, sealedBackup{Payload: other, Checksum: backupChecksum(headerData, other)})
+		}(), false},
+		{"invalid min amount", func() []byte {
+			other := payload(func(p *backupPayload) { p.MinAmount = "x" })
+			return sealTestBackup(headerData, sealedBackup{Payload: other, Checksum: backupChecksum(headerData, other)})
+		}(), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := OpenBackup(testBackupKeyring, tc.backup); (err == nil) != tc.valid {
+				t.Fatalf("open backup: %v", err)
+			}
+		})
+	}
+}
+
+func TestReadBackupHeader(t *testing.T) {
+	backup := testBackupInvoice().Encrypt(testBackupKeyring)
+	headerLen := int(binary.BigEndian.Uint16(backup[len(backupMagic)+1:]))
+	unsupported := bytes.Replace(bytes.Clone(backup), []byte(`"format":2`), []byte(`"format":3`), 1)
+	unsupported[len(backupMagic)] = 3
+	mismatched := bytes.Clone(backup)
+	mismatched[len(backupMagic)] = 3
+
+	for _, tc := range []struct {
+		name   string
+		data   []byte
+		format int
+		valid  bool
+	}{
+		{"current", backup, BackupFormat, true},
+		{"legacy", []byte("sealed"), 1, true},
+		{"magic only", backupMagic, 0, false},
+		{"no header length", backup[:len(backupMagic)+2], 0, false},
+		{"truncated header", backup[:len(backupMagic)+3+headerLen-1], 0, false},
+		{"format byte not the header format", mismatched, 0, false},
+		{"invalid header", append(append(bytes.Clone(backupMagic), BackupFormat, 0, 2), "{x"...), 0, false},
+		{"unsupported format", unsupported, 3, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			header, _, _, err := ReadBackupHeader(tc.data)
+			if (err == nil) != tc.valid || header.Format != tc.format {
+				t.Fatalf("header %+v: %v", header, err)
+			}
+		})
+	}
+
+	if _, _, err := OpenBackup(testBackupKeyring, unsupported); err == nil {
+		t.Fatal("opened a backup of an unsupported format")
+	}
+}
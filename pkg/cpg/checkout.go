@@ -0,0 +1,259 @@
+package cpg
+
+import (
+	"context"
+	"cpg/internal/daemon"
+	"cpg/pkg/ratelimit"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"github.com/itsabgr/ge"
+	"github.com/skip2/go-qrcode"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+//go:embed templates/*.html
+var defaultTemplates embed.FS
+
+const (
+	checkoutPollInterval      = time.Second * 2
+	checkoutHeartbeatInterval = time.Second * 15
+)
+
+type checkoutServer struct {
+	cpg       *CPG
+	rateLimit *ratelimit.RateLimit
+	templates *template.Template
+}
+
+// NewCheckoutServer returns the hosted checkout web ui handler, templatesDir overrides the embedded templates
+func NewCheckoutServer(cpg *CPG, rateLimit *ratelimit.RateLimit, templatesDir string) (http.Handler, error) {
+
+	templates, err := parseCheckoutTemplates(templatesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	serv := &checkoutServer{
+		cpg:       cpg,
+		rateLimit: rateLimit,
+		templates: templates,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pay/{invoiceID}", serv.page)
+	mux.HandleFunc("GET /pay/{invoiceID}/qr.png", serv.qrCode)
+	mux.HandleFunc("GET /pay/{invoiceID}/events", serv.events)
+
+	return mux, nil
+}
+
+// parseCheckoutTemplates parses the embedded templates and the overrides of templatesDir
+func parseCheckoutTemplates(templatesDir string) (*template.Template, error) {
+
+	templates, err := template.New("").Funcs(template.FuncMap{
+		"unix": func(t time.Time) int64 { return t.Unix() },
+	}).ParseFS(defaultTemplates, "templates/*.html")
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to parse embedded templates"), err)
+	}
+
+	if templatesDir != "" {
+		matches, err := filepath.Glob(filepath.Join(templatesDir, "*.html"))
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) > 0 {
+			if templates, err = templates.ParseFiles(matches...); err != nil {
+				return nil, ge.Wrap(ge.Detail(ge.New("failed to parse templates"), ge.D{"dir": templatesDir}), err)
+			}
+		}
+	}
+
+	return templates, nil
+}
+
+type checkoutView struct {
+	InvoiceID     string
+	Asset         string
+	Symbol        string
+	Amount        string
+	WalletAddress string
+	PaymentURI    template.URL
+	QRCodeURL     string
+	EventsURL     string
+	Deadline      time.Time
+	Status        string
+}
+
+type checkoutStatus struct {
+	Status string     `json:"status"`
+	FillAt *time.Time `json:"fill_at,omitempty"`
+}
+
+func statusName(status InvoiceStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "InvoiceStatus"))
+}
+
+func (serv *checkoutServer) invoice(ctx context.Context, id string) (*Invoice, Asset, error) {
+	inv, err := serv.cpg.db.GetInvoice(ctx, id, "", false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if inv == nil {
+		return nil, nil, nil
+	}
+	asset := serv.cpg.assets.Get(inv.Asset)
+	if asset == nil {
+		return nil, nil, ge.New("asset is not supported")
+	}
+	return inv, asset, nil
+}
+
+func (serv *checkoutServer) render(w http.ResponseWriter, code int, name string, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(code)
+	if err := serv.templates.ExecuteTemplate(w, name, data); err != nil {
+		slog.Warn("failed to render checkout template", slog.String("template", name), slog.String("error", err.Error()))
+	}
+}
+
+func (serv *checkoutServer) fail(w http.ResponseWriter, code int, err error) {
+	if err != nil && daemon.Debug() {
+		slog.Debug("checkout request failed", "error", err.Error())
+	}
+	serv.render(w, code, "error.html", struct {
+		Code    int
+		Message string
+	}{code, http.StatusText(code)})
+}
+
+func (serv *checkoutServer) page(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("invoiceID")
+	inv, asset, err := serv.invoice(r.Context(), id)
+	if err != nil {
+		serv.fail(w, http.StatusInternalServerError, err)
+		return
+	}
+	if inv == nil {
+		serv.fail(w, http.StatusNotFound, nil)
+		return
+	}
+	info := asset.Info()
+	serv.render(w, http.StatusOK, "pay.html", checkoutView{
+		InvoiceID:     inv.ID,
+		Asset:         inv.Asset,
+		Symbol:        info.Symbol,
+		Amount:        FormatAmount(&inv.MinAmount, info.Decimals),
+		WalletAddress: inv.WalletAddress,
+		PaymentURI:    template.URL(asset.PaymentURI(inv)),
+		QRCodeURL:     "/pay/" + inv.ID + "/qr.png",
+		EventsURL:     "/pay/" + inv.ID + "/events",
+		Deadline:      inv.Deadline,
+		Status:        statusName(inv.Status()),
+	})
+}
+
+func (serv *checkoutServer) qrCode(w http.ResponseWriter, r *http.Request) {
+	inv, asset, err := serv.invoice(r.Context(), r.PathValue("invoiceID"))
+	if err != nil {
+		serv.fail(w, http.StatusInternalServerError, err)
+		return
+	}
+	if inv == nil {
+		serv.fail(w, http.StatusNotFound, nil)
+		return
+	}
+	png, err := qrcode.Encode(asset.PaymentURI(inv), qrcode.Medium, 320)
+	if err != nil {
+		serv.fail(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	_, _ = w.Write(png)
+}
+
+// events streams the invoice status as server-sent events until it is no longer pending
+func (serv *checkoutServer) events(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		serv.fail(w, http.StatusInternalServerError, ge.New("streaming is not supported"))
+		return
+	}
+
+	ctx := r.Context()
+	id := r.PathValue("invoiceID")
+
+	inv, asset, err := serv.invoice(ctx, id)
+	if err != nil {
+		serv.fail(w, http.StatusInternalServerError, err)
+		return
+	}
+	if inv == nil {
+		serv.fail(w, http.StatusNotFound, nil)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	_, _ = fmt.Fprintf(w, "retry: %d\n\n", checkoutPollInterval.Milliseconds())
+
+	poll := time.NewTicker(checkoutPollInterval)
+	defer poll.Stop()
+	heartbeat := time.NewTicker(checkoutHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	last := ""
+	for {
+		status := checkoutStatus{Status: statusName(inv.Status()), FillAt: inv.FillAt}
+		if status.Status != last {
+			last = status.Status
+			if err = writeEvent(w, "status", status); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+		if inv.Status() != InvoiceStatusPending {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err = fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+			continue
+		case <-poll.C:
+		}
+
+		if ok, _ := serv.rateLimit.Limit(ctx, "checkout:"+id, asset.Info().MinDelay); ok {
+			if _, err = serv.cpg.CheckInvoice(ctx, CheckInvoiceParams{InvoiceID: id}); err != nil && daemon.Debug() {
+				slog.Debug("failed to check invoice", "invoice", id, "error", err.Error())
+			}
+		}
+
+		if inv, err = serv.cpg.db.GetInvoice(ctx, id, "", false); err != nil || inv == nil {
+			return
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event string, data any) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, encoded)
+	return err
+}
@@ -0,0 +1,159 @@
+package cpg
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testCheckoutView() checkoutView {
+	return checkoutView{
+		InvoiceID:     "inv",
+		Asset:         "eth",
+		Symbol:        "ETH",
+		Amount:        "0.001",
+		WalletAddress: "0xWallet",
+		PaymentURI:    "ethereum:0xWallet?value=1000000000000000",
+		QRCodeURL:     "/pay/inv/qr.png",
+		EventsURL:     "/pay/inv/events",
+		Deadline:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:        statusName(InvoiceStatusPending),
+	}
+}
+
+func TestCheckoutPage(t *testing.T) {
+	templates, err := parseCheckoutTemplates("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	(&checkoutServer{templates: templates}).render(w, http.StatusOK, "pay.html", testCheckoutView())
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "text/html") {
+		t.Fatalf("code %d content type %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	page := w.Body.String()
+	for _, want := range []string{
+		"0.001 ETH",
+		"0xWallet",
+		`href="ethereum:0xWallet?value=1000000000000000"`,
+		`src="/pay/inv/qr.png"`,
+		`data-deadline="` + strconv.FormatInt(testCheckoutView().Deadline.Unix(), 10) + `"`,
+		`data-status="pending"`,
+		`EventSource("\/pay\/inv\/events")`,
+	} {
+		if !strings.Contains(page, want) {
+			t.Fatalf("page has no %s", want)
+		}
+	}
+
+	view := testCheckoutView()
+	view.WalletAddress = "<script>"
+	w = httptest.NewRecorder()
+	(&checkoutServer{templates: templates}).render(w, http.StatusOK, "pay.html", view)
+	if strings.Contains(w.Body.String(), `id="address"><script>`) || !strings.Contains(w.Body.String(), "&lt;script&gt;") {
+		t.Fatal("page does not escape the invoice fields")
+	}
+}
+
+func TestCheckoutTemplatesOverride(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pay.html"), []byte(`{{define "pay.html"}}custom {{.Amount}} {{unix .Deadline}}{{end}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	templates, err := parseCheckoutTemplates(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serv := &checkoutServer{templates: templates}
+
+	w := httptest.NewRecorder()
+	serv.render(w, http.StatusOK, "pay.html", testCheckoutView())
+	if want := "custom 0.001 " + strconv.FormatInt(testCheckoutView().Deadline.Unix(), 10); w.Body.String() != want {
+		t.Fatalf("page %q, not %q", w.Body.String(), want)
+	}
+
+	// templates the directory does not override stay embedded
+	w = httptest.NewRecorder()
+	serv.fail(w, http.StatusNotFound, nil)
+	if w.Code != http.StatusNotFound || !strings.Contains(w.Body.String(), "Not Found") {
+		t.Fatalf("error page %d %q", w.Code, w.Body.String())
+	}
+
+	if err = os.WriteFile(filepath.Join(dir, "error.html"), []byte(`{{define "error.html"}}{{.Code}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewCheckoutServer(nil, nil, dir); err == nil {
+		t.Fatal("parsed a broken template")
+	}
+}
+
+// noFlushWriter hides the Flush of the recorder
+type noFlushWriter struct {
+	http.ResponseWriter
+}
+
+func TestCheckoutRoutes(t *testing.T) {
+	handler, err := NewCheckoutServer(nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name   string
+		method string
+		path   string
+		code   int
+	}{
+		{"no invoice", http.MethodGet, "/pay/", http.StatusNotFound},
+		{"unknown page", http.MethodGet, "/pay/inv/other", http.StatusNotFound},
+		{"not a checkout path", http.MethodGet, "/other", http.StatusNotFound},
+		{"post", http.MethodPost, "/pay/inv", http.StatusMethodNotAllowed},
+		{"events without streaming", http.MethodGet, "/pay/inv/events", http.StatusInternalServerError},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(noFlushWriter{w}, httptest.NewRequest(tc.method, tc.path, nil))
+			if w.Code != tc.code {
+				t.Fatalf("code %d, not %d", w.Code, tc.code)
+			}
+		})
+	}
+}
+
+func TestCheckoutWriteEvent(t *testing.T) {
+	fillAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := httptest.NewRecorder()
+	if err := writeEvent(w, "status", checkoutStatus{Status: statusName(InvoiceStatusFilled), FillAt: &fillAt}); err != nil {
+		t.Fatal(err)
+	}
+	event, data, ok := strings.Cut(strings.TrimSuffix(w.Body.String(), "\n\n"), "\n")
+	if !ok || event != "event: status" || !strings.HasPrefix(data, "data: ") {
+		t.Fatalf("event %q", w.Body.String())
+	}
+	var status checkoutStatus
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), &status); err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != "filled" || status.FillAt == nil || !status.FillAt.Equal(fillAt) {
+		t.Fatalf("status %+v", status)
+	}
+}
+
+func TestCheckoutStatusName(t *testing.T) {
+	for status, want := range map[InvoiceStatus]string{
+		InvoiceStatusPending:  "pending",
+		InvoiceStatusFilled:   "filled",
+		InvoiceStatusExpired:  "expired",
+		InvoiceStatusHeld:     "held",
+		InvoiceStatusCheckout: "checkout",
+	} {
+		if name := statusName(status); name != want {
+			t.Fatalf("status name %q, not %q", name, want)
+		}
+	}
+}
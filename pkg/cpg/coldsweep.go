@@ -0,0 +1,502 @@
+package cpg
+
+import (
+	"bytes"
+	"context"
+	"cpg/pkg/backupcode"
+	"cpg/pkg/crypto"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// SweepBundleFormat 2 carries the fee of each tx, format 1 bundles are rejected and exported again by a new checkout
+const SweepBundleFormat = 2
+
+// SweepBundle carries an unsigned sweep to an air-gapped host and its signed tx back
+type SweepBundle struct {
+	Format        int      `json:"format"`
+	InvoiceID     string   `json:"invoice_id"`
+	Asset         string   `json:"asset"`
+	WalletAddress string   `json:"wallet_address"`
+	To            string   `json:"to"`
+	Amount        *big.Int `json:"amount"`
+	// Fee sums the fees of the sweep tx and its legs, TxFee is of the sweep tx
+	Fee           *big.Int  `json:"fee"`
+	TxFee         *big.Int  `json:"tx_fee"`
+	UnsignedTx    []byte    `json:"unsigned_tx"`
+	InvoiceBackup []byte    `json:"invoice_backup"`
+	CreateAt      time.Time `json:"create_at"`
+	SignedTx      []byte    `json:"signed_tx,omitempty"`
+	// FeeTo, PlatformFee, FeeTxFee and UnsignedFeeTx are the fee leg sent before the sweep
+	FeeTo         string   `json:"fee_to,omitempty"`
+	PlatformFee   *big.Int `json:"platform_fee,omitempty"`
+	FeeTxFee      *big.Int `json:"fee_tx_fee,omitempty"`
+	UnsignedFeeTx []byte   `json:"unsigned_fee_tx,omitempty"`
+	SignedFeeTx   []byte   `json:"signed_fee_tx,omitempty"`
+	// SplitLegs are sent after the fee leg and before the sweep
+	SplitLegs []SweepBundleLeg `json:"split_legs,omitempty"`
+}
+
+// SweepBundleLeg is the transfer to a split recipient, Share indexes the invoice split
+type SweepBundleLeg struct {
+	Share      int      `json:"share"`
+	To         string   `json:"to"`
+	Amount     *big.Int `json:"amount"`
+	Fee        *big.Int `json:"fee"`
+	UnsignedTx []byte   `json:"unsigned_tx"`
+	SignedTx   []byte   `json:"signed_tx,omitempty"`
+}
+
+// txFees sums the fees of the bundle txs, false when one is missing
+func (bundle SweepBundle) txFees() (*big.Int, bool) {
+	if bundle.TxFee == nil || len(bundle.UnsignedFeeTx) > 0 && bundle.FeeTxFee == nil {
+		return nil, false
+	}
+	fees := new(big.Int).Set(bundle.TxFee)
+	if len(bundle.UnsignedFeeTx) > 0 {
+		fees.Add(fees, bundle.FeeTxFee)
+	}
+	for _, leg := range bundle.SplitLegs {
+		if leg.Fee == nil {
+			return nil, false
+		}
+		fees.Add(fees, leg.Fee)
+	}
+	return fees, true
+}
+
+// balance is the wallet balance the bundle was built from
+func (bundle SweepBundle) balance() *big.Int {
+	balance := new(big.Int).Add(bundle.Amount, bundle.Fee)
+	if bundle.PlatformFee != nil && len(bundle.UnsignedFeeTx) > 0 {
+		balance.Add(balance, bundle.PlatformFee)
+	}
+	for _, leg := range bundle.SplitLegs {
+		balance.Add(balance, leg.Amount)
+	}
+	return balance
+}
+
+// unsent reports whether none of the legs of the bundle was sent yet
+func (bundle SweepBundle) unsent(inv *Invoice) bool {
+	if len(bundle.UnsignedFeeTx) > 0 && inv.FeeTxHash != "" {
+		return false
+	}
+	for _, leg := range bundle.SplitLegs {
+		if inv.Split[leg.Share].TxHash != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseSweepBundle reads a bundle from its json or its qr part texts separated by newlines
+func ParseSweepBundle(data []byte) (bundle SweepBundle, err error) {
+	if data, err = backupcode.Decode(data); err != nil {
+		return bundle, ge.Wrap(ge.New("failed to decode sweep bundle"), err)
+	}
+	if err = json.Unmarshal(data, &bundle); err != nil {
+		return bundle, ge.Wrap(ge.New("invalid sweep bundle"), err)
+	}
+	if bundle.Format != SweepBundleFormat {
+		return bundle, ge.Detail(ge.New("unknown sweep bundle format"), ge.D{"format": bundle.Format})
+	}
+	if bundle.InvoiceID == "" || len(bundle.UnsignedTx) == 0 || bundle.Amount == nil || bundle.Fee == nil {
+		return bundle, ge.New("incomplete sweep bundle")
+	}
+	if fees, ok := bundle.txFees(); !ok || fees.Cmp(bundle.Fee) != 0 {
+		return bundle, ge.New("sweep bundle fee is not the sum of its tx fees")
+	}
+	return bundle, nil
+}
+
+// ColdSigning maps asset names to their cold signing threshold
+type ColdSigning map[string]*big.Int
+
+func ParseColdSigning(data []byte) (ColdSigning, error) {
+	thresholds := ColdSigning{}
+	if err := json.Unmarshal(data, &thresholds); err != nil {
+		return nil, err
+	}
+	for asset, threshold := range thresholds {
+		if threshold == nil || threshold.Sign() < 0 {
+			return nil, ge.Detail(ge.New("cold signing threshold must not be negative"), ge.D{"asset": asset})
+		}
+	}
+	return thresholds, nil
+}
+
+// UseColdSigning makes checkouts over the asset threshold export a sweep bundle
+func (cpg *CPG) UseColdSigning(thresholds ColdSigning) {
+	cpg.coldSigning = thresholds
+}
+
+// coldSweepBundle is nil for a sweep under the cold signing threshold
+func (cpg *CPG) coldSweepBundle(ctx context.Context, inv *Invoice, asset Asset) ([]byte, error) {
+
+	threshold, ok := cpg.coldSigning[inv.Asset]
+	if !ok {
+		return nil, nil
+	}
+
+	sweepSigner, ok := asset.(SweepSigner)
+	if !ok {
+		return nil, ge.Detail(ge.New("asset can not sign sweeps apart"), ge.D{"asset": inv.Asset})
+	}
+
+	to, splitState := inv.Destination(), inv.splitState()
+	unsigned, err := sweepSigner.BuildSweep(ctx, inv, to)
+	// the legs planned by the first attempt are kept
+	cpg.recordSplit(ctx, inv, splitState)
+	if err != nil {
+		return nil, err
+	}
+	if unsigned.Sweep.Amount.Cmp(threshold) < 0 {
+		return nil, nil
+	}
+
+	sweepBundle := SweepBundle{
+		Format:        SweepBundleFormat,
+		InvoiceID:     inv.ID,
+		Asset:         inv.Asset,
+		WalletAddress: inv.WalletAddress,
+		To:            to,
+		Amount:        unsigned.Sweep.Amount,
+		Fee:           unsigned.Sweep.Fee,
+		TxFee:         unsigned.Sweep.TxFee,
+		UnsignedTx:    unsigned.Tx,
+		InvoiceBackup: inv.Encrypt(cpg.backupKeyring),
+		CreateAt:      time.Now().UTC(),
+	}
+	detail := map[string]string{
+		"unsigned_tx": hex.EncodeToString(unsigned.Tx),
+		"to":          to,
+		"amount":      unsigned.Sweep.Amount.String(),
+		"fee":         unsigned.Sweep.Fee.String(),
+	}
+	if unsigned.FeeTx != nil {
+		sweepBundle.FeeTo = inv.Fee.Address
+		sweepBundle.PlatformFee = unsigned.Sweep.PlatformFee
+		sweepBundle.FeeTxFee = unsigned.Sweep.TxFee
+		sweepBundle.UnsignedFeeTx = unsigned.FeeTx
+		detail["unsigned_fee_tx"] = hex.EncodeToString(unsigned.FeeTx)
+		detail["platform_fee"] = unsigned.Sweep.PlatformFee.String()
+	}
+	if len(unsigned.SplitTxs) > 0 {
+		splitTxs := make([]string, len(unsigned.SplitTxs))
+		for i, leg := range unsigned.Sweep.Split {
+			sweepBundle.SplitLegs = append(sweepBundle.SplitLegs, SweepBundleLeg{Share: leg.Share, To: leg.To, Amount: leg.Amount, Fee: unsigned.Sweep.TxFee, UnsignedTx: unsigned.SplitTxs[i]})
+			splitTxs[i] = hex.EncodeToString(unsigned.SplitTxs[i])
+		}
+		detail["unsigned_split_txs"] = strings.Join(splitTxs, ",")
+	}
+	bundle := ge.Must(json.Marshal(sweepBundle))
+
+	if err = cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+		InvoiceID: inv.ID,
+		Kind:      InvoiceEventSweepBundle,
+		Asset:     inv.Asset,
+		Detail:    detail,
+	}); err != nil {
+		return nil, ge.Wrap(ge.New("failed to record sweep bundle"), err)
+	}
+
+	slog.Info("sweep bundle exported", slog.String("invoice", inv.ID), slog.String("to", to), slog.String("amount", unsigned.Sweep.Amount.String()))
+	return bundle, nil
+}
+
+// SignSweepBundle signs a bundle on an air-gapped host from its backup
+func SignSweepBundle(ctx context.Context, assets *Assets, backupKeyring *crypto.KeyRing, saltCipher crypto.Cipher, data []byte) (bundle SweepBundle, err error) {
+
+	if bundle, err = ParseSweepBundle(data); err != nil {
+		return bundle, err
+	}
+
+	inv, _, err := OpenBackup(backupKeyring, bundle.InvoiceBackup)
+	if err != nil {
+		return bundle, ge.Wrap(ge.New("failed to open bundle backup"), err)
+	}
+	if inv.ID != bundle.InvoiceID || inv.Asset != bundle.Asset {
+		return bundle, ge.New("bundle backup is of another invoice")
+	}
+	inv.saltCipher = saltCipher
+
+	asset := assets.Get(inv.Asset)
+	if asset == nil {
+		return bundle, ge.Detail(ge.New("asset is not configured"), ge.D{"asset": inv.Asset})
+	}
+	sweepSigner, ok := asset.(SweepSigner)
+	if !ok {
+		return bundle, ge.Detail(ge.New("asset can not sign sweeps apart"), ge.D{"asset": inv.Asset})
+	}
+
+	inv.WalletAddress = ""
+	if err = asset.PrepareInvoice(ctx, inv); err != nil {
+		return bundle, ge.Wrap(ge.New("failed to derive invoice wallet"), err)
+	}
+	if !strings.EqualFold(inv.WalletAddress, bundle.WalletAddress) {
+		return bundle, ge.Detail(ge.New("derived wallet address does not match the bundle"), ge.D{"bundle": bundle.WalletAddress, "derived": inv.WalletAddress})
+	}
+
+	sweep, err := sweepSigner.InspectSweep(bundle.UnsignedTx)
+	if err != nil {
+		return bundle, err
+	}
+	if !strings.EqualFold(sweep.To, inv.Recipient) && !strings.EqualFold(sweep.To, inv.Beneficiary) &&
+		(inv.TreasuryAddress == "" || !strings.EqualFold(sweep.To, inv.TreasuryAddress)) {
+		return bundle, ge.Detail(ge.New("sweep destination is not the invoice recipient, its beneficiary or its treasury"), ge.D{"to": sweep.To})
+	}
+	if !strings.EqualFold(sweep.To, bundle.To) || sweep.Amount.Cmp(bundle.Amount) != 0 || sweep.Fee.Cmp(bundle.TxFee) != 0 {
+		return bundle, ge.New("sweep tx does not match the bundle summary")
+	}
+
+	if err = checkBundleLegs(inv, sweepSigner, bundle, sweep); err != nil {
+		return bundle, err
+	}
+	if len(bundle.UnsignedFeeTx) > 0 {
+		if bundle.SignedFeeTx, err = sweepSigner.SignSweep(ctx, inv, bundle.UnsignedFeeTx); err != nil {
+			return bundle, ge.Wrap(ge.New("failed to sign fee leg"), err)
+		}
+	}
+	for i := range bundle.SplitLegs {
+		if bundle.SplitLegs[i].SignedTx, err = sweepSigner.SignSweep(ctx, inv, bundle.SplitLegs[i].UnsignedTx); err != nil {
+			return bundle, ge.Wrap(ge.New("failed to sign split leg"), err)
+		}
+	}
+
+	if bundle.SignedTx, err = sweepSigner.SignSweep(ctx, inv, bundle.UnsignedTx); err != nil {
+		return bundle, ge.Wrap(ge.New("failed to sign sweep"), err)
+	}
+	return bundle, nil
+}
+
+// checkBundleLegs checks the fee leg and split legs of a bundle against its backup
+func checkBundleLegs(inv *Invoice, sweepSigner SweepSigner, bundle SweepBundle, sweep SweepTx) error {
+
+	if len(bundle.UnsignedFeeTx) == 0 && len(bundle.SplitLegs) == 0 {
+		return nil
+	}
+	if !strings.EqualFold(sweep.To, inv.Recipient) {
+		return ge.New("sweep with legs does not pay the invoice recipient")
+	}
+
+	balance := new(big.Int).Add(sweep.Amount, sweep.Fee)
+
+	var feeLeg SweepTx
+	if len(bundle.UnsignedFeeTx) > 0 {
+		var err error
+		if feeLeg, err = sweepSigner.InspectSweep(bundle.UnsignedFeeTx); err != nil {
+			return err
+		}
+		if inv.Fee == nil || !strings.EqualFold(feeLeg.To, inv.Fee.Address) || !strings.EqualFold(feeLeg.To, bundle.FeeTo) {
+			return ge.Detail(ge.New("fee leg destination is not the invoice fee address"), ge.D{"to": feeLeg.To})
+		}
+		if bundle.PlatformFee == nil || feeLeg.Amount.Cmp(bundle.PlatformFee) != 0 || feeLeg.Fee.Cmp(bundle.FeeTxFee) != 0 {
+			return ge.New("fee leg tx does not match the bundle summary")
+		}
+		balance.Add(balance, feeLeg.Amount).Add(balance, feeLeg.Fee)
+	}
+
+	seen := map[int]bool{}
+	for _, leg := range bundle.SplitLegs {
+		tx, err := sweepSigner.InspectSweep(leg.UnsignedTx)
+		if err != nil {
+			return err
+		}
+		if leg.Share <= 0 || leg.Share >= len(inv.Split) || seen[leg.Share] ||
+			!strings.EqualFold(tx.To, inv.Split[leg.Share].Address) || !strings.EqualFold(tx.To, leg.To) {
+			return ge.Detail(ge.New("split leg destination is not its split recipient"), ge.D{"to": tx.To})
+		}
+		if leg.Amount == nil || tx.Amount.Cmp(leg.Amount) != 0 || tx.Fee.Cmp(leg.Fee) != 0 {
+			return ge.New("split leg tx does not match the bundle summary")
+		}
+		seen[leg.Share] = true
+		balance.Add(balance, tx.Amount).Add(balance, tx.Fee)
+	}
+
+	if len(bundle.UnsignedFeeTx) > 0 {
+		fee := inv.FeeLeg(sweep.To, balance)
+		if fee == nil {
+			return ge.New("sweep owes no fee leg")
+		}
+		if feeLeg.Amount.Cmp(fee) > 0 {
+			return ge.Detail(ge.New("fee leg is over the invoice fee"), ge.D{"amount": feeLeg.Amount, "fee": fee})
+		}
+	}
+	for _, leg := range bundle.SplitLegs {
+		share := new(big.Int).Mul(balance, big.NewInt(inv.Split[leg.Share].BasisPoints))
+		share.Quo(share, big.NewInt(MaxFeeBasisPoints))
+		if leg.Amount.Cmp(share) > 0 {
+			return ge.Detail(ge.New("split leg is over the recipient share"), ge.D{"amount": leg.Amount, "share": share})
+		}
+	}
+	return nil
+}
+
+type SubmitSweepBundleParams struct {
+	InvoiceID string
+	Bundle    []byte
+}
+
+type SubmitSweepBundleResult struct {
+	InvoiceID string
+	TxHash    string
+}
+
+// SubmitSweepBundle broadcasts the signed tx of the latest exported bundle of an invoice
+func (cpg *CPG) SubmitSweepBundle(ctx context.Context, params SubmitSweepBundleParams) (result SubmitSweepBundleResult, err error) {
+
+	bundle, err := ParseSweepBundle(params.Bundle)
+	if err != nil {
+		return result, err
+	}
+	if bundle.InvoiceID != params.InvoiceID {
+		return result, ge.New("sweep bundle is of another invoice")
+	}
+	if len(bundle.SignedTx) == 0 {
+		return result, ge.New("sweep bundle is not signed")
+	}
+	result.InvoiceID = bundle.InvoiceID
+
+	inv, err := cpg.db.GetInvoice(ctx, bundle.InvoiceID, "", false)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to get invoice"), err)
+	}
+	if inv == nil {
+		return result, ge.New("invoice not found")
+	}
+
+	asset := cpg.assets.Get(inv.Asset)
+	if asset == nil {
+		return result, ge.New("asset is not supported")
+	}
+	sweepSigner, ok := asset.(SweepSigner)
+	if !ok {
+		return result, ge.Detail(ge.New("asset can not sign sweeps apart"), ge.D{"asset": inv.Asset})
+	}
+
+	// a bundle must not be broadcast twice
+	events, err := cpg.db.ListInvoiceEvents(ctx, inv.ID)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to list invoice events"), err)
+	}
+	var exported *InvoiceEvent
+	for i := range events {
+		switch events[i].Kind {
+		case InvoiceEventSweepBundle:
+			exported = &events[i]
+		case InvoiceEventColdSweep:
+			exported = nil
+		}
+	}
+	if exported == nil {
+		return result, ge.New("invoice has no sweep bundle awaiting its signed tx")
+	}
+	splitTxs := make([]string, len(bundle.SplitLegs))
+	for i, leg := range bundle.SplitLegs {
+		splitTxs[i] = hex.EncodeToString(leg.UnsignedTx)
+	}
+	if exported.Detail["unsigned_tx"] != hex.EncodeToString(bundle.UnsignedTx) ||
+		exported.Detail["unsigned_fee_tx"] != hex.EncodeToString(bundle.UnsignedFeeTx) ||
+		exported.Detail["unsigned_split_txs"] != strings.Join(splitTxs, ",") {
+		return result, ge.New("sweep bundle is not the latest exported for the invoice")
+	}
+
+	if err = checkSignedSweep(sweepSigner, inv, bundle.UnsignedTx, bundle.SignedTx); err != nil {
+		return result, err
+	}
+
+	if len(bundle.UnsignedFeeTx) > 0 {
+		if err = checkSignedSweep(sweepSigner, inv, bundle.UnsignedFeeTx, bundle.SignedFeeTx); err != nil {
+			return result, ge.Wrap(ge.New("invalid signed fee leg"), err)
+		}
+	}
+	for _, leg := range bundle.SplitLegs {
+		if err = checkSignedSweep(sweepSigner, inv, leg.UnsignedTx, leg.SignedTx); err != nil {
+			return result, ge.Wrap(ge.New("invalid signed split leg"), err)
+		}
+	}
+
+	sweep := SweepResult{Amount: bundle.Amount, Fee: bundle.Fee, TxFee: bundle.TxFee, PlatformFee: bundle.PlatformFee, FeeTxFee: bundle.FeeTxFee}
+	if bundle.unsent(inv) {
+		// the bundle balance is the wallet balance only while none of its legs left the wallet
+		sweep.Balance = bundle.balance()
+	}
+	feeTxHash, splitState := inv.FeeTxHash, inv.splitState()
+	defer func() {
+		inv.ReportSweep(bundle.To, sweep)
+		cpg.recordFeeLeg(ctx, inv, feeTxHash)
+		cpg.recordSplit(ctx, inv, splitState)
+		cpg.recordTreasurySweep(ctx, inv)
+		cpg.postSweeps(ctx, inv)
+	}()
+
+	// legs are not sent twice
+	if len(bundle.UnsignedFeeTx) > 0 && inv.FeeTxHash == "" {
+		if sweep.FeeTxHash, err = sweepSigner.SendSweep(ctx, bundle.SignedFeeTx); err != nil {
+			sweep.FeeTxHash = ""
+			return result, ge.Wrap(ge.New("failed to send signed fee leg"), err)
+		}
+	}
+	for _, leg := range bundle.SplitLegs {
+		if inv.Split[leg.Share].TxHash != "" {
+			continue
+		}
+		txHash, err := sweepSigner.SendSweep(ctx, leg.SignedTx)
+		if err != nil {
+			return result, ge.Wrap(ge.Detail(ge.New("failed to send signed split leg"), ge.D{"to": leg.To}), err)
+		}
+		sweep.Split = append(sweep.Split, SplitLeg{Share: leg.Share, To: leg.To, Amount: leg.Amount, TxHash: txHash, TxFee: leg.Fee})
+	}
+
+	if result.TxHash, err = sweepSigner.SendSweep(ctx, bundle.SignedTx); err != nil {
+		return result, ge.Wrap(ge.New("failed to send signed sweep"), err)
+	}
+	sweep.TxHash = result.TxHash
+
+	slog.Info("cold sweep sent", slog.String("invoice", inv.ID), slog.String("tx", result.TxHash), slog.String("amount", exported.Detail["amount"]))
+
+	if err = cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+		InvoiceID: inv.ID,
+		Kind:      InvoiceEventColdSweep,
+		Asset:     inv.Asset,
+		TxHash:    result.TxHash,
+		Detail: map[string]string{
+			"to":           exported.Detail["to"],
+			"amount":       exported.Detail["amount"],
+			"fee":          exported.Detail["fee"],
+			"platform_fee": exported.Detail["platform_fee"],
+		},
+	}); err != nil {
+		slog.Error("failed to record cold sweep", slog.String("invoice", inv.ID), slog.String("tx", result.TxHash), slog.String("error", err.Error()))
+	}
+
+	if err = cpg.db.SetInvoiceLastCheckoutAt(ctx, inv.ID); err != nil {
+		slog.Warn("failed to update invoice last_checkout_at", slog.String("invoice", inv.ID), slog.String("error", err.Error()))
+	}
+
+	return result, nil
+}
+
+// checkSignedSweep checks a signed tx of a bundle is its unsigned tx signed by the invoice wallet
+func checkSignedSweep(sweepSigner SweepSigner, inv *Invoice, unsignedTx, signedTx []byte) error {
+	if len(signedTx) == 0 {
+		return ge.New("sweep bundle is not signed")
+	}
+	unsigned, from, err := sweepSigner.OpenSignedSweep(signedTx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(unsigned, unsignedTx) {
+		return ge.New("signed tx is not the bundle tx")
+	}
+	if !strings.EqualFold(from, inv.WalletAddress) {
+		return ge.Detail(ge.New("signed tx is not signed by the invoice wallet"), ge.D{"from": from})
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package cpg
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+// testSweepSigner reads unsigned txs as json sweep txs
+type testSweepSigner struct{}
+
+func (testSweepSigner) BuildSweep(context.Context, *Invoice, string) (UnsignedSweep, error) {
+	panic("not used")
+}
+
+func (testSweepSigner) InspectSweep(unsigned []byte) (tx SweepTx, err error) {
+	err = json.Unmarshal(unsigned, &tx)
+	return tx, err
+}
+
+func (testSweepSigner) SignSweep(context.Context, *Invoice, []byte) ([]byte, error) {
+	panic("not used")
+}
+
+func (testSweepSigner) OpenSignedSweep([]byte) ([]byte, string, error) {
+	panic("not used")
+}
+
+func (testSweepSigner) SendSweep(context.Context, []byte) (string, error) {
+	panic("not used")
+}
+
+func testSweepTx(to string, amount int64) []byte {
+	data, _ := json.Marshal(SweepTx{To: to, Amount: big.NewInt(amount), Fee: big.NewInt(10)})
+	return data
+}
+
+func testColdInvoice() *Invoice {
+	return &Invoice{
+		ID:          "inv",
+		Recipient:   "0xRecipient",
+		Beneficiary: "0xBeneficiary",
+		Fee:         &PlatformFee{Address: "0xFee", BasisPoints: 100},
+		Split:       []SplitShare{{Address: "0xRecipient", BasisPoints: 7000}, {Address: "0xSplit", BasisPoints: 3000}},
+	}
+}
+
+// testColdBundle sweeps a balance of 10000 with a fee leg of 100 and a split leg of 3000, each tx costs 10
+func testColdBundle() (SweepBundle, SweepTx) {
+	bundle := SweepBundle{
+		Format:        SweepBundleFormat,
+		InvoiceID:     "inv",
+		To:            "0xRecipient",
+		Amount:        big.NewInt(6870),
+		Fee:           big.NewInt(30),
+		TxFee:         big.NewInt(10),
+		UnsignedTx:    testSweepTx("0xRecipient", 6870),
+		FeeTo:         "0xFee",
+		PlatformFee:   big.NewInt(100),
+		FeeTxFee:      big.NewInt(10),
+		UnsignedFeeTx: testSweepTx("0xFee", 100),
+		SplitLegs:     []SweepBundleLeg{{Share: 1, To: "0xSplit", Amount: big.NewInt(3000), Fee: big.NewInt(10), UnsignedTx: testSweepTx("0xSplit", 3000)}},
+	}
+	return bundle, SweepTx{To: "0xRecipient", Amount: big.NewInt(6870), Fee: big.NewInt(10)}
+}
+
+func TestCheckBundleLegs(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		edit  func(bundle *SweepBundle, sweep *SweepTx)
+		valid bool
+	}{
+		{"valid", func(*SweepBundle, *SweepTx) {}, true},
+		{"no legs", func(bundle *SweepBundle, _ *SweepTx) {
+			bundle.UnsignedFeeTx, bundle.SplitLegs = nil, nil
+		}, true},
+		{"fee leg over the invoice fee", func(bundle *SweepBundle, sweep *SweepTx) {
+			bundle.PlatformFee, bundle.UnsignedFeeTx = big.NewInt(101), testSweepTx("0xFee", 101)
+			sweep.Amount = big.NewInt(6869)
+		}, false},
+		{"fee leg to another address", func(bundle *SweepBundle, _ *SweepTx) {
+			bundle.UnsignedFeeTx = testSweepTx("0xOther", 100)
+		}, false},
+		{"fee leg not its summary", func(bundle *SweepBundle, _ *SweepTx) {
+			bundle.PlatformFee = big.NewInt(90)
+		}, false},
+		{"fee leg tx fee not its summary", func(bundle *SweepBundle, _ *SweepTx) {
+			bundle.FeeTxFee = big.NewInt(9)
+		}, false},
+		{"split leg over its share", func(bundle *SweepBundle, sweep *SweepTx) {
+			bundle.SplitLegs[0].Amount, bundle.SplitLegs[0].UnsignedTx = big.NewInt(3001), testSweepTx("0xSplit", 3001)
+			sweep.Amount = big.NewInt(6869)
+		}, false},
+		{"split leg tx fee not its summary", func(bundle *SweepBundle, _ *SweepTx) {
+			bundle.SplitLegs[0].Fee = big.NewInt(11)
+		}, false},
+		{"split leg to another address", func(bundle *SweepBundle, _ *SweepTx) {
+			bundle.SplitLegs[0].To, bundle.SplitLegs[0].UnsignedTx = "0xOther", testSweepTx("0xOther", 3000)
+		}, false},
+		{"split leg of the first share", func(bundle *SweepBundle, _ *SweepTx) {
+			bundle.SplitLegs[0].Share = 0
+		}, false},
+		{"duplicate share", func(bundle *SweepBundle, _ *SweepTx) {
+			bundle.SplitLegs = append(bundle.SplitLegs, bundle.SplitLegs[0])
+		}, false},
+		{"sweep not to the recipient", func(_ *SweepBundle, sweep *SweepTx) {
+			sweep.To = "0xBeneficiary"
+		}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bundle, sweep := testColdBundle()
+			tc.edit(&bundle, &sweep)
+			if err := checkBundleLegs(testColdInvoice(), testSweepSigner{}, bundle, sweep); (err == nil) != tc.valid {
+				t.Fatalf("check bundle legs: %v", err)
+			}
+		})
+	}
+}
+
+func TestSweepBundleBalance(t *testing.T) {
+	bundle, _ := testColdBundle()
+	if balance := bundle.balance(); balance.Cmp(big.NewInt(10000)) != 0 {
+		t.Fatalf("balance %s", balance)
+	}
+	bundle.UnsignedFeeTx = nil
+	if balance := bundle.balance(); balance.Cmp(big.NewInt(9900)) != 0 {
+		t.Fatalf("balance without a fee leg %s", balance)
+	}
+}
+
+func TestSweepBundleUnsent(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		edit   func(bundle *SweepBundle, inv *Invoice)
+		unsent bool
+	}{
+		{"nothing sent", func(*SweepBundle, *Invoice) {}, true},
+		{"fee leg sent", func(_ *SweepBundle, inv *Invoice) { inv.FeeTxHash = "0xf" }, false},
+		{"split leg sent", func(_ *SweepBundle, inv *Invoice) { inv.Split[1].TxHash = "0xs" }, false},
+		{"fee sent by another sweep", func(bundle *SweepBundle, inv *Invoice) {
+			bundle.UnsignedFeeTx, inv.FeeTxHash = nil, "0xf"
+		}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bundle, _ := testColdBundle()
+			inv := testColdInvoice()
+			tc.edit(&bundle, inv)
+			if unsent := bundle.unsent(inv); unsent != tc.unsent {
+				t.Fatalf("unsent %v", unsent)
+			}
+		})
+	}
+}
+
+func TestParseSweepBundleFees(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		edit  func(bundle *SweepBundle)
+		valid bool
+	}{
+		{"valid", func(*SweepBundle) {}, true},
+		{"fee over the tx fees", func(bundle *SweepBundle) { bundle.Fee = big.NewInt(31) }, false},
+		{"fee under the tx fees", func(bundle *SweepBundle) { bundle.Fee = big.NewInt(29) }, false},
+		{"no tx fee", func(bundle *SweepBundle) { bundle.TxFee = nil }, false},
+		{"no fee leg tx fee", func(bundle *SweepBundle) { bundle.FeeTxFee = nil }, false},
+		{"no split leg tx fee", func(bundle *SweepBundle) { bundle.SplitLegs[0].Fee = nil }, false},
+		{"format 1", func(bundle *SweepBundle) { bundle.Format = 1 }, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bundle, _ := testColdBundle()
+			tc.edit(&bundle)
+			data, err := json.Marshal(bundle)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = ParseSweepBundle(data); (err == nil) != tc.valid {
+				t.Fatalf("parse sweep bundle: %v", err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package cpg
 import (
 	"context"
 	"cpg/internal/daemon"
+	"cpg/pkg/backupcode"
 	"cpg/pkg/crypto"
 	"github.com/google/uuid"
 	"github.com/itsabgr/ge"
@@ -17,18 +18,39 @@ type CPG struct {
 	assets        *Assets
 	db            *DB
 	backupKeyring *crypto.KeyRing
-	saltKeyring   *crypto.KeyRing
+	saltCipher    crypto.Cipher
+	signer        Signer
+	coldSigning   ColdSigning
+
+	rescueAllowlist map[string]bool
+
+	approvalPolicy ApprovalPolicy
+	approvers      Approvers
+
+	arbiters Approvers
+
+	feePolicy FeePolicy
+
+	treasury TreasuryConfig
+
+	payoutPolicy  PayoutPolicy
+	payoutWebhook *Webhook
 }
 
-func NewCPG(assets *Assets, db *DB, backupKeyring, saltKeyring *crypto.KeyRing) *CPG {
+func NewCPG(assets *Assets, db *DB, backupKeyring *crypto.KeyRing, saltCipher crypto.Cipher) *CPG {
 	return &CPG{
 		assets:        assets,
 		db:            db,
 		backupKeyring: backupKeyring,
-		saltKeyring:   saltKeyring,
+		saltCipher:    saltCipher,
 	}
 }
 
+// UseSigner leaves invoice salts and sweep signing to a separate signer
+func (cpg *CPG) UseSigner(signer Signer) {
+	cpg.signer = signer
+}
+
 type RecoverInvoiceParams struct {
 	InvoiceID     string
 	InvoiceBackup []byte
@@ -48,37 +70,97 @@ func constantTimeOr(conds ...bool) bool {
 	}
 	return true
 }
-func (cpg *CPG) RecoverInvoice(ctx context.Context, params RecoverInvoiceParams) (err error) {
 
-	inv, err := DecryptInvoice(cpg.backupKeyring, params.InvoiceBackup)
+// openBackup decrypts and validates a backup, the salt stays sealed
+func (cpg *CPG) openBackup(ctx context.Context, invoiceID string, backup []byte) (*Invoice, BackupHeader, Asset, error) {
+
+	backup, err := backupcode.Decode(backup)
+	if err != nil {
+		return nil, BackupHeader{}, nil, ge.Wrap(ge.New("failed to decode backup"), err)
+	}
+
+	inv, header, err := OpenBackup(cpg.backupKeyring, backup)
 
 	//inv is always non-nil
 
 	if !constantTimeOr(
 		err != nil,
-		inv.ID != params.InvoiceID,
+		inv.ID != invoiceID,
 		inv.MinAmount.Cmp(big.NewInt(0)) <= 0,
 		len(inv.Metadata) >= 256,
 		!inv.Deadline.After(inv.CreateAt),
 		inv.Recipient == inv.Beneficiary,
 	) {
-		err = ge.Wrap(ge.New("failed to recover backup"), err)
-		return
+		return nil, header, nil, ge.Wrap(ge.New("failed to recover backup"), err)
 	}
 
 	assetProvider := cpg.assets.Get(inv.Asset)
 	if assetProvider == nil {
-		err = ge.New("asset is not supported no more")
-		return
+		return nil, header, nil, ge.New("asset is not supported no more")
 	}
 
-	if err = assetProvider.PrepareInvoice(ctx, inv); err != nil {
-		err = ge.Wrap(ge.New("failed to prepare recovered invoice"), err)
-		return
+	// a signer checks the salt by its mac
+	if cpg.signer == nil && len(inv.EncryptedSalt) != 0 {
+		if _, err = cpg.saltCipher.Decrypt(ctx, inv.EncryptedSalt); err != nil {
+			return nil, header, nil, ge.Wrap(ge.New("failed to decrypt recovered invoice salt"), err)
+		}
+		inv.saltCipher = cpg.saltCipher
+	}
+
+	return inv, header, assetProvider, nil
+}
+
+// restoreBackup re-encrypts the salt of an opened backup, re-derives its wallet and inserts it
+func (cpg *CPG) restoreBackup(ctx context.Context, inv *Invoice, assetProvider Asset) (err error) {
+
+	backupAddress := inv.WalletAddress
+	if err = cpg.prepareRecovered(ctx, inv, assetProvider); err != nil {
+		return ge.Wrap(ge.New("failed to prepare recovered invoice"), err)
+	}
+	if backupAddress != "" && backupAddress != inv.WalletAddress {
+		return ge.Detail(ge.New("re-derived wallet address does not match the backup"), ge.D{"backup": backupAddress, "derived": inv.WalletAddress})
 	}
+
 	if err = cpg.db.InsertInvoice(ctx, inv, true); err != nil {
-		err = ge.Wrap(ge.New("failed to insert invoice into db"), err)
-		return
+		return ge.Wrap(ge.New("failed to insert invoice into db"), err)
+	}
+
+	if indexer, ok := assetProvider.(WalletIndexer); ok && inv.WalletIndex != nil && indexer.WalletAccount() != "" {
+		if err = cpg.db.ReserveWalletIndex(ctx, indexer.WalletAccount(), *inv.WalletIndex); err != nil {
+			return ge.Wrap(ge.New("failed to reserve recovered wallet index"), err)
+		}
+	}
+
+	return nil
+}
+
+// prepareRecovered reseals the salt of an opened backup and re-derives its wallet
+func (cpg *CPG) prepareRecovered(ctx context.Context, inv *Invoice, assetProvider Asset) (err error) {
+	if cpg.signer != nil {
+		if len(inv.EncryptedSalt) != 0 {
+			// the signer never takes a salt back
+			return nil
+		}
+		return cpg.signer.PrepareInvoice(ctx, inv)
+	}
+	if len(inv.EncryptedSalt) != 0 {
+		salt := inv.DecryptSalt(ctx)
+		if inv.EncryptedSalt, err = cpg.saltCipher.Encrypt(ctx, salt); err != nil {
+			return ge.Wrap(ge.New("failed to encrypt recovered invoice salt"), err)
+		}
+	}
+	return assetProvider.PrepareInvoice(ctx, inv)
+}
+
+func (cpg *CPG) RecoverInvoice(ctx context.Context, params RecoverInvoiceParams) (err error) {
+
+	inv, _, assetProvider, err := cpg.openBackup(ctx, params.InvoiceID, params.InvoiceBackup)
+	if err != nil {
+		return err
+	}
+
+	if err = cpg.restoreBackup(ctx, inv, assetProvider); err != nil {
+		return err
 	}
 
 	if err = cpg.tryAutoCheckout(ctx, inv.ID); err != nil {
@@ -88,6 +170,115 @@ func (cpg *CPG) RecoverInvoice(ctx context.Context, params RecoverInvoiceParams)
 	return
 }
 
+type RecoverOutcome int
+
+const (
+	RecoverOutcomeFailed RecoverOutcome = iota
+	RecoverOutcomeRecovered
+	RecoverOutcomeExists
+)
+
+type RecoverAndCheckInvoiceResult struct {
+	Outcome       RecoverOutcome
+	WalletAddress string
+	Status        InvoiceStatus
+}
+
+// RecoverAndCheckInvoice recovers one backup of a batch and re-checks its balance
+func (cpg *CPG) RecoverAndCheckInvoice(ctx context.Context, params RecoverInvoiceParams) (result RecoverAndCheckInvoiceResult, err error) {
+
+	inv, _, assetProvider, err := cpg.openBackup(ctx, params.InvoiceID, params.InvoiceBackup)
+	if err != nil {
+		return result, err
+	}
+
+	existing, err := cpg.db.GetInvoice(ctx, inv.ID, "", false)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to get invoice"), err)
+	}
+	if existing != nil {
+		if inv.WalletAddress != "" && existing.WalletAddress != inv.WalletAddress {
+			return result, ge.Detail(ge.New("invoice exists with another wallet address"), ge.D{"backup": inv.WalletAddress, "existing": existing.WalletAddress})
+		}
+		result.Outcome = RecoverOutcomeExists
+		result.WalletAddress = existing.WalletAddress
+		result.Status = existing.Status()
+		return result, nil
+	}
+
+	if err = cpg.restoreBackup(ctx, inv, assetProvider); err != nil {
+		return result, err
+	}
+	result.Outcome = RecoverOutcomeRecovered
+	result.WalletAddress = inv.WalletAddress
+	result.Status = inv.Status()
+
+	if _, err = cpg.CheckInvoice(ctx, CheckInvoiceParams{InvoiceID: inv.ID}); err != nil {
+		return result, ge.Wrap(ge.New("invoice is recovered but its balance check failed"), err)
+	}
+
+	checked, err := cpg.db.GetInvoice(ctx, inv.ID, "", false)
+	if err != nil || checked == nil {
+		return result, ge.Wrap(ge.New("invoice is recovered but failed to get it back"), err)
+	}
+	result.Status = checked.Status()
+
+	return result, nil
+}
+
+type VerifyBackupParams struct {
+	InvoiceID     string
+	InvoiceBackup []byte
+}
+
+type VerifyBackupResult struct {
+	Header BackupHeader
+	// WalletAddress is re-derived from the backup salt
+	WalletAddress string
+	// AddressMatches reports whether the re-derived wallet is the one the backup was made for
+	AddressMatches bool
+	// Exists reports whether the invoice is already in the db
+	Exists      bool
+	MinAmount   big.Int
+	Recipient   string
+	Beneficiary string
+	Deadline    time.Time
+}
+
+// VerifyBackup runs every check RecoverInvoice does without inserting the invoice
+func (cpg *CPG) VerifyBackup(ctx context.Context, params VerifyBackupParams) (result VerifyBackupResult, err error) {
+
+	inv, header, assetProvider, err := cpg.openBackup(ctx, params.InvoiceID, params.InvoiceBackup)
+	if err != nil {
+		return result, err
+	}
+
+	backupAddress := inv.WalletAddress
+	if err = cpg.prepareRecovered(ctx, inv, assetProvider); err != nil {
+		err = ge.Wrap(ge.New("failed to prepare recovered invoice"), err)
+		return
+	}
+
+	existing, err := cpg.db.GetInvoice(ctx, inv.ID, "", false)
+	if err != nil {
+		err = ge.Wrap(ge.New("failed to get invoice"), err)
+		return
+	}
+
+	result = VerifyBackupResult{
+		Header:         header,
+		WalletAddress:  inv.WalletAddress,
+		AddressMatches: backupAddress == inv.WalletAddress,
+		Exists:         existing != nil,
+		MinAmount:      inv.MinAmount,
+		Recipient:      inv.Recipient,
+		Beneficiary:    inv.Beneficiary,
+		Deadline:       inv.Deadline,
+	}
+
+	return
+}
+
 type CreateInvoiceParams struct {
 	AssetName    string
 	Metadata     string
@@ -96,14 +287,34 @@ type CreateInvoiceParams struct {
 	AutoCheckout bool
 	MinAmount    *big.Int
 	Deadline     time.Time
+	// EscrowPeriod makes an escrow invoice
+	EscrowPeriod time.Duration
+	// Split pays the invoice to several recipients by their shares, the first one is the Recipient
+	Split        []SplitShare
+	SplitGasRule SplitGasRule
 }
 
 type CreateInvoiceResult struct {
 	InvoiceID     string
 	InvoiceBackup []byte
+	// EscrowBuyerKey releases or disputes an escrow invoice, only its hash is kept
+	EscrowBuyerKey string
 }
 
 func (cpg *CPG) CreateInvoice(ctx context.Context, params CreateInvoiceParams) (result CreateInvoiceResult, err error) {
+	if len(params.Split) > 0 {
+		if params.Split, err = newSplit(params.Split, params.Recipient, params.Beneficiary); err != nil {
+			return result, err
+		}
+		params.Recipient = params.Split[0].Address
+		if params.SplitGasRule == "" {
+			params.SplitGasRule = SplitGasProportional
+		}
+		if !params.SplitGasRule.valid() {
+			err = ge.Detail(ge.New("unknown split gas rule"), ge.D{"rule": params.SplitGasRule})
+			return result, err
+		}
+	}
 	if params.Beneficiary == params.Recipient {
 		err = ge.New("same beneficiary and recipient")
 		return result, err
@@ -124,6 +335,10 @@ func (cpg *CPG) CreateInvoice(ctx context.Context, params CreateInvoiceParams) (
 		err = ge.New("too far deadline")
 		return result, err
 	}
+	if params.EscrowPeriod < 0 || params.EscrowPeriod > MaxEscrowPeriod || params.EscrowPeriod%time.Second != 0 {
+		err = ge.New("escrow period must be whole seconds up to a year")
+		return result, err
+	}
 	assetProvider := cpg.assets.Get(params.AssetName)
 	if assetProvider == nil {
 		err = ge.New("asset is not supported")
@@ -132,7 +347,7 @@ func (cpg *CPG) CreateInvoice(ctx context.Context, params CreateInvoiceParams) (
 
 	assetInfo := assetProvider.Info()
 
-	inv := &Invoice{saltKeyring: cpg.saltKeyring}
+	inv := &Invoice{saltCipher: cpg.saltCipher}
 	inv.Metadata = params.Metadata
 	inv.Recipient = params.Recipient
 	inv.Beneficiary = params.Beneficiary
@@ -141,11 +356,41 @@ func (cpg *CPG) CreateInvoice(ctx context.Context, params CreateInvoiceParams) (
 	inv.ID = uuid.NewString()
 	inv.CreateAt = time.Now()
 	inv.AuthCheckout = params.AutoCheckout
+	inv.DerivationVersion = assetInfo.DerivationVersion
+	inv.EscrowPeriod = params.EscrowPeriod
+	if inv.EscrowPeriod > 0 {
+		result.EscrowBuyerKey, inv.BuyerKeyHash = newBuyerKey()
+	}
+	inv.Fee = cpg.feePolicy.terms(params.AssetName, params.Recipient)
+	inv.TreasuryAddress = cpg.treasuryAddress(params.AssetName)
+	if len(params.Split) > 0 {
+		inv.Split = params.Split
+		inv.SplitGasRule = params.SplitGasRule
+	}
 	inv.MinAmount.Set(params.MinAmount)
-	inv.EncryptedSalt = randomEncryptedSalt(cpg.saltKeyring, assetInfo.SaltLength)
+	if cpg.signer == nil && usesSalt(assetProvider) {
+		if inv.EncryptedSalt, err = randomEncryptedSalt(ctx, cpg.saltCipher, assetInfo.SaltLength); err != nil {
+			err = ge.Wrap(ge.New("failed to encrypt invoice salt"), err)
+			return result, err
+		}
+	}
+	if indexer, ok := assetProvider.(WalletIndexer); ok && indexer.WalletAccount() != "" {
+		var index int64
+		if index, err = cpg.db.AllocateWalletIndex(ctx, indexer.WalletAccount()); err != nil {
+			err = ge.Wrap(ge.New("failed to allocate wallet index"), err)
+			return result, err
+		}
+		inv.WalletIndex = &index
+	}
 
 	inv.WalletAddress = ""
-	if err = assetProvider.PrepareInvoice(ctx, inv); err != nil {
+	if cpg.signer != nil {
+		// the signer seals the salt
+		err = cpg.signer.PrepareInvoice(ctx, inv)
+	} else {
+		err = assetProvider.PrepareInvoice(ctx, inv)
+	}
+	if err != nil {
 		if daemon.Debug() {
 			slog.Debug("failed to prepare invoice", "error", err.Error())
 		}
@@ -165,8 +410,17 @@ func (cpg *CPG) CreateInvoice(ctx context.Context, params CreateInvoiceParams) (
 	return
 }
 
-func randomEncryptedSalt(saltKeyring *crypto.KeyRing, saltLength int) []byte {
-	return saltKeyring.Encrypt(crypto.ReadN(nil, saltLength), (*[24]byte)(crypto.ReadN(nil, 24)))
+// usesSalt reports whether the invoice wallets of the asset are derived from a salt
+func usesSalt(asset Asset) bool {
+	if asset.Info().WatchOnly {
+		return false
+	}
+	indexer, ok := asset.(WalletIndexer)
+	return !ok || indexer.WalletAccount() == ""
+}
+
+func randomEncryptedSalt(ctx context.Context, saltCipher crypto.Cipher, saltLength int) ([]byte, error) {
+	return saltCipher.Encrypt(ctx, crypto.ReadN(nil, saltLength))
 }
 
 type RequestCheckoutParams struct {
@@ -195,9 +449,11 @@ func (cpg *CPG) RequestCheckout(ctx context.Context, params RequestCheckoutParam
 	invoiceStatus := inv.Status()
 
 	switch invoiceStatus {
-	case InvoiceStatusExpired, InvoiceStatusCanceled, InvoiceStatusFilled, InvoiceStatusCheckout:
+	case InvoiceStatusExpired, InvoiceStatusCanceled, InvoiceStatusFilled, InvoiceStatusCheckout, InvoiceStatusReleased, InvoiceStatusRefunded:
 	case InvoiceStatusPending:
 		return ge.New("invoice status is pending")
+	case InvoiceStatusHeld, InvoiceStatusDisputed:
+		return ErrEscrowHeld
 	default:
 		return ErrInvalidInvoiceStatus
 	}
@@ -240,7 +496,8 @@ func (cpg *CPG) CancelInvoice(ctx context.Context, params CancelInvoiceParams) (
 
 	switch invoiceStatus {
 	case InvoiceStatusPending:
-	case InvoiceStatusExpired, InvoiceStatusCanceled, InvoiceStatusFilled, InvoiceStatusCheckout:
+	case InvoiceStatusExpired, InvoiceStatusCanceled, InvoiceStatusFilled, InvoiceStatusCheckout, InvoiceStatusAwaitingApproval,
+		InvoiceStatusHeld, InvoiceStatusDisputed, InvoiceStatusReleased, InvoiceStatusRefunded:
 		err = ge.Detail(ge.New("invoice status is not pending"), ge.D{"invoiceStatus": invoiceStatus})
 		return err
 	default:
@@ -278,6 +535,22 @@ type GetInvoiceResult struct {
 	AutoCheckout      bool
 	WalletAddress     string
 	Status            InvoiceStatus
+	ApprovalRequestAt *time.Time
+	ApproveAt         *time.Time
+	EscrowPeriod      time.Duration
+	AutoReleaseAt     *time.Time
+	ReleaseAt         *time.Time
+	RefundAt          *time.Time
+	DisputeAt         *time.Time
+	// Fee is nil for invoices without a platform fee
+	Fee       *PlatformFee
+	FeeAmount *big.Int
+	FeeTxHash string
+	// Split is the breakdown of a split invoice
+	Split        []SplitShare
+	SplitGasRule SplitGasRule
+	// TreasuryAddress is set for invoices of treasury mode
+	TreasuryAddress string
 }
 
 func (cpg *CPG) GetInvoice(ctx context.Context, params GetInvoiceParams) (result GetInvoiceResult, err error) {
@@ -311,6 +584,19 @@ func (cpg *CPG) GetInvoice(ctx context.Context, params GetInvoiceParams) (result
 		AutoCheckout:      inv.AuthCheckout,
 		WalletAddress:     inv.WalletAddress,
 		Status:            inv.Status(),
+		ApprovalRequestAt: inv.ApprovalRequestAt,
+		ApproveAt:         inv.ApproveAt,
+		EscrowPeriod:      inv.EscrowPeriod,
+		AutoReleaseAt:     inv.AutoReleaseAt(),
+		ReleaseAt:         inv.ReleaseAt,
+		RefundAt:          inv.RefundAt,
+		DisputeAt:         inv.DisputeAt,
+		Fee:               inv.Fee,
+		FeeAmount:         inv.FeeAmount,
+		FeeTxHash:         inv.FeeTxHash,
+		Split:             inv.Split,
+		SplitGasRule:      inv.SplitGasRule,
+		TreasuryAddress:   inv.TreasuryAddress,
 	}
 
 	return
@@ -341,13 +627,14 @@ func (cpg *CPG) CheckInvoice(ctx context.Context, params CheckInvoiceParams) (re
 
 	switch result.InvoiceStatus = inv.Status(); result.InvoiceStatus {
 
-	case InvoiceStatusExpired, InvoiceStatusCanceled, InvoiceStatusFilled, InvoiceStatusCheckout:
+	case InvoiceStatusExpired, InvoiceStatusCanceled, InvoiceStatusFilled, InvoiceStatusCheckout, InvoiceStatusAwaitingApproval,
+		InvoiceStatusHeld, InvoiceStatusDisputed, InvoiceStatusReleased, InvoiceStatusRefunded:
 
 		break
 
 	case InvoiceStatusPending:
 
-		inv.saltKeyring = cpg.saltKeyring
+		inv.saltCipher = cpg.saltCipher
 
 		invoiceBalance, err := asset.GetBalance(ctx, inv)
 		if err != nil {
@@ -369,6 +656,8 @@ func (cpg *CPG) CheckInvoice(ctx context.Context, params CheckInvoiceParams) (re
 
 		inv.FillAt = &now
 
+		cpg.reconcileWallet(ctx, inv, inv.Asset, LedgerPayments, invoiceBalance)
+
 	default:
 		return result, ErrInvalidInvoiceStatus
 	}
@@ -384,57 +673,157 @@ type TryCheckoutInvoiceParams struct {
 	InvoiceID string
 }
 
-func (cpg *CPG) TryCheckoutInvoice(ctx context.Context, params TryCheckoutInvoiceParams) (err error) {
+type TryCheckoutInvoiceResult struct {
+	// SweepBundle is the exported unsigned sweep of a cold signed checkout
+	SweepBundle []byte
+}
+
+func (cpg *CPG) TryCheckoutInvoice(ctx context.Context, params TryCheckoutInvoiceParams) (result TryCheckoutInvoiceResult, err error) {
 
 	inv, err := cpg.db.GetInvoice(ctx, params.InvoiceID, "", true)
 
 	if err != nil {
-		return ge.Wrap(ge.New("failed to get invoice"), err)
+		return result, ge.Wrap(ge.New("failed to get invoice"), err)
 	}
 
 	if inv == nil {
-		return ge.New("invoice not found")
+		return result, ge.New("invoice not found")
 	}
 
 	if inv.CheckoutRequestAt == nil {
-		return ge.New("invoice not requested to checkout")
+		return result, ge.New("invoice not requested to checkout")
 	}
 
 	if false == inv.CheckoutRequestAt.Before(time.Now()) {
-		return ge.New("invoice is already checking out")
+		return result, ge.New("invoice is already checking out")
 	}
 
 	asset := cpg.assets.Get(inv.Asset)
 	if asset == nil {
-		return ge.New("asset is not supported")
+		return result, ge.New("asset is not supported")
 	}
 
 	switch invoiceStatus := inv.Status(); invoiceStatus {
 
 	case InvoiceStatusPending:
 
-		return ge.New("invoice status is pending")
+		return result, ge.New("invoice status is pending")
+
+	case InvoiceStatusAwaitingApproval:
+
+		return result, ErrAwaitingApproval
 
-	case InvoiceStatusExpired, InvoiceStatusCanceled, InvoiceStatusFilled, InvoiceStatusCheckout:
+	case InvoiceStatusHeld, InvoiceStatusDisputed:
 
-		inv.saltKeyring = cpg.saltKeyring
+		return result, ErrEscrowHeld
 
-		if err = asset.TryFlush(ctx, inv); err != nil {
+	case InvoiceStatusExpired, InvoiceStatusCanceled, InvoiceStatusFilled, InvoiceStatusCheckout, InvoiceStatusReleased, InvoiceStatusRefunded:
+
+		if err = cpg.checkApproval(ctx, inv, asset); err != nil {
+			return result, err
+		}
+
+		if result.SweepBundle, err = cpg.coldSweepBundle(ctx, inv, asset); err != nil {
+			return result, ge.Wrap(ge.New("failed to build sweep bundle"), err)
+		}
+		if result.SweepBundle != nil {
+			cpg.clearApproval(ctx, inv)
+			// the checkout completes once SubmitSweepBundle broadcasts the signed tx
+			return result, nil
+		}
+
+		feeTxHash, splitState := inv.FeeTxHash, inv.splitState()
+		if cpg.signer != nil {
+			err = cpg.flushWithSigner(ctx, inv, asset)
+		} else if asset.Info().WatchOnly {
+			// the checkout request stays for a signing component to carry out
+			return result, ErrWatchOnly
+		} else {
+			inv.saltCipher = cpg.saltCipher
+			err = asset.TryFlush(ctx, inv)
+		}
+		// legs may be sent even if the sweep after them failed
+		cpg.recordFeeLeg(ctx, inv, feeTxHash)
+		cpg.recordSplit(ctx, inv, splitState)
+		cpg.recordTreasurySweep(ctx, inv)
+		cpg.postSweeps(ctx, inv)
+
+		if err != nil {
 			if daemon.Debug() {
 				slog.Debug("failed to flush invoice", "err", err.Error())
 			}
-			return ge.Wrap(ge.New("failed to flush invoice"), err)
+			return result, ge.Wrap(ge.New("failed to flush invoice"), err)
 		}
+		cpg.clearApproval(ctx, inv)
 
 		if err = cpg.db.SetInvoiceLastCheckoutAt(ctx, inv.ID); err != nil {
 			slog.Warn("failed to update invoice last_checkout_at", slog.String("invoice", inv.ID), slog.String("error", err.Error()))
 		}
 
-		return nil
+		return result, nil
 
 	default:
-		return ErrInvalidInvoiceStatus
+		return result, ErrInvalidInvoiceStatus
+	}
+}
+
+// flushWithSigner builds the sweep here, has the signer sign it and broadcasts it
+func (cpg *CPG) flushWithSigner(ctx context.Context, inv *Invoice, asset Asset) error {
+
+	sweepSigner, ok := asset.(SweepSigner)
+	if !ok {
+		return ge.Detail(ge.New("asset can not sign sweeps apart"), ge.D{"asset": inv.Asset})
 	}
+
+	unsigned, err := sweepSigner.BuildSweep(ctx, inv, inv.Destination())
+	if err != nil {
+		return err
+	}
+
+	var signedFee []byte
+	if unsigned.FeeTx != nil {
+		if signedFee, err = cpg.signer.SignSweep(ctx, inv.ID, unsigned.FeeTx); err != nil {
+			return ge.Wrap(ge.New("signer refused the fee leg"), err)
+		}
+	}
+
+	signedSplit := make([][]byte, len(unsigned.SplitTxs))
+	for i := range unsigned.SplitTxs {
+		if signedSplit[i], err = cpg.signer.SignSweep(ctx, inv.ID, unsigned.SplitTxs[i]); err != nil {
+			return ge.Wrap(ge.New("signer refused a split leg"), err)
+		}
+	}
+
+	signed, err := cpg.signer.SignSweep(ctx, inv.ID, unsigned.Tx)
+	if err != nil {
+		return ge.Wrap(ge.New("signer refused the sweep"), err)
+	}
+
+	to, sweep := inv.Destination(), unsigned.Sweep
+	defer func() { inv.ReportSweep(to, sweep) }()
+
+	if signedFee != nil {
+		if sweep.FeeTxHash, err = sweepSigner.SendSweep(ctx, signedFee); err != nil {
+			sweep.FeeTxHash = ""
+			return ge.Wrap(ge.New("failed to send fee leg"), err)
+		}
+	}
+
+	for i := range signedSplit {
+		if sweep.Split[i].TxHash, err = sweepSigner.SendSweep(ctx, signedSplit[i]); err != nil {
+			sweep.Split[i].TxHash = ""
+			return ge.Wrap(ge.Detail(ge.New("failed to send split leg"), ge.D{"to": sweep.Split[i].To}), err)
+		}
+	}
+
+	txHash, err := sweepSigner.SendSweep(ctx, signed)
+	if err != nil {
+		return err
+	}
+	sweep.TxHash = txHash
+
+	slog.Info("sweep sent", slog.String("invoice", inv.ID), slog.String("tx", txHash), slog.String("amount", unsigned.Sweep.Amount.String()))
+	return nil
 }
 
 func (cpg *CPG) tryAutoCheckout(ctx context.Context, invoiceId string) error {
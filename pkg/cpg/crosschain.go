@@ -0,0 +1,115 @@
+package cpg
+
+import (
+	"context"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"math/big"
+	"slices"
+)
+
+type RecoverCrossChainParams struct {
+	InvoiceID string
+	DryRun    bool
+}
+
+type CrossChainSweep struct {
+	Asset       string
+	Destination string
+	Sweep       SweepResult
+	Err         error
+}
+
+// RecoverCrossChain sweeps funds sent to the invoice wallet on the other assets of its wallet family
+func (cpg *CPG) RecoverCrossChain(ctx context.Context, params RecoverCrossChainParams) (sweeps []CrossChainSweep, err error) {
+
+	if cpg.signer != nil {
+		return nil, ErrSaltsOnSigner
+	}
+
+	inv, err := cpg.db.GetInvoice(ctx, params.InvoiceID, "", true)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to get invoice"), err)
+	}
+	if inv == nil {
+		return nil, ge.New("invoice not found")
+	}
+	inv.saltCipher = cpg.saltCipher
+
+	invoiceAsset := cpg.assets.Get(inv.Asset)
+	if invoiceAsset == nil {
+		return nil, ge.New("asset is not supported")
+	}
+	family, ok := invoiceAsset.(WalletFamily)
+	if !ok {
+		return nil, ge.Detail(ge.New("asset wallets are not shared with other assets"), ge.D{"asset": inv.Asset})
+	}
+
+	if inv.Status() == InvoiceStatusInvalid {
+		return nil, ErrInvalidInvoiceStatus
+	}
+	destination := inv.payee()
+
+	names := make([]string, 0, len(cpg.assets.map_))
+	for name, asset := range cpg.assets.map_ {
+		if other, ok := asset.(WalletFamily); ok && name != inv.Asset && other.WalletFamily() == family.WalletFamily() {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		asset := cpg.assets.Get(name)
+		sweep := CrossChainSweep{Asset: name, Destination: destination}
+
+		balance, err := asset.GetBalance(ctx, inv)
+		if err != nil {
+			sweep.Err = ge.Wrap(ge.New("failed to get wallet balance"), err)
+			sweeps = append(sweeps, sweep)
+			continue
+		}
+		sweep.Sweep.Balance = balance
+		if balance.Cmp(big.NewInt(0)) <= 0 {
+			sweeps = append(sweeps, sweep)
+			continue
+		}
+
+		sweeper, ok := asset.(Sweeper)
+		if !ok {
+			sweep.Err = ge.Detail(ge.New("asset can not sweep"), ge.D{"asset": name})
+			sweeps = append(sweeps, sweep)
+			continue
+		}
+
+		if sweep.Sweep, err = sweeper.Sweep(ctx, inv, destination, params.DryRun); err != nil {
+			sweep.Sweep.Balance = balance
+			sweep.Err = err
+			sweeps = append(sweeps, sweep)
+			continue
+		}
+
+		if !params.DryRun {
+			cpg.postSweep(ctx, inv, name, destination, sweep.Sweep, LedgerRecoveries)
+			err = cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+				InvoiceID: inv.ID,
+				Kind:      InvoiceEventCrossChainRecovery,
+				Asset:     name,
+				TxHash:    sweep.Sweep.TxHash,
+				Detail: map[string]string{
+					"invoice_asset": inv.Asset,
+					"to":            destination,
+					"amount":        sweep.Sweep.Amount.String(),
+					"fee":           sweep.Sweep.Fee.String(),
+				},
+			})
+			if err != nil {
+				slog.Error("failed to record cross chain recovery", "invoice", inv.ID, "asset", name, "tx", sweep.Sweep.TxHash, "error", err.Error())
+				sweep.Err = ge.Wrap(ge.New("swept but failed to record the recovery event"), err)
+			}
+		}
+
+		sweeps = append(sweeps, sweep)
+	}
+
+	return sweeps, nil
+}
@@ -4,8 +4,20 @@ import (
 	"context"
 	"cpg/pkg/ent/database"
 	"cpg/pkg/ent/database/invoice"
+	"cpg/pkg/ent/database/invoiceevent"
+	"cpg/pkg/ent/database/ledgeraccount"
+	"cpg/pkg/ent/database/ledgerentry"
+	"cpg/pkg/ent/database/payout"
+	"cpg/pkg/ent/database/payoutday"
 	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/treasurycredit"
+	"cpg/pkg/ent/database/treasurytransfer"
+	"cpg/pkg/ent/database/walletaccount"
+	"cpg/pkg/ent/schema"
+	"entgo.io/ent/dialect/sql"
+	"github.com/itsabgr/fak"
 	"github.com/itsabgr/ge"
+	"math/big"
 	"time"
 )
 
@@ -155,8 +167,153 @@ func (db *DB) DoneInvoiceCheckoutRequestAt(ctx context.Context, id string) error
 	return nil
 }
 
+// RequestInvoiceApproval holds the requested checkout of an invoice for approval
+func (db *DB) RequestInvoiceApproval(ctx context.Context, id string) error {
+	n, err := db.client.Invoice.Update().Where(
+		invoice.ID(id),
+		invoice.CheckoutRequestAtNotNil(),
+	).SetApprovalRequestAt(time.Now()).ClearApproveAt().Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ge.New("invoice not found or not requested to checkout")
+	}
+	return nil
+}
+
+// ApproveInvoice approves the approval request made at requestAt
+func (db *DB) ApproveInvoice(ctx context.Context, id string, requestAt time.Time) error {
+	n, err := db.client.Invoice.Update().Where(
+		invoice.ID(id),
+		invoice.ApprovalRequestAt(requestAt),
+	).SetApproveAt(time.Now()).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ge.New("invoice approval request is no longer open")
+	}
+	return nil
+}
+
+// RejectInvoiceApproval drops the approval request made at requestAt along with the checkout request
+func (db *DB) RejectInvoiceApproval(ctx context.Context, id string, requestAt time.Time) error {
+	n, err := db.client.Invoice.Update().Where(
+		invoice.ID(id),
+		invoice.ApprovalRequestAt(requestAt),
+		invoice.ApproveAtIsNil(),
+	).ClearApprovalRequestAt().ClearCheckoutRequestAt().Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ge.New("invoice approval request is no longer open")
+	}
+	return nil
+}
+
+// ClearInvoiceApproval drops the approval of a checkout once its sweep is done
+func (db *DB) ClearInvoiceApproval(ctx context.Context, id string) error {
+	return db.client.Invoice.UpdateOneID(id).ClearApprovalRequestAt().ClearApproveAt().Exec(ctx)
+}
+
+// escrowOpen matches filled escrow invoices neither released, refunded nor checked out
+func escrowOpen(id string) predicate.Invoice {
+	return invoice.And(
+		invoice.ID(id),
+		invoice.EscrowPeriodGT(0),
+		invoice.FillAtNotNil(),
+		invoice.ReleaseAtIsNil(),
+		invoice.RefundAtIsNil(),
+		invoice.LastCheckoutAtIsNil(),
+	)
+}
+
+func (db *DB) SetInvoiceReleaseAt(ctx context.Context, id string) error {
+	n, err := db.client.Invoice.Update().Where(escrowOpen(id)).SetReleaseAt(time.Now()).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ge.New("invoice not found or not held in escrow")
+	}
+	return nil
+}
+
+func (db *DB) SetInvoiceRefundAt(ctx context.Context, id string) error {
+	n, err := db.client.Invoice.Update().Where(escrowOpen(id)).SetRefundAt(time.Now()).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ge.New("invoice not found or not held in escrow")
+	}
+	return nil
+}
+
+func (db *DB) SetInvoiceDisputeAt(ctx context.Context, id string) error {
+	n, err := db.client.Invoice.Update().Where(escrowOpen(id), invoice.DisputeAtIsNil()).SetDisputeAt(time.Now()).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ge.New("invoice not found or not held in escrow or already disputed")
+	}
+	return nil
+}
+
+// SetInvoiceFeeLeg records the sent fee leg of an invoice
+func (db *DB) SetInvoiceFeeLeg(ctx context.Context, id string, amount *big.Int, txHash string) error {
+	n, err := db.client.Invoice.Update().Where(invoice.ID(id)).SetFeeAmount(amount).SetFeeTxHash(txHash).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ge.New("invoice not found")
+	}
+	return nil
+}
+
+// SetInvoiceSplit records the planned and sent legs of a split invoice
+func (db *DB) SetInvoiceSplit(ctx context.Context, id string, split []SplitShare) error {
+	n, err := db.client.Invoice.Update().Where(invoice.ID(id), invoice.SplitNotNil()).SetSplit(splitToEnt(split)).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ge.New("invoice not found or not split")
+	}
+	return nil
+}
+
+func splitToEnt(split []SplitShare) []schema.SplitShare {
+	stored := make([]schema.SplitShare, len(split))
+	for i, share := range split {
+		stored[i] = schema.SplitShare{Address: share.Address, BasisPoints: share.BasisPoints, TxHash: share.TxHash}
+		if share.Amount != nil {
+			stored[i].Amount = share.Amount.String()
+		}
+	}
+	return stored
+}
+
+func splitFromEnt(stored []schema.SplitShare) []SplitShare {
+	if len(stored) == 0 {
+		return nil
+	}
+	split := make([]SplitShare, len(stored))
+	for i, share := range stored {
+		split[i] = SplitShare{Address: share.Address, BasisPoints: share.BasisPoints, TxHash: share.TxHash}
+		if share.Amount != "" {
+			split[i].Amount, _ = new(big.Int).SetString(share.Amount, 10)
+		}
+	}
+	return split
+}
+
 func (db *DB) InsertInvoice(ctx context.Context, inv *Invoice, recovered bool) error {
-	return db.client.Invoice.Create().
+	create := db.client.Invoice.Create().
 		SetID(inv.ID).
 		SetMinAmount(&inv.MinAmount).
 		SetRecipient(inv.Recipient).
@@ -166,9 +323,42 @@ func (db *DB) InsertInvoice(ctx context.Context, inv *Invoice, recovered bool) e
 		SetCreateAt(inv.CreateAt).
 		SetDeadline(inv.Deadline).
 		SetWalletAddress(inv.WalletAddress).
-		SetEncryptedSalt(inv.EncryptedSalt).
 		SetAutoCheckout(inv.AuthCheckout).
-		Exec(ctx)
+		SetDerivationVersion(inv.DerivationVersion).
+		SetNillableWalletIndex(inv.WalletIndex).
+		SetNillableFillAt(inv.FillAt).
+		SetNillableCancelAt(inv.CancelAt).
+		SetNillableLastCheckoutAt(inv.LastCheckoutAt).
+		SetNillableCheckoutRequestAt(inv.CheckoutRequestAt).
+		SetEscrowPeriod(int64(inv.EscrowPeriod / time.Second)).
+		SetNillableReleaseAt(inv.ReleaseAt).
+		SetNillableRefundAt(inv.RefundAt).
+		SetNillableDisputeAt(inv.DisputeAt)
+	if len(inv.EncryptedSalt) != 0 {
+		create.SetEncryptedSalt(inv.EncryptedSalt)
+	}
+	if len(inv.SignerMAC) != 0 {
+		create.SetSignerMAC(inv.SignerMAC)
+	}
+	if len(inv.BuyerKeyHash) != 0 {
+		create.SetBuyerKeyHash(inv.BuyerKeyHash)
+	}
+	if inv.Fee != nil {
+		create.SetFeeAddress(inv.Fee.Address).SetFeeBasisPoints(inv.Fee.BasisPoints)
+		if inv.Fee.Flat != nil {
+			create.SetFeeFlat(inv.Fee.Flat)
+		}
+	}
+	if inv.FeeTxHash != "" {
+		create.SetFeeAmount(inv.FeeAmount).SetFeeTxHash(inv.FeeTxHash)
+	}
+	if len(inv.Split) > 0 {
+		create.SetSplit(splitToEnt(inv.Split)).SetSplitGasRule(string(inv.SplitGasRule))
+	}
+	if inv.TreasuryAddress != "" {
+		create.SetTreasuryAddress(inv.TreasuryAddress)
+	}
+	return create.Exec(ctx)
 }
 
 func (db *DB) GetInvoice(ctx context.Context, id, walletAddress string, withSalt bool) (*Invoice, error) {
@@ -187,9 +377,26 @@ func (db *DB) GetInvoice(ctx context.Context, id, walletAddress string, withSalt
 		invoice.FieldCancelAt,
 		invoice.FieldWalletAddress,
 		invoice.FieldAutoCheckout,
+		invoice.FieldDerivationVersion,
+		invoice.FieldWalletIndex,
+		invoice.FieldApprovalRequestAt,
+		invoice.FieldApproveAt,
+		invoice.FieldEscrowPeriod,
+		invoice.FieldBuyerKeyHash,
+		invoice.FieldReleaseAt,
+		invoice.FieldRefundAt,
+		invoice.FieldDisputeAt,
+		invoice.FieldFeeAddress,
+		invoice.FieldFeeBasisPoints,
+		invoice.FieldFeeFlat,
+		invoice.FieldFeeAmount,
+		invoice.FieldFeeTxHash,
+		invoice.FieldSplit,
+		invoice.FieldSplitGasRule,
+		invoice.FieldTreasuryAddress,
 	}
 	if withSalt {
-		fields = append(fields, invoice.FieldEncryptedSalt)
+		fields = append(fields, invoice.FieldEncryptedSalt, invoice.FieldSignerMAC)
 	}
 
 	where := make([]predicate.Invoice, 0, 2)
@@ -213,6 +420,10 @@ func (db *DB) GetInvoice(ctx context.Context, id, walletAddress string, withSalt
 		}
 		return nil, err
 	}
+	return invoiceFromEnt(found), nil
+}
+
+func invoiceFromEnt(found *database.Invoice) *Invoice {
 	inv := &Invoice{
 		ID:                found.ID,
 		MinAmount:         *found.MinAmount,
@@ -229,7 +440,770 @@ func (db *DB) GetInvoice(ctx context.Context, id, walletAddress string, withSalt
 		AuthCheckout:      found.AutoCheckout,
 		WalletAddress:     found.WalletAddress,
 		EncryptedSalt:     found.EncryptedSalt,
+		SignerMAC:         found.SignerMAC,
+		DerivationVersion: found.DerivationVersion,
+		WalletIndex:       found.WalletIndex,
+		ApprovalRequestAt: found.ApprovalRequestAt,
+		ApproveAt:         found.ApproveAt,
+		EscrowPeriod:      time.Duration(found.EscrowPeriod) * time.Second,
+		BuyerKeyHash:      found.BuyerKeyHash,
+		ReleaseAt:         found.ReleaseAt,
+		RefundAt:          found.RefundAt,
+		DisputeAt:         found.DisputeAt,
+		FeeTxHash:         found.FeeTxHash,
+		Split:             splitFromEnt(found.Split),
+		SplitGasRule:      SplitGasRule(found.SplitGasRule),
+		TreasuryAddress:   found.TreasuryAddress,
+	}
+	if found.FeeAddress != "" {
+		inv.Fee = &PlatformFee{Address: found.FeeAddress, BasisPoints: found.FeeBasisPoints, Flat: found.FeeFlat}
+	}
+	if found.FeeTxHash != "" {
+		inv.FeeAmount = found.FeeAmount
+	}
+	return inv
+}
+
+// ListInvoices returns up to limit invoices after afterID, changed since the given time unless it is zero
+func (db *DB) ListInvoices(ctx context.Context, afterID string, since time.Time, limit int) ([]*Invoice, error) {
+	where := []predicate.Invoice{invoice.IDGT(afterID)}
+	if !since.IsZero() {
+		where = append(where, invoice.Or(
+			invoice.CreateAtGTE(since),
+			invoice.FillAtGTE(since),
+			invoice.CancelAtGTE(since),
+			invoice.LastCheckoutAtGTE(since),
+			invoice.CheckoutRequestAtGTE(since),
+			invoice.ReleaseAtGTE(since),
+			invoice.RefundAtGTE(since),
+			invoice.DisputeAtGTE(since),
+		))
+	}
+	found, err := db.client.Invoice.Query().
+		Where(where...).
+		Order(invoice.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invs := make([]*Invoice, len(found))
+	for i := range found {
+		invs[i] = invoiceFromEnt(found[i])
+	}
+	return invs, nil
+}
+
+// RestoreInvoices inserts the invoices in one transaction, upsert updates the existing ones
+func (db *DB) RestoreInvoices(ctx context.Context, invs []*Invoice, upsert bool) (inserted, updated int, err error) {
+	tx, err := db.client.Tx(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	txDB := &DB{client: tx.Client()}
+
+	for _, inv := range invs {
+		if upsert {
+			update := tx.Invoice.Update().Where(
+				invoice.ID(inv.ID),
+				invoice.WalletAddress(inv.WalletAddress),
+			)
+			if len(inv.EncryptedSalt) != 0 {
+				update.SetEncryptedSalt(inv.EncryptedSalt)
+			}
+			if inv.FillAt == nil {
+				update.ClearFillAt()
+			} else {
+				update.SetFillAt(*inv.FillAt)
+			}
+			if inv.CancelAt == nil {
+				update.ClearCancelAt()
+			} else {
+				update.SetCancelAt(*inv.CancelAt)
+			}
+			if inv.LastCheckoutAt == nil {
+				update.ClearLastCheckoutAt()
+			} else {
+				update.SetLastCheckoutAt(*inv.LastCheckoutAt)
+			}
+			if inv.CheckoutRequestAt == nil {
+				update.ClearCheckoutRequestAt()
+			} else {
+				update.SetCheckoutRequestAt(*inv.CheckoutRequestAt)
+			}
+			if inv.ReleaseAt == nil {
+				update.ClearReleaseAt()
+			} else {
+				update.SetReleaseAt(*inv.ReleaseAt)
+			}
+			if inv.RefundAt == nil {
+				update.ClearRefundAt()
+			} else {
+				update.SetRefundAt(*inv.RefundAt)
+			}
+			if inv.DisputeAt == nil {
+				update.ClearDisputeAt()
+			} else {
+				update.SetDisputeAt(*inv.DisputeAt)
+			}
+			if inv.FeeTxHash == "" {
+				update.ClearFeeAmount().ClearFeeTxHash()
+			} else {
+				update.SetFeeAmount(inv.FeeAmount).SetFeeTxHash(inv.FeeTxHash)
+			}
+			if len(inv.Split) > 0 {
+				update.SetSplit(splitToEnt(inv.Split))
+			}
+			var n int
+			n, err = update.Save(ctx)
+			if err != nil {
+				return 0, 0, ge.Join(err, tx.Rollback())
+			}
+			if n > 0 {
+				updated++
+				continue
+			}
+		}
+		if err = txDB.InsertInvoice(ctx, inv, true); err != nil {
+			return 0, 0, ge.Join(ge.Wrap(ge.Detail(ge.New("failed to insert invoice"), ge.D{"invoice": inv.ID}), err), tx.Rollback())
+		}
+		inserted++
+	}
+
+	return inserted, updated, tx.Commit()
+}
+
+// AllocateWalletIndex takes the next child index of a hd wallet account
+func (db *DB) AllocateWalletIndex(ctx context.Context, account string) (int64, error) {
+	for range 3 {
+		found, err := db.client.WalletAccount.UpdateOneID(account).AddNextIndex(1).Save(ctx)
+		if err == nil {
+			return found.NextIndex - 1, nil
+		}
+		if !database.IsNotFound(err) {
+			return 0, err
+		}
+		next := int64(0)
+		top, err := db.client.Invoice.Query().
+			Where(invoice.WalletIndexNotNil()).
+			Order(invoice.ByWalletIndex(sql.OrderDesc())).
+			Select(invoice.FieldWalletIndex).
+			First(ctx)
+		if err == nil {
+			next = *top.WalletIndex + 1
+		} else if !database.IsNotFound(err) {
+			return 0, err
+		}
+		err = db.client.WalletAccount.Create().SetID(account).SetNextIndex(next + 1).Exec(ctx)
+		if err == nil {
+			return next, nil
+		}
+		if !database.IsConstraintError(err) {
+			return 0, err
+		}
+	}
+	return 0, ge.New("wallet index allocation kept racing")
+}
+
+// ReserveWalletIndex moves the next index of a hd wallet account past a restored one
+func (db *DB) ReserveWalletIndex(ctx context.Context, account string, index int64) error {
+	return db.client.WalletAccount.Update().
+		Where(walletaccount.ID(account), walletaccount.NextIndexLTE(index)).
+		SetNextIndex(index + 1).
+		Exec(ctx)
+}
+
+type InvoiceSalt struct {
+	ID            string
+	EncryptedSalt []byte
+}
+
+func (db *DB) CountInvoices(ctx context.Context) (int, error) {
+	return db.client.Invoice.Query().Count(ctx)
+}
+
+// ListInvoiceSalts returns up to limit invoice salts ordered by id and starting after afterID
+func (db *DB) ListInvoiceSalts(ctx context.Context, afterID string, limit int) ([]InvoiceSalt, error) {
+	found, err := db.client.Invoice.Query().
+		Where(invoice.IDGT(afterID), invoice.EncryptedSaltNotNil()).
+		Order(invoice.ByID()).
+		Limit(limit).
+		Select(invoice.FieldID, invoice.FieldEncryptedSalt).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	salts := make([]InvoiceSalt, len(found))
+	for i, inv := range found {
+		salts[i] = InvoiceSalt{ID: inv.ID, EncryptedSalt: inv.EncryptedSalt}
+	}
+	return salts, nil
+}
+
+// ReplaceEncryptedSalts replaces the encrypted salts which did not change meanwhile
+func (db *DB) ReplaceEncryptedSalts(ctx context.Context, old, new []InvoiceSalt) error {
+	ge.Assert(len(old) == len(new))
+
+	tx, err := db.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range old {
+		ge.Assert(old[i].ID == new[i].ID)
+		n, err := tx.Invoice.Update().Where(
+			invoice.ID(old[i].ID),
+			invoice.EncryptedSalt(old[i].EncryptedSalt),
+		).SetEncryptedSalt(new[i].EncryptedSalt).Save(ctx)
+		if err == nil && n != 1 {
+			err = ge.Detail(ge.New("invoice salt changed concurrently"), ge.D{"invoice": old[i].ID})
+		}
+		if err != nil {
+			return ge.Join(err, tx.Rollback())
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListInvoicesWithoutSignerMAC returns the invoices no signer summed the terms of
+func (db *DB) ListInvoicesWithoutSignerMAC(ctx context.Context, afterID string, limit int) ([]*Invoice, error) {
+	found, err := db.client.Invoice.Query().
+		Where(invoice.IDGT(afterID), invoice.SignerMACIsNil()).
+		Order(invoice.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invs := make([]*Invoice, len(found))
+	for i := range found {
+		invs[i] = invoiceFromEnt(found[i])
+	}
+	return invs, nil
+}
+
+// SetInvoiceSignerMAC sets the signer mac of an invoice which has none
+func (db *DB) SetInvoiceSignerMAC(ctx context.Context, id string, mac []byte) error {
+	n, err := db.client.Invoice.Update().
+		Where(invoice.ID(id), invoice.SignerMACIsNil()).
+		SetSignerMAC(mac).
+		Save(ctx)
+	if err == nil && n != 1 {
+		err = ge.Detail(ge.New("invoice signer mac is set already"), ge.D{"invoice": id})
+	}
+	return err
+}
+
+func (db *DB) InsertInvoiceEvent(ctx context.Context, event InvoiceEvent) error {
+	return db.client.InvoiceEvent.Create().
+		SetInvoiceID(event.InvoiceID).
+		SetKind(event.Kind).
+		SetAsset(event.Asset).
+		SetTxHash(event.TxHash).
+		SetDetail(event.Detail).
+		Exec(ctx)
+}
+
+// ListInvoiceEvents returns the audit trail of an invoice oldest first
+func (db *DB) ListInvoiceEvents(ctx context.Context, invoiceID string) ([]InvoiceEvent, error) {
+	found, err := db.client.InvoiceEvent.Query().
+		Where(invoiceevent.InvoiceID(invoiceID)).
+		Order(invoiceevent.ByCreateAt(), invoiceevent.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]InvoiceEvent, len(found))
+	for i, event := range found {
+		events[i] = InvoiceEvent{
+			InvoiceID: event.InvoiceID,
+			Kind:      event.Kind,
+			Asset:     event.Asset,
+			TxHash:    event.TxHash,
+			Detail:    event.Detail,
+			CreateAt:  event.CreateAt,
+		}
+	}
+	return events, nil
+}
+
+// InsertTreasuryCredits reports false for a sweep credited already
+func (db *DB) InsertTreasuryCredits(ctx context.Context, credits []TreasuryCredit) (bool, error) {
+	tx, err := db.client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, credit := range credits {
+		if err = tx.TreasuryCredit.Create().
+			SetAsset(credit.Asset).
+			SetAccount(credit.Account).
+			SetInvoiceID(credit.InvoiceID).
+			SetTxHash(credit.TxHash).
+			SetAmount(credit.Amount).
+			Exec(ctx); err != nil {
+			if database.IsConstraintError(err) {
+				return false, tx.Rollback()
+			}
+			return false, ge.Join(err, tx.Rollback())
+		}
+	}
+	return true, tx.Commit()
+}
+
+// ListTreasuryCredits returns the unsettled credits of an asset oldest first
+func (db *DB) ListTreasuryCredits(ctx context.Context, asset, account string) ([]TreasuryCredit, error) {
+	where := []predicate.TreasuryCredit{treasurycredit.Asset(asset), treasurycredit.SettlementIDIsNil()}
+	if account != "" {
+		where = append(where, treasurycredit.Account(account))
+	}
+	found, err := db.client.TreasuryCredit.Query().
+		Where(where...).
+		Order(treasurycredit.ByCreateAt(), treasurycredit.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return treasuryCreditsFromEnt(found), nil
+}
+
+// ClaimTreasuryCredits returns the unsettled credits among ids it claimed for a settlement
+func (db *DB) ClaimTreasuryCredits(ctx context.Context, ids []int, settlementID string) ([]TreasuryCredit, error) {
+	if _, err := db.client.TreasuryCredit.Update().
+		Where(treasurycredit.IDIn(ids...), treasurycredit.SettlementIDIsNil()).
+		SetSettlementID(settlementID).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	found, err := db.client.TreasuryCredit.Query().Where(treasurycredit.SettlementID(settlementID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return treasuryCreditsFromEnt(found), nil
+}
+
+// ReleaseTreasuryCredits unclaims the credits of a failed settlement
+func (db *DB) ReleaseTreasuryCredits(ctx context.Context, settlementID string) error {
+	return db.client.TreasuryCredit.Update().
+		Where(treasurycredit.SettlementID(settlementID)).
+		ClearSettlementID().
+		Exec(ctx)
+}
+
+func treasuryCreditsFromEnt(found []*database.TreasuryCredit) []TreasuryCredit {
+	credits := make([]TreasuryCredit, len(found))
+	for i, credit := range found {
+		credits[i] = TreasuryCredit{
+			ID:        credit.ID,
+			Asset:     credit.Asset,
+			Account:   credit.Account,
+			InvoiceID: credit.InvoiceID,
+			TxHash:    credit.TxHash,
+			Amount:    credit.Amount,
+			CreateAt:  credit.CreateAt,
+		}
+	}
+	return credits
+}
+
+func (db *DB) InsertTreasuryTransfer(ctx context.Context, transfer TreasuryTransfer) error {
+	return db.client.TreasuryTransfer.Create().
+		SetID(transfer.ID).
+		SetAsset(transfer.Asset).
+		SetKind(string(transfer.Kind)).
+		SetTo(transfer.To).
+		SetAmount(transfer.Amount).
+		SetCreateAt(transfer.CreateAt).
+		Exec(ctx)
+}
+
+// SetTreasuryTransferSigned records the tx of a transfer before it is sent
+func (db *DB) SetTreasuryTransferSigned(ctx context.Context, id string, fee *big.Int, txHash string) error {
+	return db.client.TreasuryTransfer.UpdateOneID(id).
+		SetFee(fee).
+		SetTxHash(txHash).
+		Exec(ctx)
+}
+
+func (db *DB) SetTreasuryTransferSent(ctx context.Context, id string) error {
+	return db.client.TreasuryTransfer.UpdateOneID(id).
+		SetSendAt(time.Now()).
+		SetError("").
+		Exec(ctx)
+}
+
+func (db *DB) SetTreasuryTransferError(ctx context.Context, id, message string) error {
+	return db.client.TreasuryTransfer.UpdateOneID(id).SetError(message).Exec(ctx)
+}
+
+// ListTreasuryTransfers returns up to limit transfers to an address newest first
+func (db *DB) ListTreasuryTransfers(ctx context.Context, asset, to string, limit int) ([]TreasuryTransfer, error) {
+	found, err := db.client.TreasuryTransfer.Query().
+		Where(treasurytransfer.Asset(asset), treasurytransfer.To(to)).
+		Order(treasurytransfer.ByCreateAt(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return treasuryTransfersFromEnt(found), nil
+}
+
+// ListUnsentTreasuryTransfers returns the signed transfers not known to be sent
+func (db *DB) ListUnsentTreasuryTransfers(ctx context.Context, asset string) ([]TreasuryTransfer, error) {
+	found, err := db.client.TreasuryTransfer.Query().
+		Where(treasurytransfer.Asset(asset), treasurytransfer.TxHashNEQ(""), treasurytransfer.SendAtIsNil()).
+		Order(treasurytransfer.ByCreateAt()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return treasuryTransfersFromEnt(found), nil
+}
+
+func treasuryTransfersFromEnt(found []*database.TreasuryTransfer) []TreasuryTransfer {
+	transfers := make([]TreasuryTransfer, len(found))
+	for i, transfer := range found {
+		transfers[i] = TreasuryTransfer{
+			ID:       transfer.ID,
+			Asset:    transfer.Asset,
+			Kind:     TreasuryTransferKind(transfer.Kind),
+			To:       transfer.To,
+			Amount:   transfer.Amount,
+			TxHash:   transfer.TxHash,
+			Error:    transfer.Error,
+			CreateAt: transfer.CreateAt,
+			SendAt:   transfer.SendAt,
+		}
+		if transfer.TxHash != "" {
+			transfers[i].Fee = transfer.Fee
+		}
+	}
+	return transfers
+}
+
+func (db *DB) InsertPayout(ctx context.Context, payout Payout) error {
+	create := db.client.Payout.Create().
+		SetID(payout.ID).
+		SetIdempotencyKey(payout.IdempotencyKey).
+		SetAsset(payout.Asset).
+		SetTo(payout.To).
+		SetAmount(payout.Amount).
+		SetStatus(string(payout.Status)).
+		SetCreateAt(payout.CreateAt)
+	if payout.Reference != "" {
+		create.SetReference(payout.Reference)
+	}
+	return create.Exec(ctx)
+}
+
+// InsertPayoutWithinLimit reports false for a payout over the daily limit, the day row lock serializes the check
+func (db *DB) InsertPayoutWithinLimit(ctx context.Context, p Payout, day time.Time, limit *big.Int) (bool, error) {
+	dayID := p.Asset + ":" + day.Format(time.DateOnly)
+	if err := db.client.PayoutDay.Create().SetID(dayID).SetPayouts(0).Exec(ctx); err != nil && !database.IsConstraintError(err) {
+		return false, err
+	}
+
+	tx, err := db.client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	txDB := &DB{client: tx.Client()}
+
+	if err = tx.PayoutDay.Update().Where(payoutday.ID(dayID)).AddPayouts(1).Exec(ctx); err != nil {
+		return false, ge.Join(err, tx.Rollback())
+	}
+	spent, err := txDB.SumPayouts(ctx, p.Asset, day)
+	if err != nil {
+		return false, ge.Join(err, tx.Rollback())
+	}
+	if spent.Add(spent, p.Amount).Cmp(limit) > 0 {
+		return false, tx.Rollback()
+	}
+	if err = txDB.InsertPayout(ctx, p); err != nil {
+		return false, ge.Join(err, tx.Rollback())
+	}
+	return true, tx.Commit()
+}
+
+// SetPayoutSigned records the tx of a payout before it is sent
+func (db *DB) SetPayoutSigned(ctx context.Context, id string, fee *big.Int, txHash string) error {
+	return db.client.Payout.UpdateOneID(id).
+		SetFee(fee).
+		SetTxHash(txHash).
+		Exec(ctx)
+}
+
+func (db *DB) SetPayoutSent(ctx context.Context, id string) error {
+	return db.client.Payout.UpdateOneID(id).
+		SetStatus(string(PayoutSent)).
+		SetSendAt(time.Now()).
+		SetError("").
+		Exec(ctx)
+}
+
+// SetPayoutError records why the send of a signed payout failed
+func (db *DB) SetPayoutError(ctx context.Context, id, message string) error {
+	return db.client.Payout.UpdateOneID(id).SetError(message).Exec(ctx)
+}
+
+func (db *DB) SetPayoutConfirmed(ctx context.Context, id string) error {
+	return db.client.Payout.UpdateOneID(id).SetStatus(string(PayoutConfirmed)).SetConfirmAt(time.Now()).Exec(ctx)
+}
+
+func (db *DB) SetPayoutFailed(ctx context.Context, id, message string) error {
+	return db.client.Payout.UpdateOneID(id).SetStatus(string(PayoutFailed)).SetError(message).Exec(ctx)
+}
+
+func (db *DB) SetPayoutNotified(ctx context.Context, id string, status PayoutStatus) error {
+	return db.client.Payout.UpdateOneID(id).SetNotifiedStatus(string(status)).Exec(ctx)
+}
+
+// GetPayout returns nil for an unknown payout
+func (db *DB) GetPayout(ctx context.Context, id string) (*Payout, error) {
+	found, err := db.client.Payout.Get(ctx, id)
+	if err != nil {
+		if database.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return fak.Ptr(payoutFromEnt(found)), nil
+}
+
+// GetPayoutByIdempotencyKey returns nil when no payout was created with the key
+func (db *DB) GetPayoutByIdempotencyKey(ctx context.Context, key string) (*Payout, error) {
+	found, err := db.client.Payout.Query().Where(payout.IdempotencyKey(key)).Only(ctx)
+	if err != nil {
+		if database.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
 	}
+	return fak.Ptr(payoutFromEnt(found)), nil
+}
 
-	return inv, nil
+// SumPayouts sums the payouts of an asset since the given time which did not fail
+func (db *DB) SumPayouts(ctx context.Context, asset string, since time.Time) (*big.Int, error) {
+	found, err := db.client.Payout.Query().
+		Where(payout.Asset(asset), payout.CreateAtGTE(since), payout.StatusNEQ(string(PayoutFailed))).
+		Select(payout.FieldAmount).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sum := new(big.Int)
+	for _, p := range found {
+		sum.Add(sum, p.Amount)
+	}
+	return sum, nil
+}
+
+// ListPayouts returns up to limit payouts newest first, empty filters match any
+func (db *DB) ListPayouts(ctx context.Context, asset string, status PayoutStatus, before time.Time, limit int) ([]Payout, error) {
+	var where []predicate.Payout
+	if asset != "" {
+		where = append(where, payout.Asset(asset))
+	}
+	if status != "" {
+		where = append(where, payout.Status(string(status)))
+	}
+	if !before.IsZero() {
+		where = append(where, payout.CreateAtLT(before))
+	}
+	found, err := db.client.Payout.Query().
+		Where(where...).
+		Order(payout.ByCreateAt(sql.OrderDesc()), payout.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return payoutsFromEnt(found), nil
+}
+
+// ListPayoutsToTrack returns the sent or signed payouts and, with notified, the undelivered ones
+func (db *DB) ListPayoutsToTrack(ctx context.Context, notified bool) ([]Payout, error) {
+	where := payout.Or(payout.Status(string(PayoutSent)), payout.And(payout.Status(string(PayoutPending)), payout.TxHashNEQ("")))
+	if notified {
+		where = payout.Or(where, payout.And(payout.StatusNEQ(string(PayoutPending)), func(s *sql.Selector) {
+			s.Where(sql.ColumnsNEQ(s.C(payout.FieldStatus), s.C(payout.FieldNotifiedStatus)))
+		}))
+	}
+	found, err := db.client.Payout.Query().
+		Where(where).
+		Order(payout.ByCreateAt()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return payoutsFromEnt(found), nil
+}
+
+func payoutsFromEnt(found []*database.Payout) []Payout {
+	payouts := make([]Payout, len(found))
+	for i, p := range found {
+		payouts[i] = payoutFromEnt(p)
+	}
+	return payouts
+}
+
+func payoutFromEnt(found *database.Payout) Payout {
+	p := Payout{
+		ID:             found.ID,
+		IdempotencyKey: found.IdempotencyKey,
+		Asset:          found.Asset,
+		To:             found.To,
+		Amount:         found.Amount,
+		Reference:      found.Reference,
+		Status:         PayoutStatus(found.Status),
+		TxHash:         found.TxHash,
+		Error:          found.Error,
+		CreateAt:       found.CreateAt,
+		SendAt:         found.SendAt,
+		ConfirmAt:      found.ConfirmAt,
+		notifiedStatus: PayoutStatus(found.NotifiedStatus),
+	}
+	if found.TxHash != "" {
+		p.Fee = found.Fee
+	}
+	return p
+}
+
+// PostLedgerJournal reports false for a journal posted already
+func (db *DB) PostLedgerJournal(ctx context.Context, lines []LedgerEntry) (bool, error) {
+	for _, line := range lines {
+		for _, account := range []string{line.Debit, line.Credit} {
+			exists, err := db.client.LedgerAccount.Query().Where(ledgeraccount.Asset(line.Asset), ledgeraccount.Name(account)).Exist(ctx)
+			if err != nil {
+				return false, err
+			}
+			if exists {
+				continue
+			}
+			// a concurrent posting may create it first
+			if err = db.client.LedgerAccount.Create().
+				SetAsset(line.Asset).
+				SetName(account).
+				SetKind(string(ledgerAccountKind(account))).
+				Exec(ctx); err != nil && !database.IsConstraintError(err) {
+				return false, err
+			}
+		}
+	}
+	tx, err := db.client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, line := range lines {
+		create := tx.LedgerEntry.Create().
+			SetJournal(line.Journal).
+			SetLine(line.Line).
+			SetAsset(line.Asset).
+			SetKind(line.Kind).
+			SetDebit(line.Debit).
+			SetCredit(line.Credit).
+			SetAmount(line.Amount).
+			SetCreateAt(line.CreateAt)
+		if line.InvoiceID != "" {
+			create.SetInvoiceID(line.InvoiceID)
+		}
+		if line.TxHash != "" {
+			create.SetTxHash(line.TxHash)
+		}
+		if err = create.Exec(ctx); err != nil {
+			if database.IsConstraintError(err) {
+				return false, tx.Rollback()
+			}
+			return false, ge.Join(err, tx.Rollback())
+		}
+	}
+	return true, tx.Commit()
+}
+
+// LedgerAccountTotals sums the debits and the credits of an account
+func (db *DB) LedgerAccountTotals(ctx context.Context, asset, account string) (debits, credits *big.Int, err error) {
+	found, err := db.client.LedgerEntry.Query().
+		Where(ledgerentry.Asset(asset), ledgerentry.Or(ledgerentry.Debit(account), ledgerentry.Credit(account))).
+		Select(ledgerentry.FieldDebit, ledgerentry.FieldAmount).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	debits, credits = new(big.Int), new(big.Int)
+	for _, entry := range found {
+		if entry.Debit == account {
+			debits.Add(debits, entry.Amount)
+		} else {
+			credits.Add(credits, entry.Amount)
+		}
+	}
+	return debits, credits, nil
+}
+
+// ListLedgerAccounts returns the accounts of an asset by name
+func (db *DB) ListLedgerAccounts(ctx context.Context, asset string) ([]*database.LedgerAccount, error) {
+	return db.client.LedgerAccount.Query().
+		Where(ledgeraccount.Asset(asset)).
+		Order(ledgeraccount.ByName()).
+		All(ctx)
+}
+
+// ListLedgerEntries returns up to limit entries after the given id, created in [since, until)
+func (db *DB) ListLedgerEntries(ctx context.Context, asset string, afterID int, since, until time.Time, limit int) ([]LedgerEntry, error) {
+	where := []predicate.LedgerEntry{ledgerentry.Asset(asset), ledgerentry.IDGT(afterID)}
+	if !since.IsZero() {
+		where = append(where, ledgerentry.CreateAtGTE(since))
+	}
+	if !until.IsZero() {
+		where = append(where, ledgerentry.CreateAtLT(until))
+	}
+	found, err := db.client.LedgerEntry.Query().
+		Where(where...).
+		Order(ledgerentry.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]LedgerEntry, len(found))
+	for i, entry := range found {
+		entries[i] = LedgerEntry{
+			ID:        entry.ID,
+			Journal:   entry.Journal,
+			Line:      entry.Line,
+			Asset:     entry.Asset,
+			Kind:      entry.Kind,
+			Debit:     entry.Debit,
+			Credit:    entry.Credit,
+			Amount:    entry.Amount,
+			InvoiceID: entry.InvoiceID,
+			TxHash:    entry.TxHash,
+			CreateAt:  entry.CreateAt,
+		}
+	}
+	return entries, nil
+}
+
+// SumLedger sums the debits and the credits of every account up to the given time
+func (db *DB) SumLedger(ctx context.Context, asset string, at time.Time) (debits, credits map[string]*big.Int, err error) {
+	found, err := db.client.LedgerEntry.Query().
+		Where(ledgerentry.Asset(asset), ledgerentry.CreateAtLTE(at)).
+		Select(ledgerentry.FieldDebit, ledgerentry.FieldCredit, ledgerentry.FieldAmount).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	debits, credits = map[string]*big.Int{}, map[string]*big.Int{}
+	add := func(sums map[string]*big.Int, account string, amount *big.Int) {
+		if sums[account] == nil {
+			sums[account] = new(big.Int)
+		}
+		sums[account].Add(sums[account], amount)
+	}
+	for _, entry := range found {
+		add(debits, entry.Debit, entry.Amount)
+		add(credits, entry.Credit, entry.Amount)
+	}
+	return debits, credits, nil
 }
@@ -0,0 +1,118 @@
+package cpg
+
+import (
+	"context"
+	"cpg/pkg/crypto"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"time"
+)
+
+// ArbiterKeyHeader carries the key of the arbiter calling the escrow rpcs
+const ArbiterKeyHeader = "x-arbiter-key"
+
+// ErrEscrowHeld is returned for moving the funds of a held escrow invoice
+var ErrEscrowHeld = ge.New("invoice is held in escrow")
+
+// MaxEscrowPeriod bounds the auto-release period of escrow invoices
+const MaxEscrowPeriod = time.Hour * 24 * 365
+
+type EscrowActionParams struct {
+	InvoiceID  string
+	ArbiterKey string
+	// BuyerKey is the key CreateInvoice returned for the buyer, it releases and disputes without an arbiter key
+	BuyerKey string
+	Reason   string
+}
+
+// newBuyerKey returns a random buyer key and the hash an escrow invoice keeps of it
+func newBuyerKey() (string, []byte) {
+	key := hex.EncodeToString(crypto.ReadN(nil, 32))
+	hash := sha256.Sum256([]byte(key))
+	return key, hash[:]
+}
+
+// buyerKeyMatches reports whether key is the buyer key of the invoice
+func (inv *Invoice) buyerKeyMatches(key string) bool {
+	if key == "" || len(inv.BuyerKeyHash) == 0 {
+		return false
+	}
+	hash := sha256.Sum256([]byte(key))
+	return subtle.ConstantTimeCompare(hash[:], inv.BuyerKeyHash) == 1
+}
+
+// UseArbiters sets the named keys allowed to act on escrow invoices
+func (cpg *CPG) UseArbiters(arbiters Approvers) {
+	cpg.arbiters = arbiters
+}
+
+// ReleaseEscrow releases a held or disputed escrow invoice to its recipient, by an arbiter or its buyer
+func (cpg *CPG) ReleaseEscrow(ctx context.Context, params EscrowActionParams) error {
+	return cpg.escrowAction(ctx, params, InvoiceEventEscrowRelease, true, cpg.db.SetInvoiceReleaseAt, InvoiceStatusHeld, InvoiceStatusDisputed)
+}
+
+// RefundEscrow refunds a held or disputed escrow invoice to its beneficiary, only by an arbiter
+func (cpg *CPG) RefundEscrow(ctx context.Context, params EscrowActionParams) error {
+	return cpg.escrowAction(ctx, params, InvoiceEventEscrowRefund, false, cpg.db.SetInvoiceRefundAt, InvoiceStatusHeld, InvoiceStatusDisputed)
+}
+
+// DisputeEscrow stops the auto-release of a held escrow invoice, by an arbiter or its buyer
+func (cpg *CPG) DisputeEscrow(ctx context.Context, params EscrowActionParams) error {
+	return cpg.escrowAction(ctx, params, InvoiceEventEscrowDispute, true, cpg.db.SetInvoiceDisputeAt, InvoiceStatusHeld)
+}
+
+func (cpg *CPG) escrowAction(ctx context.Context, params EscrowActionParams, kind string, byBuyer bool, set func(ctx context.Context, id string) error, from ...InvoiceStatus) error {
+
+	actor, role := "buyer", "buyer"
+	arbiter, ok := cpg.arbiters.lookup(params.ArbiterKey)
+	switch {
+	case ok:
+		actor, role = arbiter, "arbiter"
+	case !byBuyer || params.BuyerKey == "":
+		return ge.New("unknown arbiter key")
+	}
+
+	inv, err := cpg.db.GetInvoice(ctx, params.InvoiceID, "", false)
+	if err != nil {
+		return ge.Wrap(ge.New("failed to get invoice"), err)
+	}
+	if inv == nil {
+		return ge.New("invoice not found")
+	}
+	if role == "buyer" && !inv.buyerKeyMatches(params.BuyerKey) {
+		return ge.New("unknown buyer key")
+	}
+
+	invoiceStatus := inv.Status()
+	allowed := false
+	for _, status := range from {
+		allowed = allowed || invoiceStatus == status
+	}
+	if !allowed {
+		return ge.Detail(ge.New("invoice escrow status does not allow the action"), ge.D{"invoiceStatus": invoiceStatus, "action": kind})
+	}
+
+	if err = set(ctx, inv.ID); err != nil {
+		return ge.Wrap(ge.New("failed to update invoice escrow"), err)
+	}
+
+	if err = cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+		InvoiceID: inv.ID,
+		Kind:      kind,
+		Asset:     inv.Asset,
+		Detail: map[string]string{
+			"actor":  actor,
+			"role":   role,
+			"reason": params.Reason,
+			"status": statusName(invoiceStatus),
+		},
+	}); err != nil {
+		slog.Error("failed to record escrow action", slog.String("invoice", inv.ID), slog.String("action", kind), slog.String("error", err.Error()))
+	}
+
+	slog.Info("escrow action", slog.String("invoice", inv.ID), slog.String("action", kind), slog.String("actor", actor), slog.String("role", role))
+	return nil
+}
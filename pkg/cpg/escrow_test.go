@@ -0,0 +1,108 @@
+package cpg
+
+import (
+	"testing"
+	"time"
+)
+
+func testEscrowInvoice(period time.Duration, filled time.Duration) *Invoice {
+	inv := &Invoice{Recipient: "0xRecipient", Beneficiary: "0xBeneficiary", Deadline: time.Now().Add(time.Hour), EscrowPeriod: period}
+	if filled > 0 {
+		fillAt := time.Now().Add(-filled)
+		inv.FillAt = &fillAt
+	}
+	return inv
+}
+
+func TestEscrowStatus(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		name   string
+		inv    *Invoice
+		set    func(inv *Invoice)
+		status InvoiceStatus
+		payee  string
+	}{
+		{"no escrow", testEscrowInvoice(0, time.Minute), nil, InvoiceStatusFilled, "0xRecipient"},
+		{"held", testEscrowInvoice(time.Hour, time.Minute), nil, InvoiceStatusHeld, ""},
+		{"auto released", testEscrowInvoice(time.Hour, 2*time.Hour), nil, InvoiceStatusReleased, "0xRecipient"},
+		{"disputed", testEscrowInvoice(time.Hour, time.Minute), func(inv *Invoice) { inv.DisputeAt = &now }, InvoiceStatusDisputed, ""},
+		{"disputed past the period", testEscrowInvoice(time.Hour, 2*time.Hour), func(inv *Invoice) { inv.DisputeAt = &now }, InvoiceStatusDisputed, ""},
+		{"released", testEscrowInvoice(time.Hour, time.Minute), func(inv *Invoice) { inv.ReleaseAt = &now }, InvoiceStatusReleased, "0xRecipient"},
+		{"released after dispute", testEscrowInvoice(time.Hour, time.Minute), func(inv *Invoice) { inv.DisputeAt, inv.ReleaseAt = &now, &now }, InvoiceStatusReleased, "0xRecipient"},
+		{"refunded", testEscrowInvoice(time.Hour, 2*time.Hour), func(inv *Invoice) { inv.RefundAt = &now }, InvoiceStatusRefunded, "0xBeneficiary"},
+		{"released and refunded", testEscrowInvoice(time.Hour, time.Minute), func(inv *Invoice) { inv.ReleaseAt, inv.RefundAt = &now, &now }, InvoiceStatusInvalid, "0xBeneficiary"},
+		{"pending", testEscrowInvoice(time.Hour, 0), nil, InvoiceStatusPending, "0xRecipient"},
+		{"expired", &Invoice{Recipient: "0xRecipient", Beneficiary: "0xBeneficiary", Deadline: now.Add(-time.Hour), EscrowPeriod: time.Hour}, nil, InvoiceStatusExpired, "0xBeneficiary"},
+		{"checked out", testEscrowInvoice(time.Hour, time.Minute), func(inv *Invoice) { inv.ReleaseAt, inv.LastCheckoutAt = &now, &now }, InvoiceStatusCheckout, "0xRecipient"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.set != nil {
+				tc.set(tc.inv)
+			}
+			if status := tc.inv.Status(); status != tc.status {
+				t.Fatalf("status %s, not %s", status, tc.status)
+			}
+			if payee := tc.inv.payee(); payee != tc.payee {
+				t.Fatalf("payee %q, not %q", payee, tc.payee)
+			}
+		})
+	}
+}
+
+func TestEscrowPayeeOfInvalidInvoice(t *testing.T) {
+	now := time.Now()
+	inv := testEscrowInvoice(0, time.Minute)
+	inv.CancelAt = &now
+	defer func() {
+		if recover() == nil {
+			t.Fatal("payee of an invalid invoice did not panic")
+		}
+	}()
+	inv.payee()
+}
+
+func TestEscrowAutoReleaseAt(t *testing.T) {
+	fillAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name     string
+		period   time.Duration
+		fillAt   *time.Time
+		releases bool
+	}{
+		{"no escrow", 0, &fillAt, false},
+		{"not filled", time.Hour, nil, false},
+		{"filled", time.Hour, &fillAt, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := &Invoice{EscrowPeriod: tc.period, FillAt: tc.fillAt}
+			got := inv.AutoReleaseAt()
+			if (got != nil) != tc.releases || tc.releases && !got.Equal(fillAt.Add(tc.period)) {
+				t.Fatalf("auto release at %v", got)
+			}
+		})
+	}
+}
+
+func TestEscrowBuyerKey(t *testing.T) {
+	key, hash := newBuyerKey()
+	other, _ := newBuyerKey()
+	inv := &Invoice{BuyerKeyHash: hash}
+	for _, tc := range []struct {
+		name string
+		inv  *Invoice
+		key  string
+		ok   bool
+	}{
+		{"buyer key", inv, key, true},
+		{"other key", inv, other, false},
+		{"no key", inv, "", false},
+		{"no buyer", &Invoice{}, key, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if ok := tc.inv.buyerKeyMatches(tc.key); ok != tc.ok {
+				t.Fatalf("buyer key matches %v", ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package cpg
+
+import (
+	"time"
+)
+
+// invoice event kinds of the audit trail
+const (
+	InvoiceEventCrossChainRecovery = "cross_chain_recovery"
+	InvoiceEventTokenRescue        = "token_rescue"
+	InvoiceEventSweepBundle        = "sweep_bundle"
+	InvoiceEventColdSweep          = "cold_sweep"
+	InvoiceEventApprovalRequest    = "approval_request"
+	InvoiceEventCheckoutApproval   = "checkout_approval"
+	InvoiceEventCheckoutRejection  = "checkout_rejection"
+	InvoiceEventEscrowRelease      = "escrow_release"
+	InvoiceEventEscrowRefund       = "escrow_refund"
+	InvoiceEventEscrowDispute      = "escrow_dispute"
+	InvoiceEventFeeTransfer        = "fee_transfer"
+	InvoiceEventSplitTransfer      = "split_transfer"
+	InvoiceEventTreasuryCredit     = "treasury_credit"
+)
+
+type InvoiceEvent struct {
+	InvoiceID string
+	Kind      string
+	Asset     string
+	TxHash    string
+	Detail    map[string]string
+	CreateAt  time.Time
+}
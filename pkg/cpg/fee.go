@@ -0,0 +1,134 @@
+package cpg
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"math/big"
+	"strings"
+)
+
+// MaxFeeBasisPoints is a fee of the whole payment
+const MaxFeeBasisPoints = 10000
+
+// PlatformFee is the platform cut of a payment in basis points plus a flat amount
+type PlatformFee struct {
+	Address     string   `json:"address"`
+	BasisPoints int64    `json:"basis_points"`
+	Flat        *big.Int `json:"flat,omitempty"`
+}
+
+func (fee PlatformFee) zero() bool {
+	return fee.BasisPoints == 0 && (fee.Flat == nil || fee.Flat.Sign() == 0)
+}
+
+func (fee PlatformFee) validate() error {
+	if fee.BasisPoints < 0 || fee.BasisPoints > MaxFeeBasisPoints {
+		return ge.Detail(ge.New("fee basis points must be between 0 and 10000"), ge.D{"basis_points": fee.BasisPoints})
+	}
+	if fee.Flat != nil && fee.Flat.Sign() < 0 {
+		return ge.New("flat fee must not be negative")
+	}
+	if fee.Address == "" && !fee.zero() {
+		return ge.New("fee needs an address")
+	}
+	return nil
+}
+
+// AssetFee is the fee of the invoices of an asset, Merchants override it by recipient address
+type AssetFee struct {
+	PlatformFee
+	Merchants map[string]PlatformFee `json:"merchants"`
+}
+
+// FeePolicy maps asset names to their fee
+type FeePolicy map[string]AssetFee
+
+func ParseFeePolicy(data []byte) (FeePolicy, error) {
+	policy := FeePolicy{}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, err
+	}
+	for asset, assetFee := range policy {
+		if err := assetFee.validate(); err != nil {
+			return nil, ge.Wrap(ge.Detail(ge.New("invalid asset fee"), ge.D{"asset": asset}), err)
+		}
+		merchants := make(map[string]PlatformFee, len(assetFee.Merchants))
+		for recipient, fee := range assetFee.Merchants {
+			if fee.Address == "" {
+				fee.Address = assetFee.Address
+			}
+			if err := fee.validate(); err != nil {
+				return nil, ge.Wrap(ge.Detail(ge.New("invalid merchant fee"), ge.D{"asset": asset, "recipient": recipient}), err)
+			}
+			merchants[strings.ToLower(recipient)] = fee
+		}
+		assetFee.Merchants = merchants
+		policy[asset] = assetFee
+	}
+	return policy, nil
+}
+
+// terms returns the fee of a new invoice, nil when it takes none
+func (policy FeePolicy) terms(asset, recipient string) *PlatformFee {
+	assetFee, ok := policy[asset]
+	if !ok {
+		return nil
+	}
+	fee, ok := assetFee.Merchants[strings.ToLower(recipient)]
+	if !ok {
+		fee = assetFee.PlatformFee
+	}
+	if fee.zero() {
+		return nil
+	}
+	if fee.Flat != nil {
+		fee.Flat = new(big.Int).Set(fee.Flat)
+	}
+	return &fee
+}
+
+// UseFees fixes the platform fee of new invoices by the policy
+func (cpg *CPG) UseFees(policy FeePolicy) {
+	cpg.feePolicy = policy
+}
+
+// FeeLeg returns the fee leg of a sweep to the given address, nil unless the sweep pays the recipient an unpaid fee
+func (inv *Invoice) FeeLeg(to string, balance *big.Int) *big.Int {
+	if inv.Fee == nil || inv.FeeTxHash != "" || !strings.EqualFold(to, inv.Recipient) {
+		return nil
+	}
+	fee := new(big.Int).Mul(balance, big.NewInt(inv.Fee.BasisPoints))
+	fee.Quo(fee, big.NewInt(MaxFeeBasisPoints))
+	if inv.Fee.Flat != nil {
+		fee.Add(fee, inv.Fee.Flat)
+	}
+	if fee.Sign() <= 0 {
+		return nil
+	}
+	return fee
+}
+
+// recordFeeLeg stores the fee leg a sweep reported on the invoice
+func (cpg *CPG) recordFeeLeg(ctx context.Context, inv *Invoice, previousTxHash string) {
+	if inv.FeeTxHash == "" || inv.FeeTxHash == previousTxHash {
+		return
+	}
+	if err := cpg.db.SetInvoiceFeeLeg(ctx, inv.ID, inv.FeeAmount, inv.FeeTxHash); err != nil {
+		slog.Error("failed to record fee leg", slog.String("invoice", inv.ID), slog.String("tx", inv.FeeTxHash), slog.String("error", err.Error()))
+	}
+	if err := cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+		InvoiceID: inv.ID,
+		Kind:      InvoiceEventFeeTransfer,
+		Asset:     inv.Asset,
+		TxHash:    inv.FeeTxHash,
+		Detail: map[string]string{
+			"to":     inv.Fee.Address,
+			"amount": inv.FeeAmount.String(),
+		},
+	}); err != nil {
+		slog.Error("failed to record fee transfer", slog.String("invoice", inv.ID), slog.String("error", err.Error()))
+	}
+	slog.Info("fee leg sent", slog.String("invoice", inv.ID), slog.String("tx", inv.FeeTxHash), slog.String("amount", inv.FeeAmount.String()))
+}
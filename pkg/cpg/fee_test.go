@@ -0,0 +1,116 @@
+package cpg
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFeeLeg(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		fee     *PlatformFee
+		to      string
+		balance int64
+		sent    string
+		want    int64
+	}{
+		{"basis points", &PlatformFee{Address: "0xFee", BasisPoints: 250}, "0xRecipient", 10000, "", 250},
+		{"rounds down", &PlatformFee{Address: "0xFee", BasisPoints: 250}, "0xRecipient", 10039, "", 250},
+		{"under one unit", &PlatformFee{Address: "0xFee", BasisPoints: 1}, "0xRecipient", 9999, "", 0},
+		{"flat", &PlatformFee{Address: "0xFee", BasisPoints: 100, Flat: big.NewInt(7)}, "0xRecipient", 1000, "", 17},
+		{"flat only", &PlatformFee{Address: "0xFee", Flat: big.NewInt(7)}, "0xRecipient", 1000, "", 7},
+		{"recipient in another case", &PlatformFee{Address: "0xFee", BasisPoints: 100}, "0xRECIPIENT", 1000, "", 10},
+		{"no fee leg on refund", &PlatformFee{Address: "0xFee", BasisPoints: 100}, "0xBeneficiary", 1000, "", 0},
+		{"no fee leg to the treasury", &PlatformFee{Address: "0xFee", BasisPoints: 100}, "0xTreasury", 1000, "", 0},
+		{"fee leg sent", &PlatformFee{Address: "0xFee", BasisPoints: 100}, "0xRecipient", 1000, "0xf", 0},
+		{"no fee", nil, "0xRecipient", 1000, "", 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := &Invoice{Recipient: "0xRecipient", Beneficiary: "0xBeneficiary", TreasuryAddress: "0xTreasury", Fee: tc.fee, FeeTxHash: tc.sent}
+			fee := inv.FeeLeg(tc.to, big.NewInt(tc.balance))
+			switch {
+			case tc.want == 0 && fee != nil:
+				t.Fatalf("fee leg %s", fee)
+			case tc.want != 0 && (fee == nil || fee.Cmp(big.NewInt(tc.want)) != 0):
+				t.Fatalf("fee leg %v, not %d", fee, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlatformFeeValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		fee   PlatformFee
+		valid bool
+	}{
+		{"basis points", PlatformFee{Address: "0xFee", BasisPoints: 100}, true},
+		{"whole payment", PlatformFee{Address: "0xFee", BasisPoints: MaxFeeBasisPoints}, true},
+		{"flat", PlatformFee{Address: "0xFee", Flat: big.NewInt(1)}, true},
+		{"zero without address", PlatformFee{}, true},
+		{"negative basis points", PlatformFee{Address: "0xFee", BasisPoints: -1}, false},
+		{"over the whole payment", PlatformFee{Address: "0xFee", BasisPoints: MaxFeeBasisPoints + 1}, false},
+		{"negative flat", PlatformFee{Address: "0xFee", Flat: big.NewInt(-1)}, false},
+		{"no address", PlatformFee{BasisPoints: 100}, false},
+		{"flat without address", PlatformFee{Flat: big.NewInt(1)}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.fee.validate(); (err == nil) != tc.valid {
+				t.Fatalf("validate: %v", err)
+			}
+		})
+	}
+}
+
+func TestFeePolicyTerms(t *testing.T) {
+	policy, err := ParseFeePolicy([]byte(`{
+		"eth": {"address": "0xFee", "basis_points": 100, "flat": 5, "merchants": {
+			"0xMERCHANT": {"basis_points": 50},
+			"0xFree": {}
+		}},
+		"btc": {}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name      string
+		asset     string
+		recipient string
+		want      *PlatformFee
+	}{
+		{"asset fee", "eth", "0xOther", &PlatformFee{Address: "0xFee", BasisPoints: 100, Flat: big.NewInt(5)}},
+		{"merchant fee with the asset address", "eth", "0xmerchant", &PlatformFee{Address: "0xFee", BasisPoints: 50}},
+		{"merchant without fee", "eth", "0xFree", nil},
+		{"zero asset fee", "btc", "0xOther", nil},
+		{"asset without fee", "tron", "0xOther", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fee := policy.terms(tc.asset, tc.recipient)
+			switch {
+			case tc.want == nil && fee != nil:
+				t.Fatalf("fee %+v", fee)
+			case tc.want != nil && (fee == nil || fee.Address != tc.want.Address || fee.BasisPoints != tc.want.BasisPoints ||
+				(fee.Flat == nil) != (tc.want.Flat == nil) || fee.Flat != nil && fee.Flat.Cmp(tc.want.Flat) != 0):
+				t.Fatalf("fee %+v, not %+v", fee, tc.want)
+			}
+		})
+	}
+
+	// the terms of an invoice do not share the policy flat fee
+	policy.terms("eth", "0xOther").Flat.SetInt64(9)
+	if flat := policy.terms("eth", "0xOther").Flat; flat.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("policy flat fee changed to %s", flat)
+	}
+
+	for _, data := range []string{
+		`{"eth": {"basis_points": 100}}`,
+		`{"eth": {"address": "0xFee", "basis_points": 10001}}`,
+		`{"eth": {"address": "0xFee", "merchants": {"0xM": {"flat": -1}}}}`,
+		`{"eth": {"merchants": {"0xM": {"basis_points": 1}}}}`,
+	} {
+		if _, err = ParseFeePolicy([]byte(data)); err == nil {
+			t.Fatalf("parsed %s", data)
+		}
+	}
+}
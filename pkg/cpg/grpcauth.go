@@ -0,0 +1,117 @@
+package cpg
+
+import (
+	"context"
+	"cpg/pkg/proto"
+	"crypto/subtle"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const APIKeyHeader = "x-api-key"
+
+type apiKeys [][]byte
+
+// NewAPIKeyInterceptors returns grpc interceptors requiring one of the api keys in the x-api-key header
+func NewAPIKeyInterceptors(keys []string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	ak := make(apiKeys, 0, len(keys))
+	for _, key := range keys {
+		if key != "" {
+			ak = append(ak, []byte(key))
+		}
+	}
+	return ak.unary, ak.stream
+}
+
+func (ak apiKeys) authenticate(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, given := range md.Get(APIKeyHeader) {
+		for _, key := range ak {
+			if subtle.ConstantTimeCompare([]byte(given), key) == 1 {
+				return nil
+			}
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid api key")
+}
+
+func (ak apiKeys) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := ak.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (ak apiKeys) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := ak.authenticate(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+const AdminKeyHeader = "x-admin-key"
+
+// adminMethods are the rpcs marked admin only
+var adminMethods = map[string]bool{
+	proto.CPG_RecoverInvoices_FullMethodName:    true,
+	proto.CPG_RecoverCrossChain_FullMethodName:  true,
+	proto.CPG_RescueToken_FullMethodName:        true,
+	proto.CPG_ListInvoiceEvents_FullMethodName:  true,
+	proto.CPG_SubmitSweepBundle_FullMethodName:  true,
+	proto.CPG_RefundEscrow_FullMethodName:       true,
+	proto.CPG_GetMerchantBalance_FullMethodName: true,
+	proto.CPG_SettleTreasury_FullMethodName:     true,
+	proto.CPG_CreatePayout_FullMethodName:       true,
+	proto.CPG_GetPayout_FullMethodName:          true,
+	proto.CPG_ListPayouts_FullMethodName:        true,
+	proto.CPG_GetLedgerBalances_FullMethodName:  true,
+}
+
+type adminKeys struct {
+	keys apiKeys
+}
+
+// NewAdminKeyInterceptors returns grpc interceptors requiring one of the keys in the x-admin-key header for admin rpcs
+func NewAdminKeyInterceptors(keys []string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	admin := adminKeys{}
+	for _, key := range keys {
+		if key != "" {
+			admin.keys = append(admin.keys, []byte(key))
+		}
+	}
+	return admin.unary, admin.stream
+}
+
+func (admin adminKeys) authenticate(ctx context.Context, method string) error {
+	if !adminMethods[method] {
+		return nil
+	}
+	if len(admin.keys) == 0 {
+		return status.Error(codes.PermissionDenied, "admin rpcs are disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, given := range md.Get(AdminKeyHeader) {
+		for _, key := range admin.keys {
+			if subtle.ConstantTimeCompare([]byte(given), key) == 1 {
+				return nil
+			}
+		}
+	}
+	return status.Error(codes.PermissionDenied, "invalid admin key")
+}
+
+func (admin adminKeys) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := admin.authenticate(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (admin adminKeys) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := admin.authenticate(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
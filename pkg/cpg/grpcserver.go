@@ -2,14 +2,18 @@ package cpg
 
 import (
 	"context"
+	"cpg/pkg/backupcode"
 	"cpg/pkg/proto"
 	"cpg/pkg/ratelimit"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/itsabgr/ge"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"math/big"
 	"time"
 )
@@ -32,7 +36,10 @@ func NewGRPCServer(cpg *CPG, rateLimit *ratelimit.RateLimit) proto.CPGServer {
 
 	for name, info := range cpg.assets.Infos() {
 		serv.assets[name] = &proto.AssetInfo{
-			MinDelay: durationpb.New(info.MinDelay),
+			MinDelay:  durationpb.New(info.MinDelay),
+			Decimals:  uint32(info.Decimals),
+			Symbol:    info.Symbol,
+			WatchOnly: info.WatchOnly,
 		}
 	}
 
@@ -84,8 +91,86 @@ func (serv grpcServer) RecoverInvoice(ctx context.Context, input *proto.RecoverI
 
 }
 
+func (serv grpcServer) RecoverInvoices(stream grpc.BidiStreamingServer[proto.RecoverInvoiceInput, proto.RecoverInvoicesOutput]) error {
+
+	ctx := stream.Context()
+
+	for {
+		input, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		result, err := serv.cpg.RecoverAndCheckInvoice(ctx, RecoverInvoiceParams{
+			InvoiceID:     input.GetInvoiceId(),
+			InvoiceBackup: input.GetInvoiceBackup(),
+		})
+
+		output := &proto.RecoverInvoicesOutput{
+			InvoiceId:     input.GetInvoiceId(),
+			Outcome:       proto.RecoverOutcome(result.Outcome),
+			WalletAddress: result.WalletAddress,
+			Status:        invoiceStatus2proto(result.Status),
+		}
+		if err != nil {
+			output.Error = err.Error()
+		}
+
+		if err = stream.Send(output); err != nil {
+			return err
+		}
+	}
+
+}
+
+func (serv grpcServer) VerifyBackup(ctx context.Context, input *proto.VerifyBackupInput) (*proto.VerifyBackupOutput, error) {
+
+	cancel, err := serv.rateLimitRequest(&ctx, time.Second*2, input)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	if ok, err := serv.rateLimit.Limit(ctx, input.GetInvoiceId(), time.Second*5); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, status.Error(codes.ResourceExhausted, "busy")
+	}
+
+	result, err := serv.cpg.VerifyBackup(ctx, VerifyBackupParams{
+		InvoiceID:     input.GetInvoiceId(),
+		InvoiceBackup: input.GetInvoiceBackup(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.VerifyBackupOutput{
+		Format:         uint32(result.Header.Format),
+		KeyId:          result.Header.KeyID,
+		Asset:          result.Header.Asset,
+		CreateAt:       timestamppb.New(result.Header.CreateAt),
+		Deadline:       timestamppb.New(result.Deadline),
+		MinAmount:      result.MinAmount.String(),
+		Recipient:      result.Recipient,
+		Beneficiary:    result.Beneficiary,
+		WalletAddress:  result.WalletAddress,
+		AddressMatches: result.AddressMatches,
+		Exists:         result.Exists,
+	}, nil
+
+}
+
 func (serv grpcServer) CreateInvoice(ctx context.Context, input *proto.CreateInvoiceInput) (*proto.CreateInvoiceOutput, error) {
 
+	var split []SplitShare
+	for _, share := range input.GetSplit() {
+		split = append(split, SplitShare{Address: share.GetAddress(), BasisPoints: share.GetBasisPoints()})
+	}
+
 	result, err := serv.cpg.CreateInvoice(ctx, CreateInvoiceParams{
 		AssetName:    input.GetAssetName(),
 		Metadata:     input.GetMetadata(),
@@ -94,15 +179,27 @@ func (serv grpcServer) CreateInvoice(ctx context.Context, input *proto.CreateInv
 		AutoCheckout: input.GetAutoCheckout(),
 		MinAmount:    str2BigInt(input.GetMinAmount(), 10),
 		Deadline:     input.GetDeadline().AsTime(),
+		EscrowPeriod: input.GetEscrowPeriod().AsDuration(),
+		Split:        split,
+		SplitGasRule: SplitGasRule(input.GetSplitGasRule()),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &proto.CreateInvoiceOutput{
-		InvoiceId:     result.InvoiceID,
-		InvoiceBackup: result.InvoiceBackup,
-	}, nil
+	output := &proto.CreateInvoiceOutput{
+		InvoiceId:      result.InvoiceID,
+		InvoiceBackup:  result.InvoiceBackup,
+		EscrowBuyerKey: result.EscrowBuyerKey,
+	}
+	if input.GetBackupWords() {
+		output.InvoiceBackupWords = backupcode.EncodeWords(result.InvoiceBackup)
+	}
+	if input.GetBackupQr() {
+		output.InvoiceBackupQr = backupcode.EncodeQR(result.InvoiceBackup, backupcode.DefaultQRPartSize)
+	}
+
+	return output, nil
 
 }
 
@@ -154,7 +251,7 @@ func (serv grpcServer) GetInvoice(ctx context.Context, input *proto.GetInvoiceIn
 		return nil, err
 	}
 
-	return &proto.GetInvoiceOutput{
+	output := &proto.GetInvoiceOutput{
 		MinAmount:         result.MinAmount.Text(10),
 		Recipient:         result.Recipient,
 		Beneficiary:       result.Beneficiary,
@@ -168,8 +265,36 @@ func (serv grpcServer) GetInvoice(ctx context.Context, input *proto.GetInvoiceIn
 		LastCheckoutAt:    optionalTime2timestamp(result.LastCheckoutAt),
 		AutoCheckout:      result.AutoCheckout,
 		WalletAddress:     result.WalletAddress,
-		Status:            proto.InvoiceStatus(result.Status),
-	}, nil
+		Status:            invoiceStatus2proto(result.Status),
+		ApprovalRequestAt: optionalTime2timestamp(result.ApprovalRequestAt),
+		ApproveAt:         optionalTime2timestamp(result.ApproveAt),
+		EscrowPeriod:      durationpb.New(result.EscrowPeriod),
+		AutoReleaseAt:     optionalTime2timestamp(result.AutoReleaseAt),
+		ReleaseAt:         optionalTime2timestamp(result.ReleaseAt),
+		RefundAt:          optionalTime2timestamp(result.RefundAt),
+		DisputeAt:         optionalTime2timestamp(result.DisputeAt),
+		FeeTxHash:         result.FeeTxHash,
+		SplitGasRule:      string(result.SplitGasRule),
+		TreasuryAddress:   result.TreasuryAddress,
+	}
+	if result.Fee != nil {
+		output.FeeAddress = result.Fee.Address
+		output.FeeBasisPoints = result.Fee.BasisPoints
+		if result.Fee.Flat != nil {
+			output.FeeFlat = result.Fee.Flat.Text(10)
+		}
+	}
+	if result.FeeAmount != nil {
+		output.FeeAmount = result.FeeAmount.Text(10)
+	}
+	for _, share := range result.Split {
+		splitShare := &proto.SplitShare{Address: share.Address, BasisPoints: share.BasisPoints, TxHash: share.TxHash}
+		if share.Amount != nil {
+			splitShare.Amount = share.Amount.Text(10)
+		}
+		output.Split = append(output.Split, splitShare)
+	}
+	return output, nil
 
 }
 
@@ -192,12 +317,12 @@ func (serv grpcServer) CheckInvoice(ctx context.Context, input *proto.CheckInvoi
 	}
 
 	return &proto.CheckInvoiceOutput{
-		InvoiceStatus: proto.InvoiceStatus(result.InvoiceStatus),
+		InvoiceStatus: invoiceStatus2proto(result.InvoiceStatus),
 	}, nil
 
 }
 
-func (serv grpcServer) TryCheckoutInvoice(ctx context.Context, input *proto.TryCheckoutInvoiceInput) (*empty.Empty, error) {
+func (serv grpcServer) TryCheckoutInvoice(ctx context.Context, input *proto.TryCheckoutInvoiceInput) (*proto.TryCheckoutInvoiceOutput, error) {
 
 	cancel, err := serv.rateLimitRequest(&ctx, time.Second*10, input)
 	if err != nil {
@@ -205,7 +330,7 @@ func (serv grpcServer) TryCheckoutInvoice(ctx context.Context, input *proto.TryC
 	}
 	defer cancel()
 
-	err = serv.cpg.TryCheckoutInvoice(ctx, TryCheckoutInvoiceParams{
+	result, err := serv.cpg.TryCheckoutInvoice(ctx, TryCheckoutInvoiceParams{
 		InvoiceID: input.GetInvoiceId(),
 	})
 
@@ -213,8 +338,392 @@ func (serv grpcServer) TryCheckoutInvoice(ctx context.Context, input *proto.TryC
 		return nil, err
 	}
 
+	return &proto.TryCheckoutInvoiceOutput{SweepBundle: result.SweepBundle}, nil
+
+}
+
+func (serv grpcServer) SubmitSweepBundle(ctx context.Context, input *proto.SubmitSweepBundleInput) (*proto.SubmitSweepBundleOutput, error) {
+
+	cancel, err := serv.rateLimitRequest(&ctx, time.Second*10, input)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	result, err := serv.cpg.SubmitSweepBundle(ctx, SubmitSweepBundleParams{
+		InvoiceID: input.GetInvoiceId(),
+		Bundle:    input.GetBundle(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SubmitSweepBundleOutput{
+		InvoiceId: result.InvoiceID,
+		TxHash:    result.TxHash,
+	}, nil
+}
+
+// incomingKey returns the key the call carries in the header, empty without one
+func incomingKey(ctx context.Context, header string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get(header); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+func (serv grpcServer) ApproveCheckout(ctx context.Context, input *proto.ApproveCheckoutInput) (*proto.ApproveCheckoutOutput, error) {
+
+	cancel, err := serv.rateLimitRequest(&ctx, time.Second*5, input)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	result, err := serv.cpg.ApproveCheckout(ctx, ApproveCheckoutParams{
+		InvoiceID:   input.GetInvoiceId(),
+		ApproverKey: incomingKey(ctx, ApproverKeyHeader),
+		Note:        input.GetNote(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ApproveCheckoutOutput{
+		Approver:  result.Approver,
+		Approvals: int32(result.Approvals),
+		Required:  int32(result.Required),
+		Approved:  result.Approved,
+	}, nil
+}
+
+func (serv grpcServer) RejectCheckout(ctx context.Context, input *proto.RejectCheckoutInput) (*proto.RejectCheckoutOutput, error) {
+
+	cancel, err := serv.rateLimitRequest(&ctx, time.Second*5, input)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	approver, err := serv.cpg.RejectCheckout(ctx, RejectCheckoutParams{
+		InvoiceID:   input.GetInvoiceId(),
+		ApproverKey: incomingKey(ctx, ApproverKeyHeader),
+		Reason:      input.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RejectCheckoutOutput{Approver: approver}, nil
+}
+
+func (serv grpcServer) ReleaseEscrow(ctx context.Context, input *proto.EscrowActionInput) (*empty.Empty, error) {
+	return serv.escrowAction(ctx, input, serv.cpg.ReleaseEscrow)
+}
+
+func (serv grpcServer) RefundEscrow(ctx context.Context, input *proto.EscrowActionInput) (*empty.Empty, error) {
+	return serv.escrowAction(ctx, input, serv.cpg.RefundEscrow)
+}
+
+func (serv grpcServer) DisputeEscrow(ctx context.Context, input *proto.EscrowActionInput) (*empty.Empty, error) {
+	return serv.escrowAction(ctx, input, serv.cpg.DisputeEscrow)
+}
+
+func (serv grpcServer) escrowAction(ctx context.Context, input *proto.EscrowActionInput, action func(context.Context, EscrowActionParams) error) (*empty.Empty, error) {
+
+	cancel, err := serv.rateLimitRequest(&ctx, time.Second*2, input)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	err = action(ctx, EscrowActionParams{
+		InvoiceID:  input.GetInvoiceId(),
+		ArbiterKey: incomingKey(ctx, ArbiterKeyHeader),
+		BuyerKey:   input.GetBuyerKey(),
+		Reason:     input.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return nil, nil
+}
+
+func (serv grpcServer) GetMerchantBalance(ctx context.Context, input *proto.GetMerchantBalanceInput) (*proto.GetMerchantBalanceOutput, error) {
+
+	result, err := serv.cpg.GetMerchantBalance(ctx, GetMerchantBalanceParams{
+		Asset:   input.GetAsset(),
+		Account: input.GetAccount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetMerchantBalanceOutput{
+		Balance:        result.Balance.Text(10),
+		Credits:        int32(result.Credits),
+		OldestCreditAt: optionalTime2timestamp(result.OldestCreditAt),
+		Transfers:      treasuryTransfers2proto(result.Transfers),
+	}, nil
+}
+
+func (serv grpcServer) SettleTreasury(ctx context.Context, input *proto.SettleTreasuryInput) (*proto.SettleTreasuryOutput, error) {
+
+	result, err := serv.cpg.SettleTreasury(ctx, SettleTreasuryParams{
+		Asset: input.GetAsset(),
+		Force: input.GetForce(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SettleTreasuryOutput{Transfers: treasuryTransfers2proto(result.Transfers)}, nil
+}
+
+func treasuryTransfers2proto(transfers []TreasuryTransfer) []*proto.TreasuryTransfer {
+	output := make([]*proto.TreasuryTransfer, len(transfers))
+	for i, transfer := range transfers {
+		output[i] = &proto.TreasuryTransfer{
+			Id:       transfer.ID,
+			Kind:     string(transfer.Kind),
+			To:       transfer.To,
+			Amount:   transfer.Amount.Text(10),
+			TxHash:   transfer.TxHash,
+			Error:    transfer.Error,
+			CreateAt: timestamppb.New(transfer.CreateAt),
+			SendAt:   optionalTime2timestamp(transfer.SendAt),
+		}
+		if transfer.Fee != nil {
+			output[i].Fee = transfer.Fee.Text(10)
+		}
+	}
+	return output
+}
+
+func (serv grpcServer) CreatePayout(ctx context.Context, input *proto.CreatePayoutInput) (*proto.CreatePayoutOutput, error) {
+
+	result, err := serv.cpg.CreatePayout(ctx, CreatePayoutParams{
+		Asset:          input.GetAsset(),
+		To:             input.GetTo(),
+		Amount:         str2BigInt(input.GetAmount(), 10),
+		IdempotencyKey: input.GetIdempotencyKey(),
+		Reference:      input.GetReference(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreatePayoutOutput{Payout: payout2proto(result.Payout), Replayed: result.Replayed}, nil
+}
+
+func (serv grpcServer) GetPayout(ctx context.Context, input *proto.GetPayoutInput) (*proto.Payout, error) {
+
+	payout, err := serv.cpg.GetPayout(ctx, input.GetPayoutId())
+	if err != nil {
+		return nil, err
+	}
+
+	return payout2proto(*payout), nil
+}
+
+func (serv grpcServer) ListPayouts(ctx context.Context, input *proto.ListPayoutsInput) (*proto.ListPayoutsOutput, error) {
+
+	params := ListPayoutsParams{
+		Asset:  input.GetAsset(),
+		Status: PayoutStatus(input.GetStatus()),
+		Limit:  int(input.GetLimit()),
+	}
+	if input.Before != nil {
+		params.Before = input.GetBefore().AsTime()
+	}
+	payouts, err := serv.cpg.ListPayouts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &proto.ListPayoutsOutput{Payouts: make([]*proto.Payout, len(payouts))}
+	for i, payout := range payouts {
+		output.Payouts[i] = payout2proto(payout)
+	}
+	return output, nil
+}
 
+func payout2proto(payout Payout) *proto.Payout {
+	output := &proto.Payout{
+		Id:             payout.ID,
+		IdempotencyKey: payout.IdempotencyKey,
+		Asset:          payout.Asset,
+		To:             payout.To,
+		Amount:         payout.Amount.Text(10),
+		Reference:      payout.Reference,
+		Status:         string(payout.Status),
+		TxHash:         payout.TxHash,
+		Error:          payout.Error,
+		CreateAt:       timestamppb.New(payout.CreateAt),
+		SendAt:         optionalTime2timestamp(payout.SendAt),
+		ConfirmAt:      optionalTime2timestamp(payout.ConfirmAt),
+	}
+	if payout.Fee != nil {
+		output.Fee = payout.Fee.Text(10)
+	}
+	return output
+}
+
+func (serv grpcServer) GetLedgerBalances(ctx context.Context, input *proto.GetLedgerBalancesInput) (*proto.GetLedgerBalancesOutput, error) {
+
+	params := GetLedgerBalancesParams{
+		Asset:  input.GetAsset(),
+		Prefix: input.GetPrefix(),
+	}
+	if input.At != nil {
+		params.At = input.GetAt().AsTime()
+	}
+	balances, err := serv.cpg.GetLedgerBalances(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &proto.GetLedgerBalancesOutput{Balances: make([]*proto.LedgerBalance, len(balances))}
+	for i, balance := range balances {
+		output.Balances[i] = &proto.LedgerBalance{
+			Account: balance.Account,
+			Kind:    string(balance.Kind),
+			Debits:  balance.Debits.Text(10),
+			Credits: balance.Credits.Text(10),
+			Balance: balance.Balance.Text(10),
+		}
+	}
+	return output, nil
+}
+
+func (serv grpcServer) RecoverCrossChain(ctx context.Context, input *proto.RecoverCrossChainInput) (*proto.RecoverCrossChainOutput, error) {
+
+	cancel, err := serv.rateLimitRequest(&ctx, time.Second*30, input)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	sweeps, err := serv.cpg.RecoverCrossChain(ctx, RecoverCrossChainParams{
+		InvoiceID: input.GetInvoiceId(),
+		DryRun:    input.GetDryRun(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &proto.RecoverCrossChainOutput{}
+	for _, sweep := range sweeps {
+		output.Sweeps = append(output.Sweeps, assetSweep2proto(sweep.Asset, sweep.Destination, sweep.Sweep, sweep.Err))
+	}
+
+	return output, nil
+
+}
+
+func (serv grpcServer) RescueToken(ctx context.Context, input *proto.RescueTokenInput) (*proto.RescueTokenOutput, error) {
+
+	cancel, err := serv.rateLimitRequest(&ctx, time.Minute*2, input)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	result, err := serv.cpg.RescueToken(ctx, RescueTokenParams{
+		InvoiceID: input.GetInvoiceId(),
+		Token:     input.GetToken(),
+		To:        input.GetTo(),
+		FundGas:   input.GetFundGas(),
+		DryRun:    input.GetDryRun(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &proto.RescueTokenOutput{
+		TokenBalance:  result.TokenBalance.String(),
+		Fee:           result.Fee.String(),
+		FundingTxHash: result.FundingTxHash,
+		TxHash:        result.TxHash,
+	}
+	if result.FundingAmount != nil {
+		output.FundingAmount = result.FundingAmount.String()
+	}
+
+	return output, nil
+
+}
+
+func (serv grpcServer) ListInvoiceEvents(ctx context.Context, input *proto.ListInvoiceEventsInput) (*proto.ListInvoiceEventsOutput, error) {
+
+	events, err := serv.cpg.ListInvoiceEvents(ctx, input.GetInvoiceId())
+	if err != nil {
+		return nil, err
+	}
+
+	output := &proto.ListInvoiceEventsOutput{}
+	for _, event := range events {
+		output.Events = append(output.Events, &proto.InvoiceEvent{
+			Kind:     event.Kind,
+			Asset:    event.Asset,
+			TxHash:   event.TxHash,
+			Detail:   event.Detail,
+			CreateAt: timestamppb.New(event.CreateAt),
+		})
+	}
+
+	return output, nil
+
+}
+
+func assetSweep2proto(asset, destination string, sweep SweepResult, err error) *proto.AssetSweep {
+	out := &proto.AssetSweep{
+		Asset:       asset,
+		Destination: destination,
+		TxHash:      sweep.TxHash,
+	}
+	if sweep.Balance != nil {
+		out.Balance = sweep.Balance.String()
+	}
+	if sweep.Amount != nil {
+		out.Amount = sweep.Amount.String()
+	}
+	if sweep.Fee != nil {
+		out.Fee = sweep.Fee.String()
+	}
+	if err != nil {
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func invoiceStatus2proto(status InvoiceStatus) proto.InvoiceStatus {
+	switch status {
+	case InvoiceStatusPending:
+		return proto.InvoiceStatus_INVOICE_STATUS_PENDING
+	case InvoiceStatusFilled:
+		return proto.InvoiceStatus_INVOICE_STATUS_FILLED
+	case InvoiceStatusExpired:
+		return proto.InvoiceStatus_INVOICE_STATUS_EXPIRED
+	case InvoiceStatusCanceled:
+		return proto.InvoiceStatus_INVOICE_STATUS_CANCELED
+	case InvoiceStatusCheckout:
+		return proto.InvoiceStatus_INVOICE_STATUS_CHECKOUT
+	case InvoiceStatusAwaitingApproval:
+		return proto.InvoiceStatus_INVOICE_STATUS_AWAITING_APPROVAL
+	case InvoiceStatusHeld:
+		return proto.InvoiceStatus_INVOICE_STATUS_HELD
+	case InvoiceStatusDisputed:
+		return proto.InvoiceStatus_INVOICE_STATUS_DISPUTED
+	case InvoiceStatusReleased:
+		return proto.InvoiceStatus_INVOICE_STATUS_RELEASED
+	case InvoiceStatusRefunded:
+		return proto.InvoiceStatus_INVOICE_STATUS_REFUNDED
+	default:
+		return proto.InvoiceStatus_INVOICE_STATUS_INVALID
+	}
 }
 
 func optionalTime2timestamp(t *time.Time) *timestamppb.Timestamp {
@@ -1,8 +1,8 @@
 package cpg
 
 import (
+	"context"
 	"cpg/pkg/crypto"
-	"encoding/json"
 	"github.com/itsabgr/ge"
 	"math/big"
 	"sync"
@@ -20,6 +20,14 @@ const (
 	InvoiceStatusExpired  InvoiceStatus = 3
 	InvoiceStatusCanceled InvoiceStatus = 4
 	InvoiceStatusCheckout InvoiceStatus = 5
+	// InvoiceStatusAwaitingApproval is a checkout held until enough approvers approve its sweep
+	InvoiceStatusAwaitingApproval InvoiceStatus = 6
+	// InvoiceStatusHeld is a filled escrow invoice waiting for release or refund
+	InvoiceStatusHeld InvoiceStatus = 7
+	// InvoiceStatusDisputed is a held escrow invoice which is never auto-released
+	InvoiceStatusDisputed InvoiceStatus = 8
+	InvoiceStatusReleased InvoiceStatus = 9
+	InvoiceStatusRefunded InvoiceStatus = 10
 )
 
 var ErrInvalidInvoiceStatus = ge.New("invoice has invalid status")
@@ -41,29 +49,91 @@ type Invoice struct {
 	AuthCheckout      bool
 	WalletAddress     string
 	EncryptedSalt     []byte
-	saltKeyring       *crypto.KeyRing
+	// DerivationVersion selects how the asset derives the wallet, fixed at creation
+	DerivationVersion int
+	// WalletIndex is the hd child index, nil for salt derived wallets
+	WalletIndex *int64
+	// ApprovalRequestAt is set while a checkout waits for its approvers
+	ApprovalRequestAt *time.Time
+	ApproveAt         *time.Time
+	// EscrowPeriod is how long a filled escrow invoice is held, zero without escrow
+	EscrowPeriod time.Duration
+	// BuyerKeyHash is the sha256 of the buyer key of an escrow invoice
+	BuyerKeyHash []byte
+	ReleaseAt    *time.Time
+	RefundAt     *time.Time
+	DisputeAt    *time.Time
+	// Fee is the platform fee of the invoice, nil without one
+	Fee       *PlatformFee
+	FeeAmount *big.Int
+	FeeTxHash string
+	// Split is nil for invoices paying one recipient, its first share is the Recipient
+	Split        []SplitShare
+	SplitGasRule SplitGasRule
+	// TreasuryAddress is set for invoices sweeping into the asset treasury
+	TreasuryAddress string
+	// SignerMAC is the signer sum of the invoice terms
+	SignerMAC  []byte
+	saltCipher crypto.Cipher
+	// treasurySweep is credited once recorded
+	treasurySweep SweepResult
+	// sweeps are posted to the ledger once recorded
+	sweeps []reportedSweep
 }
 
-func (inv *Invoice) DecryptSalt() []byte {
-	if inv.EncryptedSalt == nil {
+// DecryptSalt returns the plain salt or nil when it can not be decrypted
+func (inv *Invoice) DecryptSalt(ctx context.Context) []byte {
+	if inv.EncryptedSalt == nil || inv.saltCipher == nil {
 		return nil
 	}
-	key, _ := inv.saltKeyring.Decrypt(inv.EncryptedSalt)
-	return key
+	salt, err := inv.saltCipher.Decrypt(ctx, inv.EncryptedSalt)
+	if err != nil {
+		return nil
+	}
+	return salt
 }
 
+// Destination is where a checkout sweeps
 func (inv *Invoice) Destination() string {
-	switch inv.Status() {
+	payee := inv.payee()
+	if inv.TreasuryAddress != "" && payee == inv.Recipient {
+		return inv.TreasuryAddress
+	}
+	return payee
+}
+
+// payee is who the invoice funds belong to, empty while held in escrow
+func (inv *Invoice) payee() string {
+	if inv.RefundAt != nil {
+		return inv.Beneficiary
+	}
+	switch inv.baseStatus() {
 	case InvoiceStatusExpired, InvoiceStatusCanceled:
 		return inv.Beneficiary
-	case InvoiceStatusFilled, InvoiceStatusPending, InvoiceStatusCheckout:
+	case InvoiceStatusFilled, InvoiceStatusPending, InvoiceStatusCheckout, InvoiceStatusReleased:
 		return inv.Recipient
+	case InvoiceStatusHeld, InvoiceStatusDisputed:
+		// escrow funds have no destination until released or refunded
+		return ""
 	default:
 		panic(ErrInvalidInvoiceStatus)
 	}
 }
 
 func (inv *Invoice) Status() InvoiceStatus {
+	status := inv.baseStatus()
+	switch status {
+	case InvoiceStatusInvalid, InvoiceStatusPending:
+		return status
+	}
+	if inv.ApprovalRequestAt != nil && inv.ApproveAt == nil {
+		return InvoiceStatusAwaitingApproval
+	}
+	return status
+}
+
+// baseStatus is the status regardless of a checkout approval
+func (inv *Invoice) baseStatus() InvoiceStatus {
 	if inv.FillAt != nil && inv.CancelAt != nil {
 		return InvoiceStatusInvalid
 	}
@@ -79,27 +149,38 @@ func (inv *Invoice) Status() InvoiceStatus {
 				return InvoiceStatusCanceled
 			}
 		} else {
-			return InvoiceStatusFilled
+			return inv.escrowStatus()
 		}
 	} else {
 		return InvoiceStatusCheckout
 	}
 }
 
-func (inv *Invoice) pack() []byte {
-	return ge.Must(json.Marshal(inv))
-}
-
-func unpackInvoice(data []byte) (*Invoice, error) {
-	inv := &Invoice{}
-	return inv, json.Unmarshal(data, inv)
-}
-
-func (inv *Invoice) Encrypt(keyring *crypto.KeyRing) []byte {
-	return keyring.Encrypt(inv.pack(), (*[24]byte)(crypto.ReadN(nil, 24)))
+// AutoReleaseAt is when a filled escrow invoice releases by itself
+func (inv *Invoice) AutoReleaseAt() *time.Time {
+	if inv.EscrowPeriod <= 0 || inv.FillAt == nil {
+		return nil
+	}
+	at := inv.FillAt.Add(inv.EscrowPeriod)
+	return &at
 }
 
-func DecryptInvoice(keyring *crypto.KeyRing, encrypted []byte) (*Invoice, error) {
-	invData, _ := keyring.Decrypt(encrypted)
-	return unpackInvoice(invData)
+// escrowStatus is the status of a filled invoice not checked out yet
+func (inv *Invoice) escrowStatus() InvoiceStatus {
+	switch {
+	case inv.EscrowPeriod <= 0:
+		return InvoiceStatusFilled
+	case inv.RefundAt != nil && inv.ReleaseAt != nil:
+		return InvoiceStatusInvalid
+	case inv.RefundAt != nil:
+		return InvoiceStatusRefunded
+	case inv.ReleaseAt != nil:
+		return InvoiceStatusReleased
+	case inv.DisputeAt != nil:
+		return InvoiceStatusDisputed
+	case inv.AutoReleaseAt().After(time.Now()):
+		return InvoiceStatusHeld
+	default:
+		return InvoiceStatusReleased
+	}
 }
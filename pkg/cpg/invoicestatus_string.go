@@ -14,11 +14,16 @@ func _() {
 	_ = x[InvoiceStatusExpired-3]
 	_ = x[InvoiceStatusCanceled-4]
 	_ = x[InvoiceStatusCheckout-5]
+	_ = x[InvoiceStatusAwaitingApproval-6]
+	_ = x[InvoiceStatusHeld-7]
+	_ = x[InvoiceStatusDisputed-8]
+	_ = x[InvoiceStatusReleased-9]
+	_ = x[InvoiceStatusRefunded-10]
 }
 
-const _InvoiceStatus_name = "InvoiceStatusInvalidInvoiceStatusPendingInvoiceStatusFilledInvoiceStatusExpiredInvoiceStatusCanceledInvoiceStatusCheckout"
+const _InvoiceStatus_name = "InvoiceStatusInvalidInvoiceStatusPendingInvoiceStatusFilledInvoiceStatusExpiredInvoiceStatusCanceledInvoiceStatusCheckoutInvoiceStatusAwaitingApprovalInvoiceStatusHeldInvoiceStatusDisputedInvoiceStatusReleasedInvoiceStatusRefunded"
 
-var _InvoiceStatus_index = [...]uint8{0, 20, 40, 59, 79, 100, 121}
+var _InvoiceStatus_index = [...]uint8{0, 20, 40, 59, 79, 100, 121, 150, 167, 188, 209, 230}
 
 func (i InvoiceStatus) String() string {
 	if i < 0 || i >= InvoiceStatus(len(_InvoiceStatus_index)-1) {
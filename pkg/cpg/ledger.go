@@ -0,0 +1,450 @@
+package cpg
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/itsabgr/ge"
+	"io"
+	"log/slog"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LedgerAccountKind is the nature of a ledger account
+type LedgerAccountKind string
+
+const (
+	// LedgerAccountHolding accounts are wallets whose keys cpg holds
+	LedgerAccountHolding LedgerAccountKind = "holding"
+	// LedgerAccountSource accounts are where funds came in from
+	LedgerAccountSource LedgerAccountKind = "source"
+	// LedgerAccountExternal accounts are addresses funds were sent out to
+	LedgerAccountExternal LedgerAccountKind = "external"
+	// LedgerAccountExpense accounts are the tx fees spent
+	LedgerAccountExpense LedgerAccountKind = "expense"
+)
+
+// ledger accounts, those of an address are named prefix:address
+const (
+	LedgerWallet     = "wallet"
+	LedgerTreasury   = "treasury"
+	LedgerFunding    = "funding"
+	LedgerGasFunder  = "gas_funder"
+	LedgerPayments   = "payments"
+	LedgerRecoveries = "recoveries"
+	LedgerGas        = "gas"
+	LedgerRecipient  = "recipient"
+	LedgerRefund     = "refund"
+	LedgerFee        = "fee"
+	LedgerSettlement = "settlement"
+	LedgerCold       = "cold"
+	LedgerPayout     = "payout"
+)
+
+// ledger entry kinds
+const (
+	LedgerEntryPayment        = "payment"
+	LedgerEntrySweep          = "sweep"
+	LedgerEntryRefund         = "refund"
+	LedgerEntryFee            = "fee"
+	LedgerEntrySplit          = "split"
+	LedgerEntryGas            = "gas"
+	LedgerEntryRecovery       = "recovery"
+	LedgerEntryGasFunding     = "gas_funding"
+	LedgerEntrySettlement     = "settlement"
+	LedgerEntryForward        = "forward"
+	LedgerEntryPayout         = "payout"
+	LedgerEntryPayoutReversal = "payout_reversal"
+)
+
+func ledgerAccount(prefix, address string) string {
+	// hex addresses may differ in case only
+	return prefix + ":" + strings.ToLower(address)
+}
+
+func ledgerAccountKind(account string) LedgerAccountKind {
+	prefix, _, _ := strings.Cut(account, ":")
+	switch prefix {
+	case LedgerWallet, LedgerTreasury, LedgerFunding, LedgerGasFunder:
+		return LedgerAccountHolding
+	case LedgerPayments, LedgerRecoveries:
+		return LedgerAccountSource
+	case LedgerGas:
+		return LedgerAccountExpense
+	}
+	return LedgerAccountExternal
+}
+
+// ledgerTokenAsset is the ledger asset of a token held on the chain of an asset
+func ledgerTokenAsset(asset, token string) string {
+	return asset + "/" + strings.ToLower(token)
+}
+
+// LedgerEntry is a line of a journal moving Amount from Credit to Debit
+type LedgerEntry struct {
+	ID        int
+	Journal   string
+	Line      int
+	Asset     string
+	Kind      string
+	Debit     string
+	Credit    string
+	Amount    *big.Int
+	InvoiceID string
+	TxHash    string
+	CreateAt  time.Time
+}
+
+// ledgerJournal collects the lines of one fund movement
+type ledgerJournal struct {
+	id        string
+	invoiceID string
+	lines     []LedgerEntry
+}
+
+// add appends a line unless its amount is nil or zero
+func (journal *ledgerJournal) add(asset, kind, debit, credit string, amount *big.Int, txHash string) {
+	if amount == nil || amount.Sign() == 0 {
+		return
+	}
+	journal.lines = append(journal.lines, LedgerEntry{
+		Journal:   journal.id,
+		Line:      len(journal.lines),
+		Asset:     asset,
+		Kind:      kind,
+		Debit:     debit,
+		Credit:    credit,
+		Amount:    new(big.Int).Set(amount),
+		InvoiceID: journal.invoiceID,
+		TxHash:    txHash,
+	})
+}
+
+// postLedger posts a journal once, a failure is only reported
+func (cpg *CPG) postLedger(ctx context.Context, journal ledgerJournal) {
+	if len(journal.lines) == 0 {
+		return
+	}
+	now := time.Now()
+	for i := range journal.lines {
+		journal.lines[i].CreateAt = now
+	}
+	if _, err := cpg.db.PostLedgerJournal(ctx, journal.lines); err != nil {
+		slog.Error("failed to post ledger journal", slog.String("journal", journal.id), slog.String("error", err.Error()))
+	}
+}
+
+// reconcileWallet posts the wallet balance over what the ledger holds for it
+func (cpg *CPG) reconcileWallet(ctx context.Context, inv *Invoice, asset, source string, balance *big.Int) {
+	if balance == nil {
+		return
+	}
+	wallet := ledgerAccount(LedgerWallet, inv.WalletAddress)
+	debits, credits, err := cpg.db.LedgerAccountTotals(ctx, asset, wallet)
+	if err != nil {
+		slog.Error("failed to reconcile ledger wallet", slog.String("invoice", inv.ID), slog.String("error", err.Error()))
+		return
+	}
+	received := new(big.Int).Sub(balance, new(big.Int).Sub(debits, credits))
+	if received.Sign() < 0 {
+		slog.Warn("ledger holds more than the wallet balance", slog.String("invoice", inv.ID), slog.String("asset", asset), slog.String("missing", received.Neg(received).String()))
+		return
+	}
+	cpg.postLedger(ctx, paymentJournal(inv, asset, source, debits, received))
+}
+
+// paymentJournal moves what a wallet received from the source account
+func paymentJournal(inv *Invoice, asset, source string, debits, received *big.Int) ledgerJournal {
+	wallet := ledgerAccount(LedgerWallet, inv.WalletAddress)
+	kind := LedgerEntryPayment
+	if source == LedgerRecoveries {
+		kind = LedgerEntryRecovery
+	}
+	// the total received by the wallet only grows, so it names each payment once
+	journal := ledgerJournal{id: kind + ":" + asset + ":" + wallet + ":" + new(big.Int).Add(debits, received).String(), invoiceID: inv.ID}
+	journal.add(asset, kind, wallet, source, received, "")
+	return journal
+}
+
+// postSweeps posts the sweeps the asset of an invoice reported
+func (cpg *CPG) postSweeps(ctx context.Context, inv *Invoice) {
+	for _, sweep := range inv.sweeps {
+		cpg.postSweep(ctx, inv, inv.Asset, sweep.to, sweep.result, LedgerPayments)
+	}
+	inv.sweeps = nil
+}
+
+type reportedSweep struct {
+	to     string
+	result SweepResult
+}
+
+// postSweep reconciles the wallet balance and posts the sent txs of a sweep
+func (cpg *CPG) postSweep(ctx context.Context, inv *Invoice, asset, to string, sweep SweepResult, source string) {
+
+	sent := sweep.TxHash != "" || sweep.FeeTxHash != ""
+	for _, leg := range sweep.Split {
+		sent = sent || leg.TxHash != ""
+	}
+	if !sent {
+		return
+	}
+	// the balance of a sweep the node refused may be stale, e.g. while an earlier sweep is pending
+	cpg.reconcileWallet(ctx, inv, asset, source, sweep.Balance)
+
+	for _, journal := range sweepJournals(inv, asset, to, sweep, source) {
+		cpg.postLedger(ctx, journal)
+	}
+}
+
+// sweepJournals are the journals of the sent txs of a sweep
+func sweepJournals(inv *Invoice, asset, to string, sweep SweepResult, source string) (journals []ledgerJournal) {
+	wallet := ledgerAccount(LedgerWallet, inv.WalletAddress)
+	post := func(txHash, kind, debit string, amount, txFee *big.Int) {
+		if txFee == nil {
+			txFee = sweep.TxFee
+		}
+		journal := ledgerJournal{id: kind + ":" + asset + ":" + txHash, invoiceID: inv.ID}
+		journal.add(asset, kind, debit, wallet, amount, txHash)
+		journal.add(asset, LedgerEntryGas, LedgerGas, wallet, txFee, txHash)
+		journals = append(journals, journal)
+	}
+
+	if sweep.FeeTxHash != "" && inv.Fee != nil {
+		post(sweep.FeeTxHash, LedgerEntryFee, ledgerAccount(LedgerFee, inv.Fee.Address), sweep.PlatformFee, sweep.FeeTxFee)
+	}
+	for _, leg := range sweep.Split {
+		if leg.TxHash != "" {
+			post(leg.TxHash, LedgerEntrySplit, ledgerAccount(LedgerRecipient, leg.To), leg.Amount, leg.TxFee)
+		}
+	}
+	if sweep.TxHash == "" {
+		return journals
+	}
+	switch {
+	case source == LedgerRecoveries:
+		post(sweep.TxHash, LedgerEntryRecovery, ledgerAccount(LedgerRecipient, to), sweep.Amount, nil)
+	case inv.TreasuryAddress != "" && strings.EqualFold(to, inv.TreasuryAddress):
+		post(sweep.TxHash, LedgerEntrySweep, ledgerAccount(LedgerTreasury, to), sweep.Amount, nil)
+	case strings.EqualFold(to, inv.Beneficiary) && !strings.EqualFold(to, inv.Recipient):
+		post(sweep.TxHash, LedgerEntryRefund, ledgerAccount(LedgerRefund, to), sweep.Amount, nil)
+	default:
+		post(sweep.TxHash, LedgerEntrySweep, ledgerAccount(LedgerRecipient, to), sweep.Amount, nil)
+	}
+	return journals
+}
+
+// postTokenRescue posts the tokens a rescue moved and its gas
+func (cpg *CPG) postTokenRescue(ctx context.Context, inv *Invoice, token, to string, rescue TokenRescueResult) {
+	for _, journal := range tokenRescueJournals(inv, token, to, rescue) {
+		cpg.postLedger(ctx, journal)
+	}
+}
+
+func tokenRescueJournals(inv *Invoice, token, to string, rescue TokenRescueResult) (journals []ledgerJournal) {
+	wallet := ledgerAccount(LedgerWallet, inv.WalletAddress)
+	if rescue.FundingTxHash != "" {
+		journal := ledgerJournal{id: LedgerEntryGasFunding + ":" + inv.Asset + ":" + rescue.FundingTxHash, invoiceID: inv.ID}
+		journal.add(inv.Asset, LedgerEntryGasFunding, wallet, LedgerGasFunder, rescue.FundingAmount, rescue.FundingTxHash)
+		journals = append(journals, journal)
+	}
+	if rescue.TxHash == "" {
+		return journals
+	}
+	tokenAsset := ledgerTokenAsset(inv.Asset, token)
+	journal := ledgerJournal{id: LedgerEntryRecovery + ":" + tokenAsset + ":" + rescue.TxHash, invoiceID: inv.ID}
+	journal.add(tokenAsset, LedgerEntryRecovery, wallet, LedgerRecoveries, rescue.TokenBalance, rescue.TxHash)
+	journal.add(tokenAsset, LedgerEntryRecovery, ledgerAccount(LedgerRecipient, to), wallet, rescue.TokenBalance, rescue.TxHash)
+	journal.add(inv.Asset, LedgerEntryGas, LedgerGas, wallet, rescue.Fee, rescue.TxHash)
+	return append(journals, journal)
+}
+
+// postTreasuryTransfer posts a sent settlement or forward
+func (cpg *CPG) postTreasuryTransfer(ctx context.Context, treasurer Treasurer, transfer TreasuryTransfer) {
+	treasury := ledgerAccount(LedgerTreasury, treasurer.TreasuryAddress())
+	kind, receiver := LedgerEntrySettlement, ledgerAccount(LedgerSettlement, transfer.To)
+	if transfer.Kind == TreasuryTransferForward {
+		kind, receiver = LedgerEntryForward, ledgerAccount(LedgerCold, transfer.To)
+	}
+	journal := ledgerJournal{id: kind + ":" + transfer.Asset + ":" + transfer.ID}
+	journal.add(transfer.Asset, kind, receiver, treasury, new(big.Int).Sub(transfer.Amount, transfer.Fee), transfer.TxHash)
+	journal.add(transfer.Asset, LedgerEntryGas, LedgerGas, treasury, transfer.Fee, transfer.TxHash)
+	cpg.postLedger(ctx, journal)
+}
+
+// postPayout posts a sent payout or reverses a reverted one
+func (cpg *CPG) postPayout(ctx context.Context, payout Payout) {
+	funding := ledgerAccount(LedgerFunding, cpg.assets.Get(payout.Asset).(Payer).PayoutAddress())
+	receiver := ledgerAccount(LedgerPayout, payout.To)
+	switch payout.Status {
+	case PayoutSent:
+		journal := ledgerJournal{id: LedgerEntryPayout + ":" + payout.Asset + ":" + payout.ID}
+		journal.add(payout.Asset, LedgerEntryPayout, receiver, funding, payout.Amount, payout.TxHash)
+		journal.add(payout.Asset, LedgerEntryGas, LedgerGas, funding, payout.Fee, payout.TxHash)
+		cpg.postLedger(ctx, journal)
+	case PayoutFailed:
+		if payout.TxHash == "" {
+			return
+		}
+		// a reverted tx moved nothing but its fee
+		journal := ledgerJournal{id: LedgerEntryPayoutReversal + ":" + payout.Asset + ":" + payout.ID}
+		journal.add(payout.Asset, LedgerEntryPayoutReversal, funding, receiver, payout.Amount, payout.TxHash)
+		cpg.postLedger(ctx, journal)
+	}
+}
+
+type GetLedgerBalancesParams struct {
+	// Asset is an asset name, or asset/token for rescued tokens
+	Asset string
+	// At is the point in time of the balances, now when it is zero
+	At time.Time
+	// Prefix filters the accounts by name, e.g. recipient:0x...
+	Prefix string
+}
+
+type LedgerBalance struct {
+	Account string
+	Kind    LedgerAccountKind
+	Debits  *big.Int
+	Credits *big.Int
+	// Balance is Debits minus Credits
+	Balance *big.Int
+}
+
+// GetLedgerBalances returns the account balances of an asset by name
+func (cpg *CPG) GetLedgerBalances(ctx context.Context, params GetLedgerBalancesParams) ([]LedgerBalance, error) {
+
+	if params.Asset == "" {
+		return nil, ge.New("asset is required")
+	}
+	if params.At.IsZero() {
+		params.At = time.Now()
+	}
+
+	debits, credits, err := cpg.db.SumLedger(ctx, params.Asset, params.At)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to sum ledger"), err)
+	}
+
+	accounts, err := cpg.db.ListLedgerAccounts(ctx, params.Asset)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to list ledger accounts"), err)
+	}
+
+	balances := make([]LedgerBalance, 0, len(accounts))
+	for _, account := range accounts {
+		if account.CreateAt.After(params.At) || !strings.HasPrefix(account.Name, params.Prefix) {
+			continue
+		}
+		balance := LedgerBalance{Account: account.Name, Kind: LedgerAccountKind(account.Kind), Debits: new(big.Int), Credits: new(big.Int)}
+		if debits[account.Name] != nil {
+			balance.Debits.Set(debits[account.Name])
+		}
+		if credits[account.Name] != nil {
+			balance.Credits.Set(credits[account.Name])
+		}
+		balance.Balance = new(big.Int).Sub(balance.Debits, balance.Credits)
+		balances = append(balances, balance)
+	}
+	return balances, nil
+}
+
+// ledger export formats
+const (
+	LedgerExportCSV  = "csv"
+	LedgerExportJSON = "json"
+)
+
+var ledgerExportColumns = []string{"id", "journal", "line", "create_at", "asset", "kind", "debit", "credit", "amount", "invoice_id", "tx_hash"}
+
+type ExportLedgerParams struct {
+	Asset string
+	// Since and Until bound the entries to [Since, Until) where they are not zero
+	Since, Until time.Time
+	// Format is csv with a header row or json, one object per line
+	Format    string
+	BatchSize int
+	Progress  func(exported int)
+}
+
+// ExportLedger writes the ledger entries of an asset in posting order
+func ExportLedger(ctx context.Context, db *DB, w io.Writer, params ExportLedgerParams) (exported int, err error) {
+
+	if params.Asset == "" {
+		return 0, ge.New("asset is required")
+	}
+	if params.BatchSize <= 0 {
+		params.BatchSize = 500
+	}
+
+	var write func(entry LedgerEntry) error
+	csvWriter := csv.NewWriter(w)
+	switch params.Format {
+	case LedgerExportCSV:
+		if err = csvWriter.Write(ledgerExportColumns); err != nil {
+			return 0, err
+		}
+		write = func(entry LedgerEntry) error {
+			return csvWriter.Write([]string{
+				strconv.Itoa(entry.ID),
+				entry.Journal,
+				strconv.Itoa(entry.Line),
+				entry.CreateAt.UTC().Format(time.RFC3339Nano),
+				entry.Asset,
+				entry.Kind,
+				entry.Debit,
+				entry.Credit,
+				entry.Amount.String(),
+				entry.InvoiceID,
+				entry.TxHash,
+			})
+		}
+	case LedgerExportJSON:
+		encoder := json.NewEncoder(w)
+		write = func(entry LedgerEntry) error {
+			return encoder.Encode(map[string]any{
+				"id":         entry.ID,
+				"journal":    entry.Journal,
+				"line":       entry.Line,
+				"create_at":  entry.CreateAt.UTC(),
+				"asset":      entry.Asset,
+				"kind":       entry.Kind,
+				"debit":      entry.Debit,
+				"credit":     entry.Credit,
+				"amount":     entry.Amount.String(),
+				"invoice_id": entry.InvoiceID,
+				"tx_hash":    entry.TxHash,
+			})
+		}
+	default:
+		return 0, ge.Detail(ge.New("unknown ledger export format"), ge.D{"format": params.Format})
+	}
+
+	afterID := 0
+	for {
+		entries, err := db.ListLedgerEntries(ctx, params.Asset, afterID, params.Since, params.Until, params.BatchSize)
+		if err != nil {
+			return exported, ge.Wrap(ge.New("failed to list ledger entries"), err)
+		}
+		for _, entry := range entries {
+			if err = write(entry); err != nil {
+				return exported, err
+			}
+		}
+		csvWriter.Flush()
+		if err = csvWriter.Error(); err != nil {
+			return exported, err
+		}
+		exported += len(entries)
+		if params.Progress != nil && len(entries) > 0 {
+			params.Progress(exported)
+		}
+		if len(entries) < params.BatchSize {
+			return exported, nil
+		}
+		afterID = entries[len(entries)-1].ID
+	}
+}
@@ -0,0 +1,154 @@
+package cpg
+
+import (
+	"math/big"
+	"testing"
+)
+
+// testLedger applies journals to account balances by asset, debits minus credits
+type testLedger map[string]map[string]*big.Int
+
+func (ledger testLedger) post(t *testing.T, journals ...ledgerJournal) {
+	t.Helper()
+	for _, journal := range journals {
+		if len(journal.lines) == 0 {
+			t.Fatalf("journal %s has no lines", journal.id)
+		}
+		for i, line := range journal.lines {
+			if line.Journal != journal.id || line.Line != i || line.Amount.Sign() <= 0 || line.Debit == line.Credit {
+				t.Fatalf("journal %s line %d: %+v", journal.id, i, line)
+			}
+			if ledger[line.Asset] == nil {
+				ledger[line.Asset] = map[string]*big.Int{}
+			}
+			for account, amount := range map[string]*big.Int{line.Debit: line.Amount, line.Credit: new(big.Int).Neg(line.Amount)} {
+				if ledger[line.Asset][account] == nil {
+					ledger[line.Asset][account] = new(big.Int)
+				}
+				ledger[line.Asset][account].Add(ledger[line.Asset][account], amount)
+			}
+		}
+	}
+}
+
+func (ledger testLedger) balance(asset, account string) *big.Int {
+	if balance := ledger[asset][account]; balance != nil {
+		return balance
+	}
+	return new(big.Int)
+}
+
+// check fails unless the accounts of every asset sum to zero and each listed account holds its balance
+func (ledger testLedger) check(t *testing.T, asset string, balances map[string]int64) {
+	t.Helper()
+	for name, accounts := range ledger {
+		sum := new(big.Int)
+		for _, balance := range accounts {
+			sum.Add(sum, balance)
+		}
+		if sum.Sign() != 0 {
+			t.Fatalf("%s accounts sum to %s", name, sum)
+		}
+	}
+	for account, balance := range balances {
+		if got := ledger.balance(asset, account); got.Cmp(big.NewInt(balance)) != 0 {
+			t.Fatalf("%s holds %s, not %d", account, got, balance)
+		}
+	}
+}
+
+func TestLedgerSweepJournals(t *testing.T) {
+	inv := &Invoice{
+		ID:              "inv",
+		Asset:           "eth",
+		WalletAddress:   "0xWallet",
+		Recipient:       "0xRecipient",
+		Beneficiary:     "0xBeneficiary",
+		TreasuryAddress: "0xTreasury",
+		Fee:             &PlatformFee{Address: "0xFee", BasisPoints: 100},
+	}
+	wallet := ledgerAccount(LedgerWallet, inv.WalletAddress)
+	split := []SplitLeg{{Share: 1, To: "0xSplit", Amount: big.NewInt(200), TxHash: "0xs"}}
+
+	for _, tc := range []struct {
+		name     string
+		to       string
+		source   string
+		sweep    SweepResult
+		kind     string
+		receiver string
+	}{
+		{"payment", inv.Recipient, LedgerPayments,
+			SweepResult{Balance: big.NewInt(1000), Amount: big.NewInt(990), TxFee: big.NewInt(10), TxHash: "0xt"},
+			LedgerEntrySweep, ledgerAccount(LedgerRecipient, inv.Recipient)},
+		{"fee and split", inv.Recipient, LedgerPayments,
+			SweepResult{Balance: big.NewInt(1000), Amount: big.NewInt(760), TxFee: big.NewInt(10), TxHash: "0xt", PlatformFee: big.NewInt(10), FeeTxHash: "0xf", Split: split},
+			LedgerEntrySweep, ledgerAccount(LedgerRecipient, inv.Recipient)},
+		{"treasury", inv.TreasuryAddress, LedgerPayments,
+			SweepResult{Balance: big.NewInt(1000), Amount: big.NewInt(990), TxFee: big.NewInt(10), TxHash: "0xt"},
+			LedgerEntrySweep, ledgerAccount(LedgerTreasury, inv.TreasuryAddress)},
+		{"refund", inv.Beneficiary, LedgerPayments,
+			SweepResult{Balance: big.NewInt(1000), Amount: big.NewInt(990), TxFee: big.NewInt(10), TxHash: "0xt"},
+			LedgerEntryRefund, ledgerAccount(LedgerRefund, inv.Beneficiary)},
+		{"recovery", "0xRescue", LedgerRecoveries,
+			SweepResult{Balance: big.NewInt(1000), Amount: big.NewInt(990), TxFee: big.NewInt(10), TxHash: "0xt"},
+			LedgerEntryRecovery, ledgerAccount(LedgerRecipient, "0xRescue")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ledger := testLedger{}
+			ledger.post(t, paymentJournal(inv, inv.Asset, tc.source, new(big.Int), tc.sweep.Balance))
+			journals := sweepJournals(inv, inv.Asset, tc.to, tc.sweep, tc.source)
+			ledger.post(t, journals...)
+
+			last := journals[len(journals)-1].lines[0]
+			if last.Kind != tc.kind || last.Debit != tc.receiver || last.InvoiceID != inv.ID {
+				t.Fatalf("sweep line %+v", last)
+			}
+			gas := int64(10 * len(journals))
+			ledger.check(t, inv.Asset, map[string]int64{
+				wallet:      0,
+				tc.source:   -1000,
+				tc.receiver: tc.sweep.Amount.Int64(),
+				LedgerGas:   gas,
+			})
+			if tc.sweep.PlatformFee != nil {
+				ledger.check(t, inv.Asset, map[string]int64{
+					ledgerAccount(LedgerFee, inv.Fee.Address): 10,
+					ledgerAccount(LedgerRecipient, "0xSplit"): 200,
+				})
+			}
+		})
+	}
+}
+
+func TestLedgerTokenRescueJournals(t *testing.T) {
+	inv := &Invoice{ID: "inv", Asset: "eth", WalletAddress: "0xWallet"}
+	wallet := ledgerAccount(LedgerWallet, inv.WalletAddress)
+	rescue := TokenRescueResult{TokenBalance: big.NewInt(500), Fee: big.NewInt(7), FundingAmount: big.NewInt(7), FundingTxHash: "0xg", TxHash: "0xt"}
+
+	ledger := testLedger{}
+	ledger.post(t, tokenRescueJournals(inv, "0xToken", "0xRescue", rescue)...)
+	ledger.check(t, ledgerTokenAsset(inv.Asset, "0xToken"), map[string]int64{
+		wallet:           0,
+		LedgerRecoveries: -500,
+		ledgerAccount(LedgerRecipient, "0xRescue"): 500,
+	})
+	ledger.check(t, inv.Asset, map[string]int64{
+		wallet:          0,
+		LedgerGasFunder: -7,
+		LedgerGas:       7,
+	})
+}
+
+func TestLedgerPaymentJournalID(t *testing.T) {
+	inv := &Invoice{ID: "inv", WalletAddress: "0xWallet"}
+	first := paymentJournal(inv, "eth", LedgerPayments, new(big.Int), big.NewInt(5))
+	again := paymentJournal(inv, "eth", LedgerPayments, new(big.Int), big.NewInt(5))
+	next := paymentJournal(inv, "eth", LedgerPayments, big.NewInt(5), big.NewInt(5))
+	if first.id != again.id || first.id == next.id {
+		t.Fatalf("journal ids %s %s %s", first.id, again.id, next.id)
+	}
+	if empty := paymentJournal(inv, "eth", LedgerPayments, new(big.Int), new(big.Int)); len(empty.lines) != 0 {
+		t.Fatal("journal of nothing received has lines")
+	}
+}
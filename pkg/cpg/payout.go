@@ -0,0 +1,345 @@
+package cpg
+
+import (
+	"context"
+	"cpg/pkg/ent/database"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ErrIdempotencyConflict is returned for an idempotency key reused with another payout
+var ErrIdempotencyConflict = ge.New("idempotency key was used for another payout")
+
+// MaxIdempotencyKeyLength bounds the idempotency keys of payouts
+const MaxIdempotencyKeyLength = 128
+
+// AssetPayouts is the payout policy of an asset, DailyLimit bounds the payouts of a utc day
+type AssetPayouts struct {
+	DailyLimit    *big.Int `json:"daily_limit"`
+	Confirmations uint64   `json:"confirmations"`
+	Allowlist     []string `json:"allowlist"`
+}
+
+func (policy AssetPayouts) allowed(to string) bool {
+	for _, address := range policy.Allowlist {
+		if strings.EqualFold(address, to) {
+			return true
+		}
+	}
+	return false
+}
+
+// PayoutPolicy maps asset names to their payout policy
+type PayoutPolicy map[string]AssetPayouts
+
+func ParsePayoutPolicy(data []byte) (PayoutPolicy, error) {
+	policy := PayoutPolicy{}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, err
+	}
+	for asset, payouts := range policy {
+		if payouts.DailyLimit == nil || payouts.DailyLimit.Sign() <= 0 {
+			return nil, ge.Detail(ge.New("payouts need a positive daily limit"), ge.D{"asset": asset})
+		}
+		if payouts.Confirmations == 0 {
+			return nil, ge.Detail(ge.New("payouts need at least one confirmation"), ge.D{"asset": asset})
+		}
+		if len(payouts.Allowlist) == 0 {
+			return nil, ge.Detail(ge.New("payouts need an address allowlist"), ge.D{"asset": asset})
+		}
+	}
+	return policy, nil
+}
+
+// UsePayouts enables payouts of the assets of the policy, webhook may be nil
+func (cpg *CPG) UsePayouts(policy PayoutPolicy, webhook *Webhook) error {
+	for asset := range policy {
+		payer, ok := cpg.assets.Get(asset).(Payer)
+		if !ok || payer.PayoutAddress() == "" {
+			return ge.Detail(ge.New("payout asset has no payout key"), ge.D{"asset": asset})
+		}
+	}
+	cpg.payoutPolicy = policy
+	cpg.payoutWebhook = webhook
+	return nil
+}
+
+type PayoutStatus string
+
+const (
+	// PayoutPending is a payout whose tx is not sent yet, it is never resent
+	PayoutPending PayoutStatus = "pending"
+	// PayoutSent is a payout whose tx is sent and waits for its confirmations
+	PayoutSent PayoutStatus = "sent"
+	// PayoutConfirmed is a payout whose tx has the confirmations of its asset
+	PayoutConfirmed PayoutStatus = "confirmed"
+	// PayoutFailed is a payout whose tx could not be sent or reverted
+	PayoutFailed PayoutStatus = "failed"
+)
+
+// Payout is an outgoing transfer from the funding wallet of an asset
+type Payout struct {
+	ID             string       `json:"id"`
+	IdempotencyKey string       `json:"idempotency_key"`
+	Asset          string       `json:"asset"`
+	To             string       `json:"to"`
+	Amount         *big.Int     `json:"amount"`
+	Reference      string       `json:"reference,omitempty"`
+	Status         PayoutStatus `json:"status"`
+	Fee            *big.Int     `json:"fee,omitempty"`
+	TxHash         string       `json:"tx_hash,omitempty"`
+	Error          string       `json:"error,omitempty"`
+	CreateAt       time.Time    `json:"create_at"`
+	SendAt         *time.Time   `json:"send_at,omitempty"`
+	ConfirmAt      *time.Time   `json:"confirm_at,omitempty"`
+	notifiedStatus PayoutStatus
+}
+
+type CreatePayoutParams struct {
+	Asset  string
+	To     string
+	Amount *big.Int
+	// IdempotencyKey makes retried calls return the first payout
+	IdempotencyKey string
+	// Reference is a free text for the receiver, e.g. the supplier invoice number
+	Reference string
+}
+
+type CreatePayoutResult struct {
+	Payout Payout
+	// Replayed is set when the idempotency key returned an existing payout
+	Replayed bool
+}
+
+// CreatePayout records and sends a payout, one whose send failed stays pending with its Error
+func (cpg *CPG) CreatePayout(ctx context.Context, params CreatePayoutParams) (result CreatePayoutResult, err error) {
+
+	if params.IdempotencyKey == "" || len(params.IdempotencyKey) > MaxIdempotencyKeyLength {
+		return result, ge.New("payout needs an idempotency key of up to 128 bytes")
+	}
+	if params.Amount == nil || params.Amount.Sign() <= 0 {
+		return result, ge.New("payout amount must be positive")
+	}
+	if len(params.Reference) >= 256 {
+		return result, ge.New("too long payout reference")
+	}
+
+	policy, ok := cpg.payoutPolicy[params.Asset]
+	if !ok {
+		return result, ge.Detail(ge.New("asset has no payouts"), ge.D{"asset": params.Asset})
+	}
+	if !policy.allowed(params.To) {
+		return result, ge.Detail(ge.New("payout address is not allowlisted"), ge.D{"asset": params.Asset, "to": params.To})
+	}
+
+	payout, replayed, err := cpg.reservePayout(ctx, policy, params)
+	if err != nil || replayed {
+		return CreatePayoutResult{Payout: payout, Replayed: replayed}, err
+	}
+
+	_, err = cpg.assets.Get(params.Asset).(Payer).SendPayout(ctx, payout.To, payout.Amount, func(tx SweepResult) error {
+		if err := cpg.db.SetPayoutSigned(ctx, payout.ID, tx.Fee, tx.TxHash); err != nil {
+			return ge.Wrap(ge.New("failed to record payout tx"), err)
+		}
+		payout.Fee, payout.TxHash = tx.Fee, tx.TxHash
+		return nil
+	})
+	switch {
+	case err != nil && payout.TxHash == "":
+		payout.Status, payout.Error = PayoutFailed, err.Error()
+		slog.Warn("payout failed", slog.String("payout", payout.ID), slog.String("asset", payout.Asset), slog.String("to", payout.To), slog.String("error", payout.Error))
+		if err = cpg.db.SetPayoutFailed(ctx, payout.ID, payout.Error); err != nil {
+			return result, ge.Wrap(ge.New("failed to record payout error"), err)
+		}
+	case err != nil:
+		// the tx may have been broadcast
+		payout.Error = err.Error()
+		slog.Warn("payout send failed", slog.String("payout", payout.ID), slog.String("asset", payout.Asset), slog.String("tx", payout.TxHash), slog.String("error", payout.Error))
+		if err = cpg.db.SetPayoutError(ctx, payout.ID, payout.Error); err != nil {
+			return result, ge.Wrap(ge.Detail(ge.New("failed to record payout error"), ge.D{"tx": payout.TxHash}), err)
+		}
+		return CreatePayoutResult{Payout: payout}, nil
+	default:
+		if err = cpg.setPayoutSent(ctx, &payout); err != nil {
+			return result, err
+		}
+	}
+
+	cpg.notifyPayout(ctx, &payout)
+	return CreatePayoutResult{Payout: payout}, nil
+}
+
+func (cpg *CPG) setPayoutSent(ctx context.Context, payout *Payout) error {
+	now := time.Now()
+	payout.Status, payout.SendAt, payout.Error = PayoutSent, &now, ""
+	slog.Info("payout sent", slog.String("payout", payout.ID), slog.String("asset", payout.Asset), slog.String("to", payout.To), slog.String("amount", payout.Amount.String()), slog.String("tx", payout.TxHash))
+	if err := cpg.db.SetPayoutSent(ctx, payout.ID); err != nil {
+		return ge.Wrap(ge.Detail(ge.New("failed to record sent payout"), ge.D{"tx": payout.TxHash}), err)
+	}
+	cpg.postPayout(ctx, *payout)
+	return nil
+}
+
+// reservePayout returns the payout of a known idempotency key or records a pending one within the daily limit
+func (cpg *CPG) reservePayout(ctx context.Context, policy AssetPayouts, params CreatePayoutParams) (Payout, bool, error) {
+
+	existing, err := cpg.db.GetPayoutByIdempotencyKey(ctx, params.IdempotencyKey)
+	if err != nil {
+		return Payout{}, false, ge.Wrap(ge.New("failed to get payout"), err)
+	}
+	if existing != nil {
+		return replayPayout(existing, params)
+	}
+
+	now := time.Now().UTC()
+	payout := Payout{
+		ID:             uuid.NewString(),
+		IdempotencyKey: params.IdempotencyKey,
+		Asset:          params.Asset,
+		To:             params.To,
+		Amount:         new(big.Int).Set(params.Amount),
+		Reference:      params.Reference,
+		Status:         PayoutPending,
+		CreateAt:       now,
+	}
+	inserted, err := cpg.db.InsertPayoutWithinLimit(ctx, payout, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), policy.DailyLimit)
+	if database.IsConstraintError(err) {
+		// a concurrent call with the same idempotency key inserted first
+		if existing, err = cpg.db.GetPayoutByIdempotencyKey(ctx, params.IdempotencyKey); err == nil && existing != nil {
+			return replayPayout(existing, params)
+		}
+	}
+	if err != nil {
+		return Payout{}, false, ge.Wrap(ge.New("failed to insert payout"), err)
+	}
+	if !inserted {
+		return Payout{}, false, ge.Detail(ge.New("payout exceeds the daily limit of the asset"), ge.D{"asset": params.Asset, "limit": policy.DailyLimit})
+	}
+	return payout, false, nil
+}
+
+func replayPayout(existing *Payout, params CreatePayoutParams) (Payout, bool, error) {
+	if existing.Asset != params.Asset || !strings.EqualFold(existing.To, params.To) || existing.Amount.Cmp(params.Amount) != 0 {
+		return Payout{}, false, ge.Detail(ErrIdempotencyConflict, ge.D{"payout": existing.ID})
+	}
+	return *existing, true, nil
+}
+
+func (cpg *CPG) GetPayout(ctx context.Context, id string) (*Payout, error) {
+	payout, err := cpg.db.GetPayout(ctx, id)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to get payout"), err)
+	}
+	if payout == nil {
+		return nil, ge.New("payout not found")
+	}
+	return payout, nil
+}
+
+type ListPayoutsParams struct {
+	// Asset and Status filter the payouts unless they are empty
+	Asset  string
+	Status PayoutStatus
+	// Before pages back from the create time of the last payout of the previous page
+	Before time.Time
+	Limit  int
+}
+
+// ListPayouts returns payouts newest first
+func (cpg *CPG) ListPayouts(ctx context.Context, params ListPayoutsParams) ([]Payout, error) {
+	if params.Limit <= 0 || params.Limit > 1000 {
+		params.Limit = 100
+	}
+	payouts, err := cpg.db.ListPayouts(ctx, params.Asset, params.Status, params.Before, params.Limit)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to list payouts"), err)
+	}
+	return payouts, nil
+}
+
+// notifyPayout delivers the status of a payout to the webhook once
+func (cpg *CPG) notifyPayout(ctx context.Context, payout *Payout) {
+	if cpg.payoutWebhook == nil || payout.notifiedStatus == payout.Status {
+		return
+	}
+	if err := cpg.payoutWebhook.Post(ctx, "payout."+string(payout.Status), payout); err != nil {
+		slog.Warn("payout webhook failed", slog.String("payout", payout.ID), slog.String("status", string(payout.Status)), slog.String("error", err.Error()))
+		return
+	}
+	if err := cpg.db.SetPayoutNotified(ctx, payout.ID, payout.Status); err != nil {
+		slog.Error("failed to record payout webhook", slog.String("payout", payout.ID), slog.String("error", err.Error()))
+		return
+	}
+	payout.notifiedStatus = payout.Status
+}
+
+// trackPayouts settles the payouts by their tx and retries undelivered webhooks
+func (cpg *CPG) trackPayouts(ctx context.Context) error {
+
+	sent, err := cpg.db.ListPayoutsToTrack(ctx, cpg.payoutWebhook != nil)
+	if err != nil {
+		return ge.Wrap(ge.New("failed to list payouts to track"), err)
+	}
+
+	for i := range sent {
+		payout := &sent[i]
+		if payout.Status == PayoutSent || payout.Status == PayoutPending {
+			policy, ok := cpg.payoutPolicy[payout.Asset]
+			if !ok {
+				continue
+			}
+			confirmations, err := cpg.assets.Get(payout.Asset).(Payer).TxConfirmations(ctx, payout.TxHash)
+			if payout.Status == PayoutPending && (err == nil || ge.Is(err, ErrTxReverted)) {
+				if err == nil && confirmations == 0 {
+					continue
+				}
+				// a reverted tx still paid its fee
+				if sendErr := cpg.setPayoutSent(ctx, payout); sendErr != nil {
+					return sendErr
+				}
+			}
+			switch {
+			case ge.Is(err, ErrTxReverted):
+				payout.Status, payout.Error = PayoutFailed, err.Error()
+				slog.Warn("payout reverted", slog.String("payout", payout.ID), slog.String("tx", payout.TxHash))
+				if err = cpg.db.SetPayoutFailed(ctx, payout.ID, payout.Error); err != nil {
+					return ge.Wrap(ge.New("failed to record reverted payout"), err)
+				}
+				cpg.postPayout(ctx, *payout)
+			case err != nil:
+				slog.Warn("failed to check payout tx", slog.String("payout", payout.ID), slog.String("tx", payout.TxHash), slog.String("error", err.Error()))
+				continue
+			case confirmations >= policy.Confirmations:
+				now := time.Now()
+				payout.Status, payout.ConfirmAt = PayoutConfirmed, &now
+				slog.Info("payout confirmed", slog.String("payout", payout.ID), slog.String("tx", payout.TxHash))
+				if err = cpg.db.SetPayoutConfirmed(ctx, payout.ID); err != nil {
+					return ge.Wrap(ge.New("failed to record confirmed payout"), err)
+				}
+			}
+		}
+		cpg.notifyPayout(ctx, payout)
+	}
+	return nil
+}
+
+// RunPayouts tracks the sent payouts each period until ctx is done
+func (cpg *CPG) RunPayouts(ctx context.Context, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		if err := cpg.trackPayouts(ctx); err != nil {
+			slog.Error("payout tracking failed", slog.String("error", err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
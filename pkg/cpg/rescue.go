@@ -0,0 +1,115 @@
+package cpg
+
+import (
+	"context"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"strings"
+)
+
+// UseRescueAllowlist lets token rescues send to the given addresses besides the invoice payee
+func (cpg *CPG) UseRescueAllowlist(addresses []string) {
+	cpg.rescueAllowlist = map[string]bool{}
+	for _, address := range addresses {
+		cpg.rescueAllowlist[strings.ToLower(address)] = true
+	}
+}
+
+type RescueTokenParams struct {
+	InvoiceID string
+	Token     string
+	// To defaults to the invoice payee
+	To      string
+	FundGas bool
+	DryRun  bool
+}
+
+// RescueToken sweeps the balance of a token contract from an invoice wallet
+func (cpg *CPG) RescueToken(ctx context.Context, params RescueTokenParams) (result TokenRescueResult, err error) {
+
+	if cpg.signer != nil {
+		return result, ErrSaltsOnSigner
+	}
+
+	inv, err := cpg.db.GetInvoice(ctx, params.InvoiceID, "", true)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to get invoice"), err)
+	}
+	if inv == nil {
+		return result, ge.New("invoice not found")
+	}
+	inv.saltCipher = cpg.saltCipher
+
+	asset := cpg.assets.Get(inv.Asset)
+	if asset == nil {
+		return result, ge.New("asset is not supported")
+	}
+	rescuer, ok := asset.(TokenRescuer)
+	if !ok {
+		return result, ge.Detail(ge.New("asset can not rescue tokens"), ge.D{"asset": inv.Asset})
+	}
+
+	payee := ""
+	if inv.Status() != InvoiceStatusInvalid {
+		payee = inv.payee()
+	} else if params.To == "" {
+		return result, ErrInvalidInvoiceStatus
+	}
+	if params.To == "" {
+		params.To = payee
+	}
+	if params.To == "" || (!strings.EqualFold(params.To, payee) && !cpg.rescueAllowlist[strings.ToLower(params.To)]) {
+		return result, ge.Detail(ge.New("rescue destination is neither the invoice payee nor allowlisted"), ge.D{"to": params.To})
+	}
+
+	result, err = rescuer.RescueToken(ctx, inv, TokenRescueParams{
+		Token:   params.Token,
+		To:      params.To,
+		FundGas: params.FundGas,
+		DryRun:  params.DryRun,
+	})
+
+	if params.DryRun || (result.TxHash == "" && result.FundingTxHash == "") {
+		return result, err
+	}
+
+	cpg.postTokenRescue(ctx, inv, params.Token, params.To, result)
+
+	detail := map[string]string{
+		"token":  params.Token,
+		"to":     params.To,
+		"amount": result.TokenBalance.String(),
+		"fee":    result.Fee.String(),
+	}
+	if result.FundingTxHash != "" {
+		detail["funding_tx"] = result.FundingTxHash
+		detail["funding_amount"] = result.FundingAmount.String()
+	}
+	if err != nil {
+		detail["error"] = err.Error()
+	}
+	if eventErr := cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+		InvoiceID: inv.ID,
+		Kind:      InvoiceEventTokenRescue,
+		Asset:     inv.Asset,
+		TxHash:    result.TxHash,
+		Detail:    detail,
+	}); eventErr != nil {
+		slog.Error("failed to record token rescue", "invoice", inv.ID, "tx", result.TxHash, "funding_tx", result.FundingTxHash, "error", eventErr.Error())
+		err = ge.Join(err, ge.Wrap(ge.New("failed to record the token rescue event"), eventErr))
+	}
+
+	return result, err
+}
+
+// ListInvoiceEvents returns the audit trail of an invoice
+func (cpg *CPG) ListInvoiceEvents(ctx context.Context, invoiceID string) ([]InvoiceEvent, error) {
+	if invoiceID == "" {
+		return nil, ge.New("invoice id is empty")
+	}
+	events, err := cpg.db.ListInvoiceEvents(ctx, invoiceID)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to list invoice events"), err)
+	}
+	return events, nil
+}
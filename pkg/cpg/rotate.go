@@ -0,0 +1,101 @@
+package cpg
+
+import (
+	"context"
+	"cpg/pkg/crypto"
+	"github.com/itsabgr/ge"
+	"log/slog"
+)
+
+type RotateSaltKeyringParams struct {
+	// After resumes the rotation after this invoice id
+	After     string
+	BatchSize int
+	// VerifyOnly reports the invoices not under the primary key without changing anything
+	VerifyOnly bool
+	// Progress is called after each batch is committed
+	Progress func(RotateSaltKeyringProgress)
+}
+
+type RotateSaltKeyringProgress struct {
+	Total         int
+	Processed     int
+	Rotated       int
+	Current       int
+	Stale         int
+	Undecryptable int
+	LastID        string
+}
+
+// RotateSaltKeyring re-encrypts the invoice salts not sealed under the primary key in resumable batches
+func RotateSaltKeyring(ctx context.Context, db *DB, keyring *crypto.KeyRing, params RotateSaltKeyringParams) (progress RotateSaltKeyringProgress, err error) {
+
+	if params.BatchSize <= 0 {
+		params.BatchSize = 500
+	}
+
+	progress.LastID = params.After
+
+	if progress.Total, err = db.CountInvoices(ctx); err != nil {
+		return progress, ge.Wrap(ge.New("failed to count invoices"), err)
+	}
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return progress, err
+		}
+
+		var batch []InvoiceSalt
+		batch, err = db.ListInvoiceSalts(ctx, progress.LastID, params.BatchSize)
+		if err != nil {
+			return progress, ge.Wrap(ge.New("failed to list invoice salts"), err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		old := make([]InvoiceSalt, 0, len(batch))
+		rotated := make([]InvoiceSalt, 0, len(batch))
+
+		for _, salt := range batch {
+			switch {
+			case keyring.Current(salt.EncryptedSalt):
+				progress.Current++
+			case keyring.KeyIndex(salt.EncryptedSalt) < 0:
+				progress.Undecryptable++
+				slog.Warn("invoice salt can not be decrypted by any key", slog.String("invoice", salt.ID))
+			default:
+				if params.VerifyOnly {
+					progress.Stale++
+					continue
+				}
+				reencrypted, ok := keyring.Reencrypt(salt.EncryptedSalt)
+				ge.Assert(ok)
+				old = append(old, salt)
+				rotated = append(rotated, InvoiceSalt{ID: salt.ID, EncryptedSalt: reencrypted})
+			}
+		}
+
+		if len(rotated) > 0 {
+			if err = db.ReplaceEncryptedSalts(ctx, old, rotated); err != nil {
+				return progress, ge.Wrap(ge.Detail(ge.New("failed to replace invoice salts"), ge.D{"after": progress.LastID}), err)
+			}
+		}
+
+		progress.Rotated += len(rotated)
+		progress.Processed += len(batch)
+		progress.LastID = batch[len(batch)-1].ID
+
+		if params.Progress != nil {
+			params.Progress(progress)
+		}
+	}
+
+	if progress.Undecryptable > 0 {
+		err = ge.Detail(ge.New("some invoice salts can not be decrypted"), ge.D{"count": progress.Undecryptable})
+	} else if progress.Stale > 0 {
+		err = ge.Detail(ge.New("some invoice salts are not under the primary key"), ge.D{"count": progress.Stale})
+	}
+
+	return progress, err
+}
@@ -0,0 +1,453 @@
+package cpg
+
+import (
+	"context"
+	"cpg/pkg/crypto"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"github.com/itsabgr/ge"
+	"io/fs"
+	"log/slog"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Signer holds the invoice salts and signs their sweeps
+type Signer interface {
+	// PrepareInvoice seals a fresh salt for a new invoice, derives its wallet and sets its signer mac
+	PrepareInvoice(ctx context.Context, inv *Invoice) error
+	// SignSweep signs an unsigned sweep tx of an invoice after the signer policy checks
+	SignSweep(ctx context.Context, invoiceID string, unsigned []byte) ([]byte, error)
+}
+
+// ErrSaltsOnSigner is returned for what needs the salts a signer holds
+var ErrSaltsOnSigner = ge.New("invoice salts are held by the signer")
+
+type SignerLimit struct {
+	// MaxAmount bounds the amount of a single sweep
+	MaxAmount *big.Int `json:"max_amount"`
+	// MaxDailyAmount bounds the amount signed per utc day, it survives restarts only with a state file
+	MaxDailyAmount *big.Int `json:"max_daily_amount"`
+}
+
+// SignerPolicy maps asset names to their limits
+type SignerPolicy map[string]SignerLimit
+
+func ParseSignerPolicy(data []byte) (SignerPolicy, error) {
+	policy := SignerPolicy{}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, err
+	}
+	for asset, limit := range policy {
+		if limit.MaxAmount == nil || limit.MaxAmount.Sign() <= 0 {
+			return nil, ge.Detail(ge.New("signer policy needs a positive max amount"), ge.D{"asset": asset})
+		}
+		if limit.MaxDailyAmount != nil && limit.MaxDailyAmount.Cmp(limit.MaxAmount) < 0 {
+			return nil, ge.Detail(ge.New("signer policy max daily amount is below its max amount"), ge.D{"asset": asset})
+		}
+	}
+	return policy, nil
+}
+
+var _ Signer = (*LocalSigner)(nil)
+
+// LocalSigner is the signer holding the salts, cpg-signer serves it
+type LocalSigner struct {
+	assets     *Assets
+	db         *DB
+	saltCipher crypto.Cipher
+	macKeyring *crypto.KeyRing
+	policy     SignerPolicy
+
+	mu  sync.Mutex
+	day string
+	// signed holds the amount signed today by asset and invoice
+	signed    map[string]map[string]*big.Int
+	stateFile string
+}
+
+// signerState is what the state file keeps of the amounts signed today
+type signerState struct {
+	Day    string                         `json:"day"`
+	Signed map[string]map[string]*big.Int `json:"signed"`
+}
+
+// UseStateFile loads the amounts signed today from path and keeps them there after each reservation
+func (signer *LocalSigner) UseStateFile(path string) error {
+	signer.mu.Lock()
+	defer signer.mu.Unlock()
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if len(data) != 0 {
+		var state signerState
+		if err = json.Unmarshal(data, &state); err != nil {
+			return ge.Wrap(ge.New("failed to parse signer state file"), err)
+		}
+		signer.day, signer.signed = state.Day, state.Signed
+	}
+	signer.stateFile = path
+	return nil
+}
+
+// saveState replaces the state file with the amounts signed today, the caller holds mu
+func (signer *LocalSigner) saveState() error {
+	if signer.stateFile == "" {
+		return nil
+	}
+	data, err := json.Marshal(signerState{Day: signer.day, Signed: signer.signed})
+	if err != nil {
+		return err
+	}
+	temp := signer.stateFile + ".tmp"
+	if err = os.WriteFile(temp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(temp, signer.stateFile)
+}
+
+func NewLocalSigner(assets *Assets, db *DB, saltCipher crypto.Cipher, macKeyring *crypto.KeyRing, policy SignerPolicy) *LocalSigner {
+	return &LocalSigner{
+		assets:     assets,
+		db:         db,
+		saltCipher: saltCipher,
+		macKeyring: macKeyring,
+		policy:     policy,
+	}
+}
+
+// signerTerms encodes the invoice fields a sweep depends on
+func signerTerms(inv *Invoice, salt []byte) []byte {
+	fields := []string{inv.ID, inv.Asset, inv.WalletAddress, inv.Recipient, inv.Beneficiary, inv.TreasuryAddress, "", "", "", "", string(salt), string(inv.SplitGasRule)}
+	if inv.Fee != nil {
+		fields[6] = inv.Fee.Address
+		fields[7] = strconv.FormatInt(inv.Fee.BasisPoints, 10)
+		if inv.Fee.Flat != nil && inv.Fee.Flat.Sign() != 0 {
+			fields[8] = inv.Fee.Flat.String()
+		}
+	}
+	if inv.WalletIndex != nil {
+		fields[9] = strconv.FormatInt(*inv.WalletIndex, 10)
+	}
+	for _, share := range inv.Split {
+		fields = append(fields, share.Address, strconv.FormatInt(share.BasisPoints, 10))
+	}
+	var terms []byte
+	for _, field := range fields {
+		terms = binary.AppendUvarint(terms, uint64(len(field)))
+		terms = append(terms, field...)
+	}
+	return terms
+}
+
+func (signer *LocalSigner) PrepareInvoice(ctx context.Context, inv *Invoice) (err error) {
+
+	asset := signer.assets.Get(inv.Asset)
+	if asset == nil {
+		return ge.New("asset is not supported")
+	}
+
+	var salt []byte
+	inv.EncryptedSalt = nil
+	if usesSalt(asset) {
+		salt = crypto.ReadN(nil, asset.Info().SaltLength)
+		if inv.EncryptedSalt, err = signer.saltCipher.Encrypt(ctx, salt); err != nil {
+			return ge.Wrap(ge.New("failed to encrypt invoice salt"), err)
+		}
+	}
+	inv.saltCipher = signer.saltCipher
+
+	inv.WalletAddress = ""
+	if err = asset.PrepareInvoice(ctx, inv); err != nil {
+		return err
+	}
+	inv.SignerMAC = signer.macKeyring.Sum(signerTerms(inv, salt))
+	return nil
+}
+
+type BackfillSignerMACsParams struct {
+	// After resumes the backfill after this invoice id
+	After     string
+	BatchSize int
+	// Progress is called after each batch
+	Progress func(BackfillSignerMACsProgress)
+}
+
+type BackfillSignerMACsProgress struct {
+	Processed int
+	Summed    int
+	// Mismatched invoices do not derive their stored wallet and are left without a mac
+	Mismatched int
+	LastID     string
+}
+
+// BackfillSignerMACs sums the terms of the invoices prepared before the signer or recovered from backups taken before it,
+// each only once its wallet is re-derived from its salt
+func (signer *LocalSigner) BackfillSignerMACs(ctx context.Context, params BackfillSignerMACsParams) (progress BackfillSignerMACsProgress, err error) {
+
+	if params.BatchSize <= 0 {
+		params.BatchSize = 500
+	}
+
+	progress.LastID = params.After
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return progress, err
+		}
+
+		var batch []*Invoice
+		batch, err = signer.db.ListInvoicesWithoutSignerMAC(ctx, progress.LastID, params.BatchSize)
+		if err != nil {
+			return progress, ge.Wrap(ge.New("failed to list invoices"), err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, inv := range batch {
+			var mac []byte
+			if mac, err = signer.backfillMAC(ctx, inv); err != nil {
+				return progress, ge.Wrap(ge.Detail(ge.New("failed to sum invoice terms"), ge.D{"invoice": inv.ID}), err)
+			}
+			if mac == nil {
+				progress.Mismatched++
+				slog.Warn("invoice wallet does not derive from its salt", slog.String("invoice", inv.ID))
+				continue
+			}
+			if err = signer.db.SetInvoiceSignerMAC(ctx, inv.ID, mac); err != nil {
+				return progress, ge.Wrap(ge.Detail(ge.New("failed to set invoice signer mac"), ge.D{"invoice": inv.ID}), err)
+			}
+			progress.Summed++
+		}
+
+		progress.Processed += len(batch)
+		progress.LastID = batch[len(batch)-1].ID
+
+		if params.Progress != nil {
+			params.Progress(progress)
+		}
+	}
+
+	if progress.Mismatched > 0 {
+		err = ge.Detail(ge.New("some invoice wallets do not derive from their salts"), ge.D{"count": progress.Mismatched})
+	}
+
+	return progress, err
+}
+
+// backfillMAC sums the terms of an invoice, nil when its wallet is not the one its salt derives
+func (signer *LocalSigner) backfillMAC(ctx context.Context, inv *Invoice) ([]byte, error) {
+
+	asset := signer.assets.Get(inv.Asset)
+	if asset == nil {
+		return nil, ge.New("asset is not supported")
+	}
+
+	var salt []byte
+	if len(inv.EncryptedSalt) != 0 {
+		var err error
+		if salt, err = signer.saltCipher.Decrypt(ctx, inv.EncryptedSalt); err != nil {
+			return nil, ge.Wrap(ge.New("failed to decrypt invoice salt"), err)
+		}
+	}
+
+	stored := inv.WalletAddress
+	inv.saltCipher = signer.saltCipher
+	inv.WalletAddress = ""
+	if err := asset.PrepareInvoice(ctx, inv); err != nil {
+		return nil, ge.Wrap(ge.New("failed to re-derive invoice wallet"), err)
+	}
+	if inv.WalletAddress != stored {
+		return nil, nil
+	}
+
+	return signer.macKeyring.Sum(signerTerms(inv, salt)), nil
+}
+
+func (signer *LocalSigner) SignSweep(ctx context.Context, invoiceID string, unsigned []byte) ([]byte, error) {
+
+	inv, err := signer.db.GetInvoice(ctx, invoiceID, "", true)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to get invoice"), err)
+	}
+	if inv == nil {
+		return nil, ge.New("invoice not found")
+	}
+	inv.saltCipher = signer.saltCipher
+
+	var salt []byte
+	if len(inv.EncryptedSalt) != 0 {
+		if salt, err = signer.saltCipher.Decrypt(ctx, inv.EncryptedSalt); err != nil {
+			return nil, ge.Wrap(ge.New("failed to decrypt invoice salt"), err)
+		}
+	}
+	if !signer.macKeyring.Verify(signerTerms(inv, salt), inv.SignerMAC) {
+		return nil, ge.Detail(ge.New("invoice terms do not match the signer mac"), ge.D{"invoice": inv.ID})
+	}
+
+	asset := signer.assets.Get(inv.Asset)
+	if asset == nil {
+		return nil, ge.New("asset is not supported")
+	}
+	sweepSigner, ok := asset.(SweepSigner)
+	if !ok {
+		return nil, ge.Detail(ge.New("asset can not sign sweeps apart"), ge.D{"asset": inv.Asset})
+	}
+
+	switch inv.Status() {
+	case InvoiceStatusExpired, InvoiceStatusCanceled, InvoiceStatusFilled, InvoiceStatusCheckout, InvoiceStatusReleased, InvoiceStatusRefunded:
+	default:
+		return nil, ErrInvalidInvoiceStatus
+	}
+
+	sweep, err := sweepSigner.InspectSweep(unsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation := inv.ID
+	switch {
+	case strings.EqualFold(sweep.To, inv.Recipient), strings.EqualFold(sweep.To, inv.Beneficiary),
+		inv.TreasuryAddress != "" && strings.EqualFold(sweep.To, inv.TreasuryAddress):
+	case inv.Fee != nil && strings.EqualFold(sweep.To, inv.Fee.Address):
+		if err = signer.checkFeeLeg(ctx, inv, asset, sweep.Amount); err != nil {
+			return nil, err
+		}
+		// the fee leg must not replace the sweep reservation
+		reservation = inv.ID + "/fee"
+	case inv.splitShare(sweep.To) > 0:
+		share := inv.splitShare(sweep.To)
+		if err = signer.checkSplitLeg(ctx, inv, asset, share, sweep.Amount); err != nil {
+			return nil, err
+		}
+		reservation = inv.ID + "/split/" + strconv.Itoa(share)
+	default:
+		return nil, ge.Detail(ge.New("sweep destination is not an invoice recipient, its beneficiary, its treasury or its fee address"), ge.D{"to": sweep.To})
+	}
+
+	release, err := signer.reserve(inv.Asset, reservation, sweep.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := sweepSigner.SignSweep(ctx, inv, unsigned)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	slog.Info("sweep signed", slog.String("invoice", inv.ID), slog.String("asset", inv.Asset), slog.String("to", sweep.To), slog.String("amount", sweep.Amount.String()))
+
+	return signed, nil
+}
+
+// checkFeeLeg bounds a fee leg by the fee owed on the wallet balance
+func (signer *LocalSigner) checkFeeLeg(ctx context.Context, inv *Invoice, asset Asset, amount *big.Int) error {
+	balance, err := asset.GetBalance(ctx, inv)
+	if err != nil {
+		return ge.Wrap(ge.New("failed to get wallet balance"), err)
+	}
+	fee := inv.FeeLeg(inv.Destination(), balance)
+	if fee == nil {
+		return ge.New("invoice owes no fee leg")
+	}
+	if amount.Cmp(fee) > 0 {
+		return ge.Detail(ge.New("fee leg is over the invoice fee"), ge.D{"amount": amount, "fee": fee})
+	}
+	return nil
+}
+
+// checkSplitLeg bounds a split leg by its share or its planned leg
+func (signer *LocalSigner) checkSplitLeg(ctx context.Context, inv *Invoice, asset Asset, share int, amount *big.Int) error {
+	if !strings.EqualFold(inv.Destination(), inv.Recipient) {
+		return ge.New("invoice does not pay its split recipients")
+	}
+	if inv.Split[share].TxHash != "" {
+		return ge.Detail(ge.New("split leg is sent already"), ge.D{"tx": inv.Split[share].TxHash})
+	}
+	bound := inv.Split[share].Amount
+	if bound == nil {
+		balance, err := asset.GetBalance(ctx, inv)
+		if err != nil {
+			return ge.Wrap(ge.New("failed to get wallet balance"), err)
+		}
+		bound = new(big.Int).Mul(balance, big.NewInt(inv.Split[share].BasisPoints))
+		bound.Quo(bound, big.NewInt(MaxFeeBasisPoints))
+	}
+	if amount.Cmp(bound) > 0 {
+		return ge.Detail(ge.New("split leg is over the recipient share"), ge.D{"amount": amount, "share": bound})
+	}
+	return nil
+}
+
+// reserve checks an amount against the asset limits, release undoes it
+func (signer *LocalSigner) reserve(asset, invoiceID string, amount *big.Int) (release func(), err error) {
+
+	limit, ok := signer.policy[asset]
+	if !ok {
+		return nil, ge.Detail(ge.New("no signer policy for the asset"), ge.D{"asset": asset})
+	}
+	if amount.Cmp(limit.MaxAmount) > 0 {
+		return nil, ge.Detail(ge.New("sweep amount is over the signer limit"), ge.D{"amount": amount, "max": limit.MaxAmount})
+	}
+
+	signer.mu.Lock()
+	defer signer.mu.Unlock()
+
+	if today := time.Now().UTC().Format(time.DateOnly); signer.day != today || signer.signed == nil {
+		signer.day = today
+		signer.signed = map[string]map[string]*big.Int{}
+	}
+	signed := signer.signed[asset]
+	if signed == nil {
+		signed = map[string]*big.Int{}
+		signer.signed[asset] = signed
+	}
+
+	if limit.MaxDailyAmount != nil {
+		total := new(big.Int).Set(amount)
+		for id, previous := range signed {
+			if id != invoiceID {
+				total.Add(total, previous)
+			}
+		}
+		if total.Cmp(limit.MaxDailyAmount) > 0 {
+			return nil, ge.Detail(ge.New("sweep amount is over the signer daily limit"), ge.D{"total": total, "max": limit.MaxDailyAmount})
+		}
+	}
+
+	day, previous := signer.day, signed[invoiceID]
+	signed[invoiceID] = amount
+	undo := func() {
+		if previous == nil {
+			delete(signed, invoiceID)
+		} else {
+			signed[invoiceID] = previous
+		}
+	}
+
+	if err = signer.saveState(); err != nil {
+		undo()
+		return nil, ge.Wrap(ge.New("failed to save signer state"), err)
+	}
+
+	return func() {
+		signer.mu.Lock()
+		defer signer.mu.Unlock()
+		if signer.day != day {
+			return
+		}
+		undo()
+		if err := signer.saveState(); err != nil {
+			slog.Warn("failed to save signer state", slog.String("error", err.Error()))
+		}
+	}, nil
+}
@@ -0,0 +1,36 @@
+package cpg
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+)
+
+func TestSignerDailyLimitStateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signer.state")
+	policy := SignerPolicy{"eth": {MaxAmount: big.NewInt(60), MaxDailyAmount: big.NewInt(100)}}
+
+	signer := NewLocalSigner(nil, nil, nil, nil, policy)
+	if err := signer.UseStateFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.reserve("eth", "a", big.NewInt(60)); err != nil {
+		t.Fatal(err)
+	}
+	release, err := signer.reserve("eth", "b", big.NewInt(30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+
+	restarted := NewLocalSigner(nil, nil, nil, nil, policy)
+	if err = restarted.UseStateFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = restarted.reserve("eth", "c", big.NewInt(50)); err == nil {
+		t.Fatal("restart reset the daily limit")
+	}
+	if _, err = restarted.reserve("eth", "c", big.NewInt(40)); err != nil {
+		t.Fatalf("released amount still counted: %v", err)
+	}
+}
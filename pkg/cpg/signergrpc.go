@@ -0,0 +1,201 @@
+package cpg
+
+import (
+	"context"
+	"cpg/pkg/proto"
+	"crypto/tls"
+	"crypto/x509"
+	"github.com/itsabgr/ge"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"math/big"
+	"os"
+	"strings"
+)
+
+type signerGRPCServer struct {
+	signer *LocalSigner
+	proto.UnimplementedCPGSignerServer
+}
+
+// LoadSignerClientTLS returns the tls config of a tcp signer connection
+func LoadSignerClientTLS(caFile, certFile, keyFile string) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS13}
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// LoadSignerServerTLS returns the tls config of a tcp signer
+func LoadSignerServerTLS(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS13, Certificates: []tls.Certificate{cert}}
+	if clientCAFile != "" {
+		if config.ClientCAs, err = loadCertPool(clientCAFile); err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, ge.Detail(ge.New("no certificate found"), ge.D{"file": path})
+	}
+	return pool, nil
+}
+
+func NewSignerGRPCServer(signer *LocalSigner) proto.CPGSignerServer {
+	return signerGRPCServer{signer: signer}
+}
+
+func (serv signerGRPCServer) PrepareInvoice(ctx context.Context, input *proto.SignerPrepareInvoiceInput) (*proto.SignerPrepareInvoiceOutput, error) {
+
+	inv := &Invoice{
+		ID:                input.GetInvoiceId(),
+		Recipient:         input.GetRecipient(),
+		Beneficiary:       input.GetBeneficiary(),
+		Asset:             input.GetAsset(),
+		DerivationVersion: int(input.GetDerivationVersion()),
+		TreasuryAddress:   input.GetTreasuryAddress(),
+	}
+	if input.GetFeeAddress() != "" {
+		inv.Fee = &PlatformFee{Address: input.GetFeeAddress(), BasisPoints: input.GetFeeBasisPoints()}
+		if input.GetFeeFlat() != "" {
+			inv.Fee.Flat = new(big.Int)
+			if _, ok := inv.Fee.Flat.SetString(input.GetFeeFlat(), 10); !ok {
+				return nil, ge.New("invalid flat fee")
+			}
+		}
+	}
+	if len(input.GetSplitBasisPoints()) != len(input.GetSplitAddresses()) {
+		return nil, ge.New("split addresses and shares do not match")
+	}
+	for i, address := range input.GetSplitAddresses() {
+		inv.Split = append(inv.Split, SplitShare{Address: address, BasisPoints: input.GetSplitBasisPoints()[i]})
+	}
+	inv.SplitGasRule = SplitGasRule(input.GetSplitGasRule())
+	if _, ok := inv.MinAmount.SetString(input.GetMinAmount(), 10); !ok || inv.MinAmount.Cmp(big.NewInt(0)) <= 0 {
+		return nil, ge.New("invalid min amount")
+	}
+	if input.GetHasWalletIndex() {
+		index := input.GetWalletIndex()
+		inv.WalletIndex = &index
+	}
+
+	if err := serv.signer.PrepareInvoice(ctx, inv); err != nil {
+		return nil, err
+	}
+
+	return &proto.SignerPrepareInvoiceOutput{
+		EncryptedSalt: inv.EncryptedSalt,
+		WalletAddress: inv.WalletAddress,
+		SignerMac:     inv.SignerMAC,
+	}, nil
+}
+
+func (serv signerGRPCServer) SignSweep(ctx context.Context, input *proto.SignSweepInput) (*proto.SignSweepOutput, error) {
+
+	signed, err := serv.signer.SignSweep(ctx, input.GetInvoiceId(), input.GetUnsignedTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SignSweepOutput{SignedTx: signed}, nil
+}
+
+type signerClient struct {
+	client proto.CPGSignerClient
+}
+
+// DialSigner connects to cpg-signer at a unix:/path target, or a host:port target over tls
+func DialSigner(target, apiKey string, tlsConfig *tls.Config) (Signer, *grpc.ClientConn, error) {
+	transport := insecure.NewCredentials()
+	if !strings.HasPrefix(target, "unix:") {
+		if tlsConfig == nil {
+			return nil, nil, ge.Detail(ge.New("a tcp signer needs tls"), ge.D{"target": target})
+		}
+		transport = credentials.NewTLS(tlsConfig)
+	}
+	options := []grpc.DialOption{grpc.WithTransportCredentials(transport)}
+	if apiKey != "" {
+		options = append(options, grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(metadata.AppendToOutgoingContext(ctx, APIKeyHeader, apiKey), method, req, reply, cc, opts...)
+		}))
+	}
+	conn, err := grpc.NewClient(target, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return signerClient{client: proto.NewCPGSignerClient(conn)}, conn, nil
+}
+
+func (c signerClient) PrepareInvoice(ctx context.Context, inv *Invoice) error {
+
+	input := &proto.SignerPrepareInvoiceInput{
+		InvoiceId:         inv.ID,
+		Asset:             inv.Asset,
+		MinAmount:         inv.MinAmount.String(),
+		Recipient:         inv.Recipient,
+		Beneficiary:       inv.Beneficiary,
+		DerivationVersion: int32(inv.DerivationVersion),
+		TreasuryAddress:   inv.TreasuryAddress,
+	}
+	if inv.Fee != nil {
+		input.FeeAddress = inv.Fee.Address
+		input.FeeBasisPoints = inv.Fee.BasisPoints
+		if inv.Fee.Flat != nil {
+			input.FeeFlat = inv.Fee.Flat.String()
+		}
+	}
+	for _, share := range inv.Split {
+		input.SplitAddresses = append(input.SplitAddresses, share.Address)
+		input.SplitBasisPoints = append(input.SplitBasisPoints, share.BasisPoints)
+	}
+	input.SplitGasRule = string(inv.SplitGasRule)
+	if inv.WalletIndex != nil {
+		input.HasWalletIndex = true
+		input.WalletIndex = *inv.WalletIndex
+	}
+
+	output, err := c.client.PrepareInvoice(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	inv.EncryptedSalt = output.GetEncryptedSalt()
+	inv.WalletAddress = output.GetWalletAddress()
+	inv.SignerMAC = output.GetSignerMac()
+	return nil
+}
+
+func (c signerClient) SignSweep(ctx context.Context, invoiceID string, unsigned []byte) ([]byte, error) {
+	output, err := c.client.SignSweep(ctx, &proto.SignSweepInput{InvoiceId: invoiceID, UnsignedTx: unsigned})
+	if err != nil {
+		return nil, err
+	}
+	return output.GetSignedTx(), nil
+}
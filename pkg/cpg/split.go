@@ -0,0 +1,229 @@
+package cpg
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MaxSplitRecipients bounds the recipients of a split invoice
+const MaxSplitRecipients = 16
+
+// SplitGasRule decides who bears the tx fees of a split sweep
+type SplitGasRule string
+
+const (
+	// SplitGasProportional takes the tx fees off the balance before it is split
+	SplitGasProportional SplitGasRule = "proportional"
+	// SplitGasEach makes every recipient bear the fee of its own transfer
+	SplitGasEach SplitGasRule = "each"
+	// SplitGasFirst makes the first recipient bear every tx fee
+	SplitGasFirst SplitGasRule = "first"
+)
+
+func (rule SplitGasRule) valid() bool {
+	switch rule {
+	case SplitGasProportional, SplitGasEach, SplitGasFirst:
+		return true
+	}
+	return false
+}
+
+// SplitShare is a recipient of a split invoice with its share in basis points
+type SplitShare struct {
+	Address     string   `json:"address"`
+	BasisPoints int64    `json:"basis_points"`
+	Amount      *big.Int `json:"amount,omitempty"`
+	TxHash      string   `json:"tx_hash,omitempty"`
+}
+
+// SplitLeg is the transfer to a split recipient, Share indexes the invoice Split
+type SplitLeg struct {
+	Share  int
+	To     string
+	Amount *big.Int
+	TxHash string
+	// TxFee is the fee of the leg tx, the sweep TxFee when nil
+	TxFee *big.Int
+}
+
+// validateSplit checks the shares sum to the whole payment
+func validateSplit(split []SplitShare, beneficiary string) error {
+	if len(split) < 2 || len(split) > MaxSplitRecipients {
+		return ge.Detail(ge.New("split invoice needs 2 to 16 recipients"), ge.D{"recipients": len(split)})
+	}
+	total := int64(0)
+	seen := map[string]bool{}
+	for _, share := range split {
+		if share.Address == "" || share.BasisPoints <= 0 || share.BasisPoints > MaxFeeBasisPoints {
+			return ge.Detail(ge.New("split share needs an address and positive basis points"), ge.D{"address": share.Address})
+		}
+		address := strings.ToLower(share.Address)
+		if seen[address] || strings.EqualFold(share.Address, beneficiary) {
+			return ge.Detail(ge.New("split recipients must be distinct and not the beneficiary"), ge.D{"address": share.Address})
+		}
+		seen[address] = true
+		total += share.BasisPoints
+	}
+	if total != MaxFeeBasisPoints {
+		return ge.Detail(ge.New("split shares must sum to 10000 basis points"), ge.D{"total": total})
+	}
+	return nil
+}
+
+// newSplit checks the split of a new invoice
+func newSplit(split []SplitShare, recipient, beneficiary string) ([]SplitShare, error) {
+	if err := validateSplit(split, beneficiary); err != nil {
+		return nil, err
+	}
+	if recipient != "" && recipient != split[0].Address {
+		return nil, ge.New("recipient of a split invoice must be its first split recipient")
+	}
+	return splitTerms(split), nil
+}
+
+// splitShare returns the index of the split recipient with the address, -1 if there is none
+func (inv *Invoice) splitShare(address string) int {
+	for i, share := range inv.Split {
+		if strings.EqualFold(share.Address, address) {
+			return i
+		}
+	}
+	return -1
+}
+
+// splitTerms copies the recipients and shares of a split without its legs
+func splitTerms(split []SplitShare) []SplitShare {
+	if len(split) == 0 {
+		return nil
+	}
+	terms := make([]SplitShare, len(split))
+	for i, share := range split {
+		terms[i] = SplitShare{Address: share.Address, BasisPoints: share.BasisPoints}
+	}
+	return terms
+}
+
+// SplitLegs returns the unsent legs of a split sweep, the first call plans them and later calls keep them
+func (inv *Invoice) SplitLegs(to string, balance, platformFee, txFee *big.Int) ([]SplitLeg, error) {
+	if len(inv.Split) == 0 || !strings.EqualFold(to, inv.Recipient) {
+		return nil, nil
+	}
+	if inv.Split[1].Amount == nil {
+		if err := inv.planSplit(balance, platformFee, txFee); err != nil {
+			return nil, err
+		}
+	}
+	legs := make([]SplitLeg, 0, len(inv.Split)-1)
+	for i, share := range inv.Split[1:] {
+		if share.TxHash == "" {
+			legs = append(legs, SplitLeg{Share: i + 1, To: share.Address, Amount: new(big.Int).Set(share.Amount)})
+		}
+	}
+	return legs, nil
+}
+
+func (inv *Invoice) planSplit(balance, platformFee, txFee *big.Int) error {
+	pool := new(big.Int).Set(balance)
+	txs := int64(len(inv.Split))
+	if platformFee != nil {
+		pool.Sub(pool, platformFee)
+		txs++
+	}
+	switch inv.SplitGasRule {
+	case SplitGasProportional:
+		pool.Sub(pool, new(big.Int).Mul(txFee, big.NewInt(txs)))
+	case SplitGasEach:
+		if platformFee != nil {
+			// the fee leg tx comes off before the split
+			pool.Sub(pool, txFee)
+		}
+	case SplitGasFirst:
+	default:
+		return ge.Detail(ge.New("unknown split gas rule"), ge.D{"rule": inv.SplitGasRule})
+	}
+	if pool.Sign() <= 0 {
+		return ge.Detail(ge.New("tx fees and platform fee overcome the split balance"), ge.D{"balance": balance})
+	}
+	amounts := make([]*big.Int, len(inv.Split))
+	for i := 1; i < len(inv.Split); i++ {
+		amounts[i] = new(big.Int).Mul(pool, big.NewInt(inv.Split[i].BasisPoints))
+		amounts[i].Quo(amounts[i], big.NewInt(MaxFeeBasisPoints))
+		if inv.SplitGasRule == SplitGasEach {
+			amounts[i].Sub(amounts[i], txFee)
+		}
+		if amounts[i].Sign() <= 0 {
+			return ge.Detail(ge.New("split share does not cover its tx fee"), ge.D{"address": inv.Split[i].Address})
+		}
+	}
+	for i := 1; i < len(inv.Split); i++ {
+		inv.Split[i].Amount = amounts[i]
+	}
+	return nil
+}
+
+// ReportSweep records a TryFlush sweep and its legs on the invoice
+func (inv *Invoice) ReportSweep(to string, result SweepResult) {
+	inv.sweeps = append(inv.sweeps, reportedSweep{to: to, result: result})
+	if result.FeeTxHash != "" {
+		inv.FeeAmount, inv.FeeTxHash = result.PlatformFee, result.FeeTxHash
+	}
+	if inv.TreasuryAddress != "" && result.TxHash != "" && strings.EqualFold(to, inv.TreasuryAddress) {
+		inv.treasurySweep = result
+	}
+	if len(inv.Split) == 0 || !strings.EqualFold(to, inv.Recipient) {
+		return
+	}
+	for _, leg := range result.Split {
+		if leg.TxHash != "" {
+			inv.Split[leg.Share].TxHash = leg.TxHash
+		}
+	}
+	if result.TxHash != "" {
+		inv.Split[0].Amount, inv.Split[0].TxHash = result.Amount, result.TxHash
+	}
+}
+
+func (inv *Invoice) splitState() string {
+	if len(inv.Split) == 0 {
+		return ""
+	}
+	return string(ge.Must(json.Marshal(inv.Split)))
+}
+
+// recordSplit stores the planned and sent legs of a split sweep
+func (cpg *CPG) recordSplit(ctx context.Context, inv *Invoice, previousState string) {
+	if inv.splitState() == previousState {
+		return
+	}
+	var previous []SplitShare
+	if previousState != "" {
+		ge.Throw(json.Unmarshal([]byte(previousState), &previous))
+	}
+	if err := cpg.db.SetInvoiceSplit(ctx, inv.ID, inv.Split); err != nil {
+		slog.Error("failed to record split legs", slog.String("invoice", inv.ID), slog.String("error", err.Error()))
+	}
+	for i, share := range inv.Split {
+		if share.TxHash == "" || i < len(previous) && previous[i].TxHash == share.TxHash {
+			continue
+		}
+		if err := cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+			InvoiceID: inv.ID,
+			Kind:      InvoiceEventSplitTransfer,
+			Asset:     inv.Asset,
+			TxHash:    share.TxHash,
+			Detail: map[string]string{
+				"to":           share.Address,
+				"share":        strconv.Itoa(i),
+				"basis_points": strconv.FormatInt(share.BasisPoints, 10),
+				"amount":       share.Amount.String(),
+			},
+		}); err != nil {
+			slog.Error("failed to record split transfer", slog.String("invoice", inv.ID), slog.String("error", err.Error()))
+		}
+	}
+}
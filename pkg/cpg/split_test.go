@@ -0,0 +1,157 @@
+package cpg
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+)
+
+func testSplit() []SplitShare {
+	return []SplitShare{{Address: "0xA", BasisPoints: 5000}, {Address: "0xB", BasisPoints: 3000}, {Address: "0xC", BasisPoints: 2000}}
+}
+
+func TestValidateSplit(t *testing.T) {
+	many := make([]SplitShare, MaxSplitRecipients+1)
+	for i := range many {
+		many[i] = SplitShare{Address: "0x" + string(rune('a'+i)), BasisPoints: 1}
+	}
+	many[0].BasisPoints = MaxFeeBasisPoints - MaxSplitRecipients
+	for _, tc := range []struct {
+		name  string
+		split []SplitShare
+		valid bool
+	}{
+		{"valid", testSplit(), true},
+		{"two recipients", []SplitShare{{Address: "0xA", BasisPoints: 1}, {Address: "0xB", BasisPoints: 9999}}, true},
+		{"max recipients", append([]SplitShare{{Address: "0xz", BasisPoints: many[0].BasisPoints + 1}}, many[2:]...), true},
+		{"too many recipients", many, false},
+		{"one recipient", []SplitShare{{Address: "0xA", BasisPoints: MaxFeeBasisPoints}}, false},
+		{"no address", []SplitShare{{Address: "0xA", BasisPoints: 5000}, {BasisPoints: 5000}}, false},
+		{"zero share", []SplitShare{{Address: "0xA", BasisPoints: MaxFeeBasisPoints}, {Address: "0xB"}}, false},
+		{"negative share", []SplitShare{{Address: "0xA", BasisPoints: 10001}, {Address: "0xB", BasisPoints: -1}}, false},
+		{"under the whole payment", []SplitShare{{Address: "0xA", BasisPoints: 5000}, {Address: "0xB", BasisPoints: 4999}}, false},
+		{"over the whole payment", []SplitShare{{Address: "0xA", BasisPoints: 5000}, {Address: "0xB", BasisPoints: 5001}}, false},
+		{"repeated recipient", []SplitShare{{Address: "0xA", BasisPoints: 5000}, {Address: "0xa", BasisPoints: 5000}}, false},
+		{"beneficiary", []SplitShare{{Address: "0xA", BasisPoints: 5000}, {Address: "0xbeneficiary", BasisPoints: 5000}}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := validateSplit(tc.split, "0xBeneficiary"); (err == nil) != tc.valid {
+				t.Fatalf("validate split: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewSplit(t *testing.T) {
+	sent := testSplit()
+	sent[1].Amount, sent[1].TxHash = big.NewInt(1), "0xs"
+	split, err := newSplit(sent, "0xA", "0xBeneficiary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(split, testSplit()) {
+		t.Fatalf("split %+v keeps its legs", split)
+	}
+	if _, err = newSplit(testSplit(), "", "0xBeneficiary"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = newSplit(testSplit(), "0xB", "0xBeneficiary"); err == nil {
+		t.Fatal("recipient not the first split recipient")
+	}
+}
+
+func TestSplitGasRules(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		rule        SplitGasRule
+		balance     int64
+		platformFee int64
+		want        []int64
+	}{
+		// the tx fee is 10 and the split shares are 5000, 3000 and 2000 basis points
+		{"proportional", SplitGasProportional, 10000, 0, []int64{2991, 1994}},
+		{"proportional with fee", SplitGasProportional, 10000, 100, []int64{2958, 1972}},
+		{"each", SplitGasEach, 10000, 0, []int64{2990, 1990}},
+		{"each with fee", SplitGasEach, 10000, 100, []int64{2957, 1968}},
+		{"first", SplitGasFirst, 10000, 0, []int64{3000, 2000}},
+		{"first with fee", SplitGasFirst, 10000, 100, []int64{2970, 1980}},
+		{"rounds down", SplitGasFirst, 10009, 0, []int64{3002, 2001}},
+		{"unknown rule", "last", 10000, 0, nil},
+		{"tx fees overcome the balance", SplitGasProportional, 30, 0, nil},
+		{"platform fee overcomes the balance", SplitGasFirst, 100, 100, nil},
+		{"share does not cover its tx fee", SplitGasEach, 40, 0, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := &Invoice{Recipient: "0xA", Split: testSplit(), SplitGasRule: tc.rule}
+			var platformFee *big.Int
+			if tc.platformFee > 0 {
+				platformFee = big.NewInt(tc.platformFee)
+			}
+			legs, err := inv.SplitLegs("0xA", big.NewInt(tc.balance), platformFee, big.NewInt(10))
+			if tc.want == nil {
+				if err == nil {
+					t.Fatalf("legs %+v", legs)
+				}
+				if inv.Split[1].Amount != nil || inv.Split[2].Amount != nil {
+					t.Fatal("failed plan kept amounts")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(legs) != len(tc.want) {
+				t.Fatalf("%d legs, not %d", len(legs), len(tc.want))
+			}
+			for i, leg := range legs {
+				if leg.Share != i+1 || leg.To != inv.Split[i+1].Address || leg.Amount.Cmp(big.NewInt(tc.want[i])) != 0 {
+					t.Fatalf("leg %d %+v, not %d", i, leg, tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitLegs(t *testing.T) {
+	inv := &Invoice{Recipient: "0xA", Beneficiary: "0xBeneficiary", Split: testSplit(), SplitGasRule: SplitGasFirst}
+	if legs, err := inv.SplitLegs("0xBeneficiary", big.NewInt(10000), nil, big.NewInt(10)); legs != nil || err != nil {
+		t.Fatalf("refund legs %+v: %v", legs, err)
+	}
+	if _, err := inv.SplitLegs("0xa", big.NewInt(10000), nil, big.NewInt(10)); err != nil {
+		t.Fatal(err)
+	}
+
+	// later sweeps keep the planned amounts and skip the sent legs
+	inv.Split[1].TxHash = "0xs"
+	legs, err := inv.SplitLegs("0xA", big.NewInt(500), nil, big.NewInt(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(legs) != 1 || legs[0].Share != 2 || legs[0].Amount.Cmp(big.NewInt(2000)) != 0 {
+		t.Fatalf("legs %+v", legs)
+	}
+
+	// the legs do not share the invoice amounts
+	legs[0].Amount.SetInt64(1)
+	if inv.Split[2].Amount.Cmp(big.NewInt(2000)) != 0 {
+		t.Fatalf("split amount changed to %s", inv.Split[2].Amount)
+	}
+
+	if legs, err = (&Invoice{Recipient: "0xA"}).SplitLegs("0xA", big.NewInt(10000), nil, big.NewInt(10)); legs != nil || err != nil {
+		t.Fatalf("legs of an invoice without split %+v: %v", legs, err)
+	}
+}
+
+func TestSplitGasRuleValid(t *testing.T) {
+	for rule, valid := range map[SplitGasRule]bool{
+		SplitGasProportional: true,
+		SplitGasEach:         true,
+		SplitGasFirst:        true,
+		"":                   false,
+		"Each":               false,
+	} {
+		if rule.valid() != valid {
+			t.Fatalf("rule %q valid %v", rule, !valid)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package cpg
+
+import (
+	"context"
+	"cpg/pkg/backupcode"
+	"cpg/pkg/crypto"
+	"github.com/itsabgr/ge"
+	"time"
+)
+
+type EmergencySweepParams struct {
+	// To is a safe address to sweep to instead of the invoice destination
+	To     string
+	DryRun bool
+}
+
+type EmergencySweepResult struct {
+	InvoiceID     string
+	Asset         string
+	WalletAddress string
+	Status        InvoiceStatus
+	// InferredFill is set when a pending invoice holds its min amount
+	InferredFill bool
+	Destination  string
+	Sweep        SweepResult
+}
+
+// EmergencySweep moves the wallet balance of an invoice backup without the database
+func EmergencySweep(ctx context.Context, assets *Assets, backupKeyring *crypto.KeyRing, saltCipher crypto.Cipher, backup []byte, params EmergencySweepParams) (result EmergencySweepResult, err error) {
+
+	if backup, err = backupcode.Decode(backup); err != nil {
+		return result, ge.Wrap(ge.New("failed to decode backup"), err)
+	}
+
+	inv, _, err := OpenBackup(backupKeyring, backup)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to open backup"), err)
+	}
+	inv.saltCipher = saltCipher
+
+	result.InvoiceID = inv.ID
+	result.Asset = inv.Asset
+
+	asset := assets.Get(inv.Asset)
+	if asset == nil {
+		return result, ge.Detail(ge.New("asset is not configured"), ge.D{"asset": inv.Asset})
+	}
+	sweeper, ok := asset.(Sweeper)
+	if !ok {
+		return result, ge.Detail(ge.New("asset can not sweep"), ge.D{"asset": inv.Asset})
+	}
+
+	backupAddress := inv.WalletAddress
+	if err = asset.PrepareInvoice(ctx, inv); err != nil {
+		return result, ge.Wrap(ge.New("failed to derive invoice wallet"), err)
+	}
+	result.WalletAddress = inv.WalletAddress
+	if backupAddress != "" && backupAddress != inv.WalletAddress {
+		return result, ge.Detail(ge.New("re-derived wallet address does not match the backup"), ge.D{"backup": backupAddress, "derived": inv.WalletAddress})
+	}
+
+	balance, err := asset.GetBalance(ctx, inv)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to get wallet balance"), err)
+	}
+	result.Sweep.Balance = balance
+
+	if inv.Status() == InvoiceStatusPending && balance.Cmp(&inv.MinAmount) >= 0 {
+		now := time.Now()
+		inv.FillAt = &now
+		result.InferredFill = true
+	}
+	result.Status = inv.Status()
+
+	result.Destination = params.To
+	if result.Destination == "" {
+		// there is no treasury ledger without the database
+		result.Destination = inv.payee()
+	}
+
+	if result.Sweep, err = sweeper.Sweep(ctx, inv, result.Destination, params.DryRun); err != nil {
+		if result.Sweep.Balance == nil {
+			result.Sweep.Balance = balance
+		}
+		return result, err
+	}
+
+	return result, nil
+}
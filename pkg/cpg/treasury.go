@@ -0,0 +1,406 @@
+package cpg
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/itsabgr/ge"
+	"log/slog"
+	"maps"
+	"math/big"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// AssetTreasury is the treasury mode of an asset, the balance over HotLimit is forwarded to ColdAddress
+type AssetTreasury struct {
+	SettleThreshold       *big.Int `json:"settle_threshold"`
+	SettleIntervalSeconds int64    `json:"settle_interval_seconds"`
+	HotLimit              *big.Int `json:"hot_limit"`
+	ColdAddress           string   `json:"cold_address"`
+}
+
+// TreasuryConfig maps asset names to their treasury mode
+type TreasuryConfig map[string]AssetTreasury
+
+func ParseTreasuryConfig(data []byte) (TreasuryConfig, error) {
+	config := TreasuryConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	for asset, treasury := range config {
+		if treasury.SettleThreshold == nil && treasury.SettleIntervalSeconds == 0 {
+			return nil, ge.Detail(ge.New("treasury needs a settle threshold or interval"), ge.D{"asset": asset})
+		}
+		if treasury.SettleThreshold != nil && treasury.SettleThreshold.Sign() <= 0 || treasury.SettleIntervalSeconds < 0 {
+			return nil, ge.Detail(ge.New("treasury settle threshold and interval must be positive"), ge.D{"asset": asset})
+		}
+		if (treasury.HotLimit == nil) != (treasury.ColdAddress == "") || treasury.HotLimit != nil && treasury.HotLimit.Sign() < 0 {
+			return nil, ge.Detail(ge.New("treasury needs both a non-negative hot limit and a cold address or neither"), ge.D{"asset": asset})
+		}
+	}
+	return config, nil
+}
+
+// UseTreasury turns new invoices of the configured assets to treasury mode
+func (cpg *CPG) UseTreasury(config TreasuryConfig) error {
+	for asset := range config {
+		treasurer, ok := cpg.assets.Get(asset).(Treasurer)
+		if !ok || treasurer.TreasuryAddress() == "" {
+			return ge.Detail(ge.New("treasury asset has no treasury key"), ge.D{"asset": asset})
+		}
+	}
+	cpg.treasury = config
+	return nil
+}
+
+// treasuryAddress is empty without treasury mode
+func (cpg *CPG) treasuryAddress(asset string) string {
+	if _, ok := cpg.treasury[asset]; !ok {
+		return ""
+	}
+	return cpg.assets.Get(asset).(Treasurer).TreasuryAddress()
+}
+
+type TreasuryTransferKind string
+
+const (
+	// TreasuryTransferSettlement pays the unsettled credits of an account
+	TreasuryTransferSettlement TreasuryTransferKind = "settlement"
+	// TreasuryTransferForward moves the treasury balance over the hot limit to the cold address
+	TreasuryTransferForward TreasuryTransferKind = "forward"
+)
+
+// TreasuryCredit is what an account is owed from a sweep into the treasury
+type TreasuryCredit struct {
+	ID        int
+	Asset     string
+	Account   string
+	InvoiceID string
+	TxHash    string
+	Amount    *big.Int
+	CreateAt  time.Time
+}
+
+// TreasuryTransfer is a tx from the treasury, the receiver gets Amount minus Fee
+type TreasuryTransfer struct {
+	ID       string
+	Asset    string
+	Kind     TreasuryTransferKind
+	To       string
+	Amount   *big.Int
+	Fee      *big.Int
+	TxHash   string
+	Error    string
+	CreateAt time.Time
+	SendAt   *time.Time
+}
+
+// treasuryCredits divides a sweep into the treasury between the accounts of the invoice
+func treasuryCredits(inv *Invoice, sweep SweepResult) []TreasuryCredit {
+	credit := func(account string, amount *big.Int) TreasuryCredit {
+		return TreasuryCredit{Asset: inv.Asset, Account: account, InvoiceID: inv.ID, TxHash: sweep.TxHash, Amount: amount}
+	}
+	var credits []TreasuryCredit
+	rest := new(big.Int).Set(sweep.Amount)
+	if inv.Fee != nil {
+		fee := new(big.Int).Mul(rest, big.NewInt(inv.Fee.BasisPoints))
+		fee.Quo(fee, big.NewInt(MaxFeeBasisPoints))
+		if inv.Fee.Flat != nil {
+			fee.Add(fee, inv.Fee.Flat)
+		}
+		if fee.Cmp(rest) > 0 {
+			fee.Set(rest)
+		}
+		if fee.Sign() > 0 {
+			credits = append(credits, credit(inv.Fee.Address, fee))
+			rest.Sub(rest, fee)
+		}
+	}
+	if len(inv.Split) == 0 {
+		return append(credits, credit(inv.Recipient, rest))
+	}
+	first := new(big.Int).Set(rest)
+	shares := make([]TreasuryCredit, 0, len(inv.Split)-1)
+	for _, share := range inv.Split[1:] {
+		amount := new(big.Int).Mul(rest, big.NewInt(share.BasisPoints))
+		amount.Quo(amount, big.NewInt(MaxFeeBasisPoints))
+		first.Sub(first, amount)
+		shares = append(shares, credit(share.Address, amount))
+	}
+	return append(append(credits, credit(inv.Split[0].Address, first)), shares...)
+}
+
+// recordTreasurySweep credits the accounts of an invoice with its treasury sweep
+func (cpg *CPG) recordTreasurySweep(ctx context.Context, inv *Invoice) {
+	sweep := inv.treasurySweep
+	if sweep.TxHash == "" || sweep.Amount == nil {
+		return
+	}
+	credited, err := cpg.db.InsertTreasuryCredits(ctx, treasuryCredits(inv, sweep))
+	if err != nil {
+		slog.Error("failed to credit treasury sweep", slog.String("invoice", inv.ID), slog.String("tx", sweep.TxHash), slog.String("error", err.Error()))
+		return
+	}
+	if !credited {
+		return
+	}
+	if err = cpg.db.InsertInvoiceEvent(ctx, InvoiceEvent{
+		InvoiceID: inv.ID,
+		Kind:      InvoiceEventTreasuryCredit,
+		Asset:     inv.Asset,
+		TxHash:    sweep.TxHash,
+		Detail: map[string]string{
+			"treasury": inv.TreasuryAddress,
+			"amount":   sweep.Amount.String(),
+		},
+	}); err != nil {
+		slog.Error("failed to record treasury credit", slog.String("invoice", inv.ID), slog.String("error", err.Error()))
+	}
+}
+
+type SettleTreasuryParams struct {
+	Asset string
+	// Force settles every account with a balance
+	Force bool
+}
+
+type SettleTreasuryResult struct {
+	// Transfers are the settlements and the forward of the pass
+	Transfers []TreasuryTransfer
+}
+
+// SettleTreasury settles the due accounts of an asset and forwards the treasury excess
+func (cpg *CPG) SettleTreasury(ctx context.Context, params SettleTreasuryParams) (result SettleTreasuryResult, err error) {
+
+	policy, ok := cpg.treasury[params.Asset]
+	if !ok {
+		return result, ge.Detail(ge.New("asset has no treasury"), ge.D{"asset": params.Asset})
+	}
+	treasurer := cpg.assets.Get(params.Asset).(Treasurer)
+
+	if err = cpg.reconcileTreasuryTransfers(ctx, treasurer, params.Asset); err != nil {
+		return result, err
+	}
+
+	credits, err := cpg.db.ListTreasuryCredits(ctx, params.Asset, "")
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to list treasury credits"), err)
+	}
+
+	type account struct {
+		balance *big.Int
+		oldest  time.Time
+		ids     []int
+	}
+	accounts := map[string]*account{}
+	for _, credit := range credits {
+		acc, ok := accounts[credit.Account]
+		if !ok {
+			// credits are listed oldest first
+			acc = &account{balance: new(big.Int), oldest: credit.CreateAt}
+			accounts[credit.Account] = acc
+		}
+		acc.balance.Add(acc.balance, credit.Amount)
+		acc.ids = append(acc.ids, credit.ID)
+	}
+
+	interval := time.Duration(policy.SettleIntervalSeconds) * time.Second
+	for _, to := range slices.Sorted(maps.Keys(accounts)) {
+		acc := accounts[to]
+		due := params.Force ||
+			policy.SettleThreshold != nil && acc.balance.Cmp(policy.SettleThreshold) >= 0 ||
+			interval > 0 && time.Since(acc.oldest) >= interval
+		if !due || acc.balance.Sign() <= 0 {
+			continue
+		}
+		transfer, err := cpg.settleAccount(ctx, treasurer, params.Asset, to, acc.ids)
+		if err != nil {
+			return result, err
+		}
+		if transfer != nil {
+			result.Transfers = append(result.Transfers, *transfer)
+		}
+	}
+
+	if policy.HotLimit == nil {
+		return result, nil
+	}
+	balance, err := treasurer.TreasuryBalance(ctx)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to get treasury balance"), err)
+	}
+	if balance.Cmp(policy.HotLimit) <= 0 {
+		return result, nil
+	}
+	transfer := TreasuryTransfer{
+		ID:       uuid.NewString(),
+		Asset:    params.Asset,
+		Kind:     TreasuryTransferForward,
+		To:       policy.ColdAddress,
+		Amount:   new(big.Int).Sub(balance, policy.HotLimit),
+		CreateAt: time.Now(),
+	}
+	if err = cpg.db.InsertTreasuryTransfer(ctx, transfer); err != nil {
+		return result, ge.Wrap(ge.New("failed to insert treasury forward"), err)
+	}
+	cpg.sendTreasuryTransfer(ctx, treasurer, &transfer)
+	result.Transfers = append(result.Transfers, transfer)
+	return result, nil
+}
+
+// settleAccount claims the credits of an account and pays them
+func (cpg *CPG) settleAccount(ctx context.Context, treasurer Treasurer, asset, to string, ids []int) (*TreasuryTransfer, error) {
+
+	transfer := TreasuryTransfer{ID: uuid.NewString(), Asset: asset, Kind: TreasuryTransferSettlement, To: to, Amount: new(big.Int), CreateAt: time.Now()}
+
+	claimed, err := cpg.db.ClaimTreasuryCredits(ctx, ids, transfer.ID)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to claim treasury credits"), err)
+	}
+	if len(claimed) == 0 {
+		return nil, nil
+	}
+	for _, credit := range claimed {
+		transfer.Amount.Add(transfer.Amount, credit.Amount)
+	}
+
+	if err = cpg.db.InsertTreasuryTransfer(ctx, transfer); err != nil {
+		return nil, ge.Join(ge.Wrap(ge.New("failed to insert treasury settlement"), err), cpg.db.ReleaseTreasuryCredits(ctx, transfer.ID))
+	}
+
+	// a signed settlement is reconciled by its tx
+	if !cpg.sendTreasuryTransfer(ctx, treasurer, &transfer) {
+		cpg.releaseTreasuryCredits(ctx, transfer.ID)
+	}
+	return &transfer, nil
+}
+
+func (cpg *CPG) releaseTreasuryCredits(ctx context.Context, settlementID string) {
+	if err := cpg.db.ReleaseTreasuryCredits(ctx, settlementID); err != nil {
+		slog.Error("failed to release treasury credits", slog.String("settlement", settlementID), slog.String("error", err.Error()))
+	}
+}
+
+// sendTreasuryTransfer reports whether the tx was signed and may have been sent
+func (cpg *CPG) sendTreasuryTransfer(ctx context.Context, treasurer Treasurer, transfer *TreasuryTransfer) (signed bool) {
+	_, err := treasurer.TreasuryTransfer(ctx, transfer.To, transfer.Amount, func(tx SweepResult) error {
+		if err := cpg.db.SetTreasuryTransferSigned(ctx, transfer.ID, tx.Fee, tx.TxHash); err != nil {
+			return ge.Wrap(ge.New("failed to record treasury transfer tx"), err)
+		}
+		transfer.Fee, transfer.TxHash, signed = tx.Fee, tx.TxHash, true
+		return nil
+	})
+	if err != nil {
+		transfer.Error = err.Error()
+		slog.Warn("treasury transfer failed", slog.String("asset", transfer.Asset), slog.String("kind", string(transfer.Kind)), slog.String("to", transfer.To), slog.String("tx", transfer.TxHash), slog.String("error", transfer.Error))
+		if err = cpg.db.SetTreasuryTransferError(ctx, transfer.ID, transfer.Error); err != nil {
+			slog.Error("failed to record treasury transfer error", slog.String("transfer", transfer.ID), slog.String("error", err.Error()))
+		}
+		return signed
+	}
+	cpg.setTreasuryTransferSent(ctx, treasurer, transfer)
+	return true
+}
+
+func (cpg *CPG) setTreasuryTransferSent(ctx context.Context, treasurer Treasurer, transfer *TreasuryTransfer) {
+	now := time.Now()
+	transfer.SendAt, transfer.Error = &now, ""
+	if err := cpg.db.SetTreasuryTransferSent(ctx, transfer.ID); err != nil {
+		slog.Error("failed to record treasury transfer", slog.String("transfer", transfer.ID), slog.String("tx", transfer.TxHash), slog.String("error", err.Error()))
+	}
+	cpg.postTreasuryTransfer(ctx, treasurer, *transfer)
+	slog.Info("treasury transfer sent", slog.String("asset", transfer.Asset), slog.String("kind", string(transfer.Kind)), slog.String("to", transfer.To), slog.String("amount", transfer.Amount.String()), slog.String("tx", transfer.TxHash))
+}
+
+// reconcileTreasuryTransfers settles the signed transfers not known to be sent by their tx
+func (cpg *CPG) reconcileTreasuryTransfers(ctx context.Context, treasurer Treasurer, asset string) error {
+	unsent, err := cpg.db.ListUnsentTreasuryTransfers(ctx, asset)
+	if err != nil {
+		return ge.Wrap(ge.New("failed to list unsent treasury transfers"), err)
+	}
+	for _, transfer := range unsent {
+		confirmations, err := treasurer.TxConfirmations(ctx, transfer.TxHash)
+		switch {
+		case ge.Is(err, ErrTxReverted):
+			slog.Error("treasury transfer reverted", slog.String("transfer", transfer.ID), slog.String("tx", transfer.TxHash))
+			if err = cpg.db.SetTreasuryTransferSent(ctx, transfer.ID); err == nil {
+				err = cpg.db.SetTreasuryTransferError(ctx, transfer.ID, ErrTxReverted.Error())
+			}
+			if err != nil {
+				return ge.Wrap(ge.New("failed to record reverted treasury transfer"), err)
+			}
+			if transfer.Kind == TreasuryTransferSettlement {
+				cpg.releaseTreasuryCredits(ctx, transfer.ID)
+			}
+		case err != nil:
+			slog.Warn("failed to check treasury transfer tx", slog.String("transfer", transfer.ID), slog.String("tx", transfer.TxHash), slog.String("error", err.Error()))
+		case confirmations > 0:
+			cpg.setTreasuryTransferSent(ctx, treasurer, &transfer)
+		}
+	}
+	return nil
+}
+
+// RunTreasury settles every treasury mode asset each period until ctx is done
+func (cpg *CPG) RunTreasury(ctx context.Context, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		for _, asset := range slices.Sorted(maps.Keys(cpg.treasury)) {
+			result, err := cpg.SettleTreasury(ctx, SettleTreasuryParams{Asset: asset})
+			if err != nil {
+				slog.Error("treasury settlement failed", slog.String("asset", asset), slog.String("error", err.Error()))
+				continue
+			}
+			if len(result.Transfers) > 0 {
+				slog.Info("treasury settled", slog.String("asset", asset), slog.String("transfers", strconv.Itoa(len(result.Transfers))))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+type GetMerchantBalanceParams struct {
+	Asset   string
+	Account string
+}
+
+type GetMerchantBalanceResult struct {
+	// Balance is the sum of the unsettled credits of the account
+	Balance        *big.Int
+	Credits        int
+	OldestCreditAt *time.Time
+	// Transfers are the latest settlements to the account, newest first
+	Transfers []TreasuryTransfer
+}
+
+func (cpg *CPG) GetMerchantBalance(ctx context.Context, params GetMerchantBalanceParams) (result GetMerchantBalanceResult, err error) {
+
+	if params.Asset == "" || params.Account == "" {
+		return result, ge.New("asset and account are required")
+	}
+
+	credits, err := cpg.db.ListTreasuryCredits(ctx, params.Asset, params.Account)
+	if err != nil {
+		return result, ge.Wrap(ge.New("failed to list treasury credits"), err)
+	}
+	result.Balance = new(big.Int)
+	for _, credit := range credits {
+		result.Balance.Add(result.Balance, credit.Amount)
+	}
+	result.Credits = len(credits)
+	if len(credits) > 0 {
+		result.OldestCreditAt = &credits[0].CreateAt
+	}
+
+	if result.Transfers, err = cpg.db.ListTreasuryTransfers(ctx, params.Asset, params.Account, 20); err != nil {
+		return result, ge.Wrap(ge.New("failed to list treasury transfers"), err)
+	}
+	return result, nil
+}
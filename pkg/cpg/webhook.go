@@ -0,0 +1,57 @@
+package cpg
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/itsabgr/ge"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Webhook posts events as json, signed by Secret in the X-CPG-Signature header
+type Webhook struct {
+	URL    string
+	Secret string
+	client *http.Client
+}
+
+func NewWebhook(url, secret string) (*Webhook, error) {
+	if url == "" || secret == "" {
+		return nil, ge.New("webhook needs an url and a secret")
+	}
+	return &Webhook{URL: url, Secret: secret, client: &http.Client{Timeout: time.Second * 10}}, nil
+}
+
+// Post delivers one event, any status but 2xx fails it
+func (webhook *Webhook) Post(ctx context.Context, event string, payload any) error {
+	body, err := json.Marshal(map[string]any{"event": event, "data": payload})
+	if err != nil {
+		return err
+	}
+	mac := hmac.New(sha256.New, []byte(webhook.Secret))
+	mac.Write(body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-CPG-Event", event)
+	req.Header.Set("X-CPG-Signature", hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := webhook.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return ge.Detail(ge.New("webhook endpoint refused the event"), ge.D{"event": event, "status": resp.StatusCode})
+	}
+	return nil
+}
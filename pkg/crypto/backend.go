@@ -0,0 +1,75 @@
+package crypto
+
+import (
+	"context"
+	"github.com/itsabgr/ge"
+)
+
+// SaltBackendConfig selects where invoice salts are encrypted, it is meant to be embedded in daemon env configs
+type SaltBackendConfig struct {
+	Backend string `env:"SALT_BACKEND" envDefault:"file"`
+	// Keyring is the local keyring file, with a non-file backend it is only used to open salts encrypted before the switch
+	Keyring string `env:"SALT_KEYRING"`
+	// KeyringShares are share files or tty to combine the keyring from instead of reading Keyring
+	KeyringShares []string `env:"SALT_KEYRING_SHARES"`
+
+	VaultAddress string `env:"VAULT_ADDR"`
+	VaultToken   string `env:"VAULT_TOKEN"`
+	VaultMount   string `env:"VAULT_TRANSIT_MOUNT" envDefault:"transit"`
+	VaultKey     string `env:"VAULT_TRANSIT_KEY"`
+
+	PKCS11Provider string `env:"PKCS11_PROVIDER"`
+	PKCS11Module   string `env:"PKCS11_MODULE"`
+	PKCS11Slot     uint   `env:"PKCS11_SLOT"`
+	PKCS11PIN      string `env:"PKCS11_PIN"`
+	PKCS11KeyLabel string `env:"PKCS11_KEY_LABEL"`
+}
+
+// Open returns the configured salt cipher and the local keyring when one is loaded
+func (config SaltBackendConfig) Open(ctx context.Context) (Cipher, *KeyRing, error) {
+	var keyring *KeyRing
+	if config.Keyring != "" || len(config.KeyringShares) > 0 {
+		var err error
+		if keyring, err = LoadKeyRing(config.Keyring, config.KeyringShares); err != nil {
+			return nil, nil, ge.Wrap(ge.New("failed to load salt keyring"), err)
+		}
+	}
+
+	var cipher Cipher
+	var err error
+
+	switch config.Backend {
+	case "", "file":
+		if keyring == nil {
+			return nil, nil, ge.New("no salt keyring")
+		}
+		return NewKeyRingCipher(keyring), keyring, nil
+	case "vault":
+		cipher, err = NewTransitCipher(TransitConfig{
+			Address: config.VaultAddress,
+			Token:   config.VaultToken,
+			Mount:   config.VaultMount,
+			KeyName: config.VaultKey,
+		})
+	case "pkcs11":
+		cipher, err = NewPKCS11Cipher(ctx, PKCS11Config{
+			Provider: config.PKCS11Provider,
+			Module:   config.PKCS11Module,
+			Slot:     config.PKCS11Slot,
+			PIN:      config.PKCS11PIN,
+			KeyLabel: config.PKCS11KeyLabel,
+		})
+	default:
+		return nil, nil, ge.Detail(ge.New("unknown salt backend"), ge.D{"backend": config.Backend})
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if keyring != nil {
+		cipher = NewFallbackCipher(cipher, NewKeyRingCipher(keyring))
+	}
+
+	return cipher, keyring, nil
+}
@@ -0,0 +1,68 @@
+package crypto
+
+import (
+	"context"
+	"github.com/itsabgr/ge"
+)
+
+// Cipher encrypts and decrypts small secrets such as invoice salts,
+// implementations backed by a key management service never expose the raw keys to this process
+type Cipher interface {
+	Encrypt(ctx context.Context, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+var _ Cipher = keyRingCipher{}
+
+type keyRingCipher struct {
+	keyring *KeyRing
+}
+
+// NewKeyRingCipher returns a Cipher sealing with the local keyring primary key
+func NewKeyRingCipher(keyring *KeyRing) Cipher {
+	ge.Assert(keyring != nil, ge.New("nil keyring"))
+	return keyRingCipher{keyring: keyring}
+}
+
+func (c keyRingCipher) Encrypt(_ context.Context, plaintext []byte) ([]byte, error) {
+	return c.keyring.Encrypt(plaintext, (*[24]byte)(ReadN(nil, 24))), nil
+}
+
+func (c keyRingCipher) Decrypt(_ context.Context, ciphertext []byte) ([]byte, error) {
+	plaintext, _ := c.keyring.Decrypt(ciphertext)
+	if plaintext == nil {
+		return nil, ge.New("no key opens the ciphertext")
+	}
+	return plaintext, nil
+}
+
+type fallbackCipher struct {
+	primary   Cipher
+	fallbacks []Cipher
+}
+
+// NewFallbackCipher encrypts with primary and decrypts with the first cipher which succeeds,
+// it lets a deployment move to another backend while old ciphertexts still open
+func NewFallbackCipher(primary Cipher, fallbacks ...Cipher) Cipher {
+	if len(fallbacks) == 0 {
+		return primary
+	}
+	return fallbackCipher{primary: primary, fallbacks: fallbacks}
+}
+
+func (c fallbackCipher) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	return c.primary.Encrypt(ctx, plaintext)
+}
+
+func (c fallbackCipher) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	plaintext, err := c.primary.Decrypt(ctx, ciphertext)
+	if err == nil {
+		return plaintext, nil
+	}
+	for _, fallback := range c.fallbacks {
+		if plaintext, fallbackErr := fallback.Decrypt(ctx, ciphertext); fallbackErr == nil {
+			return plaintext, nil
+		}
+	}
+	return nil, err
+}
@@ -3,44 +3,217 @@ package crypto
 import (
 	"bufio"
 	"bytes"
+	"crypto/hmac"
 	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"github.com/itsabgr/ge"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/hkdf"
 	"golang.org/x/crypto/nacl/secretbox"
+	"io"
 	"os"
 	"slices"
 	"strings"
 )
 
+// EnvelopeVersion is the first byte of every ciphertext sealed by a KeyRing,
+// ciphertexts without it are the legacy nonce||box format
+const EnvelopeVersion byte = 1
+
+const (
+	keyIDSize      = 4
+	nonceSize      = 24
+	envelopeHeader = 1 + keyIDSize + nonceSize
+
+	// HKDFPrefix marks a keyring line holding a high entropy secret encoded in hex or base64
+	HKDFPrefix = "hkdf:"
+
+	kdfContext = "cpg keyring v1"
+)
+
+type KeyID [keyIDSize]byte
+
+func (id KeyID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+type key struct {
+	id     KeyID
+	secret [32]byte
+	// legacy is the bare sha256 of the keyring line, only used to open legacy ciphertexts
+	legacy [32]byte
+}
+
 type KeyRing struct {
-	keys [][32]byte
+	keys []key
 }
 
+// NewKeyRing derives a key from each line, high entropy secrets prefixed by hkdf: go through HKDF-SHA256
+// and anything else is treated as a passphrase and goes through argon2id, the first key is the primary one
 func NewKeyRing(keys ...string) *KeyRing {
 	ge.Assert(len(keys) > 0, ge.New("empty keyring keys"))
-	kr := &KeyRing{keys: make([][32]byte, len(keys))}
-	for i, key := range keys {
-		kr.keys[i] = sha256.Sum256([]byte(key))
+	kr := &KeyRing{keys: make([]key, len(keys))}
+	for i, line := range keys {
+		kr.keys[i] = ge.Must(deriveKey(line))
 	}
 	return kr
 }
 
+func deriveKey(line string) (k key, err error) {
+	k.legacy = sha256.Sum256([]byte(line))
+
+	if encoded, ok := strings.CutPrefix(line, HKDFPrefix); ok {
+		secret, err := decodeSecret(encoded)
+		if err != nil {
+			return k, err
+		}
+		if len(secret) < 32 {
+			return k, ge.New("hkdf keyring secret is shorter than 32 bytes")
+		}
+		ge.Must(io.ReadFull(hkdf.New(sha256.New, secret, nil, []byte(kdfContext)), k.secret[:]))
+	} else {
+		copy(k.secret[:], argon2.IDKey([]byte(line), []byte(kdfContext), 3, 64*1024, 4, 32))
+	}
+
+	id := sha256.Sum256(append([]byte(kdfContext+" id"), k.secret[:]...))
+	copy(k.id[:], id[:])
+
+	return k, nil
+}
+
+func decodeSecret(encoded string) ([]byte, error) {
+	if secret, err := hex.DecodeString(encoded); err == nil {
+		return secret, nil
+	}
+	if secret, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+		return secret, nil
+	}
+	return base64.RawURLEncoding.DecodeString(encoded)
+}
+
+// Encrypt seals msg under the primary key as version||key id||nonce||box
 func (kr *KeyRing) Encrypt(msg []byte, nonce *[24]byte) []byte {
-	return secretbox.Seal(slices.Clone(nonce[:]), msg, nonce, &kr.keys[0])
+	primary := &kr.keys[0]
+	out := make([]byte, 0, envelopeHeader+len(msg)+secretbox.Overhead)
+	out = append(out, EnvelopeVersion)
+	out = append(out, primary.id[:]...)
+	out = append(out, nonce[:]...)
+	return secretbox.Seal(out, msg, nonce, &primary.secret)
 }
 
 func (kr *KeyRing) Decrypt(encrypted []byte) ([]byte, *[24]byte) {
-	if len(encrypted) <= 24 {
-		return nil, nil
-	}
-	var nonce [24]byte
-	ge.Assert(copy(nonce[:], encrypted) == 24)
-	for _, key := range kr.keys {
-		msg, ok := secretbox.Open(nil, encrypted[24:], &nonce, &key)
-		if ok {
-			return msg, &nonce
+	msg, nonce, _, _ := kr.open(encrypted)
+	return msg, nonce
+}
+
+// KeyIndex returns the index of the key which opens encrypted, 0 is the primary key and -1 means no key opens it
+func (kr *KeyRing) KeyIndex(encrypted []byte) int {
+	_, _, index, _ := kr.open(encrypted)
+	return index
+}
+
+// Current reports whether encrypted is an envelope sealed under the primary key
+func (kr *KeyRing) Current(encrypted []byte) bool {
+	_, _, index, legacy := kr.open(encrypted)
+	return index == 0 && !legacy
+}
+
+// Reencrypt opens encrypted with any key and seals it again under the primary key with a fresh nonce
+func (kr *KeyRing) Reencrypt(encrypted []byte) ([]byte, bool) {
+	msg, _, index, _ := kr.open(encrypted)
+	if index < 0 {
+		return nil, false
+	}
+	return kr.Encrypt(msg, (*[24]byte)(ReadN(nil, 24))), true
+}
+
+func (kr *KeyRing) open(encrypted []byte) (msg []byte, nonce *[24]byte, index int, legacy bool) {
+	if len(encrypted) > envelopeHeader && encrypted[0] == EnvelopeVersion {
+		var id KeyID
+		copy(id[:], encrypted[1:])
+		nonce = (*[24]byte)(slices.Clone(encrypted[1+keyIDSize : envelopeHeader]))
+		for i := range kr.keys {
+			if kr.keys[i].id != id {
+				continue
+			}
+			if msg, ok := secretbox.Open(nil, encrypted[envelopeHeader:], nonce, &kr.keys[i].secret); ok {
+				return msg, nonce, i, false
+			}
 		}
+		// a legacy nonce may start with the version byte by chance
 	}
-	return nil, nil
+
+	if len(encrypted) <= nonceSize {
+		return nil, nil, -1, false
+	}
+	nonce = (*[24]byte)(slices.Clone(encrypted[:nonceSize]))
+	for i := range kr.keys {
+		if msg, ok := secretbox.Open(nil, encrypted[nonceSize:], nonce, &kr.keys[i].legacy); ok {
+			return msg, nonce, i, true
+		}
+	}
+	return nil, nil, -1, false
+}
+
+// Sum authenticates msg under the primary key as key id||hmac-sha256
+func (kr *KeyRing) Sum(msg []byte) []byte {
+	primary := &kr.keys[0]
+	return append(slices.Clone(primary.id[:]), primary.mac(msg)...)
+}
+
+// Verify reports whether sum authenticates msg under any key
+func (kr *KeyRing) Verify(msg, sum []byte) bool {
+	if len(sum) != keyIDSize+sha256.Size {
+		return false
+	}
+	for i := range kr.keys {
+		if bytes.Equal(kr.keys[i].id[:], sum[:keyIDSize]) && hmac.Equal(kr.keys[i].mac(msg), sum[keyIDSize:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *key) mac(msg []byte) []byte {
+	macKey := sha256.Sum256(append([]byte(kdfContext+" mac"), k.secret[:]...))
+	h := hmac.New(sha256.New, macKey[:])
+	h.Write(msg)
+	return h.Sum(nil)
+}
+
+// PrimaryID returns the id of the key new ciphertexts are sealed under
+func (kr *KeyRing) PrimaryID() KeyID {
+	return kr.keys[0].id
+}
+
+// KeyIDOf returns the id of the key an envelope claims to be sealed under, legacy ciphertexts have none
+func KeyIDOf(encrypted []byte) (KeyID, bool) {
+	var id KeyID
+	if len(encrypted) <= envelopeHeader || encrypted[0] != EnvelopeVersion {
+		return id, false
+	}
+	copy(id[:], encrypted[1:])
+	return id, true
+}
+
+// Merge returns a keyring with the keys of kr followed by the keys of others, the primary key stays the same
+func (kr *KeyRing) Merge(others ...*KeyRing) *KeyRing {
+	merged := &KeyRing{keys: slices.Clone(kr.keys)}
+	for _, other := range others {
+		for _, k := range other.keys {
+			if !merged.has(k) {
+				merged.keys = append(merged.keys, k)
+			}
+		}
+	}
+	return merged
+}
+
+func (kr *KeyRing) has(k key) bool {
+	return slices.ContainsFunc(kr.keys, func(k2 key) bool {
+		return k2.secret == k.secret || k2.legacy == k.legacy
+	})
 }
 
 func (kr *KeyRing) Size() int {
@@ -52,7 +225,11 @@ func LoadKeyRingFromFile(path string) (*KeyRing, error) {
 	if err != nil {
 		return nil, err
 	}
+	return ParseKeyRing(data)
+}
 
+// ParseKeyRing parses keyring lines, empty lines are ignored
+func ParseKeyRing(data []byte) (*KeyRing, error) {
 	lineScanner := bufio.NewScanner(bytes.NewReader(data))
 	var keys []string
 	for lineScanner.Scan() {
@@ -63,17 +240,29 @@ func LoadKeyRingFromFile(path string) (*KeyRing, error) {
 		keys = append(keys, line)
 	}
 
-	if err = lineScanner.Err(); err != nil {
+	if err := lineScanner.Err(); err != nil {
 		return nil, err
 	}
 
-	return NewKeyRing(keys...), nil
+	if len(keys) == 0 {
+		return nil, ge.New("empty keyring keys")
+	}
+
+	kr := &KeyRing{keys: make([]key, len(keys))}
+	for i, line := range keys {
+		k, err := deriveKey(line)
+		if err != nil {
+			return nil, ge.Wrap(ge.Detail(ge.New("invalid keyring line"), ge.D{"line": i + 1}), err)
+		}
+		kr.keys[i] = k
+	}
 
+	return kr, nil
 }
 
 func (kr *KeyRing) Contains(kr2 *KeyRing) bool {
 	for _, k := range kr.keys {
-		if slices.Contains(kr2.keys, k) {
+		if kr2.has(k) {
 			return true
 		}
 	}
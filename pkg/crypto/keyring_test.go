@@ -0,0 +1,165 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"golang.org/x/crypto/nacl/secretbox"
+	"strings"
+	"testing"
+)
+
+func legacySeal(line string, msg []byte, nonce *[24]byte) []byte {
+	secret := sha256.Sum256([]byte(line))
+	return secretbox.Seal(bytes.Clone(nonce[:]), msg, nonce, &secret)
+}
+
+func TestKeyRingRoundTrip(t *testing.T) {
+	hexLine := HKDFPrefix + strings.Repeat("ab", 32)
+	b64Line := HKDFPrefix + "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+	for _, tc := range []struct {
+		name  string
+		lines []string
+	}{
+		{"hkdf hex", []string{hexLine}},
+		{"hkdf base64", []string{b64Line}},
+		{"passphrase", []string{"correct horse battery staple"}},
+		{"rotated", []string{hexLine, b64Line}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kr := NewKeyRing(tc.lines...)
+			msg := []byte("salt of an invoice")
+			nonce := (*[24]byte)(ReadN(nil, 24))
+
+			sealed := kr.Encrypt(msg, nonce)
+			if sealed[0] != EnvelopeVersion {
+				t.Fatalf("version %d", sealed[0])
+			}
+			if id, ok := KeyIDOf(sealed); !ok || id != kr.PrimaryID() {
+				t.Fatalf("key id %v %v", id, ok)
+			}
+			opened, openedNonce := kr.Decrypt(sealed)
+			if !bytes.Equal(opened, msg) || *openedNonce != *nonce {
+				t.Fatalf("opened %q", opened)
+			}
+			if !kr.Current(sealed) || kr.KeyIndex(sealed) != 0 {
+				t.Fatal("not sealed by the primary key")
+			}
+
+			sealed[len(sealed)-1] ^= 1
+			if opened, _ = kr.Decrypt(sealed); opened != nil {
+				t.Fatal("tampered ciphertext opened")
+			}
+			if opened, _ = NewKeyRing(HKDFPrefix + strings.Repeat("cd", 32)).Decrypt(kr.Encrypt(msg, nonce)); opened != nil {
+				t.Fatal("foreign key opened")
+			}
+		})
+	}
+}
+
+func TestKeyRingLegacyFallback(t *testing.T) {
+	oldLine, newLine := HKDFPrefix+strings.Repeat("01", 32), HKDFPrefix+strings.Repeat("02", 32)
+	msg := []byte("legacy salt")
+	nonce := (*[24]byte)(ReadN(nil, 24))
+	legacy := legacySeal(oldLine, msg, nonce)
+
+	for _, tc := range []struct {
+		name    string
+		lines   []string
+		index   int
+		current bool
+	}{
+		{"primary", []string{oldLine}, 0, false},
+		{"old key", []string{newLine, oldLine}, 1, false},
+		{"missing", []string{newLine}, -1, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kr := NewKeyRing(tc.lines...)
+			if index := kr.KeyIndex(legacy); index != tc.index {
+				t.Fatalf("index %d", index)
+			}
+			if kr.Current(legacy) != tc.current {
+				t.Fatal("legacy ciphertext is never current")
+			}
+			opened, openedNonce := kr.Decrypt(legacy)
+			if tc.index < 0 {
+				if opened != nil {
+					t.Fatal("opened without its key")
+				}
+				return
+			}
+			if !bytes.Equal(opened, msg) || *openedNonce != *nonce {
+				t.Fatalf("opened %q", opened)
+			}
+
+			reencrypted, ok := kr.Reencrypt(legacy)
+			if !ok || !kr.Current(reencrypted) {
+				t.Fatal("not reencrypted under the primary key")
+			}
+			if opened, _ = kr.Decrypt(reencrypted); !bytes.Equal(opened, msg) {
+				t.Fatalf("reencrypted opened %q", opened)
+			}
+		})
+	}
+}
+
+func TestKeyRingMerge(t *testing.T) {
+	backup := NewKeyRing(HKDFPrefix + strings.Repeat("0a", 32))
+	salt := NewKeyRing(HKDFPrefix + strings.Repeat("0b", 32))
+	merged := backup.Merge(salt, backup)
+
+	if merged.Size() != 2 || merged.PrimaryID() != backup.PrimaryID() {
+		t.Fatalf("size %d", merged.Size())
+	}
+	nonce := (*[24]byte)(ReadN(nil, 24))
+	if opened, _ := merged.Decrypt(salt.Encrypt([]byte("old"), nonce)); string(opened) != "old" {
+		t.Fatal("merged keyring does not open the other keyring")
+	}
+	if opened, _ := salt.Decrypt(merged.Encrypt([]byte("new"), nonce)); opened != nil {
+		t.Fatal("merged keyring seals under a merged key")
+	}
+}
+
+func TestParseKeyRing(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		data string
+		size int
+	}{
+		{"lines", "a\n\n  b  \n", 2},
+		{"empty", "\n \n", 0},
+		{"short hkdf", HKDFPrefix + "abcd\n", 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kr, err := ParseKeyRing([]byte(tc.data))
+			if tc.size == 0 {
+				if err == nil {
+					t.Fatal("no error")
+				}
+				return
+			}
+			if err != nil || kr.Size() != tc.size {
+				t.Fatalf("%v %v", kr, err)
+			}
+		})
+	}
+}
+
+func TestKeyRingSum(t *testing.T) {
+	oldLine, newLine := HKDFPrefix+strings.Repeat("03", 32), HKDFPrefix+strings.Repeat("04", 32)
+	msg := []byte("invoice terms")
+	sum := NewKeyRing(oldLine).Sum(msg)
+
+	if !NewKeyRing(newLine, oldLine).Verify(msg, sum) {
+		t.Fatal("rotated keyring does not verify an old sum")
+	}
+	if NewKeyRing(newLine).Verify(msg, sum) {
+		t.Fatal("foreign key verified")
+	}
+	if NewKeyRing(oldLine).Verify([]byte("other terms"), sum) {
+		t.Fatal("other message verified")
+	}
+	sum[len(sum)-1] ^= 1
+	if NewKeyRing(oldLine).Verify(msg, sum) || NewKeyRing(oldLine).Verify(msg, nil) {
+		t.Fatal("tampered sum verified")
+	}
+}
@@ -0,0 +1,95 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"github.com/itsabgr/ge"
+	"sync"
+)
+
+// PKCS11Session is the part of a PKCS#11 session used to wrap salts with a secret key which never leaves the token,
+// bindings to real modules (softhsm, cloud hsm, yubihsm, ...) implement it and register themselves by RegisterPKCS11Provider
+type PKCS11Session interface {
+	// Encrypt encrypts with the token key of the given label, e.g. by CKM_AES_GCM, the result must carry its own iv
+	Encrypt(ctx context.Context, keyLabel string, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, keyLabel string, ciphertext []byte) ([]byte, error)
+	Close() error
+}
+
+// PKCS11Config selects a registered provider, Module and Slot are passed to it as is
+type PKCS11Config struct {
+	Provider string
+	Module   string
+	Slot     uint
+	PIN      string
+	KeyLabel string
+}
+
+var (
+	pkcs11ProvidersLock sync.Mutex
+	pkcs11Providers     = map[string]func(ctx context.Context, config PKCS11Config) (PKCS11Session, error){}
+)
+
+func RegisterPKCS11Provider(name string, open func(ctx context.Context, config PKCS11Config) (PKCS11Session, error)) {
+	pkcs11ProvidersLock.Lock()
+	defer pkcs11ProvidersLock.Unlock()
+	ge.Assert(name != "", ge.New("empty pkcs11 provider name"))
+	ge.Assert(open != nil, ge.New("nil pkcs11 provider"))
+	ge.Assert(pkcs11Providers[name] == nil, ge.Detail(ge.New("duplicate pkcs11 provider name"), ge.D{"name": name}))
+	pkcs11Providers[name] = open
+}
+
+const pkcs11CiphertextPrefix = "pkcs11:"
+
+var _ Cipher = &pkcs11Cipher{}
+
+type pkcs11Cipher struct {
+	session  PKCS11Session
+	keyLabel string
+}
+
+// NewPKCS11Cipher opens a session of the configured provider and returns a Cipher wrapping with its key,
+// ciphertexts are stored as pkcs11:<key label>:<token ciphertext> so the label can be rotated later
+func NewPKCS11Cipher(ctx context.Context, config PKCS11Config) (Cipher, error) {
+	pkcs11ProvidersLock.Lock()
+	open := pkcs11Providers[config.Provider]
+	pkcs11ProvidersLock.Unlock()
+
+	if open == nil {
+		return nil, ge.Detail(ge.New("pkcs11 provider is not linked in"), ge.D{"provider": config.Provider})
+	}
+	if config.KeyLabel == "" || bytes.ContainsRune([]byte(config.KeyLabel), ':') {
+		return nil, ge.New("invalid pkcs11 key label")
+	}
+
+	session, err := open(ctx, config)
+	if err != nil {
+		return nil, ge.Wrap(ge.Detail(ge.New("failed to open pkcs11 session"), ge.D{"provider": config.Provider}), err)
+	}
+	return NewPKCS11SessionCipher(session, config.KeyLabel), nil
+}
+
+// NewPKCS11SessionCipher returns a Cipher wrapping with the key of the given label on an already open session
+func NewPKCS11SessionCipher(session PKCS11Session, keyLabel string) Cipher {
+	return &pkcs11Cipher{session: session, keyLabel: keyLabel}
+}
+
+func (c *pkcs11Cipher) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	ciphertext, err := c.session.Encrypt(ctx, c.keyLabel, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(pkcs11CiphertextPrefix+c.keyLabel+":"), ciphertext...), nil
+}
+
+func (c *pkcs11Cipher) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	rest, ok := bytes.CutPrefix(ciphertext, []byte(pkcs11CiphertextPrefix))
+	if !ok {
+		return nil, ge.New("not a pkcs11 ciphertext")
+	}
+	label, wrapped, ok := bytes.Cut(rest, []byte(":"))
+	if !ok {
+		return nil, ge.New("invalid pkcs11 ciphertext")
+	}
+	return c.session.Decrypt(ctx, string(label), wrapped)
+}
@@ -0,0 +1,207 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"github.com/itsabgr/ge"
+	"hash/crc32"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// gf256 exp and log tables over the AES polynomial x^8+x^4+x^3+x+1 with generator 3
+var gfExp, gfLog = func() (exp [510]byte, log [256]byte) {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i] = x
+		log[x] = byte(i)
+		// x *= 3
+		hi := x & 0x80
+		x2 := x << 1
+		if hi != 0 {
+			x2 ^= 0x1b
+		}
+		x ^= x2
+	}
+	for i := 255; i < len(exp); i++ {
+		exp[i] = exp[i-255]
+	}
+	return
+}()
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfDiv(a, b byte) byte {
+	ge.Assert(b != 0, ge.New("division by zero"))
+	if a == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+255-int(gfLog[b])]
+}
+
+// Share is one point of every byte polynomial of a split secret
+type Share struct {
+	Threshold int
+	X         byte
+	// SetID is random, it ties shares of one split together
+	SetID [4]byte
+	Data  []byte
+	// legacy shares have the secret hash prefix as SetID and no check value
+	legacy bool
+}
+
+// shareCheckSize is the size of the secret hash prefix split along with the secret to verify the combined secret
+const shareCheckSize = 8
+
+// SplitSecret splits secret into n shares any threshold of which recover it
+func SplitSecret(secret []byte, n, threshold int) ([]Share, error) {
+	if len(secret) == 0 {
+		return nil, ge.New("empty secret")
+	}
+	if threshold < 2 || threshold > n || n > 255 {
+		return nil, ge.Detail(ge.New("invalid shares count or threshold"), ge.D{"n": n, "threshold": threshold})
+	}
+
+	setID := [4]byte(ReadN(nil, 4))
+
+	sum := sha256.Sum256(secret)
+	payload := append(append(make([]byte, 0, len(secret)+shareCheckSize), secret...), sum[:shareCheckSize]...)
+	defer clear(payload)
+
+	shares := make([]Share, n)
+	for i := range shares {
+		shares[i] = Share{Threshold: threshold, X: byte(i + 1), SetID: setID, Data: make([]byte, len(payload))}
+	}
+
+	coefficients := make([]byte, threshold)
+	for b, secretByte := range payload {
+		coefficients[0] = secretByte
+		copy(coefficients[1:], ReadN(nil, threshold-1))
+		for i := range shares {
+			// horner evaluation at x
+			y := byte(0)
+			for c := threshold - 1; c >= 0; c-- {
+				y = gfMul(y, shares[i].X) ^ coefficients[c]
+			}
+			shares[i].Data[b] = y
+		}
+	}
+	clear(coefficients)
+
+	return shares, nil
+}
+
+// CombineShares recovers the secret from at least threshold shares of one split
+func CombineShares(shares []Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ge.New("no share")
+	}
+	first := shares[0]
+	if len(shares) < first.Threshold {
+		return nil, ge.Detail(ge.New("not enough shares"), ge.D{"have": len(shares), "threshold": first.Threshold})
+	}
+	shares = shares[:first.Threshold]
+
+	seen := map[byte]bool{}
+	for _, share := range shares {
+		if share.SetID != first.SetID || share.Threshold != first.Threshold || share.legacy != first.legacy || len(share.Data) != len(first.Data) {
+			return nil, ge.New("shares belong to different splits")
+		}
+		if share.X == 0 || seen[share.X] {
+			return nil, ge.Detail(ge.New("duplicate share"), ge.D{"x": share.X})
+		}
+		seen[share.X] = true
+	}
+
+	secret := make([]byte, len(first.Data))
+	for i, share := range shares {
+		// lagrange basis at zero
+		basis := byte(1)
+		for j, other := range shares {
+			if i != j {
+				basis = gfMul(basis, gfDiv(other.X, other.X^share.X))
+			}
+		}
+		for b := range secret {
+			secret[b] ^= gfMul(share.Data[b], basis)
+		}
+	}
+
+	if first.legacy {
+		sum := sha256.Sum256(secret)
+		if [4]byte(sum[:4]) != first.SetID {
+			return nil, ge.New("combined secret does not match the shares set id")
+		}
+		return secret, nil
+	}
+
+	if len(secret) <= shareCheckSize {
+		return nil, ge.New("invalid share data")
+	}
+	payload := secret
+	defer clear(payload)
+	secret, check := secret[:len(secret)-shareCheckSize], secret[len(secret)-shareCheckSize:]
+	sum := sha256.Sum256(secret)
+	if subtle.ConstantTimeCompare(sum[:shareCheckSize], check) != 1 {
+		return nil, ge.New("combined secret does not match its check value, a share is wrong")
+	}
+	return slices.Clone(secret), nil
+}
+
+const (
+	shareTextPrefix       = "cpg-share-2"
+	legacyShareTextPrefix = "cpg-share-1"
+)
+
+// String encodes the share as a single checksummed line
+func (share Share) String() string {
+	prefix := shareTextPrefix
+	if share.legacy {
+		prefix = legacyShareTextPrefix
+	}
+	body := fmt.Sprintf("%s:%d:%d:%s:%s", prefix, share.Threshold, share.X, hex.EncodeToString(share.SetID[:]), hex.EncodeToString(share.Data))
+	return fmt.Sprintf("%s:%08x", body, crc32.ChecksumIEEE([]byte(body)))
+}
+
+// ParseShare parses a line made by Share.String
+func ParseShare(line string) (share Share, err error) {
+	line = strings.TrimSpace(line)
+	idx := strings.LastIndexByte(line, ':')
+	if idx < 0 {
+		return share, ge.New("invalid share")
+	}
+	body, checksum := line[:idx], line[idx+1:]
+	if fmt.Sprintf("%08x", crc32.ChecksumIEEE([]byte(body))) != strings.ToLower(checksum) {
+		return share, ge.New("share checksum mismatch, check for typos")
+	}
+	parts := strings.Split(body, ":")
+	if len(parts) != 5 || (parts[0] != shareTextPrefix && parts[0] != legacyShareTextPrefix) {
+		return share, ge.New("invalid share format")
+	}
+	share.legacy = parts[0] == legacyShareTextPrefix
+	if share.Threshold, err = strconv.Atoi(parts[1]); err != nil {
+		return share, err
+	}
+	x, err := strconv.ParseUint(parts[2], 10, 8)
+	if err != nil {
+		return share, err
+	}
+	share.X = byte(x)
+	setID, err := hex.DecodeString(parts[3])
+	if err != nil || len(setID) != 4 {
+		return share, ge.New("invalid share set id")
+	}
+	share.SetID = [4]byte(setID)
+	if share.Data, err = hex.DecodeString(parts[4]); err != nil {
+		return share, err
+	}
+	return share, nil
+}
@@ -0,0 +1,141 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+)
+
+// subsets calls fn with every k-subset of indexes 0..n-1
+func subsets(n, k int, fn func([]int)) {
+	var walk func(start int, picked []int)
+	walk = func(start int, picked []int) {
+		if len(picked) == k {
+			fn(picked)
+			return
+		}
+		for i := start; i < n; i++ {
+			walk(i+1, append(picked, i))
+		}
+	}
+	walk(0, nil)
+}
+
+func pick(shares []Share, indexes []int) []Share {
+	picked := make([]Share, len(indexes))
+	for i, index := range indexes {
+		picked[i] = shares[index]
+	}
+	return picked
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("hkdf:000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f\n")
+	for n := 2; n <= 7; n++ {
+		for k := 2; k <= n; k++ {
+			shares, err := SplitSecret(secret, n, k)
+			if err != nil {
+				t.Fatalf("%d of %d: %v", k, n, err)
+			}
+			subsets(n, k, func(indexes []int) {
+				combined, err := CombineShares(pick(shares, indexes))
+				if err != nil || !bytes.Equal(combined, secret) {
+					t.Fatalf("%d of %d %v: %q %v", k, n, indexes, combined, err)
+				}
+			})
+			if _, err = CombineShares(shares[:k-1]); err == nil {
+				t.Fatalf("%d of %d combined below the threshold", k, n)
+			}
+		}
+	}
+}
+
+func TestSplitSetID(t *testing.T) {
+	secret := []byte("secret")
+	a, _ := SplitSecret(secret, 3, 2)
+	b, _ := SplitSecret(secret, 3, 2)
+	sum := sha256.Sum256(secret)
+	if a[0].SetID == b[0].SetID || a[0].SetID == [4]byte(sum[:4]) {
+		t.Fatal("set id is not random")
+	}
+	if _, err := CombineShares([]Share{a[0], b[1]}); err == nil {
+		t.Fatal("shares of different splits combined")
+	}
+}
+
+func TestCombineWrongShare(t *testing.T) {
+	shares, _ := SplitSecret([]byte("secret"), 3, 2)
+	for _, tc := range []struct {
+		name   string
+		shares []Share
+	}{
+		{"corrupted", []Share{shares[0], {Threshold: 2, X: 2, SetID: shares[1].SetID, Data: bytes.Repeat([]byte{7}, len(shares[1].Data))}}},
+		{"duplicate", []Share{shares[0], shares[0]}},
+		{"zero x", []Share{shares[0], {Threshold: 2, X: 0, SetID: shares[1].SetID, Data: shares[1].Data}}},
+		{"none", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if secret, err := CombineShares(tc.shares); err == nil {
+				t.Fatalf("combined %q", secret)
+			}
+		})
+	}
+}
+
+func TestShareString(t *testing.T) {
+	shares, _ := SplitSecret([]byte("secret"), 3, 2)
+	line := shares[2].String()
+	parsed, err := ParseShare(line)
+	if err != nil || parsed.X != 3 || parsed.SetID != shares[2].SetID || !bytes.Equal(parsed.Data, shares[2].Data) {
+		t.Fatalf("%+v %v", parsed, err)
+	}
+	typo := []byte(line)
+	typo[len(shareTextPrefix)+5] ^= 1
+	if _, err = ParseShare(string(typo)); err == nil {
+		t.Fatal("typo parsed")
+	}
+}
+
+// legacySplit splits like cpg-share-1 did, without a check value and the secret hash prefix as set id
+func legacySplit(secret []byte, n, threshold int) []Share {
+	sum := sha256.Sum256(secret)
+	shares := make([]Share, n)
+	for i := range shares {
+		shares[i] = Share{Threshold: threshold, X: byte(i + 1), SetID: [4]byte(sum[:4]), Data: make([]byte, len(secret)), legacy: true}
+	}
+	coefficients := make([]byte, threshold)
+	for b, secretByte := range secret {
+		coefficients[0] = secretByte
+		copy(coefficients[1:], ReadN(nil, threshold-1))
+		for i := range shares {
+			y := byte(0)
+			for c := threshold - 1; c >= 0; c-- {
+				y = gfMul(y, shares[i].X) ^ coefficients[c]
+			}
+			shares[i].Data[b] = y
+		}
+	}
+	return shares
+}
+
+func TestCombineLegacyShares(t *testing.T) {
+	secret := []byte("legacy keyring\n")
+	legacy := legacySplit(secret, 3, 2)
+	shares := make([]Share, len(legacy))
+	for i, share := range legacy {
+		line := share.String()
+		if line[:len(legacyShareTextPrefix)] != legacyShareTextPrefix {
+			t.Fatalf("legacy share encoded as %s", line)
+		}
+		var err error
+		if shares[i], err = ParseShare(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+	subsets(3, 2, func(indexes []int) {
+		combined, err := CombineShares(pick(shares, indexes))
+		if err != nil || !bytes.Equal(combined, secret) {
+			t.Fatalf("%v: %q %v", indexes, combined, err)
+		}
+	})
+}
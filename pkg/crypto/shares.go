@@ -0,0 +1,109 @@
+package crypto
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"github.com/itsabgr/ge"
+	"golang.org/x/term"
+	"os"
+	"strings"
+)
+
+// ShareSourceTTY as a share source prompts for shares on the controlling terminal without echo
+const ShareSourceTTY = "tty"
+
+// CollectShares reads one share from each source file, and prompts on the terminal for the missing ones
+// when a source is tty, until the threshold of the split is reached
+func CollectShares(sources []string) ([]Share, error) {
+	var shares []Share
+	interactive := false
+
+	add := func(share Share) error {
+		for _, have := range shares {
+			if have.X == share.X {
+				return ge.Detail(ge.New("duplicate share"), ge.D{"x": share.X})
+			}
+			if have.SetID != share.SetID {
+				return ge.New("share belongs to another split")
+			}
+		}
+		shares = append(shares, share)
+		return nil
+	}
+
+	for _, source := range sources {
+		if source == ShareSourceTTY {
+			interactive = true
+			continue
+		}
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+		share, err := ParseShare(firstLine(data))
+		if err != nil {
+			return nil, ge.Wrap(ge.Detail(ge.New("invalid share file"), ge.D{"path": source}), err)
+		}
+		if err = add(share); err != nil {
+			return nil, err
+		}
+	}
+
+	if interactive && (len(shares) == 0 || len(shares) < shares[0].Threshold) {
+		tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+		if err != nil {
+			return nil, ge.Wrap(ge.New("failed to open terminal"), err)
+		}
+		defer func() { _ = tty.Close() }()
+
+		for len(shares) == 0 || len(shares) < shares[0].Threshold {
+			if len(shares) == 0 {
+				_, _ = fmt.Fprint(tty, "enter a keyring share: ")
+			} else {
+				_, _ = fmt.Fprintf(tty, "enter keyring share %d of %d: ", len(shares)+1, shares[0].Threshold)
+			}
+			line, err := term.ReadPassword(int(tty.Fd()))
+			_, _ = fmt.Fprintln(tty)
+			if err != nil {
+				return nil, err
+			}
+			share, err := ParseShare(string(line))
+			if err == nil {
+				err = add(share)
+			}
+			if err != nil {
+				_, _ = fmt.Fprintf(tty, "rejected: %s\n", err.Error())
+			}
+		}
+	}
+
+	return shares, nil
+}
+
+// LoadKeyRing loads a keyring from its file, or combines it from a quorum of shares when share sources are given
+func LoadKeyRing(path string, shareSources []string) (*KeyRing, error) {
+	if len(shareSources) == 0 {
+		return LoadKeyRingFromFile(path)
+	}
+	shares, err := CollectShares(shareSources)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to collect keyring shares"), err)
+	}
+	data, err := CombineShares(shares)
+	if err != nil {
+		return nil, ge.Wrap(ge.New("failed to combine keyring shares"), err)
+	}
+	defer clear(data)
+	return ParseKeyRing(data)
+}
+
+func firstLine(data []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,167 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/itsabgr/ge"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const transitCiphertextPrefix = "vault:"
+
+type TransitConfig struct {
+	// Address is the base url of the vault server, e.g. https://vault:8200
+	Address string
+	Token   string
+	// Mount is the transit secrets engine mount path, transit by default
+	Mount      string
+	KeyName    string
+	HTTPClient *http.Client
+}
+
+var _ Cipher = &transitCipher{}
+
+type transitCipher struct {
+	config TransitConfig
+}
+
+// NewTransitCipher returns a Cipher backed by the encrypt and decrypt endpoints of a vault transit style key management service,
+// the key never leaves the service and ciphertexts are stored as the returned vault:v<n>:... strings
+func NewTransitCipher(config TransitConfig) (Cipher, error) {
+	if config.Address == "" {
+		return nil, ge.New("empty transit address")
+	}
+	if config.KeyName == "" {
+		return nil, ge.New("empty transit key name")
+	}
+	if config.Mount == "" {
+		config.Mount = "transit"
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: time.Second * 10}
+	}
+	config.Address = strings.TrimRight(config.Address, "/")
+	return &transitCipher{config: config}, nil
+}
+
+type transitRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type transitResponse struct {
+	Data   transitRequest `json:"data"`
+	Errors []string       `json:"errors"`
+}
+
+func (c *transitCipher) call(ctx context.Context, operation string, req transitRequest) (transitRequest, error) {
+	body := ge.Must(json.Marshal(req))
+	url := c.config.Address + "/v1/" + c.config.Mount + "/" + operation + "/" + c.config.KeyName
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return transitRequest{}, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("X-Vault-Token", c.config.Token)
+
+	httpResp, err := c.config.HTTPClient.Do(httpReq)
+	if err != nil {
+		return transitRequest{}, ge.Wrap(ge.Detail(ge.New("transit request failed"), ge.D{"operation": operation}), err)
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	var resp transitResponse
+	if err = json.NewDecoder(io.LimitReader(httpResp.Body, 1<<20)).Decode(&resp); err != nil && httpResp.StatusCode == http.StatusOK {
+		return transitRequest{}, ge.Wrap(ge.New("invalid transit response"), err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return transitRequest{}, ge.Detail(ge.New("transit request rejected"), ge.D{"operation": operation, "status": httpResp.StatusCode, "errors": resp.Errors})
+	}
+	return resp.Data, nil
+}
+
+func (c *transitCipher) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	resp, err := c.call(ctx, "encrypt", transitRequest{Plaintext: base64.StdEncoding.EncodeToString(plaintext)})
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(resp.Ciphertext, transitCiphertextPrefix) {
+		return nil, ge.New("invalid transit ciphertext")
+	}
+	return []byte(resp.Ciphertext), nil
+}
+
+func (c *transitCipher) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	if !bytes.HasPrefix(ciphertext, []byte(transitCiphertextPrefix)) {
+		return nil, ge.New("not a transit ciphertext")
+	}
+	resp, err := c.call(ctx, "decrypt", transitRequest{Ciphertext: string(ciphertext)})
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
+
+// NewTransitStandIn serves the transit encrypt and decrypt endpoints of every key name under mount with a local keyring,
+// it stands in for a real key management service in development and tests
+func NewTransitStandIn(keyring *KeyRing, mount, token string) http.Handler {
+	if mount == "" {
+		mount = "transit"
+	}
+	mux := http.NewServeMux()
+	reply := func(w http.ResponseWriter, code int, resp transitResponse) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+	handle := func(operation string, fn func(req transitRequest) (transitRequest, error)) {
+		mux.HandleFunc("POST /v1/"+mount+"/"+operation+"/{key}", func(w http.ResponseWriter, r *http.Request) {
+			if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Vault-Token")), []byte(token)) != 1 {
+				reply(w, http.StatusForbidden, transitResponse{Errors: []string{"permission denied"}})
+				return
+			}
+			var req transitRequest
+			if err := json.NewDecoder(io.LimitReader(r.Body, 1<<20)).Decode(&req); err != nil {
+				reply(w, http.StatusBadRequest, transitResponse{Errors: []string{err.Error()}})
+				return
+			}
+			resp, err := fn(req)
+			if err != nil {
+				reply(w, http.StatusBadRequest, transitResponse{Errors: []string{err.Error()}})
+				return
+			}
+			reply(w, http.StatusOK, transitResponse{Data: resp})
+		})
+	}
+	handle("encrypt", func(req transitRequest) (transitRequest, error) {
+		plaintext, err := base64.StdEncoding.DecodeString(req.Plaintext)
+		if err != nil {
+			return transitRequest{}, err
+		}
+		sealed := keyring.Encrypt(plaintext, (*[24]byte)(ReadN(nil, 24)))
+		return transitRequest{Ciphertext: transitCiphertextPrefix + "v1:" + base64.StdEncoding.EncodeToString(sealed)}, nil
+	})
+	handle("decrypt", func(req transitRequest) (transitRequest, error) {
+		encoded, ok := strings.CutPrefix(req.Ciphertext, transitCiphertextPrefix+"v1:")
+		if !ok {
+			return transitRequest{}, ge.New("invalid ciphertext")
+		}
+		sealed, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return transitRequest{}, err
+		}
+		plaintext, _ := keyring.Decrypt(sealed)
+		if plaintext == nil {
+			return transitRequest{}, ge.New("cipher: message authentication failed")
+		}
+		return transitRequest{Plaintext: base64.StdEncoding.EncodeToString(plaintext)}, nil
+	})
+	return mux
+}
@@ -12,6 +12,14 @@ import (
 	"cpg/pkg/ent/database/migrate"
 
 	"cpg/pkg/ent/database/invoice"
+	"cpg/pkg/ent/database/invoiceevent"
+	"cpg/pkg/ent/database/ledgeraccount"
+	"cpg/pkg/ent/database/ledgerentry"
+	"cpg/pkg/ent/database/payout"
+	"cpg/pkg/ent/database/payoutday"
+	"cpg/pkg/ent/database/treasurycredit"
+	"cpg/pkg/ent/database/treasurytransfer"
+	"cpg/pkg/ent/database/walletaccount"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -25,6 +33,22 @@ type Client struct {
 	Schema *migrate.Schema
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
+	// InvoiceEvent is the client for interacting with the InvoiceEvent builders.
+	InvoiceEvent *InvoiceEventClient
+	// LedgerAccount is the client for interacting with the LedgerAccount builders.
+	LedgerAccount *LedgerAccountClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
+	// Payout is the client for interacting with the Payout builders.
+	Payout *PayoutClient
+	// PayoutDay is the client for interacting with the PayoutDay builders.
+	PayoutDay *PayoutDayClient
+	// TreasuryCredit is the client for interacting with the TreasuryCredit builders.
+	TreasuryCredit *TreasuryCreditClient
+	// TreasuryTransfer is the client for interacting with the TreasuryTransfer builders.
+	TreasuryTransfer *TreasuryTransferClient
+	// WalletAccount is the client for interacting with the WalletAccount builders.
+	WalletAccount *WalletAccountClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -37,6 +61,14 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Invoice = NewInvoiceClient(c.config)
+	c.InvoiceEvent = NewInvoiceEventClient(c.config)
+	c.LedgerAccount = NewLedgerAccountClient(c.config)
+	c.LedgerEntry = NewLedgerEntryClient(c.config)
+	c.Payout = NewPayoutClient(c.config)
+	c.PayoutDay = NewPayoutDayClient(c.config)
+	c.TreasuryCredit = NewTreasuryCreditClient(c.config)
+	c.TreasuryTransfer = NewTreasuryTransferClient(c.config)
+	c.WalletAccount = NewWalletAccountClient(c.config)
 }
 
 type (
@@ -127,9 +159,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Invoice: NewInvoiceClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Invoice:          NewInvoiceClient(cfg),
+		InvoiceEvent:     NewInvoiceEventClient(cfg),
+		LedgerAccount:    NewLedgerAccountClient(cfg),
+		LedgerEntry:      NewLedgerEntryClient(cfg),
+		Payout:           NewPayoutClient(cfg),
+		PayoutDay:        NewPayoutDayClient(cfg),
+		TreasuryCredit:   NewTreasuryCreditClient(cfg),
+		TreasuryTransfer: NewTreasuryTransferClient(cfg),
+		WalletAccount:    NewWalletAccountClient(cfg),
 	}, nil
 }
 
@@ -147,9 +187,17 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Invoice: NewInvoiceClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Invoice:          NewInvoiceClient(cfg),
+		InvoiceEvent:     NewInvoiceEventClient(cfg),
+		LedgerAccount:    NewLedgerAccountClient(cfg),
+		LedgerEntry:      NewLedgerEntryClient(cfg),
+		Payout:           NewPayoutClient(cfg),
+		PayoutDay:        NewPayoutDayClient(cfg),
+		TreasuryCredit:   NewTreasuryCreditClient(cfg),
+		TreasuryTransfer: NewTreasuryTransferClient(cfg),
+		WalletAccount:    NewWalletAccountClient(cfg),
 	}, nil
 }
 
@@ -178,13 +226,23 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Invoice.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Invoice, c.InvoiceEvent, c.LedgerAccount, c.LedgerEntry, c.Payout,
+		c.PayoutDay, c.TreasuryCredit, c.TreasuryTransfer, c.WalletAccount,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Invoice.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Invoice, c.InvoiceEvent, c.LedgerAccount, c.LedgerEntry, c.Payout,
+		c.PayoutDay, c.TreasuryCredit, c.TreasuryTransfer, c.WalletAccount,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -192,6 +250,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *InvoiceMutation:
 		return c.Invoice.mutate(ctx, m)
+	case *InvoiceEventMutation:
+		return c.InvoiceEvent.mutate(ctx, m)
+	case *LedgerAccountMutation:
+		return c.LedgerAccount.mutate(ctx, m)
+	case *LedgerEntryMutation:
+		return c.LedgerEntry.mutate(ctx, m)
+	case *PayoutMutation:
+		return c.Payout.mutate(ctx, m)
+	case *PayoutDayMutation:
+		return c.PayoutDay.mutate(ctx, m)
+	case *TreasuryCreditMutation:
+		return c.TreasuryCredit.mutate(ctx, m)
+	case *TreasuryTransferMutation:
+		return c.TreasuryTransfer.mutate(ctx, m)
+	case *WalletAccountMutation:
+		return c.WalletAccount.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("database: unknown mutation type %T", m)
 	}
@@ -330,12 +404,1078 @@ func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value,
 	}
 }
 
+// InvoiceEventClient is a client for the InvoiceEvent schema.
+type InvoiceEventClient struct {
+	config
+}
+
+// NewInvoiceEventClient returns a client for the InvoiceEvent from the given config.
+func NewInvoiceEventClient(c config) *InvoiceEventClient {
+	return &InvoiceEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoiceevent.Hooks(f(g(h())))`.
+func (c *InvoiceEventClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceEvent = append(c.hooks.InvoiceEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoiceevent.Intercept(f(g(h())))`.
+func (c *InvoiceEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvoiceEvent = append(c.inters.InvoiceEvent, interceptors...)
+}
+
+// Create returns a builder for creating a InvoiceEvent entity.
+func (c *InvoiceEventClient) Create() *InvoiceEventCreate {
+	mutation := newInvoiceEventMutation(c.config, OpCreate)
+	return &InvoiceEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceEvent entities.
+func (c *InvoiceEventClient) CreateBulk(builders ...*InvoiceEventCreate) *InvoiceEventCreateBulk {
+	return &InvoiceEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceEventClient) MapCreateBulk(slice any, setFunc func(*InvoiceEventCreate, int)) *InvoiceEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceEventCreateBulk{err: fmt.Errorf("calling to InvoiceEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceEvent.
+func (c *InvoiceEventClient) Update() *InvoiceEventUpdate {
+	mutation := newInvoiceEventMutation(c.config, OpUpdate)
+	return &InvoiceEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceEventClient) UpdateOne(ie *InvoiceEvent) *InvoiceEventUpdateOne {
+	mutation := newInvoiceEventMutation(c.config, OpUpdateOne, withInvoiceEvent(ie))
+	return &InvoiceEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceEventClient) UpdateOneID(id int) *InvoiceEventUpdateOne {
+	mutation := newInvoiceEventMutation(c.config, OpUpdateOne, withInvoiceEventID(id))
+	return &InvoiceEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceEvent.
+func (c *InvoiceEventClient) Delete() *InvoiceEventDelete {
+	mutation := newInvoiceEventMutation(c.config, OpDelete)
+	return &InvoiceEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceEventClient) DeleteOne(ie *InvoiceEvent) *InvoiceEventDeleteOne {
+	return c.DeleteOneID(ie.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceEventClient) DeleteOneID(id int) *InvoiceEventDeleteOne {
+	builder := c.Delete().Where(invoiceevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceEventDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceEvent.
+func (c *InvoiceEventClient) Query() *InvoiceEventQuery {
+	return &InvoiceEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoiceEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InvoiceEvent entity by its id.
+func (c *InvoiceEventClient) Get(ctx context.Context, id int) (*InvoiceEvent, error) {
+	return c.Query().Where(invoiceevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceEventClient) GetX(ctx context.Context, id int) *InvoiceEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceEventClient) Hooks() []Hook {
+	return c.hooks.InvoiceEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceEventClient) Interceptors() []Interceptor {
+	return c.inters.InvoiceEvent
+}
+
+func (c *InvoiceEventClient) mutate(ctx context.Context, m *InvoiceEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("database: unknown InvoiceEvent mutation op: %q", m.Op())
+	}
+}
+
+// LedgerAccountClient is a client for the LedgerAccount schema.
+type LedgerAccountClient struct {
+	config
+}
+
+// NewLedgerAccountClient returns a client for the LedgerAccount from the given config.
+func NewLedgerAccountClient(c config) *LedgerAccountClient {
+	return &LedgerAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ledgeraccount.Hooks(f(g(h())))`.
+func (c *LedgerAccountClient) Use(hooks ...Hook) {
+	c.hooks.LedgerAccount = append(c.hooks.LedgerAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ledgeraccount.Intercept(f(g(h())))`.
+func (c *LedgerAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LedgerAccount = append(c.inters.LedgerAccount, interceptors...)
+}
+
+// Create returns a builder for creating a LedgerAccount entity.
+func (c *LedgerAccountClient) Create() *LedgerAccountCreate {
+	mutation := newLedgerAccountMutation(c.config, OpCreate)
+	return &LedgerAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LedgerAccount entities.
+func (c *LedgerAccountClient) CreateBulk(builders ...*LedgerAccountCreate) *LedgerAccountCreateBulk {
+	return &LedgerAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LedgerAccountClient) MapCreateBulk(slice any, setFunc func(*LedgerAccountCreate, int)) *LedgerAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LedgerAccountCreateBulk{err: fmt.Errorf("calling to LedgerAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LedgerAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LedgerAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LedgerAccount.
+func (c *LedgerAccountClient) Update() *LedgerAccountUpdate {
+	mutation := newLedgerAccountMutation(c.config, OpUpdate)
+	return &LedgerAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LedgerAccountClient) UpdateOne(la *LedgerAccount) *LedgerAccountUpdateOne {
+	mutation := newLedgerAccountMutation(c.config, OpUpdateOne, withLedgerAccount(la))
+	return &LedgerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LedgerAccountClient) UpdateOneID(id int) *LedgerAccountUpdateOne {
+	mutation := newLedgerAccountMutation(c.config, OpUpdateOne, withLedgerAccountID(id))
+	return &LedgerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LedgerAccount.
+func (c *LedgerAccountClient) Delete() *LedgerAccountDelete {
+	mutation := newLedgerAccountMutation(c.config, OpDelete)
+	return &LedgerAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LedgerAccountClient) DeleteOne(la *LedgerAccount) *LedgerAccountDeleteOne {
+	return c.DeleteOneID(la.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LedgerAccountClient) DeleteOneID(id int) *LedgerAccountDeleteOne {
+	builder := c.Delete().Where(ledgeraccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LedgerAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for LedgerAccount.
+func (c *LedgerAccountClient) Query() *LedgerAccountQuery {
+	return &LedgerAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLedgerAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LedgerAccount entity by its id.
+func (c *LedgerAccountClient) Get(ctx context.Context, id int) (*LedgerAccount, error) {
+	return c.Query().Where(ledgeraccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LedgerAccountClient) GetX(ctx context.Context, id int) *LedgerAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LedgerAccountClient) Hooks() []Hook {
+	return c.hooks.LedgerAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *LedgerAccountClient) Interceptors() []Interceptor {
+	return c.inters.LedgerAccount
+}
+
+func (c *LedgerAccountClient) mutate(ctx context.Context, m *LedgerAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LedgerAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LedgerAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LedgerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LedgerAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("database: unknown LedgerAccount mutation op: %q", m.Op())
+	}
+}
+
+// LedgerEntryClient is a client for the LedgerEntry schema.
+type LedgerEntryClient struct {
+	config
+}
+
+// NewLedgerEntryClient returns a client for the LedgerEntry from the given config.
+func NewLedgerEntryClient(c config) *LedgerEntryClient {
+	return &LedgerEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ledgerentry.Hooks(f(g(h())))`.
+func (c *LedgerEntryClient) Use(hooks ...Hook) {
+	c.hooks.LedgerEntry = append(c.hooks.LedgerEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ledgerentry.Intercept(f(g(h())))`.
+func (c *LedgerEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LedgerEntry = append(c.inters.LedgerEntry, interceptors...)
+}
+
+// Create returns a builder for creating a LedgerEntry entity.
+func (c *LedgerEntryClient) Create() *LedgerEntryCreate {
+	mutation := newLedgerEntryMutation(c.config, OpCreate)
+	return &LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LedgerEntry entities.
+func (c *LedgerEntryClient) CreateBulk(builders ...*LedgerEntryCreate) *LedgerEntryCreateBulk {
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LedgerEntryClient) MapCreateBulk(slice any, setFunc func(*LedgerEntryCreate, int)) *LedgerEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LedgerEntryCreateBulk{err: fmt.Errorf("calling to LedgerEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LedgerEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LedgerEntry.
+func (c *LedgerEntryClient) Update() *LedgerEntryUpdate {
+	mutation := newLedgerEntryMutation(c.config, OpUpdate)
+	return &LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LedgerEntryClient) UpdateOne(le *LedgerEntry) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntry(le))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LedgerEntryClient) UpdateOneID(id int) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntryID(id))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LedgerEntry.
+func (c *LedgerEntryClient) Delete() *LedgerEntryDelete {
+	mutation := newLedgerEntryMutation(c.config, OpDelete)
+	return &LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LedgerEntryClient) DeleteOne(le *LedgerEntry) *LedgerEntryDeleteOne {
+	return c.DeleteOneID(le.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LedgerEntryClient) DeleteOneID(id int) *LedgerEntryDeleteOne {
+	builder := c.Delete().Where(ledgerentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LedgerEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for LedgerEntry.
+func (c *LedgerEntryClient) Query() *LedgerEntryQuery {
+	return &LedgerEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLedgerEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LedgerEntry entity by its id.
+func (c *LedgerEntryClient) Get(ctx context.Context, id int) (*LedgerEntry, error) {
+	return c.Query().Where(ledgerentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LedgerEntryClient) GetX(ctx context.Context, id int) *LedgerEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LedgerEntryClient) Hooks() []Hook {
+	return c.hooks.LedgerEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *LedgerEntryClient) Interceptors() []Interceptor {
+	return c.inters.LedgerEntry
+}
+
+func (c *LedgerEntryClient) mutate(ctx context.Context, m *LedgerEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("database: unknown LedgerEntry mutation op: %q", m.Op())
+	}
+}
+
+// PayoutClient is a client for the Payout schema.
+type PayoutClient struct {
+	config
+}
+
+// NewPayoutClient returns a client for the Payout from the given config.
+func NewPayoutClient(c config) *PayoutClient {
+	return &PayoutClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payout.Hooks(f(g(h())))`.
+func (c *PayoutClient) Use(hooks ...Hook) {
+	c.hooks.Payout = append(c.hooks.Payout, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payout.Intercept(f(g(h())))`.
+func (c *PayoutClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Payout = append(c.inters.Payout, interceptors...)
+}
+
+// Create returns a builder for creating a Payout entity.
+func (c *PayoutClient) Create() *PayoutCreate {
+	mutation := newPayoutMutation(c.config, OpCreate)
+	return &PayoutCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Payout entities.
+func (c *PayoutClient) CreateBulk(builders ...*PayoutCreate) *PayoutCreateBulk {
+	return &PayoutCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayoutClient) MapCreateBulk(slice any, setFunc func(*PayoutCreate, int)) *PayoutCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayoutCreateBulk{err: fmt.Errorf("calling to PayoutClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayoutCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayoutCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Payout.
+func (c *PayoutClient) Update() *PayoutUpdate {
+	mutation := newPayoutMutation(c.config, OpUpdate)
+	return &PayoutUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayoutClient) UpdateOne(pa *Payout) *PayoutUpdateOne {
+	mutation := newPayoutMutation(c.config, OpUpdateOne, withPayout(pa))
+	return &PayoutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayoutClient) UpdateOneID(id string) *PayoutUpdateOne {
+	mutation := newPayoutMutation(c.config, OpUpdateOne, withPayoutID(id))
+	return &PayoutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Payout.
+func (c *PayoutClient) Delete() *PayoutDelete {
+	mutation := newPayoutMutation(c.config, OpDelete)
+	return &PayoutDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayoutClient) DeleteOne(pa *Payout) *PayoutDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayoutClient) DeleteOneID(id string) *PayoutDeleteOne {
+	builder := c.Delete().Where(payout.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayoutDeleteOne{builder}
+}
+
+// Query returns a query builder for Payout.
+func (c *PayoutClient) Query() *PayoutQuery {
+	return &PayoutQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayout},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Payout entity by its id.
+func (c *PayoutClient) Get(ctx context.Context, id string) (*Payout, error) {
+	return c.Query().Where(payout.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayoutClient) GetX(ctx context.Context, id string) *Payout {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PayoutClient) Hooks() []Hook {
+	return c.hooks.Payout
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayoutClient) Interceptors() []Interceptor {
+	return c.inters.Payout
+}
+
+func (c *PayoutClient) mutate(ctx context.Context, m *PayoutMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayoutCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayoutUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayoutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayoutDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("database: unknown Payout mutation op: %q", m.Op())
+	}
+}
+
+// PayoutDayClient is a client for the PayoutDay schema.
+type PayoutDayClient struct {
+	config
+}
+
+// NewPayoutDayClient returns a client for the PayoutDay from the given config.
+func NewPayoutDayClient(c config) *PayoutDayClient {
+	return &PayoutDayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payoutday.Hooks(f(g(h())))`.
+func (c *PayoutDayClient) Use(hooks ...Hook) {
+	c.hooks.PayoutDay = append(c.hooks.PayoutDay, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payoutday.Intercept(f(g(h())))`.
+func (c *PayoutDayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayoutDay = append(c.inters.PayoutDay, interceptors...)
+}
+
+// Create returns a builder for creating a PayoutDay entity.
+func (c *PayoutDayClient) Create() *PayoutDayCreate {
+	mutation := newPayoutDayMutation(c.config, OpCreate)
+	return &PayoutDayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayoutDay entities.
+func (c *PayoutDayClient) CreateBulk(builders ...*PayoutDayCreate) *PayoutDayCreateBulk {
+	return &PayoutDayCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayoutDayClient) MapCreateBulk(slice any, setFunc func(*PayoutDayCreate, int)) *PayoutDayCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayoutDayCreateBulk{err: fmt.Errorf("calling to PayoutDayClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayoutDayCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayoutDayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayoutDay.
+func (c *PayoutDayClient) Update() *PayoutDayUpdate {
+	mutation := newPayoutDayMutation(c.config, OpUpdate)
+	return &PayoutDayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayoutDayClient) UpdateOne(pd *PayoutDay) *PayoutDayUpdateOne {
+	mutation := newPayoutDayMutation(c.config, OpUpdateOne, withPayoutDay(pd))
+	return &PayoutDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayoutDayClient) UpdateOneID(id string) *PayoutDayUpdateOne {
+	mutation := newPayoutDayMutation(c.config, OpUpdateOne, withPayoutDayID(id))
+	return &PayoutDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayoutDay.
+func (c *PayoutDayClient) Delete() *PayoutDayDelete {
+	mutation := newPayoutDayMutation(c.config, OpDelete)
+	return &PayoutDayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayoutDayClient) DeleteOne(pd *PayoutDay) *PayoutDayDeleteOne {
+	return c.DeleteOneID(pd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayoutDayClient) DeleteOneID(id string) *PayoutDayDeleteOne {
+	builder := c.Delete().Where(payoutday.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayoutDayDeleteOne{builder}
+}
+
+// Query returns a query builder for PayoutDay.
+func (c *PayoutDayClient) Query() *PayoutDayQuery {
+	return &PayoutDayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayoutDay},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayoutDay entity by its id.
+func (c *PayoutDayClient) Get(ctx context.Context, id string) (*PayoutDay, error) {
+	return c.Query().Where(payoutday.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayoutDayClient) GetX(ctx context.Context, id string) *PayoutDay {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PayoutDayClient) Hooks() []Hook {
+	return c.hooks.PayoutDay
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayoutDayClient) Interceptors() []Interceptor {
+	return c.inters.PayoutDay
+}
+
+func (c *PayoutDayClient) mutate(ctx context.Context, m *PayoutDayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayoutDayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayoutDayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayoutDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayoutDayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("database: unknown PayoutDay mutation op: %q", m.Op())
+	}
+}
+
+// TreasuryCreditClient is a client for the TreasuryCredit schema.
+type TreasuryCreditClient struct {
+	config
+}
+
+// NewTreasuryCreditClient returns a client for the TreasuryCredit from the given config.
+func NewTreasuryCreditClient(c config) *TreasuryCreditClient {
+	return &TreasuryCreditClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `treasurycredit.Hooks(f(g(h())))`.
+func (c *TreasuryCreditClient) Use(hooks ...Hook) {
+	c.hooks.TreasuryCredit = append(c.hooks.TreasuryCredit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `treasurycredit.Intercept(f(g(h())))`.
+func (c *TreasuryCreditClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TreasuryCredit = append(c.inters.TreasuryCredit, interceptors...)
+}
+
+// Create returns a builder for creating a TreasuryCredit entity.
+func (c *TreasuryCreditClient) Create() *TreasuryCreditCreate {
+	mutation := newTreasuryCreditMutation(c.config, OpCreate)
+	return &TreasuryCreditCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TreasuryCredit entities.
+func (c *TreasuryCreditClient) CreateBulk(builders ...*TreasuryCreditCreate) *TreasuryCreditCreateBulk {
+	return &TreasuryCreditCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TreasuryCreditClient) MapCreateBulk(slice any, setFunc func(*TreasuryCreditCreate, int)) *TreasuryCreditCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TreasuryCreditCreateBulk{err: fmt.Errorf("calling to TreasuryCreditClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TreasuryCreditCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TreasuryCreditCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TreasuryCredit.
+func (c *TreasuryCreditClient) Update() *TreasuryCreditUpdate {
+	mutation := newTreasuryCreditMutation(c.config, OpUpdate)
+	return &TreasuryCreditUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TreasuryCreditClient) UpdateOne(tc *TreasuryCredit) *TreasuryCreditUpdateOne {
+	mutation := newTreasuryCreditMutation(c.config, OpUpdateOne, withTreasuryCredit(tc))
+	return &TreasuryCreditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TreasuryCreditClient) UpdateOneID(id int) *TreasuryCreditUpdateOne {
+	mutation := newTreasuryCreditMutation(c.config, OpUpdateOne, withTreasuryCreditID(id))
+	return &TreasuryCreditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TreasuryCredit.
+func (c *TreasuryCreditClient) Delete() *TreasuryCreditDelete {
+	mutation := newTreasuryCreditMutation(c.config, OpDelete)
+	return &TreasuryCreditDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TreasuryCreditClient) DeleteOne(tc *TreasuryCredit) *TreasuryCreditDeleteOne {
+	return c.DeleteOneID(tc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TreasuryCreditClient) DeleteOneID(id int) *TreasuryCreditDeleteOne {
+	builder := c.Delete().Where(treasurycredit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TreasuryCreditDeleteOne{builder}
+}
+
+// Query returns a query builder for TreasuryCredit.
+func (c *TreasuryCreditClient) Query() *TreasuryCreditQuery {
+	return &TreasuryCreditQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTreasuryCredit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TreasuryCredit entity by its id.
+func (c *TreasuryCreditClient) Get(ctx context.Context, id int) (*TreasuryCredit, error) {
+	return c.Query().Where(treasurycredit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TreasuryCreditClient) GetX(ctx context.Context, id int) *TreasuryCredit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TreasuryCreditClient) Hooks() []Hook {
+	return c.hooks.TreasuryCredit
+}
+
+// Interceptors returns the client interceptors.
+func (c *TreasuryCreditClient) Interceptors() []Interceptor {
+	return c.inters.TreasuryCredit
+}
+
+func (c *TreasuryCreditClient) mutate(ctx context.Context, m *TreasuryCreditMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TreasuryCreditCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TreasuryCreditUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TreasuryCreditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TreasuryCreditDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("database: unknown TreasuryCredit mutation op: %q", m.Op())
+	}
+}
+
+// TreasuryTransferClient is a client for the TreasuryTransfer schema.
+type TreasuryTransferClient struct {
+	config
+}
+
+// NewTreasuryTransferClient returns a client for the TreasuryTransfer from the given config.
+func NewTreasuryTransferClient(c config) *TreasuryTransferClient {
+	return &TreasuryTransferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `treasurytransfer.Hooks(f(g(h())))`.
+func (c *TreasuryTransferClient) Use(hooks ...Hook) {
+	c.hooks.TreasuryTransfer = append(c.hooks.TreasuryTransfer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `treasurytransfer.Intercept(f(g(h())))`.
+func (c *TreasuryTransferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TreasuryTransfer = append(c.inters.TreasuryTransfer, interceptors...)
+}
+
+// Create returns a builder for creating a TreasuryTransfer entity.
+func (c *TreasuryTransferClient) Create() *TreasuryTransferCreate {
+	mutation := newTreasuryTransferMutation(c.config, OpCreate)
+	return &TreasuryTransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TreasuryTransfer entities.
+func (c *TreasuryTransferClient) CreateBulk(builders ...*TreasuryTransferCreate) *TreasuryTransferCreateBulk {
+	return &TreasuryTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TreasuryTransferClient) MapCreateBulk(slice any, setFunc func(*TreasuryTransferCreate, int)) *TreasuryTransferCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TreasuryTransferCreateBulk{err: fmt.Errorf("calling to TreasuryTransferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TreasuryTransferCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TreasuryTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TreasuryTransfer.
+func (c *TreasuryTransferClient) Update() *TreasuryTransferUpdate {
+	mutation := newTreasuryTransferMutation(c.config, OpUpdate)
+	return &TreasuryTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TreasuryTransferClient) UpdateOne(tt *TreasuryTransfer) *TreasuryTransferUpdateOne {
+	mutation := newTreasuryTransferMutation(c.config, OpUpdateOne, withTreasuryTransfer(tt))
+	return &TreasuryTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TreasuryTransferClient) UpdateOneID(id string) *TreasuryTransferUpdateOne {
+	mutation := newTreasuryTransferMutation(c.config, OpUpdateOne, withTreasuryTransferID(id))
+	return &TreasuryTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TreasuryTransfer.
+func (c *TreasuryTransferClient) Delete() *TreasuryTransferDelete {
+	mutation := newTreasuryTransferMutation(c.config, OpDelete)
+	return &TreasuryTransferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TreasuryTransferClient) DeleteOne(tt *TreasuryTransfer) *TreasuryTransferDeleteOne {
+	return c.DeleteOneID(tt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TreasuryTransferClient) DeleteOneID(id string) *TreasuryTransferDeleteOne {
+	builder := c.Delete().Where(treasurytransfer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TreasuryTransferDeleteOne{builder}
+}
+
+// Query returns a query builder for TreasuryTransfer.
+func (c *TreasuryTransferClient) Query() *TreasuryTransferQuery {
+	return &TreasuryTransferQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTreasuryTransfer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TreasuryTransfer entity by its id.
+func (c *TreasuryTransferClient) Get(ctx context.Context, id string) (*TreasuryTransfer, error) {
+	return c.Query().Where(treasurytransfer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TreasuryTransferClient) GetX(ctx context.Context, id string) *TreasuryTransfer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TreasuryTransferClient) Hooks() []Hook {
+	return c.hooks.TreasuryTransfer
+}
+
+// Interceptors returns the client interceptors.
+func (c *TreasuryTransferClient) Interceptors() []Interceptor {
+	return c.inters.TreasuryTransfer
+}
+
+func (c *TreasuryTransferClient) mutate(ctx context.Context, m *TreasuryTransferMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TreasuryTransferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TreasuryTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TreasuryTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TreasuryTransferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("database: unknown TreasuryTransfer mutation op: %q", m.Op())
+	}
+}
+
+// WalletAccountClient is a client for the WalletAccount schema.
+type WalletAccountClient struct {
+	config
+}
+
+// NewWalletAccountClient returns a client for the WalletAccount from the given config.
+func NewWalletAccountClient(c config) *WalletAccountClient {
+	return &WalletAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `walletaccount.Hooks(f(g(h())))`.
+func (c *WalletAccountClient) Use(hooks ...Hook) {
+	c.hooks.WalletAccount = append(c.hooks.WalletAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `walletaccount.Intercept(f(g(h())))`.
+func (c *WalletAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WalletAccount = append(c.inters.WalletAccount, interceptors...)
+}
+
+// Create returns a builder for creating a WalletAccount entity.
+func (c *WalletAccountClient) Create() *WalletAccountCreate {
+	mutation := newWalletAccountMutation(c.config, OpCreate)
+	return &WalletAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WalletAccount entities.
+func (c *WalletAccountClient) CreateBulk(builders ...*WalletAccountCreate) *WalletAccountCreateBulk {
+	return &WalletAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WalletAccountClient) MapCreateBulk(slice any, setFunc func(*WalletAccountCreate, int)) *WalletAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WalletAccountCreateBulk{err: fmt.Errorf("calling to WalletAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WalletAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WalletAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WalletAccount.
+func (c *WalletAccountClient) Update() *WalletAccountUpdate {
+	mutation := newWalletAccountMutation(c.config, OpUpdate)
+	return &WalletAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WalletAccountClient) UpdateOne(wa *WalletAccount) *WalletAccountUpdateOne {
+	mutation := newWalletAccountMutation(c.config, OpUpdateOne, withWalletAccount(wa))
+	return &WalletAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WalletAccountClient) UpdateOneID(id string) *WalletAccountUpdateOne {
+	mutation := newWalletAccountMutation(c.config, OpUpdateOne, withWalletAccountID(id))
+	return &WalletAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WalletAccount.
+func (c *WalletAccountClient) Delete() *WalletAccountDelete {
+	mutation := newWalletAccountMutation(c.config, OpDelete)
+	return &WalletAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WalletAccountClient) DeleteOne(wa *WalletAccount) *WalletAccountDeleteOne {
+	return c.DeleteOneID(wa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WalletAccountClient) DeleteOneID(id string) *WalletAccountDeleteOne {
+	builder := c.Delete().Where(walletaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WalletAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for WalletAccount.
+func (c *WalletAccountClient) Query() *WalletAccountQuery {
+	return &WalletAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWalletAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WalletAccount entity by its id.
+func (c *WalletAccountClient) Get(ctx context.Context, id string) (*WalletAccount, error) {
+	return c.Query().Where(walletaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WalletAccountClient) GetX(ctx context.Context, id string) *WalletAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WalletAccountClient) Hooks() []Hook {
+	return c.hooks.WalletAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *WalletAccountClient) Interceptors() []Interceptor {
+	return c.inters.WalletAccount
+}
+
+func (c *WalletAccountClient) mutate(ctx context.Context, m *WalletAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WalletAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WalletAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WalletAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WalletAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("database: unknown WalletAccount mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Invoice []ent.Hook
+		Invoice, InvoiceEvent, LedgerAccount, LedgerEntry, Payout, PayoutDay,
+		TreasuryCredit, TreasuryTransfer, WalletAccount []ent.Hook
 	}
 	inters struct {
-		Invoice []ent.Interceptor
+		Invoice, InvoiceEvent, LedgerAccount, LedgerEntry, Payout, PayoutDay,
+		TreasuryCredit, TreasuryTransfer, WalletAccount []ent.Interceptor
 	}
 )
@@ -5,6 +5,14 @@ package database
 import (
 	"context"
 	"cpg/pkg/ent/database/invoice"
+	"cpg/pkg/ent/database/invoiceevent"
+	"cpg/pkg/ent/database/ledgeraccount"
+	"cpg/pkg/ent/database/ledgerentry"
+	"cpg/pkg/ent/database/payout"
+	"cpg/pkg/ent/database/payoutday"
+	"cpg/pkg/ent/database/treasurycredit"
+	"cpg/pkg/ent/database/treasurytransfer"
+	"cpg/pkg/ent/database/walletaccount"
 	"errors"
 	"fmt"
 	"reflect"
@@ -73,7 +81,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			invoice.Table: invoice.ValidColumn,
+			invoice.Table:          invoice.ValidColumn,
+			invoiceevent.Table:     invoiceevent.ValidColumn,
+			ledgeraccount.Table:    ledgeraccount.ValidColumn,
+			ledgerentry.Table:      ledgerentry.ValidColumn,
+			payout.Table:           payout.ValidColumn,
+			payoutday.Table:        payoutday.ValidColumn,
+			treasurycredit.Table:   treasurycredit.ValidColumn,
+			treasurytransfer.Table: treasurytransfer.ValidColumn,
+			walletaccount.Table:    walletaccount.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -20,6 +20,102 @@ func (f InvoiceFunc) Mutate(ctx context.Context, m database.Mutation) (database.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *database.InvoiceMutation", m)
 }
 
+// The InvoiceEventFunc type is an adapter to allow the use of ordinary
+// function as InvoiceEvent mutator.
+type InvoiceEventFunc func(context.Context, *database.InvoiceEventMutation) (database.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceEventFunc) Mutate(ctx context.Context, m database.Mutation) (database.Value, error) {
+	if mv, ok := m.(*database.InvoiceEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *database.InvoiceEventMutation", m)
+}
+
+// The LedgerAccountFunc type is an adapter to allow the use of ordinary
+// function as LedgerAccount mutator.
+type LedgerAccountFunc func(context.Context, *database.LedgerAccountMutation) (database.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LedgerAccountFunc) Mutate(ctx context.Context, m database.Mutation) (database.Value, error) {
+	if mv, ok := m.(*database.LedgerAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *database.LedgerAccountMutation", m)
+}
+
+// The LedgerEntryFunc type is an adapter to allow the use of ordinary
+// function as LedgerEntry mutator.
+type LedgerEntryFunc func(context.Context, *database.LedgerEntryMutation) (database.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LedgerEntryFunc) Mutate(ctx context.Context, m database.Mutation) (database.Value, error) {
+	if mv, ok := m.(*database.LedgerEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *database.LedgerEntryMutation", m)
+}
+
+// The PayoutFunc type is an adapter to allow the use of ordinary
+// function as Payout mutator.
+type PayoutFunc func(context.Context, *database.PayoutMutation) (database.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayoutFunc) Mutate(ctx context.Context, m database.Mutation) (database.Value, error) {
+	if mv, ok := m.(*database.PayoutMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *database.PayoutMutation", m)
+}
+
+// The PayoutDayFunc type is an adapter to allow the use of ordinary
+// function as PayoutDay mutator.
+type PayoutDayFunc func(context.Context, *database.PayoutDayMutation) (database.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayoutDayFunc) Mutate(ctx context.Context, m database.Mutation) (database.Value, error) {
+	if mv, ok := m.(*database.PayoutDayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *database.PayoutDayMutation", m)
+}
+
+// The TreasuryCreditFunc type is an adapter to allow the use of ordinary
+// function as TreasuryCredit mutator.
+type TreasuryCreditFunc func(context.Context, *database.TreasuryCreditMutation) (database.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TreasuryCreditFunc) Mutate(ctx context.Context, m database.Mutation) (database.Value, error) {
+	if mv, ok := m.(*database.TreasuryCreditMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *database.TreasuryCreditMutation", m)
+}
+
+// The TreasuryTransferFunc type is an adapter to allow the use of ordinary
+// function as TreasuryTransfer mutator.
+type TreasuryTransferFunc func(context.Context, *database.TreasuryTransferMutation) (database.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TreasuryTransferFunc) Mutate(ctx context.Context, m database.Mutation) (database.Value, error) {
+	if mv, ok := m.(*database.TreasuryTransferMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *database.TreasuryTransferMutation", m)
+}
+
+// The WalletAccountFunc type is an adapter to allow the use of ordinary
+// function as WalletAccount mutator.
+type WalletAccountFunc func(context.Context, *database.WalletAccountMutation) (database.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WalletAccountFunc) Mutate(ctx context.Context, m database.Mutation) (database.Value, error) {
+	if mv, ok := m.(*database.WalletAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *database.WalletAccountMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, database.Mutation) bool
 
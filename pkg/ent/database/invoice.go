@@ -4,6 +4,8 @@ package database
 
 import (
 	"cpg/pkg/ent/database/invoice"
+	"cpg/pkg/ent/schema"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strings"
@@ -46,7 +48,43 @@ type Invoice struct {
 	WalletAddress string `json:"wallet_address,omitempty"`
 	// EncryptedSalt holds the value of the "encrypted_salt" field.
 	EncryptedSalt []byte `json:"-"`
-	selectValues  sql.SelectValues
+	// DerivationVersion holds the value of the "derivation_version" field.
+	DerivationVersion int `json:"derivation_version,omitempty"`
+	// WalletIndex holds the value of the "wallet_index" field.
+	WalletIndex *int64 `json:"wallet_index,omitempty"`
+	// ApprovalRequestAt holds the value of the "approval_request_at" field.
+	ApprovalRequestAt *time.Time `json:"approval_request_at,omitempty"`
+	// ApproveAt holds the value of the "approve_at" field.
+	ApproveAt *time.Time `json:"approve_at,omitempty"`
+	// EscrowPeriod holds the value of the "escrow_period" field.
+	EscrowPeriod int64 `json:"escrow_period,omitempty"`
+	// BuyerKeyHash holds the value of the "buyer_key_hash" field.
+	BuyerKeyHash []byte `json:"-"`
+	// ReleaseAt holds the value of the "release_at" field.
+	ReleaseAt *time.Time `json:"release_at,omitempty"`
+	// RefundAt holds the value of the "refund_at" field.
+	RefundAt *time.Time `json:"refund_at,omitempty"`
+	// DisputeAt holds the value of the "dispute_at" field.
+	DisputeAt *time.Time `json:"dispute_at,omitempty"`
+	// FeeAddress holds the value of the "fee_address" field.
+	FeeAddress string `json:"fee_address,omitempty"`
+	// FeeBasisPoints holds the value of the "fee_basis_points" field.
+	FeeBasisPoints int64 `json:"fee_basis_points,omitempty"`
+	// FeeFlat holds the value of the "fee_flat" field.
+	FeeFlat *big.Int `json:"fee_flat,omitempty"`
+	// FeeAmount holds the value of the "fee_amount" field.
+	FeeAmount *big.Int `json:"fee_amount,omitempty"`
+	// FeeTxHash holds the value of the "fee_tx_hash" field.
+	FeeTxHash string `json:"fee_tx_hash,omitempty"`
+	// Split holds the value of the "split" field.
+	Split []schema.SplitShare `json:"split,omitempty"`
+	// SplitGasRule holds the value of the "split_gas_rule" field.
+	SplitGasRule string `json:"split_gas_rule,omitempty"`
+	// TreasuryAddress holds the value of the "treasury_address" field.
+	TreasuryAddress string `json:"treasury_address,omitempty"`
+	// SignerMAC holds the value of the "signer_mac" field.
+	SignerMAC    []byte `json:"-"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -54,16 +92,22 @@ func (*Invoice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case invoice.FieldEncryptedSalt:
+		case invoice.FieldEncryptedSalt, invoice.FieldBuyerKeyHash, invoice.FieldSplit, invoice.FieldSignerMAC:
 			values[i] = new([]byte)
 		case invoice.FieldAutoCheckout:
 			values[i] = new(sql.NullBool)
-		case invoice.FieldID, invoice.FieldRecipient, invoice.FieldBeneficiary, invoice.FieldAsset, invoice.FieldMetadata, invoice.FieldWalletAddress:
+		case invoice.FieldDerivationVersion, invoice.FieldWalletIndex, invoice.FieldEscrowPeriod, invoice.FieldFeeBasisPoints:
+			values[i] = new(sql.NullInt64)
+		case invoice.FieldID, invoice.FieldRecipient, invoice.FieldBeneficiary, invoice.FieldAsset, invoice.FieldMetadata, invoice.FieldWalletAddress, invoice.FieldFeeAddress, invoice.FieldFeeTxHash, invoice.FieldSplitGasRule, invoice.FieldTreasuryAddress:
 			values[i] = new(sql.NullString)
-		case invoice.FieldCreateAt, invoice.FieldDeadline, invoice.FieldFillAt, invoice.FieldLastCheckoutAt, invoice.FieldCheckoutRequestAt, invoice.FieldCancelAt:
+		case invoice.FieldCreateAt, invoice.FieldDeadline, invoice.FieldFillAt, invoice.FieldLastCheckoutAt, invoice.FieldCheckoutRequestAt, invoice.FieldCancelAt, invoice.FieldApprovalRequestAt, invoice.FieldApproveAt, invoice.FieldReleaseAt, invoice.FieldRefundAt, invoice.FieldDisputeAt:
 			values[i] = new(sql.NullTime)
 		case invoice.FieldMinAmount:
 			values[i] = invoice.ValueScanner.MinAmount.ScanValue()
+		case invoice.FieldFeeFlat:
+			values[i] = invoice.ValueScanner.FeeFlat.ScanValue()
+		case invoice.FieldFeeAmount:
+			values[i] = invoice.ValueScanner.FeeAmount.ScanValue()
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -173,6 +217,122 @@ func (i *Invoice) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				i.EncryptedSalt = *value
 			}
+		case invoice.FieldDerivationVersion:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field derivation_version", values[j])
+			} else if value.Valid {
+				i.DerivationVersion = int(value.Int64)
+			}
+		case invoice.FieldWalletIndex:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_index", values[j])
+			} else if value.Valid {
+				i.WalletIndex = new(int64)
+				*i.WalletIndex = value.Int64
+			}
+		case invoice.FieldApprovalRequestAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_request_at", values[j])
+			} else if value.Valid {
+				i.ApprovalRequestAt = new(time.Time)
+				*i.ApprovalRequestAt = value.Time
+			}
+		case invoice.FieldApproveAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approve_at", values[j])
+			} else if value.Valid {
+				i.ApproveAt = new(time.Time)
+				*i.ApproveAt = value.Time
+			}
+		case invoice.FieldEscrowPeriod:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field escrow_period", values[j])
+			} else if value.Valid {
+				i.EscrowPeriod = value.Int64
+			}
+		case invoice.FieldBuyerKeyHash:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_key_hash", values[j])
+			} else if value != nil {
+				i.BuyerKeyHash = *value
+			}
+		case invoice.FieldReleaseAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field release_at", values[j])
+			} else if value.Valid {
+				i.ReleaseAt = new(time.Time)
+				*i.ReleaseAt = value.Time
+			}
+		case invoice.FieldRefundAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_at", values[j])
+			} else if value.Valid {
+				i.RefundAt = new(time.Time)
+				*i.RefundAt = value.Time
+			}
+		case invoice.FieldDisputeAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field dispute_at", values[j])
+			} else if value.Valid {
+				i.DisputeAt = new(time.Time)
+				*i.DisputeAt = value.Time
+			}
+		case invoice.FieldFeeAddress:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_address", values[j])
+			} else if value.Valid {
+				i.FeeAddress = value.String
+			}
+		case invoice.FieldFeeBasisPoints:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_basis_points", values[j])
+			} else if value.Valid {
+				i.FeeBasisPoints = value.Int64
+			}
+		case invoice.FieldFeeFlat:
+			if value, err := invoice.ValueScanner.FeeFlat.FromValue(values[j]); err != nil {
+				return err
+			} else {
+				i.FeeFlat = value
+			}
+		case invoice.FieldFeeAmount:
+			if value, err := invoice.ValueScanner.FeeAmount.FromValue(values[j]); err != nil {
+				return err
+			} else {
+				i.FeeAmount = value
+			}
+		case invoice.FieldFeeTxHash:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_tx_hash", values[j])
+			} else if value.Valid {
+				i.FeeTxHash = value.String
+			}
+		case invoice.FieldSplit:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field split", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.Split); err != nil {
+					return fmt.Errorf("unmarshal field split: %w", err)
+				}
+			}
+		case invoice.FieldSplitGasRule:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field split_gas_rule", values[j])
+			} else if value.Valid {
+				i.SplitGasRule = value.String
+			}
+		case invoice.FieldTreasuryAddress:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field treasury_address", values[j])
+			} else if value.Valid {
+				i.TreasuryAddress = value.String
+			}
+		case invoice.FieldSignerMAC:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field signer_mac", values[j])
+			} else if value != nil {
+				i.SignerMAC = *value
+			}
 		default:
 			i.selectValues.Set(columns[j], values[j])
 		}
@@ -257,6 +417,70 @@ func (i *Invoice) String() string {
 	builder.WriteString(i.WalletAddress)
 	builder.WriteString(", ")
 	builder.WriteString("encrypted_salt=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("derivation_version=")
+	builder.WriteString(fmt.Sprintf("%v", i.DerivationVersion))
+	builder.WriteString(", ")
+	if v := i.WalletIndex; v != nil {
+		builder.WriteString("wallet_index=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := i.ApprovalRequestAt; v != nil {
+		builder.WriteString("approval_request_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.ApproveAt; v != nil {
+		builder.WriteString("approve_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("escrow_period=")
+	builder.WriteString(fmt.Sprintf("%v", i.EscrowPeriod))
+	builder.WriteString(", ")
+	builder.WriteString("buyer_key_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := i.ReleaseAt; v != nil {
+		builder.WriteString("release_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.RefundAt; v != nil {
+		builder.WriteString("refund_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.DisputeAt; v != nil {
+		builder.WriteString("dispute_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fee_address=")
+	builder.WriteString(i.FeeAddress)
+	builder.WriteString(", ")
+	builder.WriteString("fee_basis_points=")
+	builder.WriteString(fmt.Sprintf("%v", i.FeeBasisPoints))
+	builder.WriteString(", ")
+	builder.WriteString("fee_flat=")
+	builder.WriteString(fmt.Sprintf("%v", i.FeeFlat))
+	builder.WriteString(", ")
+	builder.WriteString("fee_amount=")
+	builder.WriteString(fmt.Sprintf("%v", i.FeeAmount))
+	builder.WriteString(", ")
+	builder.WriteString("fee_tx_hash=")
+	builder.WriteString(i.FeeTxHash)
+	builder.WriteString(", ")
+	builder.WriteString("split=")
+	builder.WriteString(fmt.Sprintf("%v", i.Split))
+	builder.WriteString(", ")
+	builder.WriteString("split_gas_rule=")
+	builder.WriteString(i.SplitGasRule)
+	builder.WriteString(", ")
+	builder.WriteString("treasury_address=")
+	builder.WriteString(i.TreasuryAddress)
+	builder.WriteString(", ")
+	builder.WriteString("signer_mac=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -43,6 +43,42 @@ const (
 	FieldWalletAddress = "wallet_address"
 	// FieldEncryptedSalt holds the string denoting the encrypted_salt field in the database.
 	FieldEncryptedSalt = "encrypted_salt"
+	// FieldDerivationVersion holds the string denoting the derivation_version field in the database.
+	FieldDerivationVersion = "derivation_version"
+	// FieldWalletIndex holds the string denoting the wallet_index field in the database.
+	FieldWalletIndex = "wallet_index"
+	// FieldApprovalRequestAt holds the string denoting the approval_request_at field in the database.
+	FieldApprovalRequestAt = "approval_request_at"
+	// FieldApproveAt holds the string denoting the approve_at field in the database.
+	FieldApproveAt = "approve_at"
+	// FieldEscrowPeriod holds the string denoting the escrow_period field in the database.
+	FieldEscrowPeriod = "escrow_period"
+	// FieldBuyerKeyHash holds the string denoting the buyer_key_hash field in the database.
+	FieldBuyerKeyHash = "buyer_key_hash"
+	// FieldReleaseAt holds the string denoting the release_at field in the database.
+	FieldReleaseAt = "release_at"
+	// FieldRefundAt holds the string denoting the refund_at field in the database.
+	FieldRefundAt = "refund_at"
+	// FieldDisputeAt holds the string denoting the dispute_at field in the database.
+	FieldDisputeAt = "dispute_at"
+	// FieldFeeAddress holds the string denoting the fee_address field in the database.
+	FieldFeeAddress = "fee_address"
+	// FieldFeeBasisPoints holds the string denoting the fee_basis_points field in the database.
+	FieldFeeBasisPoints = "fee_basis_points"
+	// FieldFeeFlat holds the string denoting the fee_flat field in the database.
+	FieldFeeFlat = "fee_flat"
+	// FieldFeeAmount holds the string denoting the fee_amount field in the database.
+	FieldFeeAmount = "fee_amount"
+	// FieldFeeTxHash holds the string denoting the fee_tx_hash field in the database.
+	FieldFeeTxHash = "fee_tx_hash"
+	// FieldSplit holds the string denoting the split field in the database.
+	FieldSplit = "split"
+	// FieldSplitGasRule holds the string denoting the split_gas_rule field in the database.
+	FieldSplitGasRule = "split_gas_rule"
+	// FieldTreasuryAddress holds the string denoting the treasury_address field in the database.
+	FieldTreasuryAddress = "treasury_address"
+	// FieldSignerMAC holds the string denoting the signer_mac field in the database.
+	FieldSignerMAC = "signer_mac"
 	// Table holds the table name of the invoice in the database.
 	Table = "invoices"
 )
@@ -64,6 +100,24 @@ var Columns = []string{
 	FieldCancelAt,
 	FieldWalletAddress,
 	FieldEncryptedSalt,
+	FieldDerivationVersion,
+	FieldWalletIndex,
+	FieldApprovalRequestAt,
+	FieldApproveAt,
+	FieldEscrowPeriod,
+	FieldBuyerKeyHash,
+	FieldReleaseAt,
+	FieldRefundAt,
+	FieldDisputeAt,
+	FieldFeeAddress,
+	FieldFeeBasisPoints,
+	FieldFeeFlat,
+	FieldFeeAmount,
+	FieldFeeTxHash,
+	FieldSplit,
+	FieldSplitGasRule,
+	FieldTreasuryAddress,
+	FieldSignerMAC,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -95,11 +149,27 @@ var (
 	WalletAddressValidator func(string) error
 	// EncryptedSaltValidator is a validator for the "encrypted_salt" field. It is called by the builders before save.
 	EncryptedSaltValidator func([]byte) error
+	// DefaultDerivationVersion holds the default value on creation for the "derivation_version" field.
+	DefaultDerivationVersion int
+	// DerivationVersionValidator is a validator for the "derivation_version" field. It is called by the builders before save.
+	DerivationVersionValidator func(int) error
+	// WalletIndexValidator is a validator for the "wallet_index" field. It is called by the builders before save.
+	WalletIndexValidator func(int64) error
+	// DefaultEscrowPeriod holds the default value on creation for the "escrow_period" field.
+	DefaultEscrowPeriod int64
+	// EscrowPeriodValidator is a validator for the "escrow_period" field. It is called by the builders before save.
+	EscrowPeriodValidator func(int64) error
+	// DefaultFeeBasisPoints holds the default value on creation for the "fee_basis_points" field.
+	DefaultFeeBasisPoints int64
+	// FeeBasisPointsValidator is a validator for the "fee_basis_points" field. It is called by the builders before save.
+	FeeBasisPointsValidator func(int64) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 	// ValueScanner of all Invoice fields.
 	ValueScanner struct {
 		MinAmount field.TypeValueScanner[*big.Int]
+		FeeFlat   field.TypeValueScanner[*big.Int]
+		FeeAmount field.TypeValueScanner[*big.Int]
 	}
 )
 
@@ -175,3 +245,78 @@ func ByCancelAt(opts ...sql.OrderTermOption) OrderOption {
 func ByWalletAddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWalletAddress, opts...).ToFunc()
 }
+
+// ByDerivationVersion orders the results by the derivation_version field.
+func ByDerivationVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDerivationVersion, opts...).ToFunc()
+}
+
+// ByWalletIndex orders the results by the wallet_index field.
+func ByWalletIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWalletIndex, opts...).ToFunc()
+}
+
+// ByApprovalRequestAt orders the results by the approval_request_at field.
+func ByApprovalRequestAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovalRequestAt, opts...).ToFunc()
+}
+
+// ByApproveAt orders the results by the approve_at field.
+func ByApproveAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApproveAt, opts...).ToFunc()
+}
+
+// ByEscrowPeriod orders the results by the escrow_period field.
+func ByEscrowPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscrowPeriod, opts...).ToFunc()
+}
+
+// ByReleaseAt orders the results by the release_at field.
+func ByReleaseAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseAt, opts...).ToFunc()
+}
+
+// ByRefundAt orders the results by the refund_at field.
+func ByRefundAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundAt, opts...).ToFunc()
+}
+
+// ByDisputeAt orders the results by the dispute_at field.
+func ByDisputeAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisputeAt, opts...).ToFunc()
+}
+
+// ByFeeAddress orders the results by the fee_address field.
+func ByFeeAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeAddress, opts...).ToFunc()
+}
+
+// ByFeeBasisPoints orders the results by the fee_basis_points field.
+func ByFeeBasisPoints(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeBasisPoints, opts...).ToFunc()
+}
+
+// ByFeeFlat orders the results by the fee_flat field.
+func ByFeeFlat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeFlat, opts...).ToFunc()
+}
+
+// ByFeeAmount orders the results by the fee_amount field.
+func ByFeeAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeAmount, opts...).ToFunc()
+}
+
+// ByFeeTxHash orders the results by the fee_tx_hash field.
+func ByFeeTxHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeTxHash, opts...).ToFunc()
+}
+
+// BySplitGasRule orders the results by the split_gas_rule field.
+func BySplitGasRule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSplitGasRule, opts...).ToFunc()
+}
+
+// ByTreasuryAddress orders the results by the treasury_address field.
+func ByTreasuryAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTreasuryAddress, opts...).ToFunc()
+}
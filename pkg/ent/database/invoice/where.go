@@ -137,6 +137,93 @@ func EncryptedSalt(v []byte) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldEncryptedSalt, v))
 }
 
+// DerivationVersion applies equality check predicate on the "derivation_version" field. It's identical to DerivationVersionEQ.
+func DerivationVersion(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDerivationVersion, v))
+}
+
+// WalletIndex applies equality check predicate on the "wallet_index" field. It's identical to WalletIndexEQ.
+func WalletIndex(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldWalletIndex, v))
+}
+
+// ApprovalRequestAt applies equality check predicate on the "approval_request_at" field. It's identical to ApprovalRequestAtEQ.
+func ApprovalRequestAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldApprovalRequestAt, v))
+}
+
+// ApproveAt applies equality check predicate on the "approve_at" field. It's identical to ApproveAtEQ.
+func ApproveAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldApproveAt, v))
+}
+
+// EscrowPeriod applies equality check predicate on the "escrow_period" field. It's identical to EscrowPeriodEQ.
+func EscrowPeriod(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldEscrowPeriod, v))
+}
+
+// BuyerKeyHash applies equality check predicate on the "buyer_key_hash" field. It's identical to BuyerKeyHashEQ.
+func BuyerKeyHash(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerKeyHash, v))
+}
+
+// ReleaseAt applies equality check predicate on the "release_at" field. It's identical to ReleaseAtEQ.
+func ReleaseAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldReleaseAt, v))
+}
+
+// RefundAt applies equality check predicate on the "refund_at" field. It's identical to RefundAtEQ.
+func RefundAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldRefundAt, v))
+}
+
+// DisputeAt applies equality check predicate on the "dispute_at" field. It's identical to DisputeAtEQ.
+func DisputeAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDisputeAt, v))
+}
+
+// FeeAddress applies equality check predicate on the "fee_address" field. It's identical to FeeAddressEQ.
+func FeeAddress(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFeeAddress, v))
+}
+
+// FeeBasisPoints applies equality check predicate on the "fee_basis_points" field. It's identical to FeeBasisPointsEQ.
+func FeeBasisPoints(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFeeBasisPoints, v))
+}
+
+// FeeFlat applies equality check predicate on the "fee_flat" field. It's identical to FeeFlatEQ.
+func FeeFlat(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldEQ(FieldFeeFlat, vc), err)
+}
+
+// FeeAmount applies equality check predicate on the "fee_amount" field. It's identical to FeeAmountEQ.
+func FeeAmount(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldEQ(FieldFeeAmount, vc), err)
+}
+
+// FeeTxHash applies equality check predicate on the "fee_tx_hash" field. It's identical to FeeTxHashEQ.
+func FeeTxHash(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFeeTxHash, v))
+}
+
+// SplitGasRule applies equality check predicate on the "split_gas_rule" field. It's identical to SplitGasRuleEQ.
+func SplitGasRule(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSplitGasRule, v))
+}
+
+// TreasuryAddress applies equality check predicate on the "treasury_address" field. It's identical to TreasuryAddressEQ.
+func TreasuryAddress(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTreasuryAddress, v))
+}
+
+// SignerMAC applies equality check predicate on the "signer_mac" field. It's identical to SignerMACEQ.
+func SignerMAC(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSignerMAC, v))
+}
+
 // MinAmountEQ applies the EQ predicate on the "min_amount" field.
 func MinAmountEQ(v *big.Int) predicate.Invoice {
 	vc, err := ValueScanner.MinAmount.Value(v)
@@ -906,6 +993,1094 @@ func EncryptedSaltLTE(v []byte) predicate.Invoice {
 	return predicate.Invoice(sql.FieldLTE(FieldEncryptedSalt, v))
 }
 
+// EncryptedSaltIsNil applies the IsNil predicate on the "encrypted_salt" field.
+func EncryptedSaltIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldEncryptedSalt))
+}
+
+// EncryptedSaltNotNil applies the NotNil predicate on the "encrypted_salt" field.
+func EncryptedSaltNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldEncryptedSalt))
+}
+
+// DerivationVersionEQ applies the EQ predicate on the "derivation_version" field.
+func DerivationVersionEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDerivationVersion, v))
+}
+
+// DerivationVersionNEQ applies the NEQ predicate on the "derivation_version" field.
+func DerivationVersionNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldDerivationVersion, v))
+}
+
+// DerivationVersionIn applies the In predicate on the "derivation_version" field.
+func DerivationVersionIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldDerivationVersion, vs...))
+}
+
+// DerivationVersionNotIn applies the NotIn predicate on the "derivation_version" field.
+func DerivationVersionNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldDerivationVersion, vs...))
+}
+
+// DerivationVersionGT applies the GT predicate on the "derivation_version" field.
+func DerivationVersionGT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldDerivationVersion, v))
+}
+
+// DerivationVersionGTE applies the GTE predicate on the "derivation_version" field.
+func DerivationVersionGTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldDerivationVersion, v))
+}
+
+// DerivationVersionLT applies the LT predicate on the "derivation_version" field.
+func DerivationVersionLT(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldDerivationVersion, v))
+}
+
+// DerivationVersionLTE applies the LTE predicate on the "derivation_version" field.
+func DerivationVersionLTE(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldDerivationVersion, v))
+}
+
+// WalletIndexEQ applies the EQ predicate on the "wallet_index" field.
+func WalletIndexEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldWalletIndex, v))
+}
+
+// WalletIndexNEQ applies the NEQ predicate on the "wallet_index" field.
+func WalletIndexNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldWalletIndex, v))
+}
+
+// WalletIndexIn applies the In predicate on the "wallet_index" field.
+func WalletIndexIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldWalletIndex, vs...))
+}
+
+// WalletIndexNotIn applies the NotIn predicate on the "wallet_index" field.
+func WalletIndexNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldWalletIndex, vs...))
+}
+
+// WalletIndexGT applies the GT predicate on the "wallet_index" field.
+func WalletIndexGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldWalletIndex, v))
+}
+
+// WalletIndexGTE applies the GTE predicate on the "wallet_index" field.
+func WalletIndexGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldWalletIndex, v))
+}
+
+// WalletIndexLT applies the LT predicate on the "wallet_index" field.
+func WalletIndexLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldWalletIndex, v))
+}
+
+// WalletIndexLTE applies the LTE predicate on the "wallet_index" field.
+func WalletIndexLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldWalletIndex, v))
+}
+
+// WalletIndexIsNil applies the IsNil predicate on the "wallet_index" field.
+func WalletIndexIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldWalletIndex))
+}
+
+// WalletIndexNotNil applies the NotNil predicate on the "wallet_index" field.
+func WalletIndexNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldWalletIndex))
+}
+
+// ApprovalRequestAtEQ applies the EQ predicate on the "approval_request_at" field.
+func ApprovalRequestAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldApprovalRequestAt, v))
+}
+
+// ApprovalRequestAtNEQ applies the NEQ predicate on the "approval_request_at" field.
+func ApprovalRequestAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldApprovalRequestAt, v))
+}
+
+// ApprovalRequestAtIn applies the In predicate on the "approval_request_at" field.
+func ApprovalRequestAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldApprovalRequestAt, vs...))
+}
+
+// ApprovalRequestAtNotIn applies the NotIn predicate on the "approval_request_at" field.
+func ApprovalRequestAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldApprovalRequestAt, vs...))
+}
+
+// ApprovalRequestAtGT applies the GT predicate on the "approval_request_at" field.
+func ApprovalRequestAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldApprovalRequestAt, v))
+}
+
+// ApprovalRequestAtGTE applies the GTE predicate on the "approval_request_at" field.
+func ApprovalRequestAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldApprovalRequestAt, v))
+}
+
+// ApprovalRequestAtLT applies the LT predicate on the "approval_request_at" field.
+func ApprovalRequestAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldApprovalRequestAt, v))
+}
+
+// ApprovalRequestAtLTE applies the LTE predicate on the "approval_request_at" field.
+func ApprovalRequestAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldApprovalRequestAt, v))
+}
+
+// ApprovalRequestAtIsNil applies the IsNil predicate on the "approval_request_at" field.
+func ApprovalRequestAtIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldApprovalRequestAt))
+}
+
+// ApprovalRequestAtNotNil applies the NotNil predicate on the "approval_request_at" field.
+func ApprovalRequestAtNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldApprovalRequestAt))
+}
+
+// ApproveAtEQ applies the EQ predicate on the "approve_at" field.
+func ApproveAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldApproveAt, v))
+}
+
+// ApproveAtNEQ applies the NEQ predicate on the "approve_at" field.
+func ApproveAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldApproveAt, v))
+}
+
+// ApproveAtIn applies the In predicate on the "approve_at" field.
+func ApproveAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldApproveAt, vs...))
+}
+
+// ApproveAtNotIn applies the NotIn predicate on the "approve_at" field.
+func ApproveAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldApproveAt, vs...))
+}
+
+// ApproveAtGT applies the GT predicate on the "approve_at" field.
+func ApproveAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldApproveAt, v))
+}
+
+// ApproveAtGTE applies the GTE predicate on the "approve_at" field.
+func ApproveAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldApproveAt, v))
+}
+
+// ApproveAtLT applies the LT predicate on the "approve_at" field.
+func ApproveAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldApproveAt, v))
+}
+
+// ApproveAtLTE applies the LTE predicate on the "approve_at" field.
+func ApproveAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldApproveAt, v))
+}
+
+// ApproveAtIsNil applies the IsNil predicate on the "approve_at" field.
+func ApproveAtIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldApproveAt))
+}
+
+// ApproveAtNotNil applies the NotNil predicate on the "approve_at" field.
+func ApproveAtNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldApproveAt))
+}
+
+// EscrowPeriodEQ applies the EQ predicate on the "escrow_period" field.
+func EscrowPeriodEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldEscrowPeriod, v))
+}
+
+// EscrowPeriodNEQ applies the NEQ predicate on the "escrow_period" field.
+func EscrowPeriodNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldEscrowPeriod, v))
+}
+
+// EscrowPeriodIn applies the In predicate on the "escrow_period" field.
+func EscrowPeriodIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldEscrowPeriod, vs...))
+}
+
+// EscrowPeriodNotIn applies the NotIn predicate on the "escrow_period" field.
+func EscrowPeriodNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldEscrowPeriod, vs...))
+}
+
+// EscrowPeriodGT applies the GT predicate on the "escrow_period" field.
+func EscrowPeriodGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldEscrowPeriod, v))
+}
+
+// EscrowPeriodGTE applies the GTE predicate on the "escrow_period" field.
+func EscrowPeriodGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldEscrowPeriod, v))
+}
+
+// EscrowPeriodLT applies the LT predicate on the "escrow_period" field.
+func EscrowPeriodLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldEscrowPeriod, v))
+}
+
+// EscrowPeriodLTE applies the LTE predicate on the "escrow_period" field.
+func EscrowPeriodLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldEscrowPeriod, v))
+}
+
+// BuyerKeyHashEQ applies the EQ predicate on the "buyer_key_hash" field.
+func BuyerKeyHashEQ(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerKeyHash, v))
+}
+
+// BuyerKeyHashNEQ applies the NEQ predicate on the "buyer_key_hash" field.
+func BuyerKeyHashNEQ(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldBuyerKeyHash, v))
+}
+
+// BuyerKeyHashIn applies the In predicate on the "buyer_key_hash" field.
+func BuyerKeyHashIn(vs ...[]byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldBuyerKeyHash, vs...))
+}
+
+// BuyerKeyHashNotIn applies the NotIn predicate on the "buyer_key_hash" field.
+func BuyerKeyHashNotIn(vs ...[]byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldBuyerKeyHash, vs...))
+}
+
+// BuyerKeyHashGT applies the GT predicate on the "buyer_key_hash" field.
+func BuyerKeyHashGT(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldBuyerKeyHash, v))
+}
+
+// BuyerKeyHashGTE applies the GTE predicate on the "buyer_key_hash" field.
+func BuyerKeyHashGTE(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldBuyerKeyHash, v))
+}
+
+// BuyerKeyHashLT applies the LT predicate on the "buyer_key_hash" field.
+func BuyerKeyHashLT(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldBuyerKeyHash, v))
+}
+
+// BuyerKeyHashLTE applies the LTE predicate on the "buyer_key_hash" field.
+func BuyerKeyHashLTE(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldBuyerKeyHash, v))
+}
+
+// BuyerKeyHashIsNil applies the IsNil predicate on the "buyer_key_hash" field.
+func BuyerKeyHashIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldBuyerKeyHash))
+}
+
+// BuyerKeyHashNotNil applies the NotNil predicate on the "buyer_key_hash" field.
+func BuyerKeyHashNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldBuyerKeyHash))
+}
+
+// ReleaseAtEQ applies the EQ predicate on the "release_at" field.
+func ReleaseAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldReleaseAt, v))
+}
+
+// ReleaseAtNEQ applies the NEQ predicate on the "release_at" field.
+func ReleaseAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldReleaseAt, v))
+}
+
+// ReleaseAtIn applies the In predicate on the "release_at" field.
+func ReleaseAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldReleaseAt, vs...))
+}
+
+// ReleaseAtNotIn applies the NotIn predicate on the "release_at" field.
+func ReleaseAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldReleaseAt, vs...))
+}
+
+// ReleaseAtGT applies the GT predicate on the "release_at" field.
+func ReleaseAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldReleaseAt, v))
+}
+
+// ReleaseAtGTE applies the GTE predicate on the "release_at" field.
+func ReleaseAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldReleaseAt, v))
+}
+
+// ReleaseAtLT applies the LT predicate on the "release_at" field.
+func ReleaseAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldReleaseAt, v))
+}
+
+// ReleaseAtLTE applies the LTE predicate on the "release_at" field.
+func ReleaseAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldReleaseAt, v))
+}
+
+// ReleaseAtIsNil applies the IsNil predicate on the "release_at" field.
+func ReleaseAtIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldReleaseAt))
+}
+
+// ReleaseAtNotNil applies the NotNil predicate on the "release_at" field.
+func ReleaseAtNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldReleaseAt))
+}
+
+// RefundAtEQ applies the EQ predicate on the "refund_at" field.
+func RefundAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldRefundAt, v))
+}
+
+// RefundAtNEQ applies the NEQ predicate on the "refund_at" field.
+func RefundAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldRefundAt, v))
+}
+
+// RefundAtIn applies the In predicate on the "refund_at" field.
+func RefundAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldRefundAt, vs...))
+}
+
+// RefundAtNotIn applies the NotIn predicate on the "refund_at" field.
+func RefundAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldRefundAt, vs...))
+}
+
+// RefundAtGT applies the GT predicate on the "refund_at" field.
+func RefundAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldRefundAt, v))
+}
+
+// RefundAtGTE applies the GTE predicate on the "refund_at" field.
+func RefundAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldRefundAt, v))
+}
+
+// RefundAtLT applies the LT predicate on the "refund_at" field.
+func RefundAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldRefundAt, v))
+}
+
+// RefundAtLTE applies the LTE predicate on the "refund_at" field.
+func RefundAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldRefundAt, v))
+}
+
+// RefundAtIsNil applies the IsNil predicate on the "refund_at" field.
+func RefundAtIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldRefundAt))
+}
+
+// RefundAtNotNil applies the NotNil predicate on the "refund_at" field.
+func RefundAtNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldRefundAt))
+}
+
+// DisputeAtEQ applies the EQ predicate on the "dispute_at" field.
+func DisputeAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDisputeAt, v))
+}
+
+// DisputeAtNEQ applies the NEQ predicate on the "dispute_at" field.
+func DisputeAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldDisputeAt, v))
+}
+
+// DisputeAtIn applies the In predicate on the "dispute_at" field.
+func DisputeAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldDisputeAt, vs...))
+}
+
+// DisputeAtNotIn applies the NotIn predicate on the "dispute_at" field.
+func DisputeAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldDisputeAt, vs...))
+}
+
+// DisputeAtGT applies the GT predicate on the "dispute_at" field.
+func DisputeAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldDisputeAt, v))
+}
+
+// DisputeAtGTE applies the GTE predicate on the "dispute_at" field.
+func DisputeAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldDisputeAt, v))
+}
+
+// DisputeAtLT applies the LT predicate on the "dispute_at" field.
+func DisputeAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldDisputeAt, v))
+}
+
+// DisputeAtLTE applies the LTE predicate on the "dispute_at" field.
+func DisputeAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldDisputeAt, v))
+}
+
+// DisputeAtIsNil applies the IsNil predicate on the "dispute_at" field.
+func DisputeAtIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldDisputeAt))
+}
+
+// DisputeAtNotNil applies the NotNil predicate on the "dispute_at" field.
+func DisputeAtNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldDisputeAt))
+}
+
+// FeeAddressEQ applies the EQ predicate on the "fee_address" field.
+func FeeAddressEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFeeAddress, v))
+}
+
+// FeeAddressNEQ applies the NEQ predicate on the "fee_address" field.
+func FeeAddressNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldFeeAddress, v))
+}
+
+// FeeAddressIn applies the In predicate on the "fee_address" field.
+func FeeAddressIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldFeeAddress, vs...))
+}
+
+// FeeAddressNotIn applies the NotIn predicate on the "fee_address" field.
+func FeeAddressNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldFeeAddress, vs...))
+}
+
+// FeeAddressGT applies the GT predicate on the "fee_address" field.
+func FeeAddressGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldFeeAddress, v))
+}
+
+// FeeAddressGTE applies the GTE predicate on the "fee_address" field.
+func FeeAddressGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldFeeAddress, v))
+}
+
+// FeeAddressLT applies the LT predicate on the "fee_address" field.
+func FeeAddressLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldFeeAddress, v))
+}
+
+// FeeAddressLTE applies the LTE predicate on the "fee_address" field.
+func FeeAddressLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldFeeAddress, v))
+}
+
+// FeeAddressContains applies the Contains predicate on the "fee_address" field.
+func FeeAddressContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldFeeAddress, v))
+}
+
+// FeeAddressHasPrefix applies the HasPrefix predicate on the "fee_address" field.
+func FeeAddressHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldFeeAddress, v))
+}
+
+// FeeAddressHasSuffix applies the HasSuffix predicate on the "fee_address" field.
+func FeeAddressHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldFeeAddress, v))
+}
+
+// FeeAddressIsNil applies the IsNil predicate on the "fee_address" field.
+func FeeAddressIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldFeeAddress))
+}
+
+// FeeAddressNotNil applies the NotNil predicate on the "fee_address" field.
+func FeeAddressNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldFeeAddress))
+}
+
+// FeeAddressEqualFold applies the EqualFold predicate on the "fee_address" field.
+func FeeAddressEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldFeeAddress, v))
+}
+
+// FeeAddressContainsFold applies the ContainsFold predicate on the "fee_address" field.
+func FeeAddressContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldFeeAddress, v))
+}
+
+// FeeBasisPointsEQ applies the EQ predicate on the "fee_basis_points" field.
+func FeeBasisPointsEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFeeBasisPoints, v))
+}
+
+// FeeBasisPointsNEQ applies the NEQ predicate on the "fee_basis_points" field.
+func FeeBasisPointsNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldFeeBasisPoints, v))
+}
+
+// FeeBasisPointsIn applies the In predicate on the "fee_basis_points" field.
+func FeeBasisPointsIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldFeeBasisPoints, vs...))
+}
+
+// FeeBasisPointsNotIn applies the NotIn predicate on the "fee_basis_points" field.
+func FeeBasisPointsNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldFeeBasisPoints, vs...))
+}
+
+// FeeBasisPointsGT applies the GT predicate on the "fee_basis_points" field.
+func FeeBasisPointsGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldFeeBasisPoints, v))
+}
+
+// FeeBasisPointsGTE applies the GTE predicate on the "fee_basis_points" field.
+func FeeBasisPointsGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldFeeBasisPoints, v))
+}
+
+// FeeBasisPointsLT applies the LT predicate on the "fee_basis_points" field.
+func FeeBasisPointsLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldFeeBasisPoints, v))
+}
+
+// FeeBasisPointsLTE applies the LTE predicate on the "fee_basis_points" field.
+func FeeBasisPointsLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldFeeBasisPoints, v))
+}
+
+// FeeFlatEQ applies the EQ predicate on the "fee_flat" field.
+func FeeFlatEQ(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldEQ(FieldFeeFlat, vc), err)
+}
+
+// FeeFlatNEQ applies the NEQ predicate on the "fee_flat" field.
+func FeeFlatNEQ(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldNEQ(FieldFeeFlat, vc), err)
+}
+
+// FeeFlatIn applies the In predicate on the "fee_flat" field.
+func FeeFlatIn(vs ...*big.Int) predicate.Invoice {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.FeeFlat.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.InvoiceOrErr(sql.FieldIn(FieldFeeFlat, v...), err)
+}
+
+// FeeFlatNotIn applies the NotIn predicate on the "fee_flat" field.
+func FeeFlatNotIn(vs ...*big.Int) predicate.Invoice {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.FeeFlat.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.InvoiceOrErr(sql.FieldNotIn(FieldFeeFlat, v...), err)
+}
+
+// FeeFlatGT applies the GT predicate on the "fee_flat" field.
+func FeeFlatGT(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldGT(FieldFeeFlat, vc), err)
+}
+
+// FeeFlatGTE applies the GTE predicate on the "fee_flat" field.
+func FeeFlatGTE(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldGTE(FieldFeeFlat, vc), err)
+}
+
+// FeeFlatLT applies the LT predicate on the "fee_flat" field.
+func FeeFlatLT(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldLT(FieldFeeFlat, vc), err)
+}
+
+// FeeFlatLTE applies the LTE predicate on the "fee_flat" field.
+func FeeFlatLTE(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldLTE(FieldFeeFlat, vc), err)
+}
+
+// FeeFlatContains applies the Contains predicate on the "fee_flat" field.
+func FeeFlatContains(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_flat value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldContains(FieldFeeFlat, vcs), err)
+}
+
+// FeeFlatHasPrefix applies the HasPrefix predicate on the "fee_flat" field.
+func FeeFlatHasPrefix(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_flat value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldHasPrefix(FieldFeeFlat, vcs), err)
+}
+
+// FeeFlatHasSuffix applies the HasSuffix predicate on the "fee_flat" field.
+func FeeFlatHasSuffix(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_flat value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldHasSuffix(FieldFeeFlat, vcs), err)
+}
+
+// FeeFlatIsNil applies the IsNil predicate on the "fee_flat" field.
+func FeeFlatIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldFeeFlat))
+}
+
+// FeeFlatNotNil applies the NotNil predicate on the "fee_flat" field.
+func FeeFlatNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldFeeFlat))
+}
+
+// FeeFlatEqualFold applies the EqualFold predicate on the "fee_flat" field.
+func FeeFlatEqualFold(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_flat value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldEqualFold(FieldFeeFlat, vcs), err)
+}
+
+// FeeFlatContainsFold applies the ContainsFold predicate on the "fee_flat" field.
+func FeeFlatContainsFold(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeFlat.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_flat value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldContainsFold(FieldFeeFlat, vcs), err)
+}
+
+// FeeAmountEQ applies the EQ predicate on the "fee_amount" field.
+func FeeAmountEQ(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldEQ(FieldFeeAmount, vc), err)
+}
+
+// FeeAmountNEQ applies the NEQ predicate on the "fee_amount" field.
+func FeeAmountNEQ(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldNEQ(FieldFeeAmount, vc), err)
+}
+
+// FeeAmountIn applies the In predicate on the "fee_amount" field.
+func FeeAmountIn(vs ...*big.Int) predicate.Invoice {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.FeeAmount.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.InvoiceOrErr(sql.FieldIn(FieldFeeAmount, v...), err)
+}
+
+// FeeAmountNotIn applies the NotIn predicate on the "fee_amount" field.
+func FeeAmountNotIn(vs ...*big.Int) predicate.Invoice {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.FeeAmount.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.InvoiceOrErr(sql.FieldNotIn(FieldFeeAmount, v...), err)
+}
+
+// FeeAmountGT applies the GT predicate on the "fee_amount" field.
+func FeeAmountGT(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldGT(FieldFeeAmount, vc), err)
+}
+
+// FeeAmountGTE applies the GTE predicate on the "fee_amount" field.
+func FeeAmountGTE(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldGTE(FieldFeeAmount, vc), err)
+}
+
+// FeeAmountLT applies the LT predicate on the "fee_amount" field.
+func FeeAmountLT(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldLT(FieldFeeAmount, vc), err)
+}
+
+// FeeAmountLTE applies the LTE predicate on the "fee_amount" field.
+func FeeAmountLTE(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	return predicate.InvoiceOrErr(sql.FieldLTE(FieldFeeAmount, vc), err)
+}
+
+// FeeAmountContains applies the Contains predicate on the "fee_amount" field.
+func FeeAmountContains(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_amount value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldContains(FieldFeeAmount, vcs), err)
+}
+
+// FeeAmountHasPrefix applies the HasPrefix predicate on the "fee_amount" field.
+func FeeAmountHasPrefix(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_amount value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldHasPrefix(FieldFeeAmount, vcs), err)
+}
+
+// FeeAmountHasSuffix applies the HasSuffix predicate on the "fee_amount" field.
+func FeeAmountHasSuffix(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_amount value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldHasSuffix(FieldFeeAmount, vcs), err)
+}
+
+// FeeAmountIsNil applies the IsNil predicate on the "fee_amount" field.
+func FeeAmountIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldFeeAmount))
+}
+
+// FeeAmountNotNil applies the NotNil predicate on the "fee_amount" field.
+func FeeAmountNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldFeeAmount))
+}
+
+// FeeAmountEqualFold applies the EqualFold predicate on the "fee_amount" field.
+func FeeAmountEqualFold(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_amount value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldEqualFold(FieldFeeAmount, vcs), err)
+}
+
+// FeeAmountContainsFold applies the ContainsFold predicate on the "fee_amount" field.
+func FeeAmountContainsFold(v *big.Int) predicate.Invoice {
+	vc, err := ValueScanner.FeeAmount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee_amount value is not a string: %T", vc)
+	}
+	return predicate.InvoiceOrErr(sql.FieldContainsFold(FieldFeeAmount, vcs), err)
+}
+
+// FeeTxHashEQ applies the EQ predicate on the "fee_tx_hash" field.
+func FeeTxHashEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFeeTxHash, v))
+}
+
+// FeeTxHashNEQ applies the NEQ predicate on the "fee_tx_hash" field.
+func FeeTxHashNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldFeeTxHash, v))
+}
+
+// FeeTxHashIn applies the In predicate on the "fee_tx_hash" field.
+func FeeTxHashIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldFeeTxHash, vs...))
+}
+
+// FeeTxHashNotIn applies the NotIn predicate on the "fee_tx_hash" field.
+func FeeTxHashNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldFeeTxHash, vs...))
+}
+
+// FeeTxHashGT applies the GT predicate on the "fee_tx_hash" field.
+func FeeTxHashGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldFeeTxHash, v))
+}
+
+// FeeTxHashGTE applies the GTE predicate on the "fee_tx_hash" field.
+func FeeTxHashGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldFeeTxHash, v))
+}
+
+// FeeTxHashLT applies the LT predicate on the "fee_tx_hash" field.
+func FeeTxHashLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldFeeTxHash, v))
+}
+
+// FeeTxHashLTE applies the LTE predicate on the "fee_tx_hash" field.
+func FeeTxHashLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldFeeTxHash, v))
+}
+
+// FeeTxHashContains applies the Contains predicate on the "fee_tx_hash" field.
+func FeeTxHashContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldFeeTxHash, v))
+}
+
+// FeeTxHashHasPrefix applies the HasPrefix predicate on the "fee_tx_hash" field.
+func FeeTxHashHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldFeeTxHash, v))
+}
+
+// FeeTxHashHasSuffix applies the HasSuffix predicate on the "fee_tx_hash" field.
+func FeeTxHashHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldFeeTxHash, v))
+}
+
+// FeeTxHashIsNil applies the IsNil predicate on the "fee_tx_hash" field.
+func FeeTxHashIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldFeeTxHash))
+}
+
+// FeeTxHashNotNil applies the NotNil predicate on the "fee_tx_hash" field.
+func FeeTxHashNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldFeeTxHash))
+}
+
+// FeeTxHashEqualFold applies the EqualFold predicate on the "fee_tx_hash" field.
+func FeeTxHashEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldFeeTxHash, v))
+}
+
+// FeeTxHashContainsFold applies the ContainsFold predicate on the "fee_tx_hash" field.
+func FeeTxHashContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldFeeTxHash, v))
+}
+
+// SplitIsNil applies the IsNil predicate on the "split" field.
+func SplitIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldSplit))
+}
+
+// SplitNotNil applies the NotNil predicate on the "split" field.
+func SplitNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldSplit))
+}
+
+// SplitGasRuleEQ applies the EQ predicate on the "split_gas_rule" field.
+func SplitGasRuleEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleNEQ applies the NEQ predicate on the "split_gas_rule" field.
+func SplitGasRuleNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleIn applies the In predicate on the "split_gas_rule" field.
+func SplitGasRuleIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSplitGasRule, vs...))
+}
+
+// SplitGasRuleNotIn applies the NotIn predicate on the "split_gas_rule" field.
+func SplitGasRuleNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSplitGasRule, vs...))
+}
+
+// SplitGasRuleGT applies the GT predicate on the "split_gas_rule" field.
+func SplitGasRuleGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleGTE applies the GTE predicate on the "split_gas_rule" field.
+func SplitGasRuleGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleLT applies the LT predicate on the "split_gas_rule" field.
+func SplitGasRuleLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleLTE applies the LTE predicate on the "split_gas_rule" field.
+func SplitGasRuleLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleContains applies the Contains predicate on the "split_gas_rule" field.
+func SplitGasRuleContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleHasPrefix applies the HasPrefix predicate on the "split_gas_rule" field.
+func SplitGasRuleHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleHasSuffix applies the HasSuffix predicate on the "split_gas_rule" field.
+func SplitGasRuleHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleIsNil applies the IsNil predicate on the "split_gas_rule" field.
+func SplitGasRuleIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldSplitGasRule))
+}
+
+// SplitGasRuleNotNil applies the NotNil predicate on the "split_gas_rule" field.
+func SplitGasRuleNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldSplitGasRule))
+}
+
+// SplitGasRuleEqualFold applies the EqualFold predicate on the "split_gas_rule" field.
+func SplitGasRuleEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSplitGasRule, v))
+}
+
+// SplitGasRuleContainsFold applies the ContainsFold predicate on the "split_gas_rule" field.
+func SplitGasRuleContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSplitGasRule, v))
+}
+
+// TreasuryAddressEQ applies the EQ predicate on the "treasury_address" field.
+func TreasuryAddressEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressNEQ applies the NEQ predicate on the "treasury_address" field.
+func TreasuryAddressNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressIn applies the In predicate on the "treasury_address" field.
+func TreasuryAddressIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTreasuryAddress, vs...))
+}
+
+// TreasuryAddressNotIn applies the NotIn predicate on the "treasury_address" field.
+func TreasuryAddressNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTreasuryAddress, vs...))
+}
+
+// TreasuryAddressGT applies the GT predicate on the "treasury_address" field.
+func TreasuryAddressGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressGTE applies the GTE predicate on the "treasury_address" field.
+func TreasuryAddressGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressLT applies the LT predicate on the "treasury_address" field.
+func TreasuryAddressLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressLTE applies the LTE predicate on the "treasury_address" field.
+func TreasuryAddressLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressContains applies the Contains predicate on the "treasury_address" field.
+func TreasuryAddressContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressHasPrefix applies the HasPrefix predicate on the "treasury_address" field.
+func TreasuryAddressHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressHasSuffix applies the HasSuffix predicate on the "treasury_address" field.
+func TreasuryAddressHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressIsNil applies the IsNil predicate on the "treasury_address" field.
+func TreasuryAddressIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldTreasuryAddress))
+}
+
+// TreasuryAddressNotNil applies the NotNil predicate on the "treasury_address" field.
+func TreasuryAddressNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldTreasuryAddress))
+}
+
+// TreasuryAddressEqualFold applies the EqualFold predicate on the "treasury_address" field.
+func TreasuryAddressEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldTreasuryAddress, v))
+}
+
+// TreasuryAddressContainsFold applies the ContainsFold predicate on the "treasury_address" field.
+func TreasuryAddressContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldTreasuryAddress, v))
+}
+
+// SignerMACEQ applies the EQ predicate on the "signer_mac" field.
+func SignerMACEQ(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSignerMAC, v))
+}
+
+// SignerMACNEQ applies the NEQ predicate on the "signer_mac" field.
+func SignerMACNEQ(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSignerMAC, v))
+}
+
+// SignerMACIn applies the In predicate on the "signer_mac" field.
+func SignerMACIn(vs ...[]byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSignerMAC, vs...))
+}
+
+// SignerMACNotIn applies the NotIn predicate on the "signer_mac" field.
+func SignerMACNotIn(vs ...[]byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSignerMAC, vs...))
+}
+
+// SignerMACGT applies the GT predicate on the "signer_mac" field.
+func SignerMACGT(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSignerMAC, v))
+}
+
+// SignerMACGTE applies the GTE predicate on the "signer_mac" field.
+func SignerMACGTE(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSignerMAC, v))
+}
+
+// SignerMACLT applies the LT predicate on the "signer_mac" field.
+func SignerMACLT(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSignerMAC, v))
+}
+
+// SignerMACLTE applies the LTE predicate on the "signer_mac" field.
+func SignerMACLTE(v []byte) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSignerMAC, v))
+}
+
+// SignerMACIsNil applies the IsNil predicate on the "signer_mac" field.
+func SignerMACIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldSignerMAC))
+}
+
+// SignerMACNotNil applies the NotNil predicate on the "signer_mac" field.
+func SignerMACNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldSignerMAC))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Invoice) predicate.Invoice {
 	return predicate.Invoice(sql.AndPredicates(predicates...))
@@ -5,6 +5,7 @@ package database
 import (
 	"context"
 	"cpg/pkg/ent/database/invoice"
+	"cpg/pkg/ent/schema"
 	"errors"
 	"fmt"
 	"math/big"
@@ -153,6 +154,218 @@ func (ic *InvoiceCreate) SetEncryptedSalt(b []byte) *InvoiceCreate {
 	return ic
 }
 
+// SetDerivationVersion sets the "derivation_version" field.
+func (ic *InvoiceCreate) SetDerivationVersion(i int) *InvoiceCreate {
+	ic.mutation.SetDerivationVersion(i)
+	return ic
+}
+
+// SetNillableDerivationVersion sets the "derivation_version" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableDerivationVersion(i *int) *InvoiceCreate {
+	if i != nil {
+		ic.SetDerivationVersion(*i)
+	}
+	return ic
+}
+
+// SetWalletIndex sets the "wallet_index" field.
+func (ic *InvoiceCreate) SetWalletIndex(i int64) *InvoiceCreate {
+	ic.mutation.SetWalletIndex(i)
+	return ic
+}
+
+// SetNillableWalletIndex sets the "wallet_index" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableWalletIndex(i *int64) *InvoiceCreate {
+	if i != nil {
+		ic.SetWalletIndex(*i)
+	}
+	return ic
+}
+
+// SetApprovalRequestAt sets the "approval_request_at" field.
+func (ic *InvoiceCreate) SetApprovalRequestAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetApprovalRequestAt(t)
+	return ic
+}
+
+// SetNillableApprovalRequestAt sets the "approval_request_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableApprovalRequestAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetApprovalRequestAt(*t)
+	}
+	return ic
+}
+
+// SetApproveAt sets the "approve_at" field.
+func (ic *InvoiceCreate) SetApproveAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetApproveAt(t)
+	return ic
+}
+
+// SetNillableApproveAt sets the "approve_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableApproveAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetApproveAt(*t)
+	}
+	return ic
+}
+
+// SetEscrowPeriod sets the "escrow_period" field.
+func (ic *InvoiceCreate) SetEscrowPeriod(i int64) *InvoiceCreate {
+	ic.mutation.SetEscrowPeriod(i)
+	return ic
+}
+
+// SetNillableEscrowPeriod sets the "escrow_period" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableEscrowPeriod(i *int64) *InvoiceCreate {
+	if i != nil {
+		ic.SetEscrowPeriod(*i)
+	}
+	return ic
+}
+
+// SetBuyerKeyHash sets the "buyer_key_hash" field.
+func (ic *InvoiceCreate) SetBuyerKeyHash(b []byte) *InvoiceCreate {
+	ic.mutation.SetBuyerKeyHash(b)
+	return ic
+}
+
+// SetReleaseAt sets the "release_at" field.
+func (ic *InvoiceCreate) SetReleaseAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetReleaseAt(t)
+	return ic
+}
+
+// SetNillableReleaseAt sets the "release_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableReleaseAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetReleaseAt(*t)
+	}
+	return ic
+}
+
+// SetRefundAt sets the "refund_at" field.
+func (ic *InvoiceCreate) SetRefundAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetRefundAt(t)
+	return ic
+}
+
+// SetNillableRefundAt sets the "refund_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableRefundAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetRefundAt(*t)
+	}
+	return ic
+}
+
+// SetDisputeAt sets the "dispute_at" field.
+func (ic *InvoiceCreate) SetDisputeAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetDisputeAt(t)
+	return ic
+}
+
+// SetNillableDisputeAt sets the "dispute_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableDisputeAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetDisputeAt(*t)
+	}
+	return ic
+}
+
+// SetFeeAddress sets the "fee_address" field.
+func (ic *InvoiceCreate) SetFeeAddress(s string) *InvoiceCreate {
+	ic.mutation.SetFeeAddress(s)
+	return ic
+}
+
+// SetNillableFeeAddress sets the "fee_address" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableFeeAddress(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetFeeAddress(*s)
+	}
+	return ic
+}
+
+// SetFeeBasisPoints sets the "fee_basis_points" field.
+func (ic *InvoiceCreate) SetFeeBasisPoints(i int64) *InvoiceCreate {
+	ic.mutation.SetFeeBasisPoints(i)
+	return ic
+}
+
+// SetNillableFeeBasisPoints sets the "fee_basis_points" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableFeeBasisPoints(i *int64) *InvoiceCreate {
+	if i != nil {
+		ic.SetFeeBasisPoints(*i)
+	}
+	return ic
+}
+
+// SetFeeFlat sets the "fee_flat" field.
+func (ic *InvoiceCreate) SetFeeFlat(b *big.Int) *InvoiceCreate {
+	ic.mutation.SetFeeFlat(b)
+	return ic
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (ic *InvoiceCreate) SetFeeAmount(b *big.Int) *InvoiceCreate {
+	ic.mutation.SetFeeAmount(b)
+	return ic
+}
+
+// SetFeeTxHash sets the "fee_tx_hash" field.
+func (ic *InvoiceCreate) SetFeeTxHash(s string) *InvoiceCreate {
+	ic.mutation.SetFeeTxHash(s)
+	return ic
+}
+
+// SetNillableFeeTxHash sets the "fee_tx_hash" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableFeeTxHash(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetFeeTxHash(*s)
+	}
+	return ic
+}
+
+// SetSplit sets the "split" field.
+func (ic *InvoiceCreate) SetSplit(ss []schema.SplitShare) *InvoiceCreate {
+	ic.mutation.SetSplit(ss)
+	return ic
+}
+
+// SetSplitGasRule sets the "split_gas_rule" field.
+func (ic *InvoiceCreate) SetSplitGasRule(s string) *InvoiceCreate {
+	ic.mutation.SetSplitGasRule(s)
+	return ic
+}
+
+// SetNillableSplitGasRule sets the "split_gas_rule" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableSplitGasRule(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetSplitGasRule(*s)
+	}
+	return ic
+}
+
+// SetTreasuryAddress sets the "treasury_address" field.
+func (ic *InvoiceCreate) SetTreasuryAddress(s string) *InvoiceCreate {
+	ic.mutation.SetTreasuryAddress(s)
+	return ic
+}
+
+// SetNillableTreasuryAddress sets the "treasury_address" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTreasuryAddress(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetTreasuryAddress(*s)
+	}
+	return ic
+}
+
+// SetSignerMAC sets the "signer_mac" field.
+func (ic *InvoiceCreate) SetSignerMAC(b []byte) *InvoiceCreate {
+	ic.mutation.SetSignerMAC(b)
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *InvoiceCreate) SetID(s string) *InvoiceCreate {
 	ic.mutation.SetID(s)
@@ -202,6 +415,18 @@ func (ic *InvoiceCreate) defaults() {
 		v := invoice.DefaultAutoCheckout
 		ic.mutation.SetAutoCheckout(v)
 	}
+	if _, ok := ic.mutation.DerivationVersion(); !ok {
+		v := invoice.DefaultDerivationVersion
+		ic.mutation.SetDerivationVersion(v)
+	}
+	if _, ok := ic.mutation.EscrowPeriod(); !ok {
+		v := invoice.DefaultEscrowPeriod
+		ic.mutation.SetEscrowPeriod(v)
+	}
+	if _, ok := ic.mutation.FeeBasisPoints(); !ok {
+		v := invoice.DefaultFeeBasisPoints
+		ic.mutation.SetFeeBasisPoints(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -263,14 +488,40 @@ func (ic *InvoiceCreate) check() error {
 			return &ValidationError{Name: "wallet_address", err: fmt.Errorf(`database: validator failed for field "Invoice.wallet_address": %w`, err)}
 		}
 	}
-	if _, ok := ic.mutation.EncryptedSalt(); !ok {
-		return &ValidationError{Name: "encrypted_salt", err: errors.New(`database: missing required field "Invoice.encrypted_salt"`)}
-	}
 	if v, ok := ic.mutation.EncryptedSalt(); ok {
 		if err := invoice.EncryptedSaltValidator(v); err != nil {
 			return &ValidationError{Name: "encrypted_salt", err: fmt.Errorf(`database: validator failed for field "Invoice.encrypted_salt": %w`, err)}
 		}
 	}
+	if _, ok := ic.mutation.DerivationVersion(); !ok {
+		return &ValidationError{Name: "derivation_version", err: errors.New(`database: missing required field "Invoice.derivation_version"`)}
+	}
+	if v, ok := ic.mutation.DerivationVersion(); ok {
+		if err := invoice.DerivationVersionValidator(v); err != nil {
+			return &ValidationError{Name: "derivation_version", err: fmt.Errorf(`database: validator failed for field "Invoice.derivation_version": %w`, err)}
+		}
+	}
+	if v, ok := ic.mutation.WalletIndex(); ok {
+		if err := invoice.WalletIndexValidator(v); err != nil {
+			return &ValidationError{Name: "wallet_index", err: fmt.Errorf(`database: validator failed for field "Invoice.wallet_index": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.EscrowPeriod(); !ok {
+		return &ValidationError{Name: "escrow_period", err: errors.New(`database: missing required field "Invoice.escrow_period"`)}
+	}
+	if v, ok := ic.mutation.EscrowPeriod(); ok {
+		if err := invoice.EscrowPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "escrow_period", err: fmt.Errorf(`database: validator failed for field "Invoice.escrow_period": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.FeeBasisPoints(); !ok {
+		return &ValidationError{Name: "fee_basis_points", err: errors.New(`database: missing required field "Invoice.fee_basis_points"`)}
+	}
+	if v, ok := ic.mutation.FeeBasisPoints(); ok {
+		if err := invoice.FeeBasisPointsValidator(v); err != nil {
+			return &ValidationError{Name: "fee_basis_points", err: fmt.Errorf(`database: validator failed for field "Invoice.fee_basis_points": %w`, err)}
+		}
+	}
 	if v, ok := ic.mutation.ID(); ok {
 		if err := invoice.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`database: validator failed for field "Invoice.id": %w`, err)}
@@ -374,6 +625,86 @@ func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec, error) {
 		_spec.SetField(invoice.FieldEncryptedSalt, field.TypeBytes, value)
 		_node.EncryptedSalt = value
 	}
+	if value, ok := ic.mutation.DerivationVersion(); ok {
+		_spec.SetField(invoice.FieldDerivationVersion, field.TypeInt, value)
+		_node.DerivationVersion = value
+	}
+	if value, ok := ic.mutation.WalletIndex(); ok {
+		_spec.SetField(invoice.FieldWalletIndex, field.TypeInt64, value)
+		_node.WalletIndex = &value
+	}
+	if value, ok := ic.mutation.ApprovalRequestAt(); ok {
+		_spec.SetField(invoice.FieldApprovalRequestAt, field.TypeTime, value)
+		_node.ApprovalRequestAt = &value
+	}
+	if value, ok := ic.mutation.ApproveAt(); ok {
+		_spec.SetField(invoice.FieldApproveAt, field.TypeTime, value)
+		_node.ApproveAt = &value
+	}
+	if value, ok := ic.mutation.EscrowPeriod(); ok {
+		_spec.SetField(invoice.FieldEscrowPeriod, field.TypeInt64, value)
+		_node.EscrowPeriod = value
+	}
+	if value, ok := ic.mutation.BuyerKeyHash(); ok {
+		_spec.SetField(invoice.FieldBuyerKeyHash, field.TypeBytes, value)
+		_node.BuyerKeyHash = value
+	}
+	if value, ok := ic.mutation.ReleaseAt(); ok {
+		_spec.SetField(invoice.FieldReleaseAt, field.TypeTime, value)
+		_node.ReleaseAt = &value
+	}
+	if value, ok := ic.mutation.RefundAt(); ok {
+		_spec.SetField(invoice.FieldRefundAt, field.TypeTime, value)
+		_node.RefundAt = &value
+	}
+	if value, ok := ic.mutation.DisputeAt(); ok {
+		_spec.SetField(invoice.FieldDisputeAt, field.TypeTime, value)
+		_node.DisputeAt = &value
+	}
+	if value, ok := ic.mutation.FeeAddress(); ok {
+		_spec.SetField(invoice.FieldFeeAddress, field.TypeString, value)
+		_node.FeeAddress = value
+	}
+	if value, ok := ic.mutation.FeeBasisPoints(); ok {
+		_spec.SetField(invoice.FieldFeeBasisPoints, field.TypeInt64, value)
+		_node.FeeBasisPoints = value
+	}
+	if value, ok := ic.mutation.FeeFlat(); ok {
+		vv, err := invoice.ValueScanner.FeeFlat.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(invoice.FieldFeeFlat, field.TypeString, vv)
+		_node.FeeFlat = value
+	}
+	if value, ok := ic.mutation.FeeAmount(); ok {
+		vv, err := invoice.ValueScanner.FeeAmount.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(invoice.FieldFeeAmount, field.TypeString, vv)
+		_node.FeeAmount = value
+	}
+	if value, ok := ic.mutation.FeeTxHash(); ok {
+		_spec.SetField(invoice.FieldFeeTxHash, field.TypeString, value)
+		_node.FeeTxHash = value
+	}
+	if value, ok := ic.mutation.Split(); ok {
+		_spec.SetField(invoice.FieldSplit, field.TypeJSON, value)
+		_node.Split = value
+	}
+	if value, ok := ic.mutation.SplitGasRule(); ok {
+		_spec.SetField(invoice.FieldSplitGasRule, field.TypeString, value)
+		_node.SplitGasRule = value
+	}
+	if value, ok := ic.mutation.TreasuryAddress(); ok {
+		_spec.SetField(invoice.FieldTreasuryAddress, field.TypeString, value)
+		_node.TreasuryAddress = value
+	}
+	if value, ok := ic.mutation.SignerMAC(); ok {
+		_spec.SetField(invoice.FieldSignerMAC, field.TypeBytes, value)
+		_node.SignerMAC = value
+	}
 	return _node, _spec, nil
 }
 
@@ -6,12 +6,15 @@ import (
 	"context"
 	"cpg/pkg/ent/database/invoice"
 	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/schema"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -108,6 +111,180 @@ func (iu *InvoiceUpdate) ClearCancelAt() *InvoiceUpdate {
 	return iu
 }
 
+// SetEncryptedSalt sets the "encrypted_salt" field.
+func (iu *InvoiceUpdate) SetEncryptedSalt(b []byte) *InvoiceUpdate {
+	iu.mutation.SetEncryptedSalt(b)
+	return iu
+}
+
+// ClearEncryptedSalt clears the value of the "encrypted_salt" field.
+func (iu *InvoiceUpdate) ClearEncryptedSalt() *InvoiceUpdate {
+	iu.mutation.ClearEncryptedSalt()
+	return iu
+}
+
+// SetApprovalRequestAt sets the "approval_request_at" field.
+func (iu *InvoiceUpdate) SetApprovalRequestAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetApprovalRequestAt(t)
+	return iu
+}
+
+// SetNillableApprovalRequestAt sets the "approval_request_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableApprovalRequestAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetApprovalRequestAt(*t)
+	}
+	return iu
+}
+
+// ClearApprovalRequestAt clears the value of the "approval_request_at" field.
+func (iu *InvoiceUpdate) ClearApprovalRequestAt() *InvoiceUpdate {
+	iu.mutation.ClearApprovalRequestAt()
+	return iu
+}
+
+// SetApproveAt sets the "approve_at" field.
+func (iu *InvoiceUpdate) SetApproveAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetApproveAt(t)
+	return iu
+}
+
+// SetNillableApproveAt sets the "approve_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableApproveAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetApproveAt(*t)
+	}
+	return iu
+}
+
+// ClearApproveAt clears the value of the "approve_at" field.
+func (iu *InvoiceUpdate) ClearApproveAt() *InvoiceUpdate {
+	iu.mutation.ClearApproveAt()
+	return iu
+}
+
+// SetReleaseAt sets the "release_at" field.
+func (iu *InvoiceUpdate) SetReleaseAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetReleaseAt(t)
+	return iu
+}
+
+// SetNillableReleaseAt sets the "release_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableReleaseAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetReleaseAt(*t)
+	}
+	return iu
+}
+
+// ClearReleaseAt clears the value of the "release_at" field.
+func (iu *InvoiceUpdate) ClearReleaseAt() *InvoiceUpdate {
+	iu.mutation.ClearReleaseAt()
+	return iu
+}
+
+// SetRefundAt sets the "refund_at" field.
+func (iu *InvoiceUpdate) SetRefundAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetRefundAt(t)
+	return iu
+}
+
+// SetNillableRefundAt sets the "refund_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableRefundAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetRefundAt(*t)
+	}
+	return iu
+}
+
+// ClearRefundAt clears the value of the "refund_at" field.
+func (iu *InvoiceUpdate) ClearRefundAt() *InvoiceUpdate {
+	iu.mutation.ClearRefundAt()
+	return iu
+}
+
+// SetDisputeAt sets the "dispute_at" field.
+func (iu *InvoiceUpdate) SetDisputeAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetDisputeAt(t)
+	return iu
+}
+
+// SetNillableDisputeAt sets the "dispute_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableDisputeAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetDisputeAt(*t)
+	}
+	return iu
+}
+
+// ClearDisputeAt clears the value of the "dispute_at" field.
+func (iu *InvoiceUpdate) ClearDisputeAt() *InvoiceUpdate {
+	iu.mutation.ClearDisputeAt()
+	return iu
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (iu *InvoiceUpdate) SetFeeAmount(b *big.Int) *InvoiceUpdate {
+	iu.mutation.SetFeeAmount(b)
+	return iu
+}
+
+// ClearFeeAmount clears the value of the "fee_amount" field.
+func (iu *InvoiceUpdate) ClearFeeAmount() *InvoiceUpdate {
+	iu.mutation.ClearFeeAmount()
+	return iu
+}
+
+// SetFeeTxHash sets the "fee_tx_hash" field.
+func (iu *InvoiceUpdate) SetFeeTxHash(s string) *InvoiceUpdate {
+	iu.mutation.SetFeeTxHash(s)
+	return iu
+}
+
+// SetNillableFeeTxHash sets the "fee_tx_hash" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableFeeTxHash(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetFeeTxHash(*s)
+	}
+	return iu
+}
+
+// ClearFeeTxHash clears the value of the "fee_tx_hash" field.
+func (iu *InvoiceUpdate) ClearFeeTxHash() *InvoiceUpdate {
+	iu.mutation.ClearFeeTxHash()
+	return iu
+}
+
+// SetSplit sets the "split" field.
+func (iu *InvoiceUpdate) SetSplit(ss []schema.SplitShare) *InvoiceUpdate {
+	iu.mutation.SetSplit(ss)
+	return iu
+}
+
+// AppendSplit appends ss to the "split" field.
+func (iu *InvoiceUpdate) AppendSplit(ss []schema.SplitShare) *InvoiceUpdate {
+	iu.mutation.AppendSplit(ss)
+	return iu
+}
+
+// ClearSplit clears the value of the "split" field.
+func (iu *InvoiceUpdate) ClearSplit() *InvoiceUpdate {
+	iu.mutation.ClearSplit()
+	return iu
+}
+
+// SetSignerMAC sets the "signer_mac" field.
+func (iu *InvoiceUpdate) SetSignerMAC(b []byte) *InvoiceUpdate {
+	iu.mutation.SetSignerMAC(b)
+	return iu
+}
+
+// ClearSignerMAC clears the value of the "signer_mac" field.
+func (iu *InvoiceUpdate) ClearSignerMAC() *InvoiceUpdate {
+	iu.mutation.ClearSignerMAC()
+	return iu
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (iu *InvoiceUpdate) Mutation() *InvoiceMutation {
 	return iu.mutation
@@ -140,7 +317,20 @@ func (iu *InvoiceUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvoiceUpdate) check() error {
+	if v, ok := iu.mutation.EncryptedSalt(); ok {
+		if err := invoice.EncryptedSaltValidator(v); err != nil {
+			return &ValidationError{Name: "encrypted_salt", err: fmt.Errorf(`database: validator failed for field "Invoice.encrypted_salt": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString))
 	if ps := iu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -173,6 +363,93 @@ func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if iu.mutation.CancelAtCleared() {
 		_spec.ClearField(invoice.FieldCancelAt, field.TypeTime)
 	}
+	if value, ok := iu.mutation.EncryptedSalt(); ok {
+		_spec.SetField(invoice.FieldEncryptedSalt, field.TypeBytes, value)
+	}
+	if iu.mutation.EncryptedSaltCleared() {
+		_spec.ClearField(invoice.FieldEncryptedSalt, field.TypeBytes)
+	}
+	if iu.mutation.WalletIndexCleared() {
+		_spec.ClearField(invoice.FieldWalletIndex, field.TypeInt64)
+	}
+	if value, ok := iu.mutation.ApprovalRequestAt(); ok {
+		_spec.SetField(invoice.FieldApprovalRequestAt, field.TypeTime, value)
+	}
+	if iu.mutation.ApprovalRequestAtCleared() {
+		_spec.ClearField(invoice.FieldApprovalRequestAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.ApproveAt(); ok {
+		_spec.SetField(invoice.FieldApproveAt, field.TypeTime, value)
+	}
+	if iu.mutation.ApproveAtCleared() {
+		_spec.ClearField(invoice.FieldApproveAt, field.TypeTime)
+	}
+	if iu.mutation.BuyerKeyHashCleared() {
+		_spec.ClearField(invoice.FieldBuyerKeyHash, field.TypeBytes)
+	}
+	if value, ok := iu.mutation.ReleaseAt(); ok {
+		_spec.SetField(invoice.FieldReleaseAt, field.TypeTime, value)
+	}
+	if iu.mutation.ReleaseAtCleared() {
+		_spec.ClearField(invoice.FieldReleaseAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.RefundAt(); ok {
+		_spec.SetField(invoice.FieldRefundAt, field.TypeTime, value)
+	}
+	if iu.mutation.RefundAtCleared() {
+		_spec.ClearField(invoice.FieldRefundAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.DisputeAt(); ok {
+		_spec.SetField(invoice.FieldDisputeAt, field.TypeTime, value)
+	}
+	if iu.mutation.DisputeAtCleared() {
+		_spec.ClearField(invoice.FieldDisputeAt, field.TypeTime)
+	}
+	if iu.mutation.FeeAddressCleared() {
+		_spec.ClearField(invoice.FieldFeeAddress, field.TypeString)
+	}
+	if iu.mutation.FeeFlatCleared() {
+		_spec.ClearField(invoice.FieldFeeFlat, field.TypeString)
+	}
+	if value, ok := iu.mutation.FeeAmount(); ok {
+		vv, err := invoice.ValueScanner.FeeAmount.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(invoice.FieldFeeAmount, field.TypeString, vv)
+	}
+	if iu.mutation.FeeAmountCleared() {
+		_spec.ClearField(invoice.FieldFeeAmount, field.TypeString)
+	}
+	if value, ok := iu.mutation.FeeTxHash(); ok {
+		_spec.SetField(invoice.FieldFeeTxHash, field.TypeString, value)
+	}
+	if iu.mutation.FeeTxHashCleared() {
+		_spec.ClearField(invoice.FieldFeeTxHash, field.TypeString)
+	}
+	if value, ok := iu.mutation.Split(); ok {
+		_spec.SetField(invoice.FieldSplit, field.TypeJSON, value)
+	}
+	if value, ok := iu.mutation.AppendedSplit(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldSplit, value)
+		})
+	}
+	if iu.mutation.SplitCleared() {
+		_spec.ClearField(invoice.FieldSplit, field.TypeJSON)
+	}
+	if iu.mutation.SplitGasRuleCleared() {
+		_spec.ClearField(invoice.FieldSplitGasRule, field.TypeString)
+	}
+	if iu.mutation.TreasuryAddressCleared() {
+		_spec.ClearField(invoice.FieldTreasuryAddress, field.TypeString)
+	}
+	if value, ok := iu.mutation.SignerMAC(); ok {
+		_spec.SetField(invoice.FieldSignerMAC, field.TypeBytes, value)
+	}
+	if iu.mutation.SignerMACCleared() {
+		_spec.ClearField(invoice.FieldSignerMAC, field.TypeBytes)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{invoice.Label}
@@ -273,6 +550,180 @@ func (iuo *InvoiceUpdateOne) ClearCancelAt() *InvoiceUpdateOne {
 	return iuo
 }
 
+// SetEncryptedSalt sets the "encrypted_salt" field.
+func (iuo *InvoiceUpdateOne) SetEncryptedSalt(b []byte) *InvoiceUpdateOne {
+	iuo.mutation.SetEncryptedSalt(b)
+	return iuo
+}
+
+// ClearEncryptedSalt clears the value of the "encrypted_salt" field.
+func (iuo *InvoiceUpdateOne) ClearEncryptedSalt() *InvoiceUpdateOne {
+	iuo.mutation.ClearEncryptedSalt()
+	return iuo
+}
+
+// SetApprovalRequestAt sets the "approval_request_at" field.
+func (iuo *InvoiceUpdateOne) SetApprovalRequestAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetApprovalRequestAt(t)
+	return iuo
+}
+
+// SetNillableApprovalRequestAt sets the "approval_request_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableApprovalRequestAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetApprovalRequestAt(*t)
+	}
+	return iuo
+}
+
+// ClearApprovalRequestAt clears the value of the "approval_request_at" field.
+func (iuo *InvoiceUpdateOne) ClearApprovalRequestAt() *InvoiceUpdateOne {
+	iuo.mutation.ClearApprovalRequestAt()
+	return iuo
+}
+
+// SetApproveAt sets the "approve_at" field.
+func (iuo *InvoiceUpdateOne) SetApproveAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetApproveAt(t)
+	return iuo
+}
+
+// SetNillableApproveAt sets the "approve_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableApproveAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetApproveAt(*t)
+	}
+	return iuo
+}
+
+// ClearApproveAt clears the value of the "approve_at" field.
+func (iuo *InvoiceUpdateOne) ClearApproveAt() *InvoiceUpdateOne {
+	iuo.mutation.ClearApproveAt()
+	return iuo
+}
+
+// SetReleaseAt sets the "release_at" field.
+func (iuo *InvoiceUpdateOne) SetReleaseAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetReleaseAt(t)
+	return iuo
+}
+
+// SetNillableReleaseAt sets the "release_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableReleaseAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetReleaseAt(*t)
+	}
+	return iuo
+}
+
+// ClearReleaseAt clears the value of the "release_at" field.
+func (iuo *InvoiceUpdateOne) ClearReleaseAt() *InvoiceUpdateOne {
+	iuo.mutation.ClearReleaseAt()
+	return iuo
+}
+
+// SetRefundAt sets the "refund_at" field.
+func (iuo *InvoiceUpdateOne) SetRefundAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetRefundAt(t)
+	return iuo
+}
+
+// SetNillableRefundAt sets the "refund_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableRefundAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetRefundAt(*t)
+	}
+	return iuo
+}
+
+// ClearRefundAt clears the value of the "refund_at" field.
+func (iuo *InvoiceUpdateOne) ClearRefundAt() *InvoiceUpdateOne {
+	iuo.mutation.ClearRefundAt()
+	return iuo
+}
+
+// SetDisputeAt sets the "dispute_at" field.
+func (iuo *InvoiceUpdateOne) SetDisputeAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetDisputeAt(t)
+	return iuo
+}
+
+// SetNillableDisputeAt sets the "dispute_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableDisputeAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetDisputeAt(*t)
+	}
+	return iuo
+}
+
+// ClearDisputeAt clears the value of the "dispute_at" field.
+func (iuo *InvoiceUpdateOne) ClearDisputeAt() *InvoiceUpdateOne {
+	iuo.mutation.ClearDisputeAt()
+	return iuo
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (iuo *InvoiceUpdateOne) SetFeeAmount(b *big.Int) *InvoiceUpdateOne {
+	iuo.mutation.SetFeeAmount(b)
+	return iuo
+}
+
+// ClearFeeAmount clears the value of the "fee_amount" field.
+func (iuo *InvoiceUpdateOne) ClearFeeAmount() *InvoiceUpdateOne {
+	iuo.mutation.ClearFeeAmount()
+	return iuo
+}
+
+// SetFeeTxHash sets the "fee_tx_hash" field.
+func (iuo *InvoiceUpdateOne) SetFeeTxHash(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetFeeTxHash(s)
+	return iuo
+}
+
+// SetNillableFeeTxHash sets the "fee_tx_hash" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableFeeTxHash(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetFeeTxHash(*s)
+	}
+	return iuo
+}
+
+// ClearFeeTxHash clears the value of the "fee_tx_hash" field.
+func (iuo *InvoiceUpdateOne) ClearFeeTxHash() *InvoiceUpdateOne {
+	iuo.mutation.ClearFeeTxHash()
+	return iuo
+}
+
+// SetSplit sets the "split" field.
+func (iuo *InvoiceUpdateOne) SetSplit(ss []schema.SplitShare) *InvoiceUpdateOne {
+	iuo.mutation.SetSplit(ss)
+	return iuo
+}
+
+// AppendSplit appends ss to the "split" field.
+func (iuo *InvoiceUpdateOne) AppendSplit(ss []schema.SplitShare) *InvoiceUpdateOne {
+	iuo.mutation.AppendSplit(ss)
+	return iuo
+}
+
+// ClearSplit clears the value of the "split" field.
+func (iuo *InvoiceUpdateOne) ClearSplit() *InvoiceUpdateOne {
+	iuo.mutation.ClearSplit()
+	return iuo
+}
+
+// SetSignerMAC sets the "signer_mac" field.
+func (iuo *InvoiceUpdateOne) SetSignerMAC(b []byte) *InvoiceUpdateOne {
+	iuo.mutation.SetSignerMAC(b)
+	return iuo
+}
+
+// ClearSignerMAC clears the value of the "signer_mac" field.
+func (iuo *InvoiceUpdateOne) ClearSignerMAC() *InvoiceUpdateOne {
+	iuo.mutation.ClearSignerMAC()
+	return iuo
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (iuo *InvoiceUpdateOne) Mutation() *InvoiceMutation {
 	return iuo.mutation
@@ -318,7 +769,20 @@ func (iuo *InvoiceUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvoiceUpdateOne) check() error {
+	if v, ok := iuo.mutation.EncryptedSalt(); ok {
+		if err := invoice.EncryptedSaltValidator(v); err != nil {
+			return &ValidationError{Name: "encrypted_salt", err: fmt.Errorf(`database: validator failed for field "Invoice.encrypted_salt": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeString))
 	id, ok := iuo.mutation.ID()
 	if !ok {
@@ -368,6 +832,93 @@ func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err e
 	if iuo.mutation.CancelAtCleared() {
 		_spec.ClearField(invoice.FieldCancelAt, field.TypeTime)
 	}
+	if value, ok := iuo.mutation.EncryptedSalt(); ok {
+		_spec.SetField(invoice.FieldEncryptedSalt, field.TypeBytes, value)
+	}
+	if iuo.mutation.EncryptedSaltCleared() {
+		_spec.ClearField(invoice.FieldEncryptedSalt, field.TypeBytes)
+	}
+	if iuo.mutation.WalletIndexCleared() {
+		_spec.ClearField(invoice.FieldWalletIndex, field.TypeInt64)
+	}
+	if value, ok := iuo.mutation.ApprovalRequestAt(); ok {
+		_spec.SetField(invoice.FieldApprovalRequestAt, field.TypeTime, value)
+	}
+	if iuo.mutation.ApprovalRequestAtCleared() {
+		_spec.ClearField(invoice.FieldApprovalRequestAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.ApproveAt(); ok {
+		_spec.SetField(invoice.FieldApproveAt, field.TypeTime, value)
+	}
+	if iuo.mutation.ApproveAtCleared() {
+		_spec.ClearField(invoice.FieldApproveAt, field.TypeTime)
+	}
+	if iuo.mutation.BuyerKeyHashCleared() {
+		_spec.ClearField(invoice.FieldBuyerKeyHash, field.TypeBytes)
+	}
+	if value, ok := iuo.mutation.ReleaseAt(); ok {
+		_spec.SetField(invoice.FieldReleaseAt, field.TypeTime, value)
+	}
+	if iuo.mutation.ReleaseAtCleared() {
+		_spec.ClearField(invoice.FieldReleaseAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.RefundAt(); ok {
+		_spec.SetField(invoice.FieldRefundAt, field.TypeTime, value)
+	}
+	if iuo.mutation.RefundAtCleared() {
+		_spec.ClearField(invoice.FieldRefundAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.DisputeAt(); ok {
+		_spec.SetField(invoice.FieldDisputeAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DisputeAtCleared() {
+		_spec.ClearField(invoice.FieldDisputeAt, field.TypeTime)
+	}
+	if iuo.mutation.FeeAddressCleared() {
+		_spec.ClearField(invoice.FieldFeeAddress, field.TypeString)
+	}
+	if iuo.mutation.FeeFlatCleared() {
+		_spec.ClearField(invoice.FieldFeeFlat, field.TypeString)
+	}
+	if value, ok := iuo.mutation.FeeAmount(); ok {
+		vv, err := invoice.ValueScanner.FeeAmount.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(invoice.FieldFeeAmount, field.TypeString, vv)
+	}
+	if iuo.mutation.FeeAmountCleared() {
+		_spec.ClearField(invoice.FieldFeeAmount, field.TypeString)
+	}
+	if value, ok := iuo.mutation.FeeTxHash(); ok {
+		_spec.SetField(invoice.FieldFeeTxHash, field.TypeString, value)
+	}
+	if iuo.mutation.FeeTxHashCleared() {
+		_spec.ClearField(invoice.FieldFeeTxHash, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Split(); ok {
+		_spec.SetField(invoice.FieldSplit, field.TypeJSON, value)
+	}
+	if value, ok := iuo.mutation.AppendedSplit(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldSplit, value)
+		})
+	}
+	if iuo.mutation.SplitCleared() {
+		_spec.ClearField(invoice.FieldSplit, field.TypeJSON)
+	}
+	if iuo.mutation.SplitGasRuleCleared() {
+		_spec.ClearField(invoice.FieldSplitGasRule, field.TypeString)
+	}
+	if iuo.mutation.TreasuryAddressCleared() {
+		_spec.ClearField(invoice.FieldTreasuryAddress, field.TypeString)
+	}
+	if value, ok := iuo.mutation.SignerMAC(); ok {
+		_spec.SetField(invoice.FieldSignerMAC, field.TypeBytes, value)
+	}
+	if iuo.mutation.SignerMACCleared() {
+		_spec.ClearField(invoice.FieldSignerMAC, field.TypeBytes)
+	}
 	_node = &Invoice{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"cpg/pkg/ent/database/invoiceevent"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// InvoiceEvent is the model entity for the InvoiceEvent schema.
+type InvoiceEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID string `json:"invoice_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Asset holds the value of the "asset" field.
+	Asset string `json:"asset,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// Detail holds the value of the "detail" field.
+	Detail map[string]string `json:"detail,omitempty"`
+	// CreateAt holds the value of the "create_at" field.
+	CreateAt     time.Time `json:"create_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoiceevent.FieldDetail:
+			values[i] = new([]byte)
+		case invoiceevent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case invoiceevent.FieldInvoiceID, invoiceevent.FieldKind, invoiceevent.FieldAsset, invoiceevent.FieldTxHash:
+			values[i] = new(sql.NullString)
+		case invoiceevent.FieldCreateAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceEvent fields.
+func (ie *InvoiceEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoiceevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ie.ID = int(value.Int64)
+		case invoiceevent.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				ie.InvoiceID = value.String
+			}
+		case invoiceevent.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ie.Kind = value.String
+			}
+		case invoiceevent.FieldAsset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset", values[i])
+			} else if value.Valid {
+				ie.Asset = value.String
+			}
+		case invoiceevent.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				ie.TxHash = value.String
+			}
+		case invoiceevent.FieldDetail:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field detail", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ie.Detail); err != nil {
+					return fmt.Errorf("unmarshal field detail: %w", err)
+				}
+			}
+		case invoiceevent.FieldCreateAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_at", values[i])
+			} else if value.Valid {
+				ie.CreateAt = value.Time
+			}
+		default:
+			ie.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvoiceEvent.
+// This includes values selected through modifiers, order, etc.
+func (ie *InvoiceEvent) Value(name string) (ent.Value, error) {
+	return ie.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InvoiceEvent.
+// Note that you need to call InvoiceEvent.Unwrap() before calling this method if this InvoiceEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ie *InvoiceEvent) Update() *InvoiceEventUpdateOne {
+	return NewInvoiceEventClient(ie.config).UpdateOne(ie)
+}
+
+// Unwrap unwraps the InvoiceEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ie *InvoiceEvent) Unwrap() *InvoiceEvent {
+	_tx, ok := ie.config.driver.(*txDriver)
+	if !ok {
+		panic("database: InvoiceEvent is not a transactional entity")
+	}
+	ie.config.driver = _tx.drv
+	return ie
+}
+
+// String implements the fmt.Stringer.
+func (ie *InvoiceEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ie.ID))
+	builder.WriteString("invoice_id=")
+	builder.WriteString(ie.InvoiceID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(ie.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("asset=")
+	builder.WriteString(ie.Asset)
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(ie.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("detail=")
+	builder.WriteString(fmt.Sprintf("%v", ie.Detail))
+	builder.WriteString(", ")
+	builder.WriteString("create_at=")
+	builder.WriteString(ie.CreateAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceEvents is a parsable slice of InvoiceEvent.
+type InvoiceEvents []*InvoiceEvent
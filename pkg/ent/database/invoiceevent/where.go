@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoiceevent
+
+import (
+	"cpg/pkg/ent/database/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLTE(FieldID, id))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldKind, v))
+}
+
+// Asset applies equality check predicate on the "asset" field. It's identical to AssetEQ.
+func Asset(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldAsset, v))
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldTxHash, v))
+}
+
+// CreateAt applies equality check predicate on the "create_at" field. It's identical to CreateAtEQ.
+func CreateAt(v time.Time) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGT(FieldInvoiceID, v))
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLT(FieldInvoiceID, v))
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDContains applies the Contains predicate on the "invoice_id" field.
+func InvoiceIDContains(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldContains(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasPrefix applies the HasPrefix predicate on the "invoice_id" field.
+func InvoiceIDHasPrefix(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldHasPrefix(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasSuffix applies the HasSuffix predicate on the "invoice_id" field.
+func InvoiceIDHasSuffix(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldHasSuffix(FieldInvoiceID, v))
+}
+
+// InvoiceIDEqualFold applies the EqualFold predicate on the "invoice_id" field.
+func InvoiceIDEqualFold(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEqualFold(FieldInvoiceID, v))
+}
+
+// InvoiceIDContainsFold applies the ContainsFold predicate on the "invoice_id" field.
+func InvoiceIDContainsFold(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldContainsFold(FieldInvoiceID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldContainsFold(FieldKind, v))
+}
+
+// AssetEQ applies the EQ predicate on the "asset" field.
+func AssetEQ(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldAsset, v))
+}
+
+// AssetNEQ applies the NEQ predicate on the "asset" field.
+func AssetNEQ(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNEQ(FieldAsset, v))
+}
+
+// AssetIn applies the In predicate on the "asset" field.
+func AssetIn(vs ...string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldIn(FieldAsset, vs...))
+}
+
+// AssetNotIn applies the NotIn predicate on the "asset" field.
+func AssetNotIn(vs ...string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNotIn(FieldAsset, vs...))
+}
+
+// AssetGT applies the GT predicate on the "asset" field.
+func AssetGT(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGT(FieldAsset, v))
+}
+
+// AssetGTE applies the GTE predicate on the "asset" field.
+func AssetGTE(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGTE(FieldAsset, v))
+}
+
+// AssetLT applies the LT predicate on the "asset" field.
+func AssetLT(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLT(FieldAsset, v))
+}
+
+// AssetLTE applies the LTE predicate on the "asset" field.
+func AssetLTE(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLTE(FieldAsset, v))
+}
+
+// AssetContains applies the Contains predicate on the "asset" field.
+func AssetContains(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldContains(FieldAsset, v))
+}
+
+// AssetHasPrefix applies the HasPrefix predicate on the "asset" field.
+func AssetHasPrefix(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldHasPrefix(FieldAsset, v))
+}
+
+// AssetHasSuffix applies the HasSuffix predicate on the "asset" field.
+func AssetHasSuffix(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldHasSuffix(FieldAsset, v))
+}
+
+// AssetIsNil applies the IsNil predicate on the "asset" field.
+func AssetIsNil() predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldIsNull(FieldAsset))
+}
+
+// AssetNotNil applies the NotNil predicate on the "asset" field.
+func AssetNotNil() predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNotNull(FieldAsset))
+}
+
+// AssetEqualFold applies the EqualFold predicate on the "asset" field.
+func AssetEqualFold(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEqualFold(FieldAsset, v))
+}
+
+// AssetContainsFold applies the ContainsFold predicate on the "asset" field.
+func AssetContainsFold(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldContainsFold(FieldAsset, v))
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashIsNil applies the IsNil predicate on the "tx_hash" field.
+func TxHashIsNil() predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldIsNull(FieldTxHash))
+}
+
+// TxHashNotNil applies the NotNil predicate on the "tx_hash" field.
+func TxHashNotNil() predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNotNull(FieldTxHash))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// DetailIsNil applies the IsNil predicate on the "detail" field.
+func DetailIsNil() predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldIsNull(FieldDetail))
+}
+
+// DetailNotNil applies the NotNil predicate on the "detail" field.
+func DetailNotNil() predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNotNull(FieldDetail))
+}
+
+// CreateAtEQ applies the EQ predicate on the "create_at" field.
+func CreateAtEQ(v time.Time) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// CreateAtNEQ applies the NEQ predicate on the "create_at" field.
+func CreateAtNEQ(v time.Time) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNEQ(FieldCreateAt, v))
+}
+
+// CreateAtIn applies the In predicate on the "create_at" field.
+func CreateAtIn(vs ...time.Time) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldIn(FieldCreateAt, vs...))
+}
+
+// CreateAtNotIn applies the NotIn predicate on the "create_at" field.
+func CreateAtNotIn(vs ...time.Time) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldNotIn(FieldCreateAt, vs...))
+}
+
+// CreateAtGT applies the GT predicate on the "create_at" field.
+func CreateAtGT(v time.Time) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGT(FieldCreateAt, v))
+}
+
+// CreateAtGTE applies the GTE predicate on the "create_at" field.
+func CreateAtGTE(v time.Time) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldGTE(FieldCreateAt, v))
+}
+
+// CreateAtLT applies the LT predicate on the "create_at" field.
+func CreateAtLT(v time.Time) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLT(FieldCreateAt, v))
+}
+
+// CreateAtLTE applies the LTE predicate on the "create_at" field.
+func CreateAtLTE(v time.Time) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.FieldLTE(FieldCreateAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoiceEvent) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoiceEvent) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoiceEvent) predicate.InvoiceEvent {
+	return predicate.InvoiceEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/invoiceevent"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceEventCreate is the builder for creating a InvoiceEvent entity.
+type InvoiceEventCreate struct {
+	config
+	mutation *InvoiceEventMutation
+	hooks    []Hook
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (iec *InvoiceEventCreate) SetInvoiceID(s string) *InvoiceEventCreate {
+	iec.mutation.SetInvoiceID(s)
+	return iec
+}
+
+// SetKind sets the "kind" field.
+func (iec *InvoiceEventCreate) SetKind(s string) *InvoiceEventCreate {
+	iec.mutation.SetKind(s)
+	return iec
+}
+
+// SetAsset sets the "asset" field.
+func (iec *InvoiceEventCreate) SetAsset(s string) *InvoiceEventCreate {
+	iec.mutation.SetAsset(s)
+	return iec
+}
+
+// SetNillableAsset sets the "asset" field if the given value is not nil.
+func (iec *InvoiceEventCreate) SetNillableAsset(s *string) *InvoiceEventCreate {
+	if s != nil {
+		iec.SetAsset(*s)
+	}
+	return iec
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (iec *InvoiceEventCreate) SetTxHash(s string) *InvoiceEventCreate {
+	iec.mutation.SetTxHash(s)
+	return iec
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (iec *InvoiceEventCreate) SetNillableTxHash(s *string) *InvoiceEventCreate {
+	if s != nil {
+		iec.SetTxHash(*s)
+	}
+	return iec
+}
+
+// SetDetail sets the "detail" field.
+func (iec *InvoiceEventCreate) SetDetail(m map[string]string) *InvoiceEventCreate {
+	iec.mutation.SetDetail(m)
+	return iec
+}
+
+// SetCreateAt sets the "create_at" field.
+func (iec *InvoiceEventCreate) SetCreateAt(t time.Time) *InvoiceEventCreate {
+	iec.mutation.SetCreateAt(t)
+	return iec
+}
+
+// SetNillableCreateAt sets the "create_at" field if the given value is not nil.
+func (iec *InvoiceEventCreate) SetNillableCreateAt(t *time.Time) *InvoiceEventCreate {
+	if t != nil {
+		iec.SetCreateAt(*t)
+	}
+	return iec
+}
+
+// Mutation returns the InvoiceEventMutation object of the builder.
+func (iec *InvoiceEventCreate) Mutation() *InvoiceEventMutation {
+	return iec.mutation
+}
+
+// Save creates the InvoiceEvent in the database.
+func (iec *InvoiceEventCreate) Save(ctx context.Context) (*InvoiceEvent, error) {
+	iec.defaults()
+	return withHooks(ctx, iec.sqlSave, iec.mutation, iec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iec *InvoiceEventCreate) SaveX(ctx context.Context) *InvoiceEvent {
+	v, err := iec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iec *InvoiceEventCreate) Exec(ctx context.Context) error {
+	_, err := iec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iec *InvoiceEventCreate) ExecX(ctx context.Context) {
+	if err := iec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iec *InvoiceEventCreate) defaults() {
+	if _, ok := iec.mutation.CreateAt(); !ok {
+		v := invoiceevent.DefaultCreateAt()
+		iec.mutation.SetCreateAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iec *InvoiceEventCreate) check() error {
+	if _, ok := iec.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`database: missing required field "InvoiceEvent.invoice_id"`)}
+	}
+	if v, ok := iec.mutation.InvoiceID(); ok {
+		if err := invoiceevent.InvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_id", err: fmt.Errorf(`database: validator failed for field "InvoiceEvent.invoice_id": %w`, err)}
+		}
+	}
+	if _, ok := iec.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`database: missing required field "InvoiceEvent.kind"`)}
+	}
+	if v, ok := iec.mutation.Kind(); ok {
+		if err := invoiceevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`database: validator failed for field "InvoiceEvent.kind": %w`, err)}
+		}
+	}
+	if _, ok := iec.mutation.CreateAt(); !ok {
+		return &ValidationError{Name: "create_at", err: errors.New(`database: missing required field "InvoiceEvent.create_at"`)}
+	}
+	return nil
+}
+
+func (iec *InvoiceEventCreate) sqlSave(ctx context.Context) (*InvoiceEvent, error) {
+	if err := iec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	iec.mutation.id = &_node.ID
+	iec.mutation.done = true
+	return _node, nil
+}
+
+func (iec *InvoiceEventCreate) createSpec() (*InvoiceEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceEvent{config: iec.config}
+		_spec = sqlgraph.NewCreateSpec(invoiceevent.Table, sqlgraph.NewFieldSpec(invoiceevent.FieldID, field.TypeInt))
+	)
+	if value, ok := iec.mutation.InvoiceID(); ok {
+		_spec.SetField(invoiceevent.FieldInvoiceID, field.TypeString, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := iec.mutation.Kind(); ok {
+		_spec.SetField(invoiceevent.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := iec.mutation.Asset(); ok {
+		_spec.SetField(invoiceevent.FieldAsset, field.TypeString, value)
+		_node.Asset = value
+	}
+	if value, ok := iec.mutation.TxHash(); ok {
+		_spec.SetField(invoiceevent.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := iec.mutation.Detail(); ok {
+		_spec.SetField(invoiceevent.FieldDetail, field.TypeJSON, value)
+		_node.Detail = value
+	}
+	if value, ok := iec.mutation.CreateAt(); ok {
+		_spec.SetField(invoiceevent.FieldCreateAt, field.TypeTime, value)
+		_node.CreateAt = value
+	}
+	return _node, _spec
+}
+
+// InvoiceEventCreateBulk is the builder for creating many InvoiceEvent entities in bulk.
+type InvoiceEventCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceEventCreate
+}
+
+// Save creates the InvoiceEvent entities in the database.
+func (iecb *InvoiceEventCreateBulk) Save(ctx context.Context) ([]*InvoiceEvent, error) {
+	if iecb.err != nil {
+		return nil, iecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iecb.builders))
+	nodes := make([]*InvoiceEvent, len(iecb.builders))
+	mutators := make([]Mutator, len(iecb.builders))
+	for i := range iecb.builders {
+		func(i int, root context.Context) {
+			builder := iecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iecb *InvoiceEventCreateBulk) SaveX(ctx context.Context) []*InvoiceEvent {
+	v, err := iecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iecb *InvoiceEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := iecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iecb *InvoiceEventCreateBulk) ExecX(ctx context.Context) {
+	if err := iecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
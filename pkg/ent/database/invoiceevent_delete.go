@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/invoiceevent"
+	"cpg/pkg/ent/database/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceEventDelete is the builder for deleting a InvoiceEvent entity.
+type InvoiceEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceEventMutation
+}
+
+// Where appends a list predicates to the InvoiceEventDelete builder.
+func (ied *InvoiceEventDelete) Where(ps ...predicate.InvoiceEvent) *InvoiceEventDelete {
+	ied.mutation.Where(ps...)
+	return ied
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ied *InvoiceEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ied.sqlExec, ied.mutation, ied.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ied *InvoiceEventDelete) ExecX(ctx context.Context) int {
+	n, err := ied.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ied *InvoiceEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(invoiceevent.Table, sqlgraph.NewFieldSpec(invoiceevent.FieldID, field.TypeInt))
+	if ps := ied.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ied.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ied.mutation.done = true
+	return affected, err
+}
+
+// InvoiceEventDeleteOne is the builder for deleting a single InvoiceEvent entity.
+type InvoiceEventDeleteOne struct {
+	ied *InvoiceEventDelete
+}
+
+// Where appends a list predicates to the InvoiceEventDelete builder.
+func (iedo *InvoiceEventDeleteOne) Where(ps ...predicate.InvoiceEvent) *InvoiceEventDeleteOne {
+	iedo.ied.mutation.Where(ps...)
+	return iedo
+}
+
+// Exec executes the deletion query.
+func (iedo *InvoiceEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := iedo.ied.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invoiceevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iedo *InvoiceEventDeleteOne) ExecX(ctx context.Context) {
+	if err := iedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/invoiceevent"
+	"cpg/pkg/ent/database/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceEventQuery is the builder for querying InvoiceEvent entities.
+type InvoiceEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []invoiceevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.InvoiceEvent
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvoiceEventQuery builder.
+func (ieq *InvoiceEventQuery) Where(ps ...predicate.InvoiceEvent) *InvoiceEventQuery {
+	ieq.predicates = append(ieq.predicates, ps...)
+	return ieq
+}
+
+// Limit the number of records to be returned by this query.
+func (ieq *InvoiceEventQuery) Limit(limit int) *InvoiceEventQuery {
+	ieq.ctx.Limit = &limit
+	return ieq
+}
+
+// Offset to start from.
+func (ieq *InvoiceEventQuery) Offset(offset int) *InvoiceEventQuery {
+	ieq.ctx.Offset = &offset
+	return ieq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ieq *InvoiceEventQuery) Unique(unique bool) *InvoiceEventQuery {
+	ieq.ctx.Unique = &unique
+	return ieq
+}
+
+// Order specifies how the records should be ordered.
+func (ieq *InvoiceEventQuery) Order(o ...invoiceevent.OrderOption) *InvoiceEventQuery {
+	ieq.order = append(ieq.order, o...)
+	return ieq
+}
+
+// First returns the first InvoiceEvent entity from the query.
+// Returns a *NotFoundError when no InvoiceEvent was found.
+func (ieq *InvoiceEventQuery) First(ctx context.Context) (*InvoiceEvent, error) {
+	nodes, err := ieq.Limit(1).All(setContextOp(ctx, ieq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invoiceevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ieq *InvoiceEventQuery) FirstX(ctx context.Context) *InvoiceEvent {
+	node, err := ieq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InvoiceEvent ID from the query.
+// Returns a *NotFoundError when no InvoiceEvent ID was found.
+func (ieq *InvoiceEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ieq.Limit(1).IDs(setContextOp(ctx, ieq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invoiceevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ieq *InvoiceEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := ieq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InvoiceEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InvoiceEvent entity is found.
+// Returns a *NotFoundError when no InvoiceEvent entities are found.
+func (ieq *InvoiceEventQuery) Only(ctx context.Context) (*InvoiceEvent, error) {
+	nodes, err := ieq.Limit(2).All(setContextOp(ctx, ieq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invoiceevent.Label}
+	default:
+		return nil, &NotSingularError{invoiceevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ieq *InvoiceEventQuery) OnlyX(ctx context.Context) *InvoiceEvent {
+	node, err := ieq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InvoiceEvent ID in the query.
+// Returns a *NotSingularError when more than one InvoiceEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ieq *InvoiceEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ieq.Limit(2).IDs(setContextOp(ctx, ieq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invoiceevent.Label}
+	default:
+		err = &NotSingularError{invoiceevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ieq *InvoiceEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ieq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvoiceEvents.
+func (ieq *InvoiceEventQuery) All(ctx context.Context) ([]*InvoiceEvent, error) {
+	ctx = setContextOp(ctx, ieq.ctx, ent.OpQueryAll)
+	if err := ieq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InvoiceEvent, *InvoiceEventQuery]()
+	return withInterceptors[[]*InvoiceEvent](ctx, ieq, qr, ieq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ieq *InvoiceEventQuery) AllX(ctx context.Context) []*InvoiceEvent {
+	nodes, err := ieq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InvoiceEvent IDs.
+func (ieq *InvoiceEventQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ieq.ctx.Unique == nil && ieq.path != nil {
+		ieq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ieq.ctx, ent.OpQueryIDs)
+	if err = ieq.Select(invoiceevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ieq *InvoiceEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := ieq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ieq *InvoiceEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ieq.ctx, ent.OpQueryCount)
+	if err := ieq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ieq, querierCount[*InvoiceEventQuery](), ieq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ieq *InvoiceEventQuery) CountX(ctx context.Context) int {
+	count, err := ieq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ieq *InvoiceEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ieq.ctx, ent.OpQueryExist)
+	switch _, err := ieq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("database: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ieq *InvoiceEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := ieq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvoiceEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ieq *InvoiceEventQuery) Clone() *InvoiceEventQuery {
+	if ieq == nil {
+		return nil
+	}
+	return &InvoiceEventQuery{
+		config:     ieq.config,
+		ctx:        ieq.ctx.Clone(),
+		order:      append([]invoiceevent.OrderOption{}, ieq.order...),
+		inters:     append([]Interceptor{}, ieq.inters...),
+		predicates: append([]predicate.InvoiceEvent{}, ieq.predicates...),
+		// clone intermediate query.
+		sql:  ieq.sql.Clone(),
+		path: ieq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID string `json:"invoice_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvoiceEvent.Query().
+//		GroupBy(invoiceevent.FieldInvoiceID).
+//		Aggregate(database.Count()).
+//		Scan(ctx, &v)
+func (ieq *InvoiceEventQuery) GroupBy(field string, fields ...string) *InvoiceEventGroupBy {
+	ieq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InvoiceEventGroupBy{build: ieq}
+	grbuild.flds = &ieq.ctx.Fields
+	grbuild.label = invoiceevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID string `json:"invoice_id,omitempty"`
+//	}
+//
+//	client.InvoiceEvent.Query().
+//		Select(invoiceevent.FieldInvoiceID).
+//		Scan(ctx, &v)
+func (ieq *InvoiceEventQuery) Select(fields ...string) *InvoiceEventSelect {
+	ieq.ctx.Fields = append(ieq.ctx.Fields, fields...)
+	sbuild := &InvoiceEventSelect{InvoiceEventQuery: ieq}
+	sbuild.label = invoiceevent.Label
+	sbuild.flds, sbuild.scan = &ieq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InvoiceEventSelect configured with the given aggregations.
+func (ieq *InvoiceEventQuery) Aggregate(fns ...AggregateFunc) *InvoiceEventSelect {
+	return ieq.Select().Aggregate(fns...)
+}
+
+func (ieq *InvoiceEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ieq.inters {
+		if inter == nil {
+			return fmt.Errorf("database: uninitialized interceptor (forgotten import database/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ieq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ieq.ctx.Fields {
+		if !invoiceevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+		}
+	}
+	if ieq.path != nil {
+		prev, err := ieq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ieq.sql = prev
+	}
+	return nil
+}
+
+func (ieq *InvoiceEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InvoiceEvent, error) {
+	var (
+		nodes = []*InvoiceEvent{}
+		_spec = ieq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InvoiceEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InvoiceEvent{config: ieq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ieq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ieq *InvoiceEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ieq.querySpec()
+	_spec.Node.Columns = ieq.ctx.Fields
+	if len(ieq.ctx.Fields) > 0 {
+		_spec.Unique = ieq.ctx.Unique != nil && *ieq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ieq.driver, _spec)
+}
+
+func (ieq *InvoiceEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(invoiceevent.Table, invoiceevent.Columns, sqlgraph.NewFieldSpec(invoiceevent.FieldID, field.TypeInt))
+	_spec.From = ieq.sql
+	if unique := ieq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ieq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ieq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoiceevent.FieldID)
+		for i := range fields {
+			if fields[i] != invoiceevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ieq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ieq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ieq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ieq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ieq *InvoiceEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ieq.driver.Dialect())
+	t1 := builder.Table(invoiceevent.Table)
+	columns := ieq.ctx.Fields
+	if len(columns) == 0 {
+		columns = invoiceevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ieq.sql != nil {
+		selector = ieq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ieq.ctx.Unique != nil && *ieq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ieq.predicates {
+		p(selector)
+	}
+	for _, p := range ieq.order {
+		p(selector)
+	}
+	if offset := ieq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ieq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InvoiceEventGroupBy is the group-by builder for InvoiceEvent entities.
+type InvoiceEventGroupBy struct {
+	selector
+	build *InvoiceEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iegb *InvoiceEventGroupBy) Aggregate(fns ...AggregateFunc) *InvoiceEventGroupBy {
+	iegb.fns = append(iegb.fns, fns...)
+	return iegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iegb *InvoiceEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iegb.build.ctx, ent.OpQueryGroupBy)
+	if err := iegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvoiceEventQuery, *InvoiceEventGroupBy](ctx, iegb.build, iegb, iegb.build.inters, v)
+}
+
+func (iegb *InvoiceEventGroupBy) sqlScan(ctx context.Context, root *InvoiceEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iegb.fns))
+	for _, fn := range iegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iegb.flds)+len(iegb.fns))
+		for _, f := range *iegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InvoiceEventSelect is the builder for selecting fields of InvoiceEvent entities.
+type InvoiceEventSelect struct {
+	*InvoiceEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ies *InvoiceEventSelect) Aggregate(fns ...AggregateFunc) *InvoiceEventSelect {
+	ies.fns = append(ies.fns, fns...)
+	return ies
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ies *InvoiceEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ies.ctx, ent.OpQuerySelect)
+	if err := ies.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvoiceEventQuery, *InvoiceEventSelect](ctx, ies.InvoiceEventQuery, ies, ies.inters, v)
+}
+
+func (ies *InvoiceEventSelect) sqlScan(ctx context.Context, root *InvoiceEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ies.fns))
+	for _, fn := range ies.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ies.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ies.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/invoiceevent"
+	"cpg/pkg/ent/database/predicate"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceEventUpdate is the builder for updating InvoiceEvent entities.
+type InvoiceEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceEventMutation
+}
+
+// Where appends a list predicates to the InvoiceEventUpdate builder.
+func (ieu *InvoiceEventUpdate) Where(ps ...predicate.InvoiceEvent) *InvoiceEventUpdate {
+	ieu.mutation.Where(ps...)
+	return ieu
+}
+
+// Mutation returns the InvoiceEventMutation object of the builder.
+func (ieu *InvoiceEventUpdate) Mutation() *InvoiceEventMutation {
+	return ieu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ieu *InvoiceEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ieu.sqlSave, ieu.mutation, ieu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ieu *InvoiceEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := ieu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ieu *InvoiceEventUpdate) Exec(ctx context.Context) error {
+	_, err := ieu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ieu *InvoiceEventUpdate) ExecX(ctx context.Context) {
+	if err := ieu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ieu *InvoiceEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invoiceevent.Table, invoiceevent.Columns, sqlgraph.NewFieldSpec(invoiceevent.FieldID, field.TypeInt))
+	if ps := ieu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ieu.mutation.AssetCleared() {
+		_spec.ClearField(invoiceevent.FieldAsset, field.TypeString)
+	}
+	if ieu.mutation.TxHashCleared() {
+		_spec.ClearField(invoiceevent.FieldTxHash, field.TypeString)
+	}
+	if ieu.mutation.DetailCleared() {
+		_spec.ClearField(invoiceevent.FieldDetail, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ieu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ieu.mutation.done = true
+	return n, nil
+}
+
+// InvoiceEventUpdateOne is the builder for updating a single InvoiceEvent entity.
+type InvoiceEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceEventMutation
+}
+
+// Mutation returns the InvoiceEventMutation object of the builder.
+func (ieuo *InvoiceEventUpdateOne) Mutation() *InvoiceEventMutation {
+	return ieuo.mutation
+}
+
+// Where appends a list predicates to the InvoiceEventUpdate builder.
+func (ieuo *InvoiceEventUpdateOne) Where(ps ...predicate.InvoiceEvent) *InvoiceEventUpdateOne {
+	ieuo.mutation.Where(ps...)
+	return ieuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ieuo *InvoiceEventUpdateOne) Select(field string, fields ...string) *InvoiceEventUpdateOne {
+	ieuo.fields = append([]string{field}, fields...)
+	return ieuo
+}
+
+// Save executes the query and returns the updated InvoiceEvent entity.
+func (ieuo *InvoiceEventUpdateOne) Save(ctx context.Context) (*InvoiceEvent, error) {
+	return withHooks(ctx, ieuo.sqlSave, ieuo.mutation, ieuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ieuo *InvoiceEventUpdateOne) SaveX(ctx context.Context) *InvoiceEvent {
+	node, err := ieuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ieuo *InvoiceEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := ieuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ieuo *InvoiceEventUpdateOne) ExecX(ctx context.Context) {
+	if err := ieuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ieuo *InvoiceEventUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invoiceevent.Table, invoiceevent.Columns, sqlgraph.NewFieldSpec(invoiceevent.FieldID, field.TypeInt))
+	id, ok := ieuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`database: missing "InvoiceEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ieuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoiceevent.FieldID)
+		for _, f := range fields {
+			if !invoiceevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+			}
+			if f != invoiceevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ieuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ieuo.mutation.AssetCleared() {
+		_spec.ClearField(invoiceevent.FieldAsset, field.TypeString)
+	}
+	if ieuo.mutation.TxHashCleared() {
+		_spec.ClearField(invoiceevent.FieldTxHash, field.TypeString)
+	}
+	if ieuo.mutation.DetailCleared() {
+		_spec.ClearField(invoiceevent.FieldDetail, field.TypeJSON)
+	}
+	_node = &InvoiceEvent{config: ieuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ieuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ieuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"cpg/pkg/ent/database/ledgeraccount"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LedgerAccount is the model entity for the LedgerAccount schema.
+type LedgerAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Asset holds the value of the "asset" field.
+	Asset string `json:"asset,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// CreateAt holds the value of the "create_at" field.
+	CreateAt     time.Time `json:"create_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LedgerAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledgeraccount.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ledgeraccount.FieldAsset, ledgeraccount.FieldName, ledgeraccount.FieldKind:
+			values[i] = new(sql.NullString)
+		case ledgeraccount.FieldCreateAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LedgerAccount fields.
+func (la *LedgerAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledgeraccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			la.ID = int(value.Int64)
+		case ledgeraccount.FieldAsset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset", values[i])
+			} else if value.Valid {
+				la.Asset = value.String
+			}
+		case ledgeraccount.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				la.Name = value.String
+			}
+		case ledgeraccount.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				la.Kind = value.String
+			}
+		case ledgeraccount.FieldCreateAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_at", values[i])
+			} else if value.Valid {
+				la.CreateAt = value.Time
+			}
+		default:
+			la.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LedgerAccount.
+// This includes values selected through modifiers, order, etc.
+func (la *LedgerAccount) Value(name string) (ent.Value, error) {
+	return la.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LedgerAccount.
+// Note that you need to call LedgerAccount.Unwrap() before calling this method if this LedgerAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (la *LedgerAccount) Update() *LedgerAccountUpdateOne {
+	return NewLedgerAccountClient(la.config).UpdateOne(la)
+}
+
+// Unwrap unwraps the LedgerAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (la *LedgerAccount) Unwrap() *LedgerAccount {
+	_tx, ok := la.config.driver.(*txDriver)
+	if !ok {
+		panic("database: LedgerAccount is not a transactional entity")
+	}
+	la.config.driver = _tx.drv
+	return la
+}
+
+// String implements the fmt.Stringer.
+func (la *LedgerAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("LedgerAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", la.ID))
+	builder.WriteString("asset=")
+	builder.WriteString(la.Asset)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(la.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(la.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("create_at=")
+	builder.WriteString(la.CreateAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LedgerAccounts is a parsable slice of LedgerAccount.
+type LedgerAccounts []*LedgerAccount
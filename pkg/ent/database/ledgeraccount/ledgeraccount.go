@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgeraccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the ledgeraccount type in the database.
+	Label = "ledger_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAsset holds the string denoting the asset field in the database.
+	FieldAsset = "asset"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldCreateAt holds the string denoting the create_at field in the database.
+	FieldCreateAt = "create_at"
+	// Table holds the table name of the ledgeraccount in the database.
+	Table = "ledger_accounts"
+)
+
+// Columns holds all SQL columns for ledgeraccount fields.
+var Columns = []string{
+	FieldID,
+	FieldAsset,
+	FieldName,
+	FieldKind,
+	FieldCreateAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AssetValidator is a validator for the "asset" field. It is called by the builders before save.
+	AssetValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// DefaultCreateAt holds the default value on creation for the "create_at" field.
+	DefaultCreateAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the LedgerAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAsset orders the results by the asset field.
+func ByAsset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAsset, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByCreateAt orders the results by the create_at field.
+func ByCreateAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateAt, opts...).ToFunc()
+}
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgeraccount
+
+import (
+	"cpg/pkg/ent/database/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLTE(FieldID, id))
+}
+
+// Asset applies equality check predicate on the "asset" field. It's identical to AssetEQ.
+func Asset(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldAsset, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldName, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldKind, v))
+}
+
+// CreateAt applies equality check predicate on the "create_at" field. It's identical to CreateAtEQ.
+func CreateAt(v time.Time) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// AssetEQ applies the EQ predicate on the "asset" field.
+func AssetEQ(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldAsset, v))
+}
+
+// AssetNEQ applies the NEQ predicate on the "asset" field.
+func AssetNEQ(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNEQ(FieldAsset, v))
+}
+
+// AssetIn applies the In predicate on the "asset" field.
+func AssetIn(vs ...string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldIn(FieldAsset, vs...))
+}
+
+// AssetNotIn applies the NotIn predicate on the "asset" field.
+func AssetNotIn(vs ...string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNotIn(FieldAsset, vs...))
+}
+
+// AssetGT applies the GT predicate on the "asset" field.
+func AssetGT(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGT(FieldAsset, v))
+}
+
+// AssetGTE applies the GTE predicate on the "asset" field.
+func AssetGTE(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGTE(FieldAsset, v))
+}
+
+// AssetLT applies the LT predicate on the "asset" field.
+func AssetLT(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLT(FieldAsset, v))
+}
+
+// AssetLTE applies the LTE predicate on the "asset" field.
+func AssetLTE(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLTE(FieldAsset, v))
+}
+
+// AssetContains applies the Contains predicate on the "asset" field.
+func AssetContains(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldContains(FieldAsset, v))
+}
+
+// AssetHasPrefix applies the HasPrefix predicate on the "asset" field.
+func AssetHasPrefix(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldHasPrefix(FieldAsset, v))
+}
+
+// AssetHasSuffix applies the HasSuffix predicate on the "asset" field.
+func AssetHasSuffix(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldHasSuffix(FieldAsset, v))
+}
+
+// AssetEqualFold applies the EqualFold predicate on the "asset" field.
+func AssetEqualFold(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEqualFold(FieldAsset, v))
+}
+
+// AssetContainsFold applies the ContainsFold predicate on the "asset" field.
+func AssetContainsFold(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldContainsFold(FieldAsset, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldContainsFold(FieldName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldContainsFold(FieldKind, v))
+}
+
+// CreateAtEQ applies the EQ predicate on the "create_at" field.
+func CreateAtEQ(v time.Time) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// CreateAtNEQ applies the NEQ predicate on the "create_at" field.
+func CreateAtNEQ(v time.Time) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNEQ(FieldCreateAt, v))
+}
+
+// CreateAtIn applies the In predicate on the "create_at" field.
+func CreateAtIn(vs ...time.Time) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldIn(FieldCreateAt, vs...))
+}
+
+// CreateAtNotIn applies the NotIn predicate on the "create_at" field.
+func CreateAtNotIn(vs ...time.Time) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldNotIn(FieldCreateAt, vs...))
+}
+
+// CreateAtGT applies the GT predicate on the "create_at" field.
+func CreateAtGT(v time.Time) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGT(FieldCreateAt, v))
+}
+
+// CreateAtGTE applies the GTE predicate on the "create_at" field.
+func CreateAtGTE(v time.Time) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldGTE(FieldCreateAt, v))
+}
+
+// CreateAtLT applies the LT predicate on the "create_at" field.
+func CreateAtLT(v time.Time) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLT(FieldCreateAt, v))
+}
+
+// CreateAtLTE applies the LTE predicate on the "create_at" field.
+func CreateAtLTE(v time.Time) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.FieldLTE(FieldCreateAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LedgerAccount) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LedgerAccount) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LedgerAccount) predicate.LedgerAccount {
+	return predicate.LedgerAccount(sql.NotPredicates(p))
+}
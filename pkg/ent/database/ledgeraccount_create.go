@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/ledgeraccount"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LedgerAccountCreate is the builder for creating a LedgerAccount entity.
+type LedgerAccountCreate struct {
+	config
+	mutation *LedgerAccountMutation
+	hooks    []Hook
+}
+
+// SetAsset sets the "asset" field.
+func (lac *LedgerAccountCreate) SetAsset(s string) *LedgerAccountCreate {
+	lac.mutation.SetAsset(s)
+	return lac
+}
+
+// SetName sets the "name" field.
+func (lac *LedgerAccountCreate) SetName(s string) *LedgerAccountCreate {
+	lac.mutation.SetName(s)
+	return lac
+}
+
+// SetKind sets the "kind" field.
+func (lac *LedgerAccountCreate) SetKind(s string) *LedgerAccountCreate {
+	lac.mutation.SetKind(s)
+	return lac
+}
+
+// SetCreateAt sets the "create_at" field.
+func (lac *LedgerAccountCreate) SetCreateAt(t time.Time) *LedgerAccountCreate {
+	lac.mutation.SetCreateAt(t)
+	return lac
+}
+
+// SetNillableCreateAt sets the "create_at" field if the given value is not nil.
+func (lac *LedgerAccountCreate) SetNillableCreateAt(t *time.Time) *LedgerAccountCreate {
+	if t != nil {
+		lac.SetCreateAt(*t)
+	}
+	return lac
+}
+
+// Mutation returns the LedgerAccountMutation object of the builder.
+func (lac *LedgerAccountCreate) Mutation() *LedgerAccountMutation {
+	return lac.mutation
+}
+
+// Save creates the LedgerAccount in the database.
+func (lac *LedgerAccountCreate) Save(ctx context.Context) (*LedgerAccount, error) {
+	lac.defaults()
+	return withHooks(ctx, lac.sqlSave, lac.mutation, lac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lac *LedgerAccountCreate) SaveX(ctx context.Context) *LedgerAccount {
+	v, err := lac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lac *LedgerAccountCreate) Exec(ctx context.Context) error {
+	_, err := lac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lac *LedgerAccountCreate) ExecX(ctx context.Context) {
+	if err := lac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lac *LedgerAccountCreate) defaults() {
+	if _, ok := lac.mutation.CreateAt(); !ok {
+		v := ledgeraccount.DefaultCreateAt()
+		lac.mutation.SetCreateAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lac *LedgerAccountCreate) check() error {
+	if _, ok := lac.mutation.Asset(); !ok {
+		return &ValidationError{Name: "asset", err: errors.New(`database: missing required field "LedgerAccount.asset"`)}
+	}
+	if v, ok := lac.mutation.Asset(); ok {
+		if err := ledgeraccount.AssetValidator(v); err != nil {
+			return &ValidationError{Name: "asset", err: fmt.Errorf(`database: validator failed for field "LedgerAccount.asset": %w`, err)}
+		}
+	}
+	if _, ok := lac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`database: missing required field "LedgerAccount.name"`)}
+	}
+	if v, ok := lac.mutation.Name(); ok {
+		if err := ledgeraccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`database: validator failed for field "LedgerAccount.name": %w`, err)}
+		}
+	}
+	if _, ok := lac.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`database: missing required field "LedgerAccount.kind"`)}
+	}
+	if v, ok := lac.mutation.Kind(); ok {
+		if err := ledgeraccount.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`database: validator failed for field "LedgerAccount.kind": %w`, err)}
+		}
+	}
+	if _, ok := lac.mutation.CreateAt(); !ok {
+		return &ValidationError{Name: "create_at", err: errors.New(`database: missing required field "LedgerAccount.create_at"`)}
+	}
+	return nil
+}
+
+func (lac *LedgerAccountCreate) sqlSave(ctx context.Context) (*LedgerAccount, error) {
+	if err := lac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lac.mutation.id = &_node.ID
+	lac.mutation.done = true
+	return _node, nil
+}
+
+func (lac *LedgerAccountCreate) createSpec() (*LedgerAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LedgerAccount{config: lac.config}
+		_spec = sqlgraph.NewCreateSpec(ledgeraccount.Table, sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeInt))
+	)
+	if value, ok := lac.mutation.Asset(); ok {
+		_spec.SetField(ledgeraccount.FieldAsset, field.TypeString, value)
+		_node.Asset = value
+	}
+	if value, ok := lac.mutation.Name(); ok {
+		_spec.SetField(ledgeraccount.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := lac.mutation.Kind(); ok {
+		_spec.SetField(ledgeraccount.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := lac.mutation.CreateAt(); ok {
+		_spec.SetField(ledgeraccount.FieldCreateAt, field.TypeTime, value)
+		_node.CreateAt = value
+	}
+	return _node, _spec
+}
+
+// LedgerAccountCreateBulk is the builder for creating many LedgerAccount entities in bulk.
+type LedgerAccountCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerAccountCreate
+}
+
+// Save creates the LedgerAccount entities in the database.
+func (lacb *LedgerAccountCreateBulk) Save(ctx context.Context) ([]*LedgerAccount, error) {
+	if lacb.err != nil {
+		return nil, lacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lacb.builders))
+	nodes := make([]*LedgerAccount, len(lacb.builders))
+	mutators := make([]Mutator, len(lacb.builders))
+	for i := range lacb.builders {
+		func(i int, root context.Context) {
+			builder := lacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lacb *LedgerAccountCreateBulk) SaveX(ctx context.Context) []*LedgerAccount {
+	v, err := lacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lacb *LedgerAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := lacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lacb *LedgerAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := lacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
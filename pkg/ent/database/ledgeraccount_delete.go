@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/ledgeraccount"
+	"cpg/pkg/ent/database/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LedgerAccountDelete is the builder for deleting a LedgerAccount entity.
+type LedgerAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *LedgerAccountMutation
+}
+
+// Where appends a list predicates to the LedgerAccountDelete builder.
+func (lad *LedgerAccountDelete) Where(ps ...predicate.LedgerAccount) *LedgerAccountDelete {
+	lad.mutation.Where(ps...)
+	return lad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lad *LedgerAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lad.sqlExec, lad.mutation, lad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lad *LedgerAccountDelete) ExecX(ctx context.Context) int {
+	n, err := lad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lad *LedgerAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ledgeraccount.Table, sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeInt))
+	if ps := lad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lad.mutation.done = true
+	return affected, err
+}
+
+// LedgerAccountDeleteOne is the builder for deleting a single LedgerAccount entity.
+type LedgerAccountDeleteOne struct {
+	lad *LedgerAccountDelete
+}
+
+// Where appends a list predicates to the LedgerAccountDelete builder.
+func (lado *LedgerAccountDeleteOne) Where(ps ...predicate.LedgerAccount) *LedgerAccountDeleteOne {
+	lado.lad.mutation.Where(ps...)
+	return lado
+}
+
+// Exec executes the deletion query.
+func (lado *LedgerAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := lado.lad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ledgeraccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lado *LedgerAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := lado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/ledgeraccount"
+	"cpg/pkg/ent/database/predicate"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LedgerAccountUpdate is the builder for updating LedgerAccount entities.
+type LedgerAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LedgerAccountMutation
+}
+
+// Where appends a list predicates to the LedgerAccountUpdate builder.
+func (lau *LedgerAccountUpdate) Where(ps ...predicate.LedgerAccount) *LedgerAccountUpdate {
+	lau.mutation.Where(ps...)
+	return lau
+}
+
+// Mutation returns the LedgerAccountMutation object of the builder.
+func (lau *LedgerAccountUpdate) Mutation() *LedgerAccountMutation {
+	return lau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lau *LedgerAccountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lau.sqlSave, lau.mutation, lau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lau *LedgerAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := lau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lau *LedgerAccountUpdate) Exec(ctx context.Context) error {
+	_, err := lau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lau *LedgerAccountUpdate) ExecX(ctx context.Context) {
+	if err := lau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lau *LedgerAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ledgeraccount.Table, ledgeraccount.Columns, sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeInt))
+	if ps := lau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgeraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lau.mutation.done = true
+	return n, nil
+}
+
+// LedgerAccountUpdateOne is the builder for updating a single LedgerAccount entity.
+type LedgerAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LedgerAccountMutation
+}
+
+// Mutation returns the LedgerAccountMutation object of the builder.
+func (lauo *LedgerAccountUpdateOne) Mutation() *LedgerAccountMutation {
+	return lauo.mutation
+}
+
+// Where appends a list predicates to the LedgerAccountUpdate builder.
+func (lauo *LedgerAccountUpdateOne) Where(ps ...predicate.LedgerAccount) *LedgerAccountUpdateOne {
+	lauo.mutation.Where(ps...)
+	return lauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lauo *LedgerAccountUpdateOne) Select(field string, fields ...string) *LedgerAccountUpdateOne {
+	lauo.fields = append([]string{field}, fields...)
+	return lauo
+}
+
+// Save executes the query and returns the updated LedgerAccount entity.
+func (lauo *LedgerAccountUpdateOne) Save(ctx context.Context) (*LedgerAccount, error) {
+	return withHooks(ctx, lauo.sqlSave, lauo.mutation, lauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lauo *LedgerAccountUpdateOne) SaveX(ctx context.Context) *LedgerAccount {
+	node, err := lauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lauo *LedgerAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := lauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lauo *LedgerAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := lauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lauo *LedgerAccountUpdateOne) sqlSave(ctx context.Context) (_node *LedgerAccount, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ledgeraccount.Table, ledgeraccount.Columns, sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeInt))
+	id, ok := lauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`database: missing "LedgerAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ledgeraccount.FieldID)
+		for _, f := range fields {
+			if !ledgeraccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+			}
+			if f != ledgeraccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &LedgerAccount{config: lauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgeraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"cpg/pkg/ent/database/ledgerentry"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LedgerEntry is the model entity for the LedgerEntry schema.
+type LedgerEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Journal holds the value of the "journal" field.
+	Journal string `json:"journal,omitempty"`
+	// Line holds the value of the "line" field.
+	Line int `json:"line,omitempty"`
+	// Asset holds the value of the "asset" field.
+	Asset string `json:"asset,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Debit holds the value of the "debit" field.
+	Debit string `json:"debit,omitempty"`
+	// Credit holds the value of the "credit" field.
+	Credit string `json:"credit,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount *big.Int `json:"amount,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID string `json:"invoice_id,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// CreateAt holds the value of the "create_at" field.
+	CreateAt     time.Time `json:"create_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LedgerEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldID, ledgerentry.FieldLine:
+			values[i] = new(sql.NullInt64)
+		case ledgerentry.FieldJournal, ledgerentry.FieldAsset, ledgerentry.FieldKind, ledgerentry.FieldDebit, ledgerentry.FieldCredit, ledgerentry.FieldInvoiceID, ledgerentry.FieldTxHash:
+			values[i] = new(sql.NullString)
+		case ledgerentry.FieldCreateAt:
+			values[i] = new(sql.NullTime)
+		case ledgerentry.FieldAmount:
+			values[i] = ledgerentry.ValueScanner.Amount.ScanValue()
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LedgerEntry fields.
+func (le *LedgerEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			le.ID = int(value.Int64)
+		case ledgerentry.FieldJournal:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field journal", values[i])
+			} else if value.Valid {
+				le.Journal = value.String
+			}
+		case ledgerentry.FieldLine:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field line", values[i])
+			} else if value.Valid {
+				le.Line = int(value.Int64)
+			}
+		case ledgerentry.FieldAsset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset", values[i])
+			} else if value.Valid {
+				le.Asset = value.String
+			}
+		case ledgerentry.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				le.Kind = value.String
+			}
+		case ledgerentry.FieldDebit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field debit", values[i])
+			} else if value.Valid {
+				le.Debit = value.String
+			}
+		case ledgerentry.FieldCredit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credit", values[i])
+			} else if value.Valid {
+				le.Credit = value.String
+			}
+		case ledgerentry.FieldAmount:
+			if value, err := ledgerentry.ValueScanner.Amount.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				le.Amount = value
+			}
+		case ledgerentry.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				le.InvoiceID = value.String
+			}
+		case ledgerentry.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				le.TxHash = value.String
+			}
+		case ledgerentry.FieldCreateAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_at", values[i])
+			} else if value.Valid {
+				le.CreateAt = value.Time
+			}
+		default:
+			le.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LedgerEntry.
+// This includes values selected through modifiers, order, etc.
+func (le *LedgerEntry) Value(name string) (ent.Value, error) {
+	return le.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LedgerEntry.
+// Note that you need to call LedgerEntry.Unwrap() before calling this method if this LedgerEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (le *LedgerEntry) Update() *LedgerEntryUpdateOne {
+	return NewLedgerEntryClient(le.config).UpdateOne(le)
+}
+
+// Unwrap unwraps the LedgerEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (le *LedgerEntry) Unwrap() *LedgerEntry {
+	_tx, ok := le.config.driver.(*txDriver)
+	if !ok {
+		panic("database: LedgerEntry is not a transactional entity")
+	}
+	le.config.driver = _tx.drv
+	return le
+}
+
+// String implements the fmt.Stringer.
+func (le *LedgerEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("LedgerEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", le.ID))
+	builder.WriteString("journal=")
+	builder.WriteString(le.Journal)
+	builder.WriteString(", ")
+	builder.WriteString("line=")
+	builder.WriteString(fmt.Sprintf("%v", le.Line))
+	builder.WriteString(", ")
+	builder.WriteString("asset=")
+	builder.WriteString(le.Asset)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(le.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("debit=")
+	builder.WriteString(le.Debit)
+	builder.WriteString(", ")
+	builder.WriteString("credit=")
+	builder.WriteString(le.Credit)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", le.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(le.InvoiceID)
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(le.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("create_at=")
+	builder.WriteString(le.CreateAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LedgerEntries is a parsable slice of LedgerEntry.
+type LedgerEntries []*LedgerEntry
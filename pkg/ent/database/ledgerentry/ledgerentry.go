@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgerentry
+
+import (
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+const (
+	// Label holds the string label denoting the ledgerentry type in the database.
+	Label = "ledger_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldJournal holds the string denoting the journal field in the database.
+	FieldJournal = "journal"
+	// FieldLine holds the string denoting the line field in the database.
+	FieldLine = "line"
+	// FieldAsset holds the string denoting the asset field in the database.
+	FieldAsset = "asset"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldDebit holds the string denoting the debit field in the database.
+	FieldDebit = "debit"
+	// FieldCredit holds the string denoting the credit field in the database.
+	FieldCredit = "credit"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldTxHash holds the string denoting the tx_hash field in the database.
+	FieldTxHash = "tx_hash"
+	// FieldCreateAt holds the string denoting the create_at field in the database.
+	FieldCreateAt = "create_at"
+	// Table holds the table name of the ledgerentry in the database.
+	Table = "ledger_entries"
+)
+
+// Columns holds all SQL columns for ledgerentry fields.
+var Columns = []string{
+	FieldID,
+	FieldJournal,
+	FieldLine,
+	FieldAsset,
+	FieldKind,
+	FieldDebit,
+	FieldCredit,
+	FieldAmount,
+	FieldInvoiceID,
+	FieldTxHash,
+	FieldCreateAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// JournalValidator is a validator for the "journal" field. It is called by the builders before save.
+	JournalValidator func(string) error
+	// LineValidator is a validator for the "line" field. It is called by the builders before save.
+	LineValidator func(int) error
+	// AssetValidator is a validator for the "asset" field. It is called by the builders before save.
+	AssetValidator func(string) error
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// DebitValidator is a validator for the "debit" field. It is called by the builders before save.
+	DebitValidator func(string) error
+	// CreditValidator is a validator for the "credit" field. It is called by the builders before save.
+	CreditValidator func(string) error
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(string) error
+	// DefaultCreateAt holds the default value on creation for the "create_at" field.
+	DefaultCreateAt func() time.Time
+	// ValueScanner of all LedgerEntry fields.
+	ValueScanner struct {
+		Amount field.TypeValueScanner[*big.Int]
+	}
+)
+
+// OrderOption defines the ordering options for the LedgerEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByJournal orders the results by the journal field.
+func ByJournal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJournal, opts...).ToFunc()
+}
+
+// ByLine orders the results by the line field.
+func ByLine(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLine, opts...).ToFunc()
+}
+
+// ByAsset orders the results by the asset field.
+func ByAsset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAsset, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByDebit orders the results by the debit field.
+func ByDebit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDebit, opts...).ToFunc()
+}
+
+// ByCredit orders the results by the credit field.
+func ByCredit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredit, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByInvoiceID orders the results by the invoice_id field.
+func ByInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceID, opts...).ToFunc()
+}
+
+// ByTxHash orders the results by the tx_hash field.
+func ByTxHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxHash, opts...).ToFunc()
+}
+
+// ByCreateAt orders the results by the create_at field.
+func ByCreateAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateAt, opts...).ToFunc()
+}
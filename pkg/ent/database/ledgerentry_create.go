@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/ledgerentry"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LedgerEntryCreate is the builder for creating a LedgerEntry entity.
+type LedgerEntryCreate struct {
+	config
+	mutation *LedgerEntryMutation
+	hooks    []Hook
+}
+
+// SetJournal sets the "journal" field.
+func (lec *LedgerEntryCreate) SetJournal(s string) *LedgerEntryCreate {
+	lec.mutation.SetJournal(s)
+	return lec
+}
+
+// SetLine sets the "line" field.
+func (lec *LedgerEntryCreate) SetLine(i int) *LedgerEntryCreate {
+	lec.mutation.SetLine(i)
+	return lec
+}
+
+// SetAsset sets the "asset" field.
+func (lec *LedgerEntryCreate) SetAsset(s string) *LedgerEntryCreate {
+	lec.mutation.SetAsset(s)
+	return lec
+}
+
+// SetKind sets the "kind" field.
+func (lec *LedgerEntryCreate) SetKind(s string) *LedgerEntryCreate {
+	lec.mutation.SetKind(s)
+	return lec
+}
+
+// SetDebit sets the "debit" field.
+func (lec *LedgerEntryCreate) SetDebit(s string) *LedgerEntryCreate {
+	lec.mutation.SetDebit(s)
+	return lec
+}
+
+// SetCredit sets the "credit" field.
+func (lec *LedgerEntryCreate) SetCredit(s string) *LedgerEntryCreate {
+	lec.mutation.SetCredit(s)
+	return lec
+}
+
+// SetAmount sets the "amount" field.
+func (lec *LedgerEntryCreate) SetAmount(b *big.Int) *LedgerEntryCreate {
+	lec.mutation.SetAmount(b)
+	return lec
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (lec *LedgerEntryCreate) SetInvoiceID(s string) *LedgerEntryCreate {
+	lec.mutation.SetInvoiceID(s)
+	return lec
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillableInvoiceID(s *string) *LedgerEntryCreate {
+	if s != nil {
+		lec.SetInvoiceID(*s)
+	}
+	return lec
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (lec *LedgerEntryCreate) SetTxHash(s string) *LedgerEntryCreate {
+	lec.mutation.SetTxHash(s)
+	return lec
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillableTxHash(s *string) *LedgerEntryCreate {
+	if s != nil {
+		lec.SetTxHash(*s)
+	}
+	return lec
+}
+
+// SetCreateAt sets the "create_at" field.
+func (lec *LedgerEntryCreate) SetCreateAt(t time.Time) *LedgerEntryCreate {
+	lec.mutation.SetCreateAt(t)
+	return lec
+}
+
+// SetNillableCreateAt sets the "create_at" field if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillableCreateAt(t *time.Time) *LedgerEntryCreate {
+	if t != nil {
+		lec.SetCreateAt(*t)
+	}
+	return lec
+}
+
+// Mutation returns the LedgerEntryMutation object of the builder.
+func (lec *LedgerEntryCreate) Mutation() *LedgerEntryMutation {
+	return lec.mutation
+}
+
+// Save creates the LedgerEntry in the database.
+func (lec *LedgerEntryCreate) Save(ctx context.Context) (*LedgerEntry, error) {
+	lec.defaults()
+	return withHooks(ctx, lec.sqlSave, lec.mutation, lec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lec *LedgerEntryCreate) SaveX(ctx context.Context) *LedgerEntry {
+	v, err := lec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lec *LedgerEntryCreate) Exec(ctx context.Context) error {
+	_, err := lec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lec *LedgerEntryCreate) ExecX(ctx context.Context) {
+	if err := lec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lec *LedgerEntryCreate) defaults() {
+	if _, ok := lec.mutation.CreateAt(); !ok {
+		v := ledgerentry.DefaultCreateAt()
+		lec.mutation.SetCreateAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lec *LedgerEntryCreate) check() error {
+	if _, ok := lec.mutation.Journal(); !ok {
+		return &ValidationError{Name: "journal", err: errors.New(`database: missing required field "LedgerEntry.journal"`)}
+	}
+	if v, ok := lec.mutation.Journal(); ok {
+		if err := ledgerentry.JournalValidator(v); err != nil {
+			return &ValidationError{Name: "journal", err: fmt.Errorf(`database: validator failed for field "LedgerEntry.journal": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.Line(); !ok {
+		return &ValidationError{Name: "line", err: errors.New(`database: missing required field "LedgerEntry.line"`)}
+	}
+	if v, ok := lec.mutation.Line(); ok {
+		if err := ledgerentry.LineValidator(v); err != nil {
+			return &ValidationError{Name: "line", err: fmt.Errorf(`database: validator failed for field "LedgerEntry.line": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.Asset(); !ok {
+		return &ValidationError{Name: "asset", err: errors.New(`database: missing required field "LedgerEntry.asset"`)}
+	}
+	if v, ok := lec.mutation.Asset(); ok {
+		if err := ledgerentry.AssetValidator(v); err != nil {
+			return &ValidationError{Name: "asset", err: fmt.Errorf(`database: validator failed for field "LedgerEntry.asset": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`database: missing required field "LedgerEntry.kind"`)}
+	}
+	if v, ok := lec.mutation.Kind(); ok {
+		if err := ledgerentry.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`database: validator failed for field "LedgerEntry.kind": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.Debit(); !ok {
+		return &ValidationError{Name: "debit", err: errors.New(`database: missing required field "LedgerEntry.debit"`)}
+	}
+	if v, ok := lec.mutation.Debit(); ok {
+		if err := ledgerentry.DebitValidator(v); err != nil {
+			return &ValidationError{Name: "debit", err: fmt.Errorf(`database: validator failed for field "LedgerEntry.debit": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.Credit(); !ok {
+		return &ValidationError{Name: "credit", err: errors.New(`database: missing required field "LedgerEntry.credit"`)}
+	}
+	if v, ok := lec.mutation.Credit(); ok {
+		if err := ledgerentry.CreditValidator(v); err != nil {
+			return &ValidationError{Name: "credit", err: fmt.Errorf(`database: validator failed for field "LedgerEntry.credit": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`database: missing required field "LedgerEntry.amount"`)}
+	}
+	if v, ok := lec.mutation.Amount(); ok {
+		if err := ledgerentry.AmountValidator(v.String()); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`database: validator failed for field "LedgerEntry.amount": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.CreateAt(); !ok {
+		return &ValidationError{Name: "create_at", err: errors.New(`database: missing required field "LedgerEntry.create_at"`)}
+	}
+	return nil
+}
+
+func (lec *LedgerEntryCreate) sqlSave(ctx context.Context) (*LedgerEntry, error) {
+	if err := lec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec, err := lec.createSpec()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlgraph.CreateNode(ctx, lec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lec.mutation.id = &_node.ID
+	lec.mutation.done = true
+	return _node, nil
+}
+
+func (lec *LedgerEntryCreate) createSpec() (*LedgerEntry, *sqlgraph.CreateSpec, error) {
+	var (
+		_node = &LedgerEntry{config: lec.config}
+		_spec = sqlgraph.NewCreateSpec(ledgerentry.Table, sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt))
+	)
+	if value, ok := lec.mutation.Journal(); ok {
+		_spec.SetField(ledgerentry.FieldJournal, field.TypeString, value)
+		_node.Journal = value
+	}
+	if value, ok := lec.mutation.Line(); ok {
+		_spec.SetField(ledgerentry.FieldLine, field.TypeInt, value)
+		_node.Line = value
+	}
+	if value, ok := lec.mutation.Asset(); ok {
+		_spec.SetField(ledgerentry.FieldAsset, field.TypeString, value)
+		_node.Asset = value
+	}
+	if value, ok := lec.mutation.Kind(); ok {
+		_spec.SetField(ledgerentry.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := lec.mutation.Debit(); ok {
+		_spec.SetField(ledgerentry.FieldDebit, field.TypeString, value)
+		_node.Debit = value
+	}
+	if value, ok := lec.mutation.Credit(); ok {
+		_spec.SetField(ledgerentry.FieldCredit, field.TypeString, value)
+		_node.Credit = value
+	}
+	if value, ok := lec.mutation.Amount(); ok {
+		vv, err := ledgerentry.ValueScanner.Amount.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(ledgerentry.FieldAmount, field.TypeString, vv)
+		_node.Amount = value
+	}
+	if value, ok := lec.mutation.InvoiceID(); ok {
+		_spec.SetField(ledgerentry.FieldInvoiceID, field.TypeString, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := lec.mutation.TxHash(); ok {
+		_spec.SetField(ledgerentry.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := lec.mutation.CreateAt(); ok {
+		_spec.SetField(ledgerentry.FieldCreateAt, field.TypeTime, value)
+		_node.CreateAt = value
+	}
+	return _node, _spec, nil
+}
+
+// LedgerEntryCreateBulk is the builder for creating many LedgerEntry entities in bulk.
+type LedgerEntryCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerEntryCreate
+}
+
+// Save creates the LedgerEntry entities in the database.
+func (lecb *LedgerEntryCreateBulk) Save(ctx context.Context) ([]*LedgerEntry, error) {
+	if lecb.err != nil {
+		return nil, lecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lecb.builders))
+	nodes := make([]*LedgerEntry, len(lecb.builders))
+	mutators := make([]Mutator, len(lecb.builders))
+	for i := range lecb.builders {
+		func(i int, root context.Context) {
+			builder := lecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lecb *LedgerEntryCreateBulk) SaveX(ctx context.Context) []*LedgerEntry {
+	v, err := lecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lecb *LedgerEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := lecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lecb *LedgerEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := lecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -24,7 +24,25 @@ var (
 		{Name: "auto_checkout", Type: field.TypeBool, Default: false},
 		{Name: "cancel_at", Type: field.TypeTime, Nullable: true},
 		{Name: "wallet_address", Type: field.TypeString, Unique: true},
-		{Name: "encrypted_salt", Type: field.TypeBytes, Unique: true},
+		{Name: "encrypted_salt", Type: field.TypeBytes, Unique: true, Nullable: true},
+		{Name: "derivation_version", Type: field.TypeInt, Default: 0},
+		{Name: "wallet_index", Type: field.TypeInt64, Nullable: true},
+		{Name: "approval_request_at", Type: field.TypeTime, Nullable: true},
+		{Name: "approve_at", Type: field.TypeTime, Nullable: true},
+		{Name: "escrow_period", Type: field.TypeInt64, Default: 0},
+		{Name: "buyer_key_hash", Type: field.TypeBytes, Nullable: true},
+		{Name: "release_at", Type: field.TypeTime, Nullable: true},
+		{Name: "refund_at", Type: field.TypeTime, Nullable: true},
+		{Name: "dispute_at", Type: field.TypeTime, Nullable: true},
+		{Name: "fee_address", Type: field.TypeString, Nullable: true},
+		{Name: "fee_basis_points", Type: field.TypeInt64, Default: 0},
+		{Name: "fee_flat", Type: field.TypeString, Nullable: true},
+		{Name: "fee_amount", Type: field.TypeString, Nullable: true},
+		{Name: "fee_tx_hash", Type: field.TypeString, Nullable: true},
+		{Name: "split", Type: field.TypeJSON, Nullable: true},
+		{Name: "split_gas_rule", Type: field.TypeString, Nullable: true},
+		{Name: "treasury_address", Type: field.TypeString, Nullable: true},
+		{Name: "signer_mac", Type: field.TypeBytes, Nullable: true},
 	}
 	// InvoicesTable holds the schema information for the "invoices" table.
 	InvoicesTable = &schema.Table{
@@ -32,9 +50,210 @@ var (
 		Columns:    InvoicesColumns,
 		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
 	}
+	// InvoiceEventsColumns holds the columns for the "invoice_events" table.
+	InvoiceEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "invoice_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "asset", Type: field.TypeString, Nullable: true},
+		{Name: "tx_hash", Type: field.TypeString, Nullable: true},
+		{Name: "detail", Type: field.TypeJSON, Nullable: true},
+		{Name: "create_at", Type: field.TypeTime},
+	}
+	// InvoiceEventsTable holds the schema information for the "invoice_events" table.
+	InvoiceEventsTable = &schema.Table{
+		Name:       "invoice_events",
+		Columns:    InvoiceEventsColumns,
+		PrimaryKey: []*schema.Column{InvoiceEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoiceevent_invoice_id_create_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceEventsColumns[1], InvoiceEventsColumns[6]},
+			},
+		},
+	}
+	// LedgerAccountsColumns holds the columns for the "ledger_accounts" table.
+	LedgerAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "asset", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "create_at", Type: field.TypeTime},
+	}
+	// LedgerAccountsTable holds the schema information for the "ledger_accounts" table.
+	LedgerAccountsTable = &schema.Table{
+		Name:       "ledger_accounts",
+		Columns:    LedgerAccountsColumns,
+		PrimaryKey: []*schema.Column{LedgerAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgeraccount_asset_name",
+				Unique:  true,
+				Columns: []*schema.Column{LedgerAccountsColumns[1], LedgerAccountsColumns[2]},
+			},
+		},
+	}
+	// LedgerEntriesColumns holds the columns for the "ledger_entries" table.
+	LedgerEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "journal", Type: field.TypeString},
+		{Name: "line", Type: field.TypeInt},
+		{Name: "asset", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "debit", Type: field.TypeString},
+		{Name: "credit", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeString},
+		{Name: "invoice_id", Type: field.TypeString, Nullable: true},
+		{Name: "tx_hash", Type: field.TypeString, Nullable: true},
+		{Name: "create_at", Type: field.TypeTime},
+	}
+	// LedgerEntriesTable holds the schema information for the "ledger_entries" table.
+	LedgerEntriesTable = &schema.Table{
+		Name:       "ledger_entries",
+		Columns:    LedgerEntriesColumns,
+		PrimaryKey: []*schema.Column{LedgerEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgerentry_journal_line",
+				Unique:  true,
+				Columns: []*schema.Column{LedgerEntriesColumns[1], LedgerEntriesColumns[2]},
+			},
+			{
+				Name:    "ledgerentry_asset_create_at",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[3], LedgerEntriesColumns[10]},
+			},
+			{
+				Name:    "ledgerentry_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[8]},
+			},
+		},
+	}
+	// PayoutsColumns holds the columns for the "payouts" table.
+	PayoutsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "idempotency_key", Type: field.TypeString, Unique: true},
+		{Name: "asset", Type: field.TypeString},
+		{Name: "to", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeString},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "create_at", Type: field.TypeTime},
+		{Name: "fee", Type: field.TypeString, Nullable: true},
+		{Name: "tx_hash", Type: field.TypeString, Nullable: true},
+		{Name: "send_at", Type: field.TypeTime, Nullable: true},
+		{Name: "confirm_at", Type: field.TypeTime, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "notified_status", Type: field.TypeString, Default: ""},
+	}
+	// PayoutsTable holds the schema information for the "payouts" table.
+	PayoutsTable = &schema.Table{
+		Name:       "payouts",
+		Columns:    PayoutsColumns,
+		PrimaryKey: []*schema.Column{PayoutsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payout_asset_create_at",
+				Unique:  false,
+				Columns: []*schema.Column{PayoutsColumns[2], PayoutsColumns[7]},
+			},
+			{
+				Name:    "payout_status",
+				Unique:  false,
+				Columns: []*schema.Column{PayoutsColumns[6]},
+			},
+		},
+	}
+	// PayoutDaysColumns holds the columns for the "payout_days" table.
+	PayoutDaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "payouts", Type: field.TypeInt64},
+	}
+	// PayoutDaysTable holds the schema information for the "payout_days" table.
+	PayoutDaysTable = &schema.Table{
+		Name:       "payout_days",
+		Columns:    PayoutDaysColumns,
+		PrimaryKey: []*schema.Column{PayoutDaysColumns[0]},
+	}
+	// TreasuryCreditsColumns holds the columns for the "treasury_credits" table.
+	TreasuryCreditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "asset", Type: field.TypeString},
+		{Name: "account", Type: field.TypeString},
+		{Name: "invoice_id", Type: field.TypeString},
+		{Name: "tx_hash", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeString},
+		{Name: "create_at", Type: field.TypeTime},
+		{Name: "settlement_id", Type: field.TypeString, Nullable: true},
+	}
+	// TreasuryCreditsTable holds the schema information for the "treasury_credits" table.
+	TreasuryCreditsTable = &schema.Table{
+		Name:       "treasury_credits",
+		Columns:    TreasuryCreditsColumns,
+		PrimaryKey: []*schema.Column{TreasuryCreditsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "treasurycredit_tx_hash_account",
+				Unique:  true,
+				Columns: []*schema.Column{TreasuryCreditsColumns[4], TreasuryCreditsColumns[2]},
+			},
+			{
+				Name:    "treasurycredit_asset_account_settlement_id",
+				Unique:  false,
+				Columns: []*schema.Column{TreasuryCreditsColumns[1], TreasuryCreditsColumns[2], TreasuryCreditsColumns[7]},
+			},
+		},
+	}
+	// TreasuryTransfersColumns holds the columns for the "treasury_transfers" table.
+	TreasuryTransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "asset", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "to", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeString},
+		{Name: "create_at", Type: field.TypeTime},
+		{Name: "fee", Type: field.TypeString, Nullable: true},
+		{Name: "tx_hash", Type: field.TypeString, Nullable: true},
+		{Name: "send_at", Type: field.TypeTime, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+	}
+	// TreasuryTransfersTable holds the schema information for the "treasury_transfers" table.
+	TreasuryTransfersTable = &schema.Table{
+		Name:       "treasury_transfers",
+		Columns:    TreasuryTransfersColumns,
+		PrimaryKey: []*schema.Column{TreasuryTransfersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "treasurytransfer_asset_to_create_at",
+				Unique:  false,
+				Columns: []*schema.Column{TreasuryTransfersColumns[1], TreasuryTransfersColumns[3], TreasuryTransfersColumns[5]},
+			},
+		},
+	}
+	// WalletAccountsColumns holds the columns for the "wallet_accounts" table.
+	WalletAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "next_index", Type: field.TypeInt64},
+	}
+	// WalletAccountsTable holds the schema information for the "wallet_accounts" table.
+	WalletAccountsTable = &schema.Table{
+		Name:       "wallet_accounts",
+		Columns:    WalletAccountsColumns,
+		PrimaryKey: []*schema.Column{WalletAccountsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		InvoicesTable,
+		InvoiceEventsTable,
+		LedgerAccountsTable,
+		LedgerEntriesTable,
+		PayoutsTable,
+		PayoutDaysTable,
+		TreasuryCreditsTable,
+		TreasuryTransfersTable,
+		WalletAccountsTable,
 	}
 )
 
@@ -5,7 +5,16 @@ package database
 import (
 	"context"
 	"cpg/pkg/ent/database/invoice"
+	"cpg/pkg/ent/database/invoiceevent"
+	"cpg/pkg/ent/database/ledgeraccount"
+	"cpg/pkg/ent/database/ledgerentry"
+	"cpg/pkg/ent/database/payout"
+	"cpg/pkg/ent/database/payoutday"
 	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/treasurycredit"
+	"cpg/pkg/ent/database/treasurytransfer"
+	"cpg/pkg/ent/database/walletaccount"
+	"cpg/pkg/ent/schema"
 	"errors"
 	"fmt"
 	"math/big"
@@ -25,33 +34,64 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeInvoice = "Invoice"
+	TypeInvoice          = "Invoice"
+	TypeInvoiceEvent     = "InvoiceEvent"
+	TypeLedgerAccount    = "LedgerAccount"
+	TypeLedgerEntry      = "LedgerEntry"
+	TypePayout           = "Payout"
+	TypePayoutDay        = "PayoutDay"
+	TypeTreasuryCredit   = "TreasuryCredit"
+	TypeTreasuryTransfer = "TreasuryTransfer"
+	TypeWalletAccount    = "WalletAccount"
 )
 
 // InvoiceMutation represents an operation that mutates the Invoice nodes in the graph.
 type InvoiceMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *string
-	min_amount          **big.Int
-	recipient           *string
-	beneficiary         *string
-	asset               *string
-	metadata            *string
-	create_at           *time.Time
-	deadline            *time.Time
-	fill_at             *time.Time
-	last_checkout_at    *time.Time
-	checkout_request_at *time.Time
-	auto_checkout       *bool
-	cancel_at           *time.Time
-	wallet_address      *string
-	encrypted_salt      *[]byte
-	clearedFields       map[string]struct{}
-	done                bool
-	oldValue            func(context.Context) (*Invoice, error)
-	predicates          []predicate.Invoice
+	op                    Op
+	typ                   string
+	id                    *string
+	min_amount            **big.Int
+	recipient             *string
+	beneficiary           *string
+	asset                 *string
+	metadata              *string
+	create_at             *time.Time
+	deadline              *time.Time
+	fill_at               *time.Time
+	last_checkout_at      *time.Time
+	checkout_request_at   *time.Time
+	auto_checkout         *bool
+	cancel_at             *time.Time
+	wallet_address        *string
+	encrypted_salt        *[]byte
+	derivation_version    *int
+	addderivation_version *int
+	wallet_index          *int64
+	addwallet_index       *int64
+	approval_request_at   *time.Time
+	approve_at            *time.Time
+	escrow_period         *int64
+	addescrow_period      *int64
+	buyer_key_hash        *[]byte
+	release_at            *time.Time
+	refund_at             *time.Time
+	dispute_at            *time.Time
+	fee_address           *string
+	fee_basis_points      *int64
+	addfee_basis_points   *int64
+	fee_flat              **big.Int
+	fee_amount            **big.Int
+	fee_tx_hash           *string
+	split                 *[]schema.SplitShare
+	appendsplit           []schema.SplitShare
+	split_gas_rule        *string
+	treasury_address      *string
+	signer_mac            *[]byte
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*Invoice, error)
+	predicates            []predicate.Invoice
 }
 
 var _ ent.Mutation = (*InvoiceMutation)(nil)
@@ -709,87 +749,7068 @@ func (m *InvoiceMutation) OldEncryptedSalt(ctx context.Context) (v []byte, err e
 	return oldValue.EncryptedSalt, nil
 }
 
+// ClearEncryptedSalt clears the value of the "encrypted_salt" field.
+func (m *InvoiceMutation) ClearEncryptedSalt() {
+	m.encrypted_salt = nil
+	m.clearedFields[invoice.FieldEncryptedSalt] = struct{}{}
+}
+
+// EncryptedSaltCleared returns if the "encrypted_salt" field was cleared in this mutation.
+func (m *InvoiceMutation) EncryptedSaltCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldEncryptedSalt]
+	return ok
+}
+
 // ResetEncryptedSalt resets all changes to the "encrypted_salt" field.
 func (m *InvoiceMutation) ResetEncryptedSalt() {
 	m.encrypted_salt = nil
+	delete(m.clearedFields, invoice.FieldEncryptedSalt)
 }
 
-// Where appends a list predicates to the InvoiceMutation builder.
-func (m *InvoiceMutation) Where(ps ...predicate.Invoice) {
-	m.predicates = append(m.predicates, ps...)
+// SetDerivationVersion sets the "derivation_version" field.
+func (m *InvoiceMutation) SetDerivationVersion(i int) {
+	m.derivation_version = &i
+	m.addderivation_version = nil
 }
 
-// WhereP appends storage-level predicates to the InvoiceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *InvoiceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Invoice, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// DerivationVersion returns the value of the "derivation_version" field in the mutation.
+func (m *InvoiceMutation) DerivationVersion() (r int, exists bool) {
+	v := m.derivation_version
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *InvoiceMutation) Op() Op {
-	return m.op
+// OldDerivationVersion returns the old "derivation_version" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldDerivationVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDerivationVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDerivationVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDerivationVersion: %w", err)
+	}
+	return oldValue.DerivationVersion, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *InvoiceMutation) SetOp(op Op) {
-	m.op = op
+// AddDerivationVersion adds i to the "derivation_version" field.
+func (m *InvoiceMutation) AddDerivationVersion(i int) {
+	if m.addderivation_version != nil {
+		*m.addderivation_version += i
+	} else {
+		m.addderivation_version = &i
+	}
 }
 
-// Type returns the node type of this mutation (Invoice).
-func (m *InvoiceMutation) Type() string {
-	return m.typ
+// AddedDerivationVersion returns the value that was added to the "derivation_version" field in this mutation.
+func (m *InvoiceMutation) AddedDerivationVersion() (r int, exists bool) {
+	v := m.addderivation_version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *InvoiceMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.min_amount != nil {
-		fields = append(fields, invoice.FieldMinAmount)
+// ResetDerivationVersion resets all changes to the "derivation_version" field.
+func (m *InvoiceMutation) ResetDerivationVersion() {
+	m.derivation_version = nil
+	m.addderivation_version = nil
+}
+
+// SetWalletIndex sets the "wallet_index" field.
+func (m *InvoiceMutation) SetWalletIndex(i int64) {
+	m.wallet_index = &i
+	m.addwallet_index = nil
+}
+
+// WalletIndex returns the value of the "wallet_index" field in the mutation.
+func (m *InvoiceMutation) WalletIndex() (r int64, exists bool) {
+	v := m.wallet_index
+	if v == nil {
+		return
 	}
-	if m.recipient != nil {
-		fields = append(fields, invoice.FieldRecipient)
+	return *v, true
+}
+
+// OldWalletIndex returns the old "wallet_index" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldWalletIndex(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletIndex is only allowed on UpdateOne operations")
 	}
-	if m.beneficiary != nil {
-		fields = append(fields, invoice.FieldBeneficiary)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletIndex requires an ID field in the mutation")
 	}
-	if m.asset != nil {
-		fields = append(fields, invoice.FieldAsset)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletIndex: %w", err)
 	}
-	if m.metadata != nil {
-		fields = append(fields, invoice.FieldMetadata)
+	return oldValue.WalletIndex, nil
+}
+
+// AddWalletIndex adds i to the "wallet_index" field.
+func (m *InvoiceMutation) AddWalletIndex(i int64) {
+	if m.addwallet_index != nil {
+		*m.addwallet_index += i
+	} else {
+		m.addwallet_index = &i
 	}
-	if m.create_at != nil {
-		fields = append(fields, invoice.FieldCreateAt)
+}
+
+// AddedWalletIndex returns the value that was added to the "wallet_index" field in this mutation.
+func (m *InvoiceMutation) AddedWalletIndex() (r int64, exists bool) {
+	v := m.addwallet_index
+	if v == nil {
+		return
 	}
-	if m.deadline != nil {
-		fields = append(fields, invoice.FieldDeadline)
+	return *v, true
+}
+
+// ClearWalletIndex clears the value of the "wallet_index" field.
+func (m *InvoiceMutation) ClearWalletIndex() {
+	m.wallet_index = nil
+	m.addwallet_index = nil
+	m.clearedFields[invoice.FieldWalletIndex] = struct{}{}
+}
+
+// WalletIndexCleared returns if the "wallet_index" field was cleared in this mutation.
+func (m *InvoiceMutation) WalletIndexCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldWalletIndex]
+	return ok
+}
+
+// ResetWalletIndex resets all changes to the "wallet_index" field.
+func (m *InvoiceMutation) ResetWalletIndex() {
+	m.wallet_index = nil
+	m.addwallet_index = nil
+	delete(m.clearedFields, invoice.FieldWalletIndex)
+}
+
+// SetApprovalRequestAt sets the "approval_request_at" field.
+func (m *InvoiceMutation) SetApprovalRequestAt(t time.Time) {
+	m.approval_request_at = &t
+}
+
+// ApprovalRequestAt returns the value of the "approval_request_at" field in the mutation.
+func (m *InvoiceMutation) ApprovalRequestAt() (r time.Time, exists bool) {
+	v := m.approval_request_at
+	if v == nil {
+		return
 	}
-	if m.fill_at != nil {
-		fields = append(fields, invoice.FieldFillAt)
+	return *v, true
+}
+
+// OldApprovalRequestAt returns the old "approval_request_at" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldApprovalRequestAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovalRequestAt is only allowed on UpdateOne operations")
 	}
-	if m.last_checkout_at != nil {
-		fields = append(fields, invoice.FieldLastCheckoutAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovalRequestAt requires an ID field in the mutation")
 	}
-	if m.checkout_request_at != nil {
-		fields = append(fields, invoice.FieldCheckoutRequestAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovalRequestAt: %w", err)
 	}
-	if m.auto_checkout != nil {
-		fields = append(fields, invoice.FieldAutoCheckout)
+	return oldValue.ApprovalRequestAt, nil
+}
+
+// ClearApprovalRequestAt clears the value of the "approval_request_at" field.
+func (m *InvoiceMutation) ClearApprovalRequestAt() {
+	m.approval_request_at = nil
+	m.clearedFields[invoice.FieldApprovalRequestAt] = struct{}{}
+}
+
+// ApprovalRequestAtCleared returns if the "approval_request_at" field was cleared in this mutation.
+func (m *InvoiceMutation) ApprovalRequestAtCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldApprovalRequestAt]
+	return ok
+}
+
+// ResetApprovalRequestAt resets all changes to the "approval_request_at" field.
+func (m *InvoiceMutation) ResetApprovalRequestAt() {
+	m.approval_request_at = nil
+	delete(m.clearedFields, invoice.FieldApprovalRequestAt)
+}
+
+// SetApproveAt sets the "approve_at" field.
+func (m *InvoiceMutation) SetApproveAt(t time.Time) {
+	m.approve_at = &t
+}
+
+// ApproveAt returns the value of the "approve_at" field in the mutation.
+func (m *InvoiceMutation) ApproveAt() (r time.Time, exists bool) {
+	v := m.approve_at
+	if v == nil {
+		return
 	}
-	if m.cancel_at != nil {
-		fields = append(fields, invoice.FieldCancelAt)
+	return *v, true
+}
+
+// OldApproveAt returns the old "approve_at" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldApproveAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApproveAt is only allowed on UpdateOne operations")
 	}
-	if m.wallet_address != nil {
-		fields = append(fields, invoice.FieldWalletAddress)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApproveAt requires an ID field in the mutation")
 	}
-	if m.encrypted_salt != nil {
-		fields = append(fields, invoice.FieldEncryptedSalt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApproveAt: %w", err)
+	}
+	return oldValue.ApproveAt, nil
+}
+
+// ClearApproveAt clears the value of the "approve_at" field.
+func (m *InvoiceMutation) ClearApproveAt() {
+	m.approve_at = nil
+	m.clearedFields[invoice.FieldApproveAt] = struct{}{}
+}
+
+// ApproveAtCleared returns if the "approve_at" field was cleared in this mutation.
+func (m *InvoiceMutation) ApproveAtCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldApproveAt]
+	return ok
+}
+
+// ResetApproveAt resets all changes to the "approve_at" field.
+func (m *InvoiceMutation) ResetApproveAt() {
+	m.approve_at = nil
+	delete(m.clearedFields, invoice.FieldApproveAt)
+}
+
+// SetEscrowPeriod sets the "escrow_period" field.
+func (m *InvoiceMutation) SetEscrowPeriod(i int64) {
+	m.escrow_period = &i
+	m.addescrow_period = nil
+}
+
+// EscrowPeriod returns the value of the "escrow_period" field in the mutation.
+func (m *InvoiceMutation) EscrowPeriod() (r int64, exists bool) {
+	v := m.escrow_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEscrowPeriod returns the old "escrow_period" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldEscrowPeriod(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscrowPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscrowPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscrowPeriod: %w", err)
+	}
+	return oldValue.EscrowPeriod, nil
+}
+
+// AddEscrowPeriod adds i to the "escrow_period" field.
+func (m *InvoiceMutation) AddEscrowPeriod(i int64) {
+	if m.addescrow_period != nil {
+		*m.addescrow_period += i
+	} else {
+		m.addescrow_period = &i
+	}
+}
+
+// AddedEscrowPeriod returns the value that was added to the "escrow_period" field in this mutation.
+func (m *InvoiceMutation) AddedEscrowPeriod() (r int64, exists bool) {
+	v := m.addescrow_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEscrowPeriod resets all changes to the "escrow_period" field.
+func (m *InvoiceMutation) ResetEscrowPeriod() {
+	m.escrow_period = nil
+	m.addescrow_period = nil
+}
+
+// SetBuyerKeyHash sets the "buyer_key_hash" field.
+func (m *InvoiceMutation) SetBuyerKeyHash(b []byte) {
+	m.buyer_key_hash = &b
+}
+
+// BuyerKeyHash returns the value of the "buyer_key_hash" field in the mutation.
+func (m *InvoiceMutation) BuyerKeyHash() (r []byte, exists bool) {
+	v := m.buyer_key_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerKeyHash returns the old "buyer_key_hash" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldBuyerKeyHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerKeyHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerKeyHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerKeyHash: %w", err)
+	}
+	return oldValue.BuyerKeyHash, nil
+}
+
+// ClearBuyerKeyHash clears the value of the "buyer_key_hash" field.
+func (m *InvoiceMutation) ClearBuyerKeyHash() {
+	m.buyer_key_hash = nil
+	m.clearedFields[invoice.FieldBuyerKeyHash] = struct{}{}
+}
+
+// BuyerKeyHashCleared returns if the "buyer_key_hash" field was cleared in this mutation.
+func (m *InvoiceMutation) BuyerKeyHashCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldBuyerKeyHash]
+	return ok
+}
+
+// ResetBuyerKeyHash resets all changes to the "buyer_key_hash" field.
+func (m *InvoiceMutation) ResetBuyerKeyHash() {
+	m.buyer_key_hash = nil
+	delete(m.clearedFields, invoice.FieldBuyerKeyHash)
+}
+
+// SetReleaseAt sets the "release_at" field.
+func (m *InvoiceMutation) SetReleaseAt(t time.Time) {
+	m.release_at = &t
+}
+
+// ReleaseAt returns the value of the "release_at" field in the mutation.
+func (m *InvoiceMutation) ReleaseAt() (r time.Time, exists bool) {
+	v := m.release_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleaseAt returns the old "release_at" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldReleaseAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleaseAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleaseAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleaseAt: %w", err)
+	}
+	return oldValue.ReleaseAt, nil
+}
+
+// ClearReleaseAt clears the value of the "release_at" field.
+func (m *InvoiceMutation) ClearReleaseAt() {
+	m.release_at = nil
+	m.clearedFields[invoice.FieldReleaseAt] = struct{}{}
+}
+
+// ReleaseAtCleared returns if the "release_at" field was cleared in this mutation.
+func (m *InvoiceMutation) ReleaseAtCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldReleaseAt]
+	return ok
+}
+
+// ResetReleaseAt resets all changes to the "release_at" field.
+func (m *InvoiceMutation) ResetReleaseAt() {
+	m.release_at = nil
+	delete(m.clearedFields, invoice.FieldReleaseAt)
+}
+
+// SetRefundAt sets the "refund_at" field.
+func (m *InvoiceMutation) SetRefundAt(t time.Time) {
+	m.refund_at = &t
+}
+
+// RefundAt returns the value of the "refund_at" field in the mutation.
+func (m *InvoiceMutation) RefundAt() (r time.Time, exists bool) {
+	v := m.refund_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefundAt returns the old "refund_at" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldRefundAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefundAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefundAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefundAt: %w", err)
+	}
+	return oldValue.RefundAt, nil
+}
+
+// ClearRefundAt clears the value of the "refund_at" field.
+func (m *InvoiceMutation) ClearRefundAt() {
+	m.refund_at = nil
+	m.clearedFields[invoice.FieldRefundAt] = struct{}{}
+}
+
+// RefundAtCleared returns if the "refund_at" field was cleared in this mutation.
+func (m *InvoiceMutation) RefundAtCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldRefundAt]
+	return ok
+}
+
+// ResetRefundAt resets all changes to the "refund_at" field.
+func (m *InvoiceMutation) ResetRefundAt() {
+	m.refund_at = nil
+	delete(m.clearedFields, invoice.FieldRefundAt)
+}
+
+// SetDisputeAt sets the "dispute_at" field.
+func (m *InvoiceMutation) SetDisputeAt(t time.Time) {
+	m.dispute_at = &t
+}
+
+// DisputeAt returns the value of the "dispute_at" field in the mutation.
+func (m *InvoiceMutation) DisputeAt() (r time.Time, exists bool) {
+	v := m.dispute_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisputeAt returns the old "dispute_at" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldDisputeAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisputeAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisputeAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisputeAt: %w", err)
+	}
+	return oldValue.DisputeAt, nil
+}
+
+// ClearDisputeAt clears the value of the "dispute_at" field.
+func (m *InvoiceMutation) ClearDisputeAt() {
+	m.dispute_at = nil
+	m.clearedFields[invoice.FieldDisputeAt] = struct{}{}
+}
+
+// DisputeAtCleared returns if the "dispute_at" field was cleared in this mutation.
+func (m *InvoiceMutation) DisputeAtCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldDisputeAt]
+	return ok
+}
+
+// ResetDisputeAt resets all changes to the "dispute_at" field.
+func (m *InvoiceMutation) ResetDisputeAt() {
+	m.dispute_at = nil
+	delete(m.clearedFields, invoice.FieldDisputeAt)
+}
+
+// SetFeeAddress sets the "fee_address" field.
+func (m *InvoiceMutation) SetFeeAddress(s string) {
+	m.fee_address = &s
+}
+
+// FeeAddress returns the value of the "fee_address" field in the mutation.
+func (m *InvoiceMutation) FeeAddress() (r string, exists bool) {
+	v := m.fee_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeeAddress returns the old "fee_address" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldFeeAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeeAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeeAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeeAddress: %w", err)
+	}
+	return oldValue.FeeAddress, nil
+}
+
+// ClearFeeAddress clears the value of the "fee_address" field.
+func (m *InvoiceMutation) ClearFeeAddress() {
+	m.fee_address = nil
+	m.clearedFields[invoice.FieldFeeAddress] = struct{}{}
+}
+
+// FeeAddressCleared returns if the "fee_address" field was cleared in this mutation.
+func (m *InvoiceMutation) FeeAddressCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldFeeAddress]
+	return ok
+}
+
+// ResetFeeAddress resets all changes to the "fee_address" field.
+func (m *InvoiceMutation) ResetFeeAddress() {
+	m.fee_address = nil
+	delete(m.clearedFields, invoice.FieldFeeAddress)
+}
+
+// SetFeeBasisPoints sets the "fee_basis_points" field.
+func (m *InvoiceMutation) SetFeeBasisPoints(i int64) {
+	m.fee_basis_points = &i
+	m.addfee_basis_points = nil
+}
+
+// FeeBasisPoints returns the value of the "fee_basis_points" field in the mutation.
+func (m *InvoiceMutation) FeeBasisPoints() (r int64, exists bool) {
+	v := m.fee_basis_points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeeBasisPoints returns the old "fee_basis_points" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldFeeBasisPoints(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeeBasisPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeeBasisPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeeBasisPoints: %w", err)
+	}
+	return oldValue.FeeBasisPoints, nil
+}
+
+// AddFeeBasisPoints adds i to the "fee_basis_points" field.
+func (m *InvoiceMutation) AddFeeBasisPoints(i int64) {
+	if m.addfee_basis_points != nil {
+		*m.addfee_basis_points += i
+	} else {
+		m.addfee_basis_points = &i
+	}
+}
+
+// AddedFeeBasisPoints returns the value that was added to the "fee_basis_points" field in this mutation.
+func (m *InvoiceMutation) AddedFeeBasisPoints() (r int64, exists bool) {
+	v := m.addfee_basis_points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeeBasisPoints resets all changes to the "fee_basis_points" field.
+func (m *InvoiceMutation) ResetFeeBasisPoints() {
+	m.fee_basis_points = nil
+	m.addfee_basis_points = nil
+}
+
+// SetFeeFlat sets the "fee_flat" field.
+func (m *InvoiceMutation) SetFeeFlat(b *big.Int) {
+	m.fee_flat = &b
+}
+
+// FeeFlat returns the value of the "fee_flat" field in the mutation.
+func (m *InvoiceMutation) FeeFlat() (r *big.Int, exists bool) {
+	v := m.fee_flat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeeFlat returns the old "fee_flat" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldFeeFlat(ctx context.Context) (v *big.Int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeeFlat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeeFlat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeeFlat: %w", err)
+	}
+	return oldValue.FeeFlat, nil
+}
+
+// ClearFeeFlat clears the value of the "fee_flat" field.
+func (m *InvoiceMutation) ClearFeeFlat() {
+	m.fee_flat = nil
+	m.clearedFields[invoice.FieldFeeFlat] = struct{}{}
+}
+
+// FeeFlatCleared returns if the "fee_flat" field was cleared in this mutation.
+func (m *InvoiceMutation) FeeFlatCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldFeeFlat]
+	return ok
+}
+
+// ResetFeeFlat resets all changes to the "fee_flat" field.
+func (m *InvoiceMutation) ResetFeeFlat() {
+	m.fee_flat = nil
+	delete(m.clearedFields, invoice.FieldFeeFlat)
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (m *InvoiceMutation) SetFeeAmount(b *big.Int) {
+	m.fee_amount = &b
+}
+
+// FeeAmount returns the value of the "fee_amount" field in the mutation.
+func (m *InvoiceMutation) FeeAmount() (r *big.Int, exists bool) {
+	v := m.fee_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeeAmount returns the old "fee_amount" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldFeeAmount(ctx context.Context) (v *big.Int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeeAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeeAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeeAmount: %w", err)
+	}
+	return oldValue.FeeAmount, nil
+}
+
+// ClearFeeAmount clears the value of the "fee_amount" field.
+func (m *InvoiceMutation) ClearFeeAmount() {
+	m.fee_amount = nil
+	m.clearedFields[invoice.FieldFeeAmount] = struct{}{}
+}
+
+// FeeAmountCleared returns if the "fee_amount" field was cleared in this mutation.
+func (m *InvoiceMutation) FeeAmountCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldFeeAmount]
+	return ok
+}
+
+// ResetFeeAmount resets all changes to the "fee_amount" field.
+func (m *InvoiceMutation) ResetFeeAmount() {
+	m.fee_amount = nil
+	delete(m.clearedFields, invoice.FieldFeeAmount)
+}
+
+// SetFeeTxHash sets the "fee_tx_hash" field.
+func (m *InvoiceMutation) SetFeeTxHash(s string) {
+	m.fee_tx_hash = &s
+}
+
+// FeeTxHash returns the value of the "fee_tx_hash" field in the mutation.
+func (m *InvoiceMutation) FeeTxHash() (r string, exists bool) {
+	v := m.fee_tx_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeeTxHash returns the old "fee_tx_hash" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldFeeTxHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeeTxHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeeTxHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeeTxHash: %w", err)
+	}
+	return oldValue.FeeTxHash, nil
+}
+
+// ClearFeeTxHash clears the value of the "fee_tx_hash" field.
+func (m *InvoiceMutation) ClearFeeTxHash() {
+	m.fee_tx_hash = nil
+	m.clearedFields[invoice.FieldFeeTxHash] = struct{}{}
+}
+
+// FeeTxHashCleared returns if the "fee_tx_hash" field was cleared in this mutation.
+func (m *InvoiceMutation) FeeTxHashCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldFeeTxHash]
+	return ok
+}
+
+// ResetFeeTxHash resets all changes to the "fee_tx_hash" field.
+func (m *InvoiceMutation) ResetFeeTxHash() {
+	m.fee_tx_hash = nil
+	delete(m.clearedFields, invoice.FieldFeeTxHash)
+}
+
+// SetSplit sets the "split" field.
+func (m *InvoiceMutation) SetSplit(ss []schema.SplitShare) {
+	m.split = &ss
+	m.appendsplit = nil
+}
+
+// Split returns the value of the "split" field in the mutation.
+func (m *InvoiceMutation) Split() (r []schema.SplitShare, exists bool) {
+	v := m.split
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSplit returns the old "split" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldSplit(ctx context.Context) (v []schema.SplitShare, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSplit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSplit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSplit: %w", err)
+	}
+	return oldValue.Split, nil
+}
+
+// AppendSplit adds ss to the "split" field.
+func (m *InvoiceMutation) AppendSplit(ss []schema.SplitShare) {
+	m.appendsplit = append(m.appendsplit, ss...)
+}
+
+// AppendedSplit returns the list of values that were appended to the "split" field in this mutation.
+func (m *InvoiceMutation) AppendedSplit() ([]schema.SplitShare, bool) {
+	if len(m.appendsplit) == 0 {
+		return nil, false
+	}
+	return m.appendsplit, true
+}
+
+// ClearSplit clears the value of the "split" field.
+func (m *InvoiceMutation) ClearSplit() {
+	m.split = nil
+	m.appendsplit = nil
+	m.clearedFields[invoice.FieldSplit] = struct{}{}
+}
+
+// SplitCleared returns if the "split" field was cleared in this mutation.
+func (m *InvoiceMutation) SplitCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldSplit]
+	return ok
+}
+
+// ResetSplit resets all changes to the "split" field.
+func (m *InvoiceMutation) ResetSplit() {
+	m.split = nil
+	m.appendsplit = nil
+	delete(m.clearedFields, invoice.FieldSplit)
+}
+
+// SetSplitGasRule sets the "split_gas_rule" field.
+func (m *InvoiceMutation) SetSplitGasRule(s string) {
+	m.split_gas_rule = &s
+}
+
+// SplitGasRule returns the value of the "split_gas_rule" field in the mutation.
+func (m *InvoiceMutation) SplitGasRule() (r string, exists bool) {
+	v := m.split_gas_rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSplitGasRule returns the old "split_gas_rule" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldSplitGasRule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSplitGasRule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSplitGasRule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSplitGasRule: %w", err)
+	}
+	return oldValue.SplitGasRule, nil
+}
+
+// ClearSplitGasRule clears the value of the "split_gas_rule" field.
+func (m *InvoiceMutation) ClearSplitGasRule() {
+	m.split_gas_rule = nil
+	m.clearedFields[invoice.FieldSplitGasRule] = struct{}{}
+}
+
+// SplitGasRuleCleared returns if the "split_gas_rule" field was cleared in this mutation.
+func (m *InvoiceMutation) SplitGasRuleCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldSplitGasRule]
+	return ok
+}
+
+// ResetSplitGasRule resets all changes to the "split_gas_rule" field.
+func (m *InvoiceMutation) ResetSplitGasRule() {
+	m.split_gas_rule = nil
+	delete(m.clearedFields, invoice.FieldSplitGasRule)
+}
+
+// SetTreasuryAddress sets the "treasury_address" field.
+func (m *InvoiceMutation) SetTreasuryAddress(s string) {
+	m.treasury_address = &s
+}
+
+// TreasuryAddress returns the value of the "treasury_address" field in the mutation.
+func (m *InvoiceMutation) TreasuryAddress() (r string, exists bool) {
+	v := m.treasury_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTreasuryAddress returns the old "treasury_address" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldTreasuryAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTreasuryAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTreasuryAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTreasuryAddress: %w", err)
+	}
+	return oldValue.TreasuryAddress, nil
+}
+
+// ClearTreasuryAddress clears the value of the "treasury_address" field.
+func (m *InvoiceMutation) ClearTreasuryAddress() {
+	m.treasury_address = nil
+	m.clearedFields[invoice.FieldTreasuryAddress] = struct{}{}
+}
+
+// TreasuryAddressCleared returns if the "treasury_address" field was cleared in this mutation.
+func (m *InvoiceMutation) TreasuryAddressCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldTreasuryAddress]
+	return ok
+}
+
+// ResetTreasuryAddress resets all changes to the "treasury_address" field.
+func (m *InvoiceMutation) ResetTreasuryAddress() {
+	m.treasury_address = nil
+	delete(m.clearedFields, invoice.FieldTreasuryAddress)
+}
+
+// SetSignerMAC sets the "signer_mac" field.
+func (m *InvoiceMutation) SetSignerMAC(b []byte) {
+	m.signer_mac = &b
+}
+
+// SignerMAC returns the value of the "signer_mac" field in the mutation.
+func (m *InvoiceMutation) SignerMAC() (r []byte, exists bool) {
+	v := m.signer_mac
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignerMAC returns the old "signer_mac" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldSignerMAC(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignerMAC is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignerMAC requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignerMAC: %w", err)
+	}
+	return oldValue.SignerMAC, nil
+}
+
+// ClearSignerMAC clears the value of the "signer_mac" field.
+func (m *InvoiceMutation) ClearSignerMAC() {
+	m.signer_mac = nil
+	m.clearedFields[invoice.FieldSignerMAC] = struct{}{}
+}
+
+// SignerMACCleared returns if the "signer_mac" field was cleared in this mutation.
+func (m *InvoiceMutation) SignerMACCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldSignerMAC]
+	return ok
+}
+
+// ResetSignerMAC resets all changes to the "signer_mac" field.
+func (m *InvoiceMutation) ResetSignerMAC() {
+	m.signer_mac = nil
+	delete(m.clearedFields, invoice.FieldSignerMAC)
+}
+
+// Where appends a list predicates to the InvoiceMutation builder.
+func (m *InvoiceMutation) Where(ps ...predicate.Invoice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InvoiceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InvoiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invoice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InvoiceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InvoiceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Invoice).
+func (m *InvoiceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvoiceMutation) Fields() []string {
+	fields := make([]string, 0, 32)
+	if m.min_amount != nil {
+		fields = append(fields, invoice.FieldMinAmount)
+	}
+	if m.recipient != nil {
+		fields = append(fields, invoice.FieldRecipient)
+	}
+	if m.beneficiary != nil {
+		fields = append(fields, invoice.FieldBeneficiary)
+	}
+	if m.asset != nil {
+		fields = append(fields, invoice.FieldAsset)
+	}
+	if m.metadata != nil {
+		fields = append(fields, invoice.FieldMetadata)
+	}
+	if m.create_at != nil {
+		fields = append(fields, invoice.FieldCreateAt)
+	}
+	if m.deadline != nil {
+		fields = append(fields, invoice.FieldDeadline)
+	}
+	if m.fill_at != nil {
+		fields = append(fields, invoice.FieldFillAt)
+	}
+	if m.last_checkout_at != nil {
+		fields = append(fields, invoice.FieldLastCheckoutAt)
+	}
+	if m.checkout_request_at != nil {
+		fields = append(fields, invoice.FieldCheckoutRequestAt)
+	}
+	if m.auto_checkout != nil {
+		fields = append(fields, invoice.FieldAutoCheckout)
+	}
+	if m.cancel_at != nil {
+		fields = append(fields, invoice.FieldCancelAt)
+	}
+	if m.wallet_address != nil {
+		fields = append(fields, invoice.FieldWalletAddress)
+	}
+	if m.encrypted_salt != nil {
+		fields = append(fields, invoice.FieldEncryptedSalt)
+	}
+	if m.derivation_version != nil {
+		fields = append(fields, invoice.FieldDerivationVersion)
+	}
+	if m.wallet_index != nil {
+		fields = append(fields, invoice.FieldWalletIndex)
+	}
+	if m.approval_request_at != nil {
+		fields = append(fields, invoice.FieldApprovalRequestAt)
+	}
+	if m.approve_at != nil {
+		fields = append(fields, invoice.FieldApproveAt)
+	}
+	if m.escrow_period != nil {
+		fields = append(fields, invoice.FieldEscrowPeriod)
+	}
+	if m.buyer_key_hash != nil {
+		fields = append(fields, invoice.FieldBuyerKeyHash)
+	}
+	if m.release_at != nil {
+		fields = append(fields, invoice.FieldReleaseAt)
+	}
+	if m.refund_at != nil {
+		fields = append(fields, invoice.FieldRefundAt)
+	}
+	if m.dispute_at != nil {
+		fields = append(fields, invoice.FieldDisputeAt)
+	}
+	if m.fee_address != nil {
+		fields = append(fields, invoice.FieldFeeAddress)
+	}
+	if m.fee_basis_points != nil {
+		fields = append(fields, invoice.FieldFeeBasisPoints)
+	}
+	if m.fee_flat != nil {
+		fields = append(fields, invoice.FieldFeeFlat)
+	}
+	if m.fee_amount != nil {
+		fields = append(fields, invoice.FieldFeeAmount)
+	}
+	if m.fee_tx_hash != nil {
+		fields = append(fields, invoice.FieldFeeTxHash)
+	}
+	if m.split != nil {
+		fields = append(fields, invoice.FieldSplit)
+	}
+	if m.split_gas_rule != nil {
+		fields = append(fields, invoice.FieldSplitGasRule)
+	}
+	if m.treasury_address != nil {
+		fields = append(fields, invoice.FieldTreasuryAddress)
+	}
+	if m.signer_mac != nil {
+		fields = append(fields, invoice.FieldSignerMAC)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InvoiceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldMinAmount:
+		return m.MinAmount()
+	case invoice.FieldRecipient:
+		return m.Recipient()
+	case invoice.FieldBeneficiary:
+		return m.Beneficiary()
+	case invoice.FieldAsset:
+		return m.Asset()
+	case invoice.FieldMetadata:
+		return m.Metadata()
+	case invoice.FieldCreateAt:
+		return m.CreateAt()
+	case invoice.FieldDeadline:
+		return m.Deadline()
+	case invoice.FieldFillAt:
+		return m.FillAt()
+	case invoice.FieldLastCheckoutAt:
+		return m.LastCheckoutAt()
+	case invoice.FieldCheckoutRequestAt:
+		return m.CheckoutRequestAt()
+	case invoice.FieldAutoCheckout:
+		return m.AutoCheckout()
+	case invoice.FieldCancelAt:
+		return m.CancelAt()
+	case invoice.FieldWalletAddress:
+		return m.WalletAddress()
+	case invoice.FieldEncryptedSalt:
+		return m.EncryptedSalt()
+	case invoice.FieldDerivationVersion:
+		return m.DerivationVersion()
+	case invoice.FieldWalletIndex:
+		return m.WalletIndex()
+	case invoice.FieldApprovalRequestAt:
+		return m.ApprovalRequestAt()
+	case invoice.FieldApproveAt:
+		return m.ApproveAt()
+	case invoice.FieldEscrowPeriod:
+		return m.EscrowPeriod()
+	case invoice.FieldBuyerKeyHash:
+		return m.BuyerKeyHash()
+	case invoice.FieldReleaseAt:
+		return m.ReleaseAt()
+	case invoice.FieldRefundAt:
+		return m.RefundAt()
+	case invoice.FieldDisputeAt:
+		return m.DisputeAt()
+	case invoice.FieldFeeAddress:
+		return m.FeeAddress()
+	case invoice.FieldFeeBasisPoints:
+		return m.FeeBasisPoints()
+	case invoice.FieldFeeFlat:
+		return m.FeeFlat()
+	case invoice.FieldFeeAmount:
+		return m.FeeAmount()
+	case invoice.FieldFeeTxHash:
+		return m.FeeTxHash()
+	case invoice.FieldSplit:
+		return m.Split()
+	case invoice.FieldSplitGasRule:
+		return m.SplitGasRule()
+	case invoice.FieldTreasuryAddress:
+		return m.TreasuryAddress()
+	case invoice.FieldSignerMAC:
+		return m.SignerMAC()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InvoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invoice.FieldMinAmount:
+		return m.OldMinAmount(ctx)
+	case invoice.FieldRecipient:
+		return m.OldRecipient(ctx)
+	case invoice.FieldBeneficiary:
+		return m.OldBeneficiary(ctx)
+	case invoice.FieldAsset:
+		return m.OldAsset(ctx)
+	case invoice.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case invoice.FieldCreateAt:
+		return m.OldCreateAt(ctx)
+	case invoice.FieldDeadline:
+		return m.OldDeadline(ctx)
+	case invoice.FieldFillAt:
+		return m.OldFillAt(ctx)
+	case invoice.FieldLastCheckoutAt:
+		return m.OldLastCheckoutAt(ctx)
+	case invoice.FieldCheckoutRequestAt:
+		return m.OldCheckoutRequestAt(ctx)
+	case invoice.FieldAutoCheckout:
+		return m.OldAutoCheckout(ctx)
+	case invoice.FieldCancelAt:
+		return m.OldCancelAt(ctx)
+	case invoice.FieldWalletAddress:
+		return m.OldWalletAddress(ctx)
+	case invoice.FieldEncryptedSalt:
+		return m.OldEncryptedSalt(ctx)
+	case invoice.FieldDerivationVersion:
+		return m.OldDerivationVersion(ctx)
+	case invoice.FieldWalletIndex:
+		return m.OldWalletIndex(ctx)
+	case invoice.FieldApprovalRequestAt:
+		return m.OldApprovalRequestAt(ctx)
+	case invoice.FieldApproveAt:
+		return m.OldApproveAt(ctx)
+	case invoice.FieldEscrowPeriod:
+		return m.OldEscrowPeriod(ctx)
+	case invoice.FieldBuyerKeyHash:
+		return m.OldBuyerKeyHash(ctx)
+	case invoice.FieldReleaseAt:
+		return m.OldReleaseAt(ctx)
+	case invoice.FieldRefundAt:
+		return m.OldRefundAt(ctx)
+	case invoice.FieldDisputeAt:
+		return m.OldDisputeAt(ctx)
+	case invoice.FieldFeeAddress:
+		return m.OldFeeAddress(ctx)
+	case invoice.FieldFeeBasisPoints:
+		return m.OldFeeBasisPoints(ctx)
+	case invoice.FieldFeeFlat:
+		return m.OldFeeFlat(ctx)
+	case invoice.FieldFeeAmount:
+		return m.OldFeeAmount(ctx)
+	case invoice.FieldFeeTxHash:
+		return m.OldFeeTxHash(ctx)
+	case invoice.FieldSplit:
+		return m.OldSplit(ctx)
+	case invoice.FieldSplitGasRule:
+		return m.OldSplitGasRule(ctx)
+	case invoice.FieldTreasuryAddress:
+		return m.OldTreasuryAddress(ctx)
+	case invoice.FieldSignerMAC:
+		return m.OldSignerMAC(ctx)
+	}
+	return nil, fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldMinAmount:
+		v, ok := value.(*big.Int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinAmount(v)
+		return nil
+	case invoice.FieldRecipient:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecipient(v)
+		return nil
+	case invoice.FieldBeneficiary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBeneficiary(v)
+		return nil
+	case invoice.FieldAsset:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAsset(v)
+		return nil
+	case invoice.FieldMetadata:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case invoice.FieldCreateAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateAt(v)
+		return nil
+	case invoice.FieldDeadline:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadline(v)
+		return nil
+	case invoice.FieldFillAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFillAt(v)
+		return nil
+	case invoice.FieldLastCheckoutAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastCheckoutAt(v)
+		return nil
+	case invoice.FieldCheckoutRequestAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckoutRequestAt(v)
+		return nil
+	case invoice.FieldAutoCheckout:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoCheckout(v)
+		return nil
+	case invoice.FieldCancelAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelAt(v)
+		return nil
+	case invoice.FieldWalletAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletAddress(v)
+		return nil
+	case invoice.FieldEncryptedSalt:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedSalt(v)
+		return nil
+	case invoice.FieldDerivationVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDerivationVersion(v)
+		return nil
+	case invoice.FieldWalletIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletIndex(v)
+		return nil
+	case invoice.FieldApprovalRequestAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovalRequestAt(v)
+		return nil
+	case invoice.FieldApproveAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApproveAt(v)
+		return nil
+	case invoice.FieldEscrowPeriod:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEscrowPeriod(v)
+		return nil
+	case invoice.FieldBuyerKeyHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerKeyHash(v)
+		return nil
+	case invoice.FieldReleaseAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleaseAt(v)
+		return nil
+	case invoice.FieldRefundAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefundAt(v)
+		return nil
+	case invoice.FieldDisputeAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisputeAt(v)
+		return nil
+	case invoice.FieldFeeAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeeAddress(v)
+		return nil
+	case invoice.FieldFeeBasisPoints:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeeBasisPoints(v)
+		return nil
+	case invoice.FieldFeeFlat:
+		v, ok := value.(*big.Int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeeFlat(v)
+		return nil
+	case invoice.FieldFeeAmount:
+		v, ok := value.(*big.Int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeeAmount(v)
+		return nil
+	case invoice.FieldFeeTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeeTxHash(v)
+		return nil
+	case invoice.FieldSplit:
+		v, ok := value.([]schema.SplitShare)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSplit(v)
+		return nil
+	case invoice.FieldSplitGasRule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSplitGasRule(v)
+		return nil
+	case invoice.FieldTreasuryAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTreasuryAddress(v)
+		return nil
+	case invoice.FieldSignerMAC:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignerMAC(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InvoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addderivation_version != nil {
+		fields = append(fields, invoice.FieldDerivationVersion)
+	}
+	if m.addwallet_index != nil {
+		fields = append(fields, invoice.FieldWalletIndex)
+	}
+	if m.addescrow_period != nil {
+		fields = append(fields, invoice.FieldEscrowPeriod)
+	}
+	if m.addfee_basis_points != nil {
+		fields = append(fields, invoice.FieldFeeBasisPoints)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InvoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldDerivationVersion:
+		return m.AddedDerivationVersion()
+	case invoice.FieldWalletIndex:
+		return m.AddedWalletIndex()
+	case invoice.FieldEscrowPeriod:
+		return m.AddedEscrowPeriod()
+	case invoice.FieldFeeBasisPoints:
+		return m.AddedFeeBasisPoints()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldDerivationVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDerivationVersion(v)
+		return nil
+	case invoice.FieldWalletIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWalletIndex(v)
+		return nil
+	case invoice.FieldEscrowPeriod:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEscrowPeriod(v)
+		return nil
+	case invoice.FieldFeeBasisPoints:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeeBasisPoints(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InvoiceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(invoice.FieldFillAt) {
+		fields = append(fields, invoice.FieldFillAt)
+	}
+	if m.FieldCleared(invoice.FieldLastCheckoutAt) {
+		fields = append(fields, invoice.FieldLastCheckoutAt)
+	}
+	if m.FieldCleared(invoice.FieldCheckoutRequestAt) {
+		fields = append(fields, invoice.FieldCheckoutRequestAt)
+	}
+	if m.FieldCleared(invoice.FieldCancelAt) {
+		fields = append(fields, invoice.FieldCancelAt)
+	}
+	if m.FieldCleared(invoice.FieldEncryptedSalt) {
+		fields = append(fields, invoice.FieldEncryptedSalt)
+	}
+	if m.FieldCleared(invoice.FieldWalletIndex) {
+		fields = append(fields, invoice.FieldWalletIndex)
+	}
+	if m.FieldCleared(invoice.FieldApprovalRequestAt) {
+		fields = append(fields, invoice.FieldApprovalRequestAt)
+	}
+	if m.FieldCleared(invoice.FieldApproveAt) {
+		fields = append(fields, invoice.FieldApproveAt)
+	}
+	if m.FieldCleared(invoice.FieldBuyerKeyHash) {
+		fields = append(fields, invoice.FieldBuyerKeyHash)
+	}
+	if m.FieldCleared(invoice.FieldReleaseAt) {
+		fields = append(fields, invoice.FieldReleaseAt)
+	}
+	if m.FieldCleared(invoice.FieldRefundAt) {
+		fields = append(fields, invoice.FieldRefundAt)
+	}
+	if m.FieldCleared(invoice.FieldDisputeAt) {
+		fields = append(fields, invoice.FieldDisputeAt)
+	}
+	if m.FieldCleared(invoice.FieldFeeAddress) {
+		fields = append(fields, invoice.FieldFeeAddress)
+	}
+	if m.FieldCleared(invoice.FieldFeeFlat) {
+		fields = append(fields, invoice.FieldFeeFlat)
+	}
+	if m.FieldCleared(invoice.FieldFeeAmount) {
+		fields = append(fields, invoice.FieldFeeAmount)
+	}
+	if m.FieldCleared(invoice.FieldFeeTxHash) {
+		fields = append(fields, invoice.FieldFeeTxHash)
+	}
+	if m.FieldCleared(invoice.FieldSplit) {
+		fields = append(fields, invoice.FieldSplit)
+	}
+	if m.FieldCleared(invoice.FieldSplitGasRule) {
+		fields = append(fields, invoice.FieldSplitGasRule)
+	}
+	if m.FieldCleared(invoice.FieldTreasuryAddress) {
+		fields = append(fields, invoice.FieldTreasuryAddress)
+	}
+	if m.FieldCleared(invoice.FieldSignerMAC) {
+		fields = append(fields, invoice.FieldSignerMAC)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InvoiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvoiceMutation) ClearField(name string) error {
+	switch name {
+	case invoice.FieldFillAt:
+		m.ClearFillAt()
+		return nil
+	case invoice.FieldLastCheckoutAt:
+		m.ClearLastCheckoutAt()
+		return nil
+	case invoice.FieldCheckoutRequestAt:
+		m.ClearCheckoutRequestAt()
+		return nil
+	case invoice.FieldCancelAt:
+		m.ClearCancelAt()
+		return nil
+	case invoice.FieldEncryptedSalt:
+		m.ClearEncryptedSalt()
+		return nil
+	case invoice.FieldWalletIndex:
+		m.ClearWalletIndex()
+		return nil
+	case invoice.FieldApprovalRequestAt:
+		m.ClearApprovalRequestAt()
+		return nil
+	case invoice.FieldApproveAt:
+		m.ClearApproveAt()
+		return nil
+	case invoice.FieldBuyerKeyHash:
+		m.ClearBuyerKeyHash()
+		return nil
+	case invoice.FieldReleaseAt:
+		m.ClearReleaseAt()
+		return nil
+	case invoice.FieldRefundAt:
+		m.ClearRefundAt()
+		return nil
+	case invoice.FieldDisputeAt:
+		m.ClearDisputeAt()
+		return nil
+	case invoice.FieldFeeAddress:
+		m.ClearFeeAddress()
+		return nil
+	case invoice.FieldFeeFlat:
+		m.ClearFeeFlat()
+		return nil
+	case invoice.FieldFeeAmount:
+		m.ClearFeeAmount()
+		return nil
+	case invoice.FieldFeeTxHash:
+		m.ClearFeeTxHash()
+		return nil
+	case invoice.FieldSplit:
+		m.ClearSplit()
+		return nil
+	case invoice.FieldSplitGasRule:
+		m.ClearSplitGasRule()
+		return nil
+	case invoice.FieldTreasuryAddress:
+		m.ClearTreasuryAddress()
+		return nil
+	case invoice.FieldSignerMAC:
+		m.ClearSignerMAC()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InvoiceMutation) ResetField(name string) error {
+	switch name {
+	case invoice.FieldMinAmount:
+		m.ResetMinAmount()
+		return nil
+	case invoice.FieldRecipient:
+		m.ResetRecipient()
+		return nil
+	case invoice.FieldBeneficiary:
+		m.ResetBeneficiary()
+		return nil
+	case invoice.FieldAsset:
+		m.ResetAsset()
+		return nil
+	case invoice.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case invoice.FieldCreateAt:
+		m.ResetCreateAt()
+		return nil
+	case invoice.FieldDeadline:
+		m.ResetDeadline()
+		return nil
+	case invoice.FieldFillAt:
+		m.ResetFillAt()
+		return nil
+	case invoice.FieldLastCheckoutAt:
+		m.ResetLastCheckoutAt()
+		return nil
+	case invoice.FieldCheckoutRequestAt:
+		m.ResetCheckoutRequestAt()
+		return nil
+	case invoice.FieldAutoCheckout:
+		m.ResetAutoCheckout()
+		return nil
+	case invoice.FieldCancelAt:
+		m.ResetCancelAt()
+		return nil
+	case invoice.FieldWalletAddress:
+		m.ResetWalletAddress()
+		return nil
+	case invoice.FieldEncryptedSalt:
+		m.ResetEncryptedSalt()
+		return nil
+	case invoice.FieldDerivationVersion:
+		m.ResetDerivationVersion()
+		return nil
+	case invoice.FieldWalletIndex:
+		m.ResetWalletIndex()
+		return nil
+	case invoice.FieldApprovalRequestAt:
+		m.ResetApprovalRequestAt()
+		return nil
+	case invoice.FieldApproveAt:
+		m.ResetApproveAt()
+		return nil
+	case invoice.FieldEscrowPeriod:
+		m.ResetEscrowPeriod()
+		return nil
+	case invoice.FieldBuyerKeyHash:
+		m.ResetBuyerKeyHash()
+		return nil
+	case invoice.FieldReleaseAt:
+		m.ResetReleaseAt()
+		return nil
+	case invoice.FieldRefundAt:
+		m.ResetRefundAt()
+		return nil
+	case invoice.FieldDisputeAt:
+		m.ResetDisputeAt()
+		return nil
+	case invoice.FieldFeeAddress:
+		m.ResetFeeAddress()
+		return nil
+	case invoice.FieldFeeBasisPoints:
+		m.ResetFeeBasisPoints()
+		return nil
+	case invoice.FieldFeeFlat:
+		m.ResetFeeFlat()
+		return nil
+	case invoice.FieldFeeAmount:
+		m.ResetFeeAmount()
+		return nil
+	case invoice.FieldFeeTxHash:
+		m.ResetFeeTxHash()
+		return nil
+	case invoice.FieldSplit:
+		m.ResetSplit()
+		return nil
+	case invoice.FieldSplitGasRule:
+		m.ResetSplitGasRule()
+		return nil
+	case invoice.FieldTreasuryAddress:
+		m.ResetTreasuryAddress()
+		return nil
+	case invoice.FieldSignerMAC:
+		m.ResetSignerMAC()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InvoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InvoiceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InvoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InvoiceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InvoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InvoiceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InvoiceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Invoice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InvoiceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Invoice edge %s", name)
+}
+
+// InvoiceEventMutation represents an operation that mutates the InvoiceEvent nodes in the graph.
+type InvoiceEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	invoice_id    *string
+	kind          *string
+	asset         *string
+	tx_hash       *string
+	detail        *map[string]string
+	create_at     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*InvoiceEvent, error)
+	predicates    []predicate.InvoiceEvent
+}
+
+var _ ent.Mutation = (*InvoiceEventMutation)(nil)
+
+// invoiceeventOption allows management of the mutation configuration using functional options.
+type invoiceeventOption func(*InvoiceEventMutation)
+
+// newInvoiceEventMutation creates new mutation for the InvoiceEvent entity.
+func newInvoiceEventMutation(c config, op Op, opts ...invoiceeventOption) *InvoiceEventMutation {
+	m := &InvoiceEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvoiceEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInvoiceEventID sets the ID field of the mutation.
+func withInvoiceEventID(id int) invoiceeventOption {
+	return func(m *InvoiceEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InvoiceEvent
+		)
+		m.oldValue = func(ctx context.Context) (*InvoiceEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InvoiceEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInvoiceEvent sets the old InvoiceEvent of the mutation.
+func withInvoiceEvent(node *InvoiceEvent) invoiceeventOption {
+	return func(m *InvoiceEventMutation) {
+		m.oldValue = func(context.Context) (*InvoiceEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InvoiceEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InvoiceEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("database: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InvoiceEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InvoiceEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InvoiceEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (m *InvoiceEventMutation) SetInvoiceID(s string) {
+	m.invoice_id = &s
+}
+
+// InvoiceID returns the value of the "invoice_id" field in the mutation.
+func (m *InvoiceEventMutation) InvoiceID() (r string, exists bool) {
+	v := m.invoice_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvoiceID returns the old "invoice_id" field's value of the InvoiceEvent entity.
+// If the InvoiceEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceEventMutation) OldInvoiceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvoiceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvoiceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvoiceID: %w", err)
+	}
+	return oldValue.InvoiceID, nil
+}
+
+// ResetInvoiceID resets all changes to the "invoice_id" field.
+func (m *InvoiceEventMutation) ResetInvoiceID() {
+	m.invoice_id = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *InvoiceEventMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *InvoiceEventMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the InvoiceEvent entity.
+// If the InvoiceEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceEventMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *InvoiceEventMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetAsset sets the "asset" field.
+func (m *InvoiceEventMutation) SetAsset(s string) {
+	m.asset = &s
+}
+
+// Asset returns the value of the "asset" field in the mutation.
+func (m *InvoiceEventMutation) Asset() (r string, exists bool) {
+	v := m.asset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAsset returns the old "asset" field's value of the InvoiceEvent entity.
+// If the InvoiceEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceEventMutation) OldAsset(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAsset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAsset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAsset: %w", err)
+	}
+	return oldValue.Asset, nil
+}
+
+// ClearAsset clears the value of the "asset" field.
+func (m *InvoiceEventMutation) ClearAsset() {
+	m.asset = nil
+	m.clearedFields[invoiceevent.FieldAsset] = struct{}{}
+}
+
+// AssetCleared returns if the "asset" field was cleared in this mutation.
+func (m *InvoiceEventMutation) AssetCleared() bool {
+	_, ok := m.clearedFields[invoiceevent.FieldAsset]
+	return ok
+}
+
+// ResetAsset resets all changes to the "asset" field.
+func (m *InvoiceEventMutation) ResetAsset() {
+	m.asset = nil
+	delete(m.clearedFields, invoiceevent.FieldAsset)
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (m *InvoiceEventMutation) SetTxHash(s string) {
+	m.tx_hash = &s
+}
+
+// TxHash returns the value of the "tx_hash" field in the mutation.
+func (m *InvoiceEventMutation) TxHash() (r string, exists bool) {
+	v := m.tx_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxHash returns the old "tx_hash" field's value of the InvoiceEvent entity.
+// If the InvoiceEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceEventMutation) OldTxHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxHash: %w", err)
+	}
+	return oldValue.TxHash, nil
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (m *InvoiceEventMutation) ClearTxHash() {
+	m.tx_hash = nil
+	m.clearedFields[invoiceevent.FieldTxHash] = struct{}{}
+}
+
+// TxHashCleared returns if the "tx_hash" field was cleared in this mutation.
+func (m *InvoiceEventMutation) TxHashCleared() bool {
+	_, ok := m.clearedFields[invoiceevent.FieldTxHash]
+	return ok
+}
+
+// ResetTxHash resets all changes to the "tx_hash" field.
+func (m *InvoiceEventMutation) ResetTxHash() {
+	m.tx_hash = nil
+	delete(m.clearedFields, invoiceevent.FieldTxHash)
+}
+
+// SetDetail sets the "detail" field.
+func (m *InvoiceEventMutation) SetDetail(value map[string]string) {
+	m.detail = &value
+}
+
+// Detail returns the value of the "detail" field in the mutation.
+func (m *InvoiceEventMutation) Detail() (r map[string]string, exists bool) {
+	v := m.detail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetail returns the old "detail" field's value of the InvoiceEvent entity.
+// If the InvoiceEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceEventMutation) OldDetail(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetail: %w", err)
+	}
+	return oldValue.Detail, nil
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (m *InvoiceEventMutation) ClearDetail() {
+	m.detail = nil
+	m.clearedFields[invoiceevent.FieldDetail] = struct{}{}
+}
+
+// DetailCleared returns if the "detail" field was cleared in this mutation.
+func (m *InvoiceEventMutation) DetailCleared() bool {
+	_, ok := m.clearedFields[invoiceevent.FieldDetail]
+	return ok
+}
+
+// ResetDetail resets all changes to the "detail" field.
+func (m *InvoiceEventMutation) ResetDetail() {
+	m.detail = nil
+	delete(m.clearedFields, invoiceevent.FieldDetail)
+}
+
+// SetCreateAt sets the "create_at" field.
+func (m *InvoiceEventMutation) SetCreateAt(t time.Time) {
+	m.create_at = &t
+}
+
+// CreateAt returns the value of the "create_at" field in the mutation.
+func (m *InvoiceEventMutation) CreateAt() (r time.Time, exists bool) {
+	v := m.create_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateAt returns the old "create_at" field's value of the InvoiceEvent entity.
+// If the InvoiceEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceEventMutation) OldCreateAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateAt: %w", err)
+	}
+	return oldValue.CreateAt, nil
+}
+
+// ResetCreateAt resets all changes to the "create_at" field.
+func (m *InvoiceEventMutation) ResetCreateAt() {
+	m.create_at = nil
+}
+
+// Where appends a list predicates to the InvoiceEventMutation builder.
+func (m *InvoiceEventMutation) Where(ps ...predicate.InvoiceEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InvoiceEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InvoiceEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InvoiceEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InvoiceEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InvoiceEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (InvoiceEvent).
+func (m *InvoiceEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvoiceEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.invoice_id != nil {
+		fields = append(fields, invoiceevent.FieldInvoiceID)
+	}
+	if m.kind != nil {
+		fields = append(fields, invoiceevent.FieldKind)
+	}
+	if m.asset != nil {
+		fields = append(fields, invoiceevent.FieldAsset)
+	}
+	if m.tx_hash != nil {
+		fields = append(fields, invoiceevent.FieldTxHash)
+	}
+	if m.detail != nil {
+		fields = append(fields, invoiceevent.FieldDetail)
+	}
+	if m.create_at != nil {
+		fields = append(fields, invoiceevent.FieldCreateAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InvoiceEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invoiceevent.FieldInvoiceID:
+		return m.InvoiceID()
+	case invoiceevent.FieldKind:
+		return m.Kind()
+	case invoiceevent.FieldAsset:
+		return m.Asset()
+	case invoiceevent.FieldTxHash:
+		return m.TxHash()
+	case invoiceevent.FieldDetail:
+		return m.Detail()
+	case invoiceevent.FieldCreateAt:
+		return m.CreateAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InvoiceEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invoiceevent.FieldInvoiceID:
+		return m.OldInvoiceID(ctx)
+	case invoiceevent.FieldKind:
+		return m.OldKind(ctx)
+	case invoiceevent.FieldAsset:
+		return m.OldAsset(ctx)
+	case invoiceevent.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case invoiceevent.FieldDetail:
+		return m.OldDetail(ctx)
+	case invoiceevent.FieldCreateAt:
+		return m.OldCreateAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown InvoiceEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invoiceevent.FieldInvoiceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvoiceID(v)
+		return nil
+	case invoiceevent.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case invoiceevent.FieldAsset:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAsset(v)
+		return nil
+	case invoiceevent.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case invoiceevent.FieldDetail:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetail(v)
+		return nil
+	case invoiceevent.FieldCreateAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InvoiceEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InvoiceEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InvoiceEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown InvoiceEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InvoiceEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(invoiceevent.FieldAsset) {
+		fields = append(fields, invoiceevent.FieldAsset)
+	}
+	if m.FieldCleared(invoiceevent.FieldTxHash) {
+		fields = append(fields, invoiceevent.FieldTxHash)
+	}
+	if m.FieldCleared(invoiceevent.FieldDetail) {
+		fields = append(fields, invoiceevent.FieldDetail)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InvoiceEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvoiceEventMutation) ClearField(name string) error {
+	switch name {
+	case invoiceevent.FieldAsset:
+		m.ClearAsset()
+		return nil
+	case invoiceevent.FieldTxHash:
+		m.ClearTxHash()
+		return nil
+	case invoiceevent.FieldDetail:
+		m.ClearDetail()
+		return nil
+	}
+	return fmt.Errorf("unknown InvoiceEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InvoiceEventMutation) ResetField(name string) error {
+	switch name {
+	case invoiceevent.FieldInvoiceID:
+		m.ResetInvoiceID()
+		return nil
+	case invoiceevent.FieldKind:
+		m.ResetKind()
+		return nil
+	case invoiceevent.FieldAsset:
+		m.ResetAsset()
+		return nil
+	case invoiceevent.FieldTxHash:
+		m.ResetTxHash()
+		return nil
+	case invoiceevent.FieldDetail:
+		m.ResetDetail()
+		return nil
+	case invoiceevent.FieldCreateAt:
+		m.ResetCreateAt()
+		return nil
+	}
+	return fmt.Errorf("unknown InvoiceEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InvoiceEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InvoiceEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InvoiceEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InvoiceEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InvoiceEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InvoiceEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InvoiceEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown InvoiceEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InvoiceEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown InvoiceEvent edge %s", name)
+}
+
+// LedgerAccountMutation represents an operation that mutates the LedgerAccount nodes in the graph.
+type LedgerAccountMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	asset         *string
+	name          *string
+	kind          *string
+	create_at     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LedgerAccount, error)
+	predicates    []predicate.LedgerAccount
+}
+
+var _ ent.Mutation = (*LedgerAccountMutation)(nil)
+
+// ledgeraccountOption allows management of the mutation configuration using functional options.
+type ledgeraccountOption func(*LedgerAccountMutation)
+
+// newLedgerAccountMutation creates new mutation for the LedgerAccount entity.
+func newLedgerAccountMutation(c config, op Op, opts ...ledgeraccountOption) *LedgerAccountMutation {
+	m := &LedgerAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLedgerAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLedgerAccountID sets the ID field of the mutation.
+func withLedgerAccountID(id int) ledgeraccountOption {
+	return func(m *LedgerAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LedgerAccount
+		)
+		m.oldValue = func(ctx context.Context) (*LedgerAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LedgerAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLedgerAccount sets the old LedgerAccount of the mutation.
+func withLedgerAccount(node *LedgerAccount) ledgeraccountOption {
+	return func(m *LedgerAccountMutation) {
+		m.oldValue = func(context.Context) (*LedgerAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LedgerAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LedgerAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("database: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LedgerAccountMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LedgerAccountMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LedgerAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAsset sets the "asset" field.
+func (m *LedgerAccountMutation) SetAsset(s string) {
+	m.asset = &s
+}
+
+// Asset returns the value of the "asset" field in the mutation.
+func (m *LedgerAccountMutation) Asset() (r string, exists bool) {
+	v := m.asset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAsset returns the old "asset" field's value of the LedgerAccount entity.
+// If the LedgerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerAccountMutation) OldAsset(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAsset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAsset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAsset: %w", err)
+	}
+	return oldValue.Asset, nil
+}
+
+// ResetAsset resets all changes to the "asset" field.
+func (m *LedgerAccountMutation) ResetAsset() {
+	m.asset = nil
+}
+
+// SetName sets the "name" field.
+func (m *LedgerAccountMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LedgerAccountMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the LedgerAccount entity.
+// If the LedgerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerAccountMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LedgerAccountMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *LedgerAccountMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *LedgerAccountMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the LedgerAccount entity.
+// If the LedgerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerAccountMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *LedgerAccountMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCreateAt sets the "create_at" field.
+func (m *LedgerAccountMutation) SetCreateAt(t time.Time) {
+	m.create_at = &t
+}
+
+// CreateAt returns the value of the "create_at" field in the mutation.
+func (m *LedgerAccountMutation) CreateAt() (r time.Time, exists bool) {
+	v := m.create_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateAt returns the old "create_at" field's value of the LedgerAccount entity.
+// If the LedgerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerAccountMutation) OldCreateAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateAt: %w", err)
+	}
+	return oldValue.CreateAt, nil
+}
+
+// ResetCreateAt resets all changes to the "create_at" field.
+func (m *LedgerAccountMutation) ResetCreateAt() {
+	m.create_at = nil
+}
+
+// Where appends a list predicates to the LedgerAccountMutation builder.
+func (m *LedgerAccountMutation) Where(ps ...predicate.LedgerAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LedgerAccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LedgerAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LedgerAccount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LedgerAccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LedgerAccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LedgerAccount).
+func (m *LedgerAccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LedgerAccountMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.asset != nil {
+		fields = append(fields, ledgeraccount.FieldAsset)
+	}
+	if m.name != nil {
+		fields = append(fields, ledgeraccount.FieldName)
+	}
+	if m.kind != nil {
+		fields = append(fields, ledgeraccount.FieldKind)
+	}
+	if m.create_at != nil {
+		fields = append(fields, ledgeraccount.FieldCreateAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LedgerAccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ledgeraccount.FieldAsset:
+		return m.Asset()
+	case ledgeraccount.FieldName:
+		return m.Name()
+	case ledgeraccount.FieldKind:
+		return m.Kind()
+	case ledgeraccount.FieldCreateAt:
+		return m.CreateAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LedgerAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ledgeraccount.FieldAsset:
+		return m.OldAsset(ctx)
+	case ledgeraccount.FieldName:
+		return m.OldName(ctx)
+	case ledgeraccount.FieldKind:
+		return m.OldKind(ctx)
+	case ledgeraccount.FieldCreateAt:
+		return m.OldCreateAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LedgerAccount field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LedgerAccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ledgeraccount.FieldAsset:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAsset(v)
+		return nil
+	case ledgeraccount.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case ledgeraccount.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case ledgeraccount.FieldCreateAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerAccount field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LedgerAccountMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LedgerAccountMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LedgerAccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LedgerAccount numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LedgerAccountMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LedgerAccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LedgerAccountMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LedgerAccount nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LedgerAccountMutation) ResetField(name string) error {
+	switch name {
+	case ledgeraccount.FieldAsset:
+		m.ResetAsset()
+		return nil
+	case ledgeraccount.FieldName:
+		m.ResetName()
+		return nil
+	case ledgeraccount.FieldKind:
+		m.ResetKind()
+		return nil
+	case ledgeraccount.FieldCreateAt:
+		m.ResetCreateAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerAccount field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LedgerAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LedgerAccountMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LedgerAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LedgerAccountMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LedgerAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LedgerAccountMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LedgerAccountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LedgerAccount unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LedgerAccountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LedgerAccount edge %s", name)
+}
+
+// LedgerEntryMutation represents an operation that mutates the LedgerEntry nodes in the graph.
+type LedgerEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	journal       *string
+	line          *int
+	addline       *int
+	asset         *string
+	kind          *string
+	debit         *string
+	credit        *string
+	amount        **big.Int
+	invoice_id    *string
+	tx_hash       *string
+	create_at     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LedgerEntry, error)
+	predicates    []predicate.LedgerEntry
+}
+
+var _ ent.Mutation = (*LedgerEntryMutation)(nil)
+
+// ledgerentryOption allows management of the mutation configuration using functional options.
+type ledgerentryOption func(*LedgerEntryMutation)
+
+// newLedgerEntryMutation creates new mutation for the LedgerEntry entity.
+func newLedgerEntryMutation(c config, op Op, opts ...ledgerentryOption) *LedgerEntryMutation {
+	m := &LedgerEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLedgerEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLedgerEntryID sets the ID field of the mutation.
+func withLedgerEntryID(id int) ledgerentryOption {
+	return func(m *LedgerEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LedgerEntry
+		)
+		m.oldValue = func(ctx context.Context) (*LedgerEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LedgerEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLedgerEntry sets the old LedgerEntry of the mutation.
+func withLedgerEntry(node *LedgerEntry) ledgerentryOption {
+	return func(m *LedgerEntryMutation) {
+		m.oldValue = func(context.Context) (*LedgerEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LedgerEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LedgerEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("database: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LedgerEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LedgerEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LedgerEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJournal sets the "journal" field.
+func (m *LedgerEntryMutation) SetJournal(s string) {
+	m.journal = &s
+}
+
+// Journal returns the value of the "journal" field in the mutation.
+func (m *LedgerEntryMutation) Journal() (r string, exists bool) {
+	v := m.journal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJournal returns the old "journal" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldJournal(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJournal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJournal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJournal: %w", err)
+	}
+	return oldValue.Journal, nil
+}
+
+// ResetJournal resets all changes to the "journal" field.
+func (m *LedgerEntryMutation) ResetJournal() {
+	m.journal = nil
+}
+
+// SetLine sets the "line" field.
+func (m *LedgerEntryMutation) SetLine(i int) {
+	m.line = &i
+	m.addline = nil
+}
+
+// Line returns the value of the "line" field in the mutation.
+func (m *LedgerEntryMutation) Line() (r int, exists bool) {
+	v := m.line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLine returns the old "line" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldLine(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLine: %w", err)
+	}
+	return oldValue.Line, nil
+}
+
+// AddLine adds i to the "line" field.
+func (m *LedgerEntryMutation) AddLine(i int) {
+	if m.addline != nil {
+		*m.addline += i
+	} else {
+		m.addline = &i
+	}
+}
+
+// AddedLine returns the value that was added to the "line" field in this mutation.
+func (m *LedgerEntryMutation) AddedLine() (r int, exists bool) {
+	v := m.addline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLine resets all changes to the "line" field.
+func (m *LedgerEntryMutation) ResetLine() {
+	m.line = nil
+	m.addline = nil
+}
+
+// SetAsset sets the "asset" field.
+func (m *LedgerEntryMutation) SetAsset(s string) {
+	m.asset = &s
+}
+
+// Asset returns the value of the "asset" field in the mutation.
+func (m *LedgerEntryMutation) Asset() (r string, exists bool) {
+	v := m.asset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAsset returns the old "asset" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldAsset(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAsset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAsset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAsset: %w", err)
+	}
+	return oldValue.Asset, nil
+}
+
+// ResetAsset resets all changes to the "asset" field.
+func (m *LedgerEntryMutation) ResetAsset() {
+	m.asset = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *LedgerEntryMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *LedgerEntryMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *LedgerEntryMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetDebit sets the "debit" field.
+func (m *LedgerEntryMutation) SetDebit(s string) {
+	m.debit = &s
+}
+
+// Debit returns the value of the "debit" field in the mutation.
+func (m *LedgerEntryMutation) Debit() (r string, exists bool) {
+	v := m.debit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDebit returns the old "debit" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldDebit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDebit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDebit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDebit: %w", err)
+	}
+	return oldValue.Debit, nil
+}
+
+// ResetDebit resets all changes to the "debit" field.
+func (m *LedgerEntryMutation) ResetDebit() {
+	m.debit = nil
+}
+
+// SetCredit sets the "credit" field.
+func (m *LedgerEntryMutation) SetCredit(s string) {
+	m.credit = &s
+}
+
+// Credit returns the value of the "credit" field in the mutation.
+func (m *LedgerEntryMutation) Credit() (r string, exists bool) {
+	v := m.credit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredit returns the old "credit" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldCredit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredit: %w", err)
+	}
+	return oldValue.Credit, nil
+}
+
+// ResetCredit resets all changes to the "credit" field.
+func (m *LedgerEntryMutation) ResetCredit() {
+	m.credit = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *LedgerEntryMutation) SetAmount(b *big.Int) {
+	m.amount = &b
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *LedgerEntryMutation) Amount() (r *big.Int, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldAmount(ctx context.Context) (v *big.Int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *LedgerEntryMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (m *LedgerEntryMutation) SetInvoiceID(s string) {
+	m.invoice_id = &s
+}
+
+// InvoiceID returns the value of the "invoice_id" field in the mutation.
+func (m *LedgerEntryMutation) InvoiceID() (r string, exists bool) {
+	v := m.invoice_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvoiceID returns the old "invoice_id" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldInvoiceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvoiceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvoiceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvoiceID: %w", err)
+	}
+	return oldValue.InvoiceID, nil
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (m *LedgerEntryMutation) ClearInvoiceID() {
+	m.invoice_id = nil
+	m.clearedFields[ledgerentry.FieldInvoiceID] = struct{}{}
+}
+
+// InvoiceIDCleared returns if the "invoice_id" field was cleared in this mutation.
+func (m *LedgerEntryMutation) InvoiceIDCleared() bool {
+	_, ok := m.clearedFields[ledgerentry.FieldInvoiceID]
+	return ok
+}
+
+// ResetInvoiceID resets all changes to the "invoice_id" field.
+func (m *LedgerEntryMutation) ResetInvoiceID() {
+	m.invoice_id = nil
+	delete(m.clearedFields, ledgerentry.FieldInvoiceID)
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (m *LedgerEntryMutation) SetTxHash(s string) {
+	m.tx_hash = &s
+}
+
+// TxHash returns the value of the "tx_hash" field in the mutation.
+func (m *LedgerEntryMutation) TxHash() (r string, exists bool) {
+	v := m.tx_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxHash returns the old "tx_hash" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldTxHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxHash: %w", err)
+	}
+	return oldValue.TxHash, nil
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (m *LedgerEntryMutation) ClearTxHash() {
+	m.tx_hash = nil
+	m.clearedFields[ledgerentry.FieldTxHash] = struct{}{}
+}
+
+// TxHashCleared returns if the "tx_hash" field was cleared in this mutation.
+func (m *LedgerEntryMutation) TxHashCleared() bool {
+	_, ok := m.clearedFields[ledgerentry.FieldTxHash]
+	return ok
+}
+
+// ResetTxHash resets all changes to the "tx_hash" field.
+func (m *LedgerEntryMutation) ResetTxHash() {
+	m.tx_hash = nil
+	delete(m.clearedFields, ledgerentry.FieldTxHash)
+}
+
+// SetCreateAt sets the "create_at" field.
+func (m *LedgerEntryMutation) SetCreateAt(t time.Time) {
+	m.create_at = &t
+}
+
+// CreateAt returns the value of the "create_at" field in the mutation.
+func (m *LedgerEntryMutation) CreateAt() (r time.Time, exists bool) {
+	v := m.create_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateAt returns the old "create_at" field's value of the LedgerEntry entity.
+// If the LedgerEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerEntryMutation) OldCreateAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateAt: %w", err)
+	}
+	return oldValue.CreateAt, nil
+}
+
+// ResetCreateAt resets all changes to the "create_at" field.
+func (m *LedgerEntryMutation) ResetCreateAt() {
+	m.create_at = nil
+}
+
+// Where appends a list predicates to the LedgerEntryMutation builder.
+func (m *LedgerEntryMutation) Where(ps ...predicate.LedgerEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LedgerEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LedgerEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LedgerEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LedgerEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LedgerEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LedgerEntry).
+func (m *LedgerEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LedgerEntryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.journal != nil {
+		fields = append(fields, ledgerentry.FieldJournal)
+	}
+	if m.line != nil {
+		fields = append(fields, ledgerentry.FieldLine)
+	}
+	if m.asset != nil {
+		fields = append(fields, ledgerentry.FieldAsset)
+	}
+	if m.kind != nil {
+		fields = append(fields, ledgerentry.FieldKind)
+	}
+	if m.debit != nil {
+		fields = append(fields, ledgerentry.FieldDebit)
+	}
+	if m.credit != nil {
+		fields = append(fields, ledgerentry.FieldCredit)
+	}
+	if m.amount != nil {
+		fields = append(fields, ledgerentry.FieldAmount)
+	}
+	if m.invoice_id != nil {
+		fields = append(fields, ledgerentry.FieldInvoiceID)
+	}
+	if m.tx_hash != nil {
+		fields = append(fields, ledgerentry.FieldTxHash)
+	}
+	if m.create_at != nil {
+		fields = append(fields, ledgerentry.FieldCreateAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LedgerEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ledgerentry.FieldJournal:
+		return m.Journal()
+	case ledgerentry.FieldLine:
+		return m.Line()
+	case ledgerentry.FieldAsset:
+		return m.Asset()
+	case ledgerentry.FieldKind:
+		return m.Kind()
+	case ledgerentry.FieldDebit:
+		return m.Debit()
+	case ledgerentry.FieldCredit:
+		return m.Credit()
+	case ledgerentry.FieldAmount:
+		return m.Amount()
+	case ledgerentry.FieldInvoiceID:
+		return m.InvoiceID()
+	case ledgerentry.FieldTxHash:
+		return m.TxHash()
+	case ledgerentry.FieldCreateAt:
+		return m.CreateAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LedgerEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ledgerentry.FieldJournal:
+		return m.OldJournal(ctx)
+	case ledgerentry.FieldLine:
+		return m.OldLine(ctx)
+	case ledgerentry.FieldAsset:
+		return m.OldAsset(ctx)
+	case ledgerentry.FieldKind:
+		return m.OldKind(ctx)
+	case ledgerentry.FieldDebit:
+		return m.OldDebit(ctx)
+	case ledgerentry.FieldCredit:
+		return m.OldCredit(ctx)
+	case ledgerentry.FieldAmount:
+		return m.OldAmount(ctx)
+	case ledgerentry.FieldInvoiceID:
+		return m.OldInvoiceID(ctx)
+	case ledgerentry.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case ledgerentry.FieldCreateAt:
+		return m.OldCreateAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LedgerEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LedgerEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ledgerentry.FieldJournal:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJournal(v)
+		return nil
+	case ledgerentry.FieldLine:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLine(v)
+		return nil
+	case ledgerentry.FieldAsset:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAsset(v)
+		return nil
+	case ledgerentry.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case ledgerentry.FieldDebit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDebit(v)
+		return nil
+	case ledgerentry.FieldCredit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredit(v)
+		return nil
+	case ledgerentry.FieldAmount:
+		v, ok := value.(*big.Int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case ledgerentry.FieldInvoiceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvoiceID(v)
+		return nil
+	case ledgerentry.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case ledgerentry.FieldCreateAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LedgerEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addline != nil {
+		fields = append(fields, ledgerentry.FieldLine)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LedgerEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ledgerentry.FieldLine:
+		return m.AddedLine()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LedgerEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ledgerentry.FieldLine:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLine(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LedgerEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ledgerentry.FieldInvoiceID) {
+		fields = append(fields, ledgerentry.FieldInvoiceID)
+	}
+	if m.FieldCleared(ledgerentry.FieldTxHash) {
+		fields = append(fields, ledgerentry.FieldTxHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LedgerEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LedgerEntryMutation) ClearField(name string) error {
+	switch name {
+	case ledgerentry.FieldInvoiceID:
+		m.ClearInvoiceID()
+		return nil
+	case ledgerentry.FieldTxHash:
+		m.ClearTxHash()
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LedgerEntryMutation) ResetField(name string) error {
+	switch name {
+	case ledgerentry.FieldJournal:
+		m.ResetJournal()
+		return nil
+	case ledgerentry.FieldLine:
+		m.ResetLine()
+		return nil
+	case ledgerentry.FieldAsset:
+		m.ResetAsset()
+		return nil
+	case ledgerentry.FieldKind:
+		m.ResetKind()
+		return nil
+	case ledgerentry.FieldDebit:
+		m.ResetDebit()
+		return nil
+	case ledgerentry.FieldCredit:
+		m.ResetCredit()
+		return nil
+	case ledgerentry.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case ledgerentry.FieldInvoiceID:
+		m.ResetInvoiceID()
+		return nil
+	case ledgerentry.FieldTxHash:
+		m.ResetTxHash()
+		return nil
+	case ledgerentry.FieldCreateAt:
+		m.ResetCreateAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LedgerEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LedgerEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LedgerEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LedgerEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LedgerEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LedgerEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LedgerEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LedgerEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LedgerEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LedgerEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LedgerEntry edge %s", name)
+}
+
+// PayoutMutation represents an operation that mutates the Payout nodes in the graph.
+type PayoutMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	idempotency_key *string
+	asset           *string
+	to              *string
+	amount          **big.Int
+	reference       *string
+	status          *string
+	create_at       *time.Time
+	fee             **big.Int
+	tx_hash         *string
+	send_at         *time.Time
+	confirm_at      *time.Time
+	error           *string
+	notified_status *string
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Payout, error)
+	predicates      []predicate.Payout
+}
+
+var _ ent.Mutation = (*PayoutMutation)(nil)
+
+// payoutOption allows management of the mutation configuration using functional options.
+type payoutOption func(*PayoutMutation)
+
+// newPayoutMutation creates new mutation for the Payout entity.
+func newPayoutMutation(c config, op Op, opts ...payoutOption) *PayoutMutation {
+	m := &PayoutMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePayout,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPayoutID sets the ID field of the mutation.
+func withPayoutID(id string) payoutOption {
+	return func(m *PayoutMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Payout
+		)
+		m.oldValue = func(ctx context.Context) (*Payout, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Payout.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPayout sets the old Payout of the mutation.
+func withPayout(node *Payout) payoutOption {
+	return func(m *PayoutMutation) {
+		m.oldValue = func(context.Context) (*Payout, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PayoutMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PayoutMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("database: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Payout entities.
+func (m *PayoutMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PayoutMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PayoutMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Payout.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *PayoutMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *PayoutMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldIdempotencyKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
+	}
+	return oldValue.IdempotencyKey, nil
+}
+
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *PayoutMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+}
+
+// SetAsset sets the "asset" field.
+func (m *PayoutMutation) SetAsset(s string) {
+	m.asset = &s
+}
+
+// Asset returns the value of the "asset" field in the mutation.
+func (m *PayoutMutation) Asset() (r string, exists bool) {
+	v := m.asset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAsset returns the old "asset" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldAsset(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAsset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAsset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAsset: %w", err)
+	}
+	return oldValue.Asset, nil
+}
+
+// ResetAsset resets all changes to the "asset" field.
+func (m *PayoutMutation) ResetAsset() {
+	m.asset = nil
+}
+
+// SetTo sets the "to" field.
+func (m *PayoutMutation) SetTo(s string) {
+	m.to = &s
+}
+
+// To returns the value of the "to" field in the mutation.
+func (m *PayoutMutation) To() (r string, exists bool) {
+	v := m.to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTo returns the old "to" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTo: %w", err)
+	}
+	return oldValue.To, nil
+}
+
+// ResetTo resets all changes to the "to" field.
+func (m *PayoutMutation) ResetTo() {
+	m.to = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *PayoutMutation) SetAmount(b *big.Int) {
+	m.amount = &b
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *PayoutMutation) Amount() (r *big.Int, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldAmount(ctx context.Context) (v *big.Int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *PayoutMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetReference sets the "reference" field.
+func (m *PayoutMutation) SetReference(s string) {
+	m.reference = &s
+}
+
+// Reference returns the value of the "reference" field in the mutation.
+func (m *PayoutMutation) Reference() (r string, exists bool) {
+	v := m.reference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReference returns the old "reference" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldReference(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReference is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReference requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReference: %w", err)
+	}
+	return oldValue.Reference, nil
+}
+
+// ClearReference clears the value of the "reference" field.
+func (m *PayoutMutation) ClearReference() {
+	m.reference = nil
+	m.clearedFields[payout.FieldReference] = struct{}{}
+}
+
+// ReferenceCleared returns if the "reference" field was cleared in this mutation.
+func (m *PayoutMutation) ReferenceCleared() bool {
+	_, ok := m.clearedFields[payout.FieldReference]
+	return ok
+}
+
+// ResetReference resets all changes to the "reference" field.
+func (m *PayoutMutation) ResetReference() {
+	m.reference = nil
+	delete(m.clearedFields, payout.FieldReference)
+}
+
+// SetStatus sets the "status" field.
+func (m *PayoutMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PayoutMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PayoutMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreateAt sets the "create_at" field.
+func (m *PayoutMutation) SetCreateAt(t time.Time) {
+	m.create_at = &t
+}
+
+// CreateAt returns the value of the "create_at" field in the mutation.
+func (m *PayoutMutation) CreateAt() (r time.Time, exists bool) {
+	v := m.create_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateAt returns the old "create_at" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldCreateAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateAt: %w", err)
+	}
+	return oldValue.CreateAt, nil
+}
+
+// ResetCreateAt resets all changes to the "create_at" field.
+func (m *PayoutMutation) ResetCreateAt() {
+	m.create_at = nil
+}
+
+// SetFee sets the "fee" field.
+func (m *PayoutMutation) SetFee(b *big.Int) {
+	m.fee = &b
+}
+
+// Fee returns the value of the "fee" field in the mutation.
+func (m *PayoutMutation) Fee() (r *big.Int, exists bool) {
+	v := m.fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFee returns the old "fee" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldFee(ctx context.Context) (v *big.Int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFee: %w", err)
+	}
+	return oldValue.Fee, nil
+}
+
+// ClearFee clears the value of the "fee" field.
+func (m *PayoutMutation) ClearFee() {
+	m.fee = nil
+	m.clearedFields[payout.FieldFee] = struct{}{}
+}
+
+// FeeCleared returns if the "fee" field was cleared in this mutation.
+func (m *PayoutMutation) FeeCleared() bool {
+	_, ok := m.clearedFields[payout.FieldFee]
+	return ok
+}
+
+// ResetFee resets all changes to the "fee" field.
+func (m *PayoutMutation) ResetFee() {
+	m.fee = nil
+	delete(m.clearedFields, payout.FieldFee)
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (m *PayoutMutation) SetTxHash(s string) {
+	m.tx_hash = &s
+}
+
+// TxHash returns the value of the "tx_hash" field in the mutation.
+func (m *PayoutMutation) TxHash() (r string, exists bool) {
+	v := m.tx_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxHash returns the old "tx_hash" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldTxHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxHash: %w", err)
+	}
+	return oldValue.TxHash, nil
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (m *PayoutMutation) ClearTxHash() {
+	m.tx_hash = nil
+	m.clearedFields[payout.FieldTxHash] = struct{}{}
+}
+
+// TxHashCleared returns if the "tx_hash" field was cleared in this mutation.
+func (m *PayoutMutation) TxHashCleared() bool {
+	_, ok := m.clearedFields[payout.FieldTxHash]
+	return ok
+}
+
+// ResetTxHash resets all changes to the "tx_hash" field.
+func (m *PayoutMutation) ResetTxHash() {
+	m.tx_hash = nil
+	delete(m.clearedFields, payout.FieldTxHash)
+}
+
+// SetSendAt sets the "send_at" field.
+func (m *PayoutMutation) SetSendAt(t time.Time) {
+	m.send_at = &t
+}
+
+// SendAt returns the value of the "send_at" field in the mutation.
+func (m *PayoutMutation) SendAt() (r time.Time, exists bool) {
+	v := m.send_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSendAt returns the old "send_at" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldSendAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendAt: %w", err)
+	}
+	return oldValue.SendAt, nil
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (m *PayoutMutation) ClearSendAt() {
+	m.send_at = nil
+	m.clearedFields[payout.FieldSendAt] = struct{}{}
+}
+
+// SendAtCleared returns if the "send_at" field was cleared in this mutation.
+func (m *PayoutMutation) SendAtCleared() bool {
+	_, ok := m.clearedFields[payout.FieldSendAt]
+	return ok
+}
+
+// ResetSendAt resets all changes to the "send_at" field.
+func (m *PayoutMutation) ResetSendAt() {
+	m.send_at = nil
+	delete(m.clearedFields, payout.FieldSendAt)
+}
+
+// SetConfirmAt sets the "confirm_at" field.
+func (m *PayoutMutation) SetConfirmAt(t time.Time) {
+	m.confirm_at = &t
+}
+
+// ConfirmAt returns the value of the "confirm_at" field in the mutation.
+func (m *PayoutMutation) ConfirmAt() (r time.Time, exists bool) {
+	v := m.confirm_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfirmAt returns the old "confirm_at" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldConfirmAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfirmAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfirmAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfirmAt: %w", err)
+	}
+	return oldValue.ConfirmAt, nil
+}
+
+// ClearConfirmAt clears the value of the "confirm_at" field.
+func (m *PayoutMutation) ClearConfirmAt() {
+	m.confirm_at = nil
+	m.clearedFields[payout.FieldConfirmAt] = struct{}{}
+}
+
+// ConfirmAtCleared returns if the "confirm_at" field was cleared in this mutation.
+func (m *PayoutMutation) ConfirmAtCleared() bool {
+	_, ok := m.clearedFields[payout.FieldConfirmAt]
+	return ok
+}
+
+// ResetConfirmAt resets all changes to the "confirm_at" field.
+func (m *PayoutMutation) ResetConfirmAt() {
+	m.confirm_at = nil
+	delete(m.clearedFields, payout.FieldConfirmAt)
+}
+
+// SetError sets the "error" field.
+func (m *PayoutMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *PayoutMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *PayoutMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[payout.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *PayoutMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[payout.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *PayoutMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, payout.FieldError)
+}
+
+// SetNotifiedStatus sets the "notified_status" field.
+func (m *PayoutMutation) SetNotifiedStatus(s string) {
+	m.notified_status = &s
+}
+
+// NotifiedStatus returns the value of the "notified_status" field in the mutation.
+func (m *PayoutMutation) NotifiedStatus() (r string, exists bool) {
+	v := m.notified_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotifiedStatus returns the old "notified_status" field's value of the Payout entity.
+// If the Payout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutMutation) OldNotifiedStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifiedStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifiedStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifiedStatus: %w", err)
+	}
+	return oldValue.NotifiedStatus, nil
+}
+
+// ResetNotifiedStatus resets all changes to the "notified_status" field.
+func (m *PayoutMutation) ResetNotifiedStatus() {
+	m.notified_status = nil
+}
+
+// Where appends a list predicates to the PayoutMutation builder.
+func (m *PayoutMutation) Where(ps ...predicate.Payout) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PayoutMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PayoutMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Payout, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PayoutMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PayoutMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Payout).
+func (m *PayoutMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PayoutMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.idempotency_key != nil {
+		fields = append(fields, payout.FieldIdempotencyKey)
+	}
+	if m.asset != nil {
+		fields = append(fields, payout.FieldAsset)
+	}
+	if m.to != nil {
+		fields = append(fields, payout.FieldTo)
+	}
+	if m.amount != nil {
+		fields = append(fields, payout.FieldAmount)
+	}
+	if m.reference != nil {
+		fields = append(fields, payout.FieldReference)
+	}
+	if m.status != nil {
+		fields = append(fields, payout.FieldStatus)
+	}
+	if m.create_at != nil {
+		fields = append(fields, payout.FieldCreateAt)
+	}
+	if m.fee != nil {
+		fields = append(fields, payout.FieldFee)
+	}
+	if m.tx_hash != nil {
+		fields = append(fields, payout.FieldTxHash)
+	}
+	if m.send_at != nil {
+		fields = append(fields, payout.FieldSendAt)
+	}
+	if m.confirm_at != nil {
+		fields = append(fields, payout.FieldConfirmAt)
+	}
+	if m.error != nil {
+		fields = append(fields, payout.FieldError)
+	}
+	if m.notified_status != nil {
+		fields = append(fields, payout.FieldNotifiedStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PayoutMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case payout.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	case payout.FieldAsset:
+		return m.Asset()
+	case payout.FieldTo:
+		return m.To()
+	case payout.FieldAmount:
+		return m.Amount()
+	case payout.FieldReference:
+		return m.Reference()
+	case payout.FieldStatus:
+		return m.Status()
+	case payout.FieldCreateAt:
+		return m.CreateAt()
+	case payout.FieldFee:
+		return m.Fee()
+	case payout.FieldTxHash:
+		return m.TxHash()
+	case payout.FieldSendAt:
+		return m.SendAt()
+	case payout.FieldConfirmAt:
+		return m.ConfirmAt()
+	case payout.FieldError:
+		return m.Error()
+	case payout.FieldNotifiedStatus:
+		return m.NotifiedStatus()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PayoutMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case payout.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	case payout.FieldAsset:
+		return m.OldAsset(ctx)
+	case payout.FieldTo:
+		return m.OldTo(ctx)
+	case payout.FieldAmount:
+		return m.OldAmount(ctx)
+	case payout.FieldReference:
+		return m.OldReference(ctx)
+	case payout.FieldStatus:
+		return m.OldStatus(ctx)
+	case payout.FieldCreateAt:
+		return m.OldCreateAt(ctx)
+	case payout.FieldFee:
+		return m.OldFee(ctx)
+	case payout.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case payout.FieldSendAt:
+		return m.OldSendAt(ctx)
+	case payout.FieldConfirmAt:
+		return m.OldConfirmAt(ctx)
+	case payout.FieldError:
+		return m.OldError(ctx)
+	case payout.FieldNotifiedStatus:
+		return m.OldNotifiedStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown Payout field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PayoutMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case payout.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	case payout.FieldAsset:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAsset(v)
+		return nil
+	case payout.FieldTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTo(v)
+		return nil
+	case payout.FieldAmount:
+		v, ok := value.(*big.Int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case payout.FieldReference:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReference(v)
+		return nil
+	case payout.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case payout.FieldCreateAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateAt(v)
+		return nil
+	case payout.FieldFee:
+		v, ok := value.(*big.Int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFee(v)
+		return nil
+	case payout.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case payout.FieldSendAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendAt(v)
+		return nil
+	case payout.FieldConfirmAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfirmAt(v)
+		return nil
+	case payout.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case payout.FieldNotifiedStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifiedStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Payout field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PayoutMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PayoutMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PayoutMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Payout numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PayoutMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(payout.FieldReference) {
+		fields = append(fields, payout.FieldReference)
+	}
+	if m.FieldCleared(payout.FieldFee) {
+		fields = append(fields, payout.FieldFee)
+	}
+	if m.FieldCleared(payout.FieldTxHash) {
+		fields = append(fields, payout.FieldTxHash)
+	}
+	if m.FieldCleared(payout.FieldSendAt) {
+		fields = append(fields, payout.FieldSendAt)
+	}
+	if m.FieldCleared(payout.FieldConfirmAt) {
+		fields = append(fields, payout.FieldConfirmAt)
+	}
+	if m.FieldCleared(payout.FieldError) {
+		fields = append(fields, payout.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PayoutMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PayoutMutation) ClearField(name string) error {
+	switch name {
+	case payout.FieldReference:
+		m.ClearReference()
+		return nil
+	case payout.FieldFee:
+		m.ClearFee()
+		return nil
+	case payout.FieldTxHash:
+		m.ClearTxHash()
+		return nil
+	case payout.FieldSendAt:
+		m.ClearSendAt()
+		return nil
+	case payout.FieldConfirmAt:
+		m.ClearConfirmAt()
+		return nil
+	case payout.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown Payout nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PayoutMutation) ResetField(name string) error {
+	switch name {
+	case payout.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	case payout.FieldAsset:
+		m.ResetAsset()
+		return nil
+	case payout.FieldTo:
+		m.ResetTo()
+		return nil
+	case payout.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case payout.FieldReference:
+		m.ResetReference()
+		return nil
+	case payout.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case payout.FieldCreateAt:
+		m.ResetCreateAt()
+		return nil
+	case payout.FieldFee:
+		m.ResetFee()
+		return nil
+	case payout.FieldTxHash:
+		m.ResetTxHash()
+		return nil
+	case payout.FieldSendAt:
+		m.ResetSendAt()
+		return nil
+	case payout.FieldConfirmAt:
+		m.ResetConfirmAt()
+		return nil
+	case payout.FieldError:
+		m.ResetError()
+		return nil
+	case payout.FieldNotifiedStatus:
+		m.ResetNotifiedStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Payout field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PayoutMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PayoutMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PayoutMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PayoutMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PayoutMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PayoutMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PayoutMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Payout unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PayoutMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Payout edge %s", name)
+}
+
+// PayoutDayMutation represents an operation that mutates the PayoutDay nodes in the graph.
+type PayoutDayMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	payouts       *int64
+	addpayouts    *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PayoutDay, error)
+	predicates    []predicate.PayoutDay
+}
+
+var _ ent.Mutation = (*PayoutDayMutation)(nil)
+
+// payoutdayOption allows management of the mutation configuration using functional options.
+type payoutdayOption func(*PayoutDayMutation)
+
+// newPayoutDayMutation creates new mutation for the PayoutDay entity.
+func newPayoutDayMutation(c config, op Op, opts ...payoutdayOption) *PayoutDayMutation {
+	m := &PayoutDayMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePayoutDay,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPayoutDayID sets the ID field of the mutation.
+func withPayoutDayID(id string) payoutdayOption {
+	return func(m *PayoutDayMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PayoutDay
+		)
+		m.oldValue = func(ctx context.Context) (*PayoutDay, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PayoutDay.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPayoutDay sets the old PayoutDay of the mutation.
+func withPayoutDay(node *PayoutDay) payoutdayOption {
+	return func(m *PayoutDayMutation) {
+		m.oldValue = func(context.Context) (*PayoutDay, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PayoutDayMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PayoutDayMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("database: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PayoutDay entities.
+func (m *PayoutDayMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PayoutDayMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PayoutDayMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PayoutDay.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPayouts sets the "payouts" field.
+func (m *PayoutDayMutation) SetPayouts(i int64) {
+	m.payouts = &i
+	m.addpayouts = nil
+}
+
+// Payouts returns the value of the "payouts" field in the mutation.
+func (m *PayoutDayMutation) Payouts() (r int64, exists bool) {
+	v := m.payouts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayouts returns the old "payouts" field's value of the PayoutDay entity.
+// If the PayoutDay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayoutDayMutation) OldPayouts(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayouts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayouts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayouts: %w", err)
+	}
+	return oldValue.Payouts, nil
+}
+
+// AddPayouts adds i to the "payouts" field.
+func (m *PayoutDayMutation) AddPayouts(i int64) {
+	if m.addpayouts != nil {
+		*m.addpayouts += i
+	} else {
+		m.addpayouts = &i
+	}
+}
+
+// AddedPayouts returns the value that was added to the "payouts" field in this mutation.
+func (m *PayoutDayMutation) AddedPayouts() (r int64, exists bool) {
+	v := m.addpayouts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPayouts resets all changes to the "payouts" field.
+func (m *PayoutDayMutation) ResetPayouts() {
+	m.payouts = nil
+	m.addpayouts = nil
+}
+
+// Where appends a list predicates to the PayoutDayMutation builder.
+func (m *PayoutDayMutation) Where(ps ...predicate.PayoutDay) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PayoutDayMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PayoutDayMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PayoutDay, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PayoutDayMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PayoutDayMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PayoutDay).
+func (m *PayoutDayMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PayoutDayMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.payouts != nil {
+		fields = append(fields, payoutday.FieldPayouts)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PayoutDayMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case payoutday.FieldPayouts:
+		return m.Payouts()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PayoutDayMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case payoutday.FieldPayouts:
+		return m.OldPayouts(ctx)
+	}
+	return nil, fmt.Errorf("unknown PayoutDay field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PayoutDayMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case payoutday.FieldPayouts:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayouts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PayoutDay field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PayoutDayMutation) AddedFields() []string {
+	var fields []string
+	if m.addpayouts != nil {
+		fields = append(fields, payoutday.FieldPayouts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PayoutDayMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case payoutday.FieldPayouts:
+		return m.AddedPayouts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PayoutDayMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case payoutday.FieldPayouts:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPayouts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PayoutDay numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PayoutDayMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PayoutDayMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PayoutDayMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PayoutDay nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PayoutDayMutation) ResetField(name string) error {
+	switch name {
+	case payoutday.FieldPayouts:
+		m.ResetPayouts()
+		return nil
+	}
+	return fmt.Errorf("unknown PayoutDay field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PayoutDayMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PayoutDayMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PayoutDayMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PayoutDayMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PayoutDayMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PayoutDayMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PayoutDayMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PayoutDay unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PayoutDayMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PayoutDay edge %s", name)
+}
+
+// TreasuryCreditMutation represents an operation that mutates the TreasuryCredit nodes in the graph.
+type TreasuryCreditMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	asset         *string
+	account       *string
+	invoice_id    *string
+	tx_hash       *string
+	amount        **big.Int
+	create_at     *time.Time
+	settlement_id *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TreasuryCredit, error)
+	predicates    []predicate.TreasuryCredit
+}
+
+var _ ent.Mutation = (*TreasuryCreditMutation)(nil)
+
+// treasurycreditOption allows management of the mutation configuration using functional options.
+type treasurycreditOption func(*TreasuryCreditMutation)
+
+// newTreasuryCreditMutation creates new mutation for the TreasuryCredit entity.
+func newTreasuryCreditMutation(c config, op Op, opts ...treasurycreditOption) *TreasuryCreditMutation {
+	m := &TreasuryCreditMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTreasuryCredit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTreasuryCreditID sets the ID field of the mutation.
+func withTreasuryCreditID(id int) treasurycreditOption {
+	return func(m *TreasuryCreditMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TreasuryCredit
+		)
+		m.oldValue = func(ctx context.Context) (*TreasuryCredit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TreasuryCredit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTreasuryCredit sets the old TreasuryCredit of the mutation.
+func withTreasuryCredit(node *TreasuryCredit) treasurycreditOption {
+	return func(m *TreasuryCreditMutation) {
+		m.oldValue = func(context.Context) (*TreasuryCredit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TreasuryCreditMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TreasuryCreditMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("database: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TreasuryCreditMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TreasuryCreditMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TreasuryCredit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAsset sets the "asset" field.
+func (m *TreasuryCreditMutation) SetAsset(s string) {
+	m.asset = &s
+}
+
+// Asset returns the value of the "asset" field in the mutation.
+func (m *TreasuryCreditMutation) Asset() (r string, exists bool) {
+	v := m.asset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAsset returns the old "asset" field's value of the TreasuryCredit entity.
+// If the TreasuryCredit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryCreditMutation) OldAsset(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAsset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAsset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAsset: %w", err)
+	}
+	return oldValue.Asset, nil
+}
+
+// ResetAsset resets all changes to the "asset" field.
+func (m *TreasuryCreditMutation) ResetAsset() {
+	m.asset = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *TreasuryCreditMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *TreasuryCreditMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the TreasuryCredit entity.
+// If the TreasuryCredit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryCreditMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *TreasuryCreditMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (m *TreasuryCreditMutation) SetInvoiceID(s string) {
+	m.invoice_id = &s
+}
+
+// InvoiceID returns the value of the "invoice_id" field in the mutation.
+func (m *TreasuryCreditMutation) InvoiceID() (r string, exists bool) {
+	v := m.invoice_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvoiceID returns the old "invoice_id" field's value of the TreasuryCredit entity.
+// If the TreasuryCredit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryCreditMutation) OldInvoiceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvoiceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvoiceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvoiceID: %w", err)
+	}
+	return oldValue.InvoiceID, nil
+}
+
+// ResetInvoiceID resets all changes to the "invoice_id" field.
+func (m *TreasuryCreditMutation) ResetInvoiceID() {
+	m.invoice_id = nil
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (m *TreasuryCreditMutation) SetTxHash(s string) {
+	m.tx_hash = &s
+}
+
+// TxHash returns the value of the "tx_hash" field in the mutation.
+func (m *TreasuryCreditMutation) TxHash() (r string, exists bool) {
+	v := m.tx_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxHash returns the old "tx_hash" field's value of the TreasuryCredit entity.
+// If the TreasuryCredit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryCreditMutation) OldTxHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxHash: %w", err)
+	}
+	return oldValue.TxHash, nil
+}
+
+// ResetTxHash resets all changes to the "tx_hash" field.
+func (m *TreasuryCreditMutation) ResetTxHash() {
+	m.tx_hash = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *TreasuryCreditMutation) SetAmount(b *big.Int) {
+	m.amount = &b
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *TreasuryCreditMutation) Amount() (r *big.Int, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the TreasuryCredit entity.
+// If the TreasuryCredit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryCreditMutation) OldAmount(ctx context.Context) (v *big.Int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *TreasuryCreditMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetCreateAt sets the "create_at" field.
+func (m *TreasuryCreditMutation) SetCreateAt(t time.Time) {
+	m.create_at = &t
+}
+
+// CreateAt returns the value of the "create_at" field in the mutation.
+func (m *TreasuryCreditMutation) CreateAt() (r time.Time, exists bool) {
+	v := m.create_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateAt returns the old "create_at" field's value of the TreasuryCredit entity.
+// If the TreasuryCredit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryCreditMutation) OldCreateAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateAt: %w", err)
+	}
+	return oldValue.CreateAt, nil
+}
+
+// ResetCreateAt resets all changes to the "create_at" field.
+func (m *TreasuryCreditMutation) ResetCreateAt() {
+	m.create_at = nil
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *TreasuryCreditMutation) SetSettlementID(s string) {
+	m.settlement_id = &s
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *TreasuryCreditMutation) SettlementID() (r string, exists bool) {
+	v := m.settlement_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the TreasuryCredit entity.
+// If the TreasuryCredit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryCreditMutation) OldSettlementID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ClearSettlementID clears the value of the "settlement_id" field.
+func (m *TreasuryCreditMutation) ClearSettlementID() {
+	m.settlement_id = nil
+	m.clearedFields[treasurycredit.FieldSettlementID] = struct{}{}
+}
+
+// SettlementIDCleared returns if the "settlement_id" field was cleared in this mutation.
+func (m *TreasuryCreditMutation) SettlementIDCleared() bool {
+	_, ok := m.clearedFields[treasurycredit.FieldSettlementID]
+	return ok
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *TreasuryCreditMutation) ResetSettlementID() {
+	m.settlement_id = nil
+	delete(m.clearedFields, treasurycredit.FieldSettlementID)
+}
+
+// Where appends a list predicates to the TreasuryCreditMutation builder.
+func (m *TreasuryCreditMutation) Where(ps ...predicate.TreasuryCredit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TreasuryCreditMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TreasuryCreditMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TreasuryCredit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TreasuryCreditMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TreasuryCreditMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TreasuryCredit).
+func (m *TreasuryCreditMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TreasuryCreditMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.asset != nil {
+		fields = append(fields, treasurycredit.FieldAsset)
+	}
+	if m.account != nil {
+		fields = append(fields, treasurycredit.FieldAccount)
+	}
+	if m.invoice_id != nil {
+		fields = append(fields, treasurycredit.FieldInvoiceID)
+	}
+	if m.tx_hash != nil {
+		fields = append(fields, treasurycredit.FieldTxHash)
+	}
+	if m.amount != nil {
+		fields = append(fields, treasurycredit.FieldAmount)
+	}
+	if m.create_at != nil {
+		fields = append(fields, treasurycredit.FieldCreateAt)
+	}
+	if m.settlement_id != nil {
+		fields = append(fields, treasurycredit.FieldSettlementID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TreasuryCreditMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case treasurycredit.FieldAsset:
+		return m.Asset()
+	case treasurycredit.FieldAccount:
+		return m.Account()
+	case treasurycredit.FieldInvoiceID:
+		return m.InvoiceID()
+	case treasurycredit.FieldTxHash:
+		return m.TxHash()
+	case treasurycredit.FieldAmount:
+		return m.Amount()
+	case treasurycredit.FieldCreateAt:
+		return m.CreateAt()
+	case treasurycredit.FieldSettlementID:
+		return m.SettlementID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TreasuryCreditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case treasurycredit.FieldAsset:
+		return m.OldAsset(ctx)
+	case treasurycredit.FieldAccount:
+		return m.OldAccount(ctx)
+	case treasurycredit.FieldInvoiceID:
+		return m.OldInvoiceID(ctx)
+	case treasurycredit.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case treasurycredit.FieldAmount:
+		return m.OldAmount(ctx)
+	case treasurycredit.FieldCreateAt:
+		return m.OldCreateAt(ctx)
+	case treasurycredit.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	}
+	return nil, fmt.Errorf("unknown TreasuryCredit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TreasuryCreditMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case treasurycredit.FieldAsset:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAsset(v)
+		return nil
+	case treasurycredit.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case treasurycredit.FieldInvoiceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvoiceID(v)
+		return nil
+	case treasurycredit.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case treasurycredit.FieldAmount:
+		v, ok := value.(*big.Int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case treasurycredit.FieldCreateAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateAt(v)
+		return nil
+	case treasurycredit.FieldSettlementID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettlementID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TreasuryCredit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TreasuryCreditMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TreasuryCreditMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TreasuryCreditMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TreasuryCredit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TreasuryCreditMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(treasurycredit.FieldSettlementID) {
+		fields = append(fields, treasurycredit.FieldSettlementID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TreasuryCreditMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TreasuryCreditMutation) ClearField(name string) error {
+	switch name {
+	case treasurycredit.FieldSettlementID:
+		m.ClearSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown TreasuryCredit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TreasuryCreditMutation) ResetField(name string) error {
+	switch name {
+	case treasurycredit.FieldAsset:
+		m.ResetAsset()
+		return nil
+	case treasurycredit.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case treasurycredit.FieldInvoiceID:
+		m.ResetInvoiceID()
+		return nil
+	case treasurycredit.FieldTxHash:
+		m.ResetTxHash()
+		return nil
+	case treasurycredit.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case treasurycredit.FieldCreateAt:
+		m.ResetCreateAt()
+		return nil
+	case treasurycredit.FieldSettlementID:
+		m.ResetSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown TreasuryCredit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TreasuryCreditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TreasuryCreditMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TreasuryCreditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TreasuryCreditMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TreasuryCreditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TreasuryCreditMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TreasuryCreditMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TreasuryCredit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TreasuryCreditMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TreasuryCredit edge %s", name)
+}
+
+// TreasuryTransferMutation represents an operation that mutates the TreasuryTransfer nodes in the graph.
+type TreasuryTransferMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	asset         *string
+	kind          *string
+	to            *string
+	amount        **big.Int
+	create_at     *time.Time
+	fee           **big.Int
+	tx_hash       *string
+	send_at       *time.Time
+	error         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TreasuryTransfer, error)
+	predicates    []predicate.TreasuryTransfer
+}
+
+var _ ent.Mutation = (*TreasuryTransferMutation)(nil)
+
+// treasurytransferOption allows management of the mutation configuration using functional options.
+type treasurytransferOption func(*TreasuryTransferMutation)
+
+// newTreasuryTransferMutation creates new mutation for the TreasuryTransfer entity.
+func newTreasuryTransferMutation(c config, op Op, opts ...treasurytransferOption) *TreasuryTransferMutation {
+	m := &TreasuryTransferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTreasuryTransfer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTreasuryTransferID sets the ID field of the mutation.
+func withTreasuryTransferID(id string) treasurytransferOption {
+	return func(m *TreasuryTransferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TreasuryTransfer
+		)
+		m.oldValue = func(ctx context.Context) (*TreasuryTransfer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TreasuryTransfer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTreasuryTransfer sets the old TreasuryTransfer of the mutation.
+func withTreasuryTransfer(node *TreasuryTransfer) treasurytransferOption {
+	return func(m *TreasuryTransferMutation) {
+		m.oldValue = func(context.Context) (*TreasuryTransfer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TreasuryTransferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TreasuryTransferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("database: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TreasuryTransfer entities.
+func (m *TreasuryTransferMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TreasuryTransferMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TreasuryTransferMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TreasuryTransfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAsset sets the "asset" field.
+func (m *TreasuryTransferMutation) SetAsset(s string) {
+	m.asset = &s
+}
+
+// Asset returns the value of the "asset" field in the mutation.
+func (m *TreasuryTransferMutation) Asset() (r string, exists bool) {
+	v := m.asset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAsset returns the old "asset" field's value of the TreasuryTransfer entity.
+// If the TreasuryTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryTransferMutation) OldAsset(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAsset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAsset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAsset: %w", err)
+	}
+	return oldValue.Asset, nil
+}
+
+// ResetAsset resets all changes to the "asset" field.
+func (m *TreasuryTransferMutation) ResetAsset() {
+	m.asset = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *TreasuryTransferMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *TreasuryTransferMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the TreasuryTransfer entity.
+// If the TreasuryTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryTransferMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *TreasuryTransferMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetTo sets the "to" field.
+func (m *TreasuryTransferMutation) SetTo(s string) {
+	m.to = &s
+}
+
+// To returns the value of the "to" field in the mutation.
+func (m *TreasuryTransferMutation) To() (r string, exists bool) {
+	v := m.to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTo returns the old "to" field's value of the TreasuryTransfer entity.
+// If the TreasuryTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryTransferMutation) OldTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTo: %w", err)
+	}
+	return oldValue.To, nil
+}
+
+// ResetTo resets all changes to the "to" field.
+func (m *TreasuryTransferMutation) ResetTo() {
+	m.to = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *TreasuryTransferMutation) SetAmount(b *big.Int) {
+	m.amount = &b
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *TreasuryTransferMutation) Amount() (r *big.Int, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the TreasuryTransfer entity.
+// If the TreasuryTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryTransferMutation) OldAmount(ctx context.Context) (v *big.Int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *TreasuryTransferMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetCreateAt sets the "create_at" field.
+func (m *TreasuryTransferMutation) SetCreateAt(t time.Time) {
+	m.create_at = &t
+}
+
+// CreateAt returns the value of the "create_at" field in the mutation.
+func (m *TreasuryTransferMutation) CreateAt() (r time.Time, exists bool) {
+	v := m.create_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateAt returns the old "create_at" field's value of the TreasuryTransfer entity.
+// If the TreasuryTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryTransferMutation) OldCreateAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateAt: %w", err)
+	}
+	return oldValue.CreateAt, nil
+}
+
+// ResetCreateAt resets all changes to the "create_at" field.
+func (m *TreasuryTransferMutation) ResetCreateAt() {
+	m.create_at = nil
+}
+
+// SetFee sets the "fee" field.
+func (m *TreasuryTransferMutation) SetFee(b *big.Int) {
+	m.fee = &b
+}
+
+// Fee returns the value of the "fee" field in the mutation.
+func (m *TreasuryTransferMutation) Fee() (r *big.Int, exists bool) {
+	v := m.fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFee returns the old "fee" field's value of the TreasuryTransfer entity.
+// If the TreasuryTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryTransferMutation) OldFee(ctx context.Context) (v *big.Int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFee: %w", err)
+	}
+	return oldValue.Fee, nil
+}
+
+// ClearFee clears the value of the "fee" field.
+func (m *TreasuryTransferMutation) ClearFee() {
+	m.fee = nil
+	m.clearedFields[treasurytransfer.FieldFee] = struct{}{}
+}
+
+// FeeCleared returns if the "fee" field was cleared in this mutation.
+func (m *TreasuryTransferMutation) FeeCleared() bool {
+	_, ok := m.clearedFields[treasurytransfer.FieldFee]
+	return ok
+}
+
+// ResetFee resets all changes to the "fee" field.
+func (m *TreasuryTransferMutation) ResetFee() {
+	m.fee = nil
+	delete(m.clearedFields, treasurytransfer.FieldFee)
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (m *TreasuryTransferMutation) SetTxHash(s string) {
+	m.tx_hash = &s
+}
+
+// TxHash returns the value of the "tx_hash" field in the mutation.
+func (m *TreasuryTransferMutation) TxHash() (r string, exists bool) {
+	v := m.tx_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxHash returns the old "tx_hash" field's value of the TreasuryTransfer entity.
+// If the TreasuryTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryTransferMutation) OldTxHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxHash: %w", err)
+	}
+	return oldValue.TxHash, nil
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (m *TreasuryTransferMutation) ClearTxHash() {
+	m.tx_hash = nil
+	m.clearedFields[treasurytransfer.FieldTxHash] = struct{}{}
+}
+
+// TxHashCleared returns if the "tx_hash" field was cleared in this mutation.
+func (m *TreasuryTransferMutation) TxHashCleared() bool {
+	_, ok := m.clearedFields[treasurytransfer.FieldTxHash]
+	return ok
+}
+
+// ResetTxHash resets all changes to the "tx_hash" field.
+func (m *TreasuryTransferMutation) ResetTxHash() {
+	m.tx_hash = nil
+	delete(m.clearedFields, treasurytransfer.FieldTxHash)
+}
+
+// SetSendAt sets the "send_at" field.
+func (m *TreasuryTransferMutation) SetSendAt(t time.Time) {
+	m.send_at = &t
+}
+
+// SendAt returns the value of the "send_at" field in the mutation.
+func (m *TreasuryTransferMutation) SendAt() (r time.Time, exists bool) {
+	v := m.send_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSendAt returns the old "send_at" field's value of the TreasuryTransfer entity.
+// If the TreasuryTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryTransferMutation) OldSendAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendAt: %w", err)
+	}
+	return oldValue.SendAt, nil
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (m *TreasuryTransferMutation) ClearSendAt() {
+	m.send_at = nil
+	m.clearedFields[treasurytransfer.FieldSendAt] = struct{}{}
+}
+
+// SendAtCleared returns if the "send_at" field was cleared in this mutation.
+func (m *TreasuryTransferMutation) SendAtCleared() bool {
+	_, ok := m.clearedFields[treasurytransfer.FieldSendAt]
+	return ok
+}
+
+// ResetSendAt resets all changes to the "send_at" field.
+func (m *TreasuryTransferMutation) ResetSendAt() {
+	m.send_at = nil
+	delete(m.clearedFields, treasurytransfer.FieldSendAt)
+}
+
+// SetError sets the "error" field.
+func (m *TreasuryTransferMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *TreasuryTransferMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the TreasuryTransfer entity.
+// If the TreasuryTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreasuryTransferMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *TreasuryTransferMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[treasurytransfer.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *TreasuryTransferMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[treasurytransfer.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *TreasuryTransferMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, treasurytransfer.FieldError)
+}
+
+// Where appends a list predicates to the TreasuryTransferMutation builder.
+func (m *TreasuryTransferMutation) Where(ps ...predicate.TreasuryTransfer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TreasuryTransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TreasuryTransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TreasuryTransfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TreasuryTransferMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TreasuryTransferMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TreasuryTransfer).
+func (m *TreasuryTransferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TreasuryTransferMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.asset != nil {
+		fields = append(fields, treasurytransfer.FieldAsset)
+	}
+	if m.kind != nil {
+		fields = append(fields, treasurytransfer.FieldKind)
+	}
+	if m.to != nil {
+		fields = append(fields, treasurytransfer.FieldTo)
+	}
+	if m.amount != nil {
+		fields = append(fields, treasurytransfer.FieldAmount)
+	}
+	if m.create_at != nil {
+		fields = append(fields, treasurytransfer.FieldCreateAt)
+	}
+	if m.fee != nil {
+		fields = append(fields, treasurytransfer.FieldFee)
+	}
+	if m.tx_hash != nil {
+		fields = append(fields, treasurytransfer.FieldTxHash)
+	}
+	if m.send_at != nil {
+		fields = append(fields, treasurytransfer.FieldSendAt)
+	}
+	if m.error != nil {
+		fields = append(fields, treasurytransfer.FieldError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TreasuryTransferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case treasurytransfer.FieldAsset:
+		return m.Asset()
+	case treasurytransfer.FieldKind:
+		return m.Kind()
+	case treasurytransfer.FieldTo:
+		return m.To()
+	case treasurytransfer.FieldAmount:
+		return m.Amount()
+	case treasurytransfer.FieldCreateAt:
+		return m.CreateAt()
+	case treasurytransfer.FieldFee:
+		return m.Fee()
+	case treasurytransfer.FieldTxHash:
+		return m.TxHash()
+	case treasurytransfer.FieldSendAt:
+		return m.SendAt()
+	case treasurytransfer.FieldError:
+		return m.Error()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TreasuryTransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case treasurytransfer.FieldAsset:
+		return m.OldAsset(ctx)
+	case treasurytransfer.FieldKind:
+		return m.OldKind(ctx)
+	case treasurytransfer.FieldTo:
+		return m.OldTo(ctx)
+	case treasurytransfer.FieldAmount:
+		return m.OldAmount(ctx)
+	case treasurytransfer.FieldCreateAt:
+		return m.OldCreateAt(ctx)
+	case treasurytransfer.FieldFee:
+		return m.OldFee(ctx)
+	case treasurytransfer.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case treasurytransfer.FieldSendAt:
+		return m.OldSendAt(ctx)
+	case treasurytransfer.FieldError:
+		return m.OldError(ctx)
+	}
+	return nil, fmt.Errorf("unknown TreasuryTransfer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TreasuryTransferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case treasurytransfer.FieldAsset:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAsset(v)
+		return nil
+	case treasurytransfer.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case treasurytransfer.FieldTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTo(v)
+		return nil
+	case treasurytransfer.FieldAmount:
+		v, ok := value.(*big.Int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case treasurytransfer.FieldCreateAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateAt(v)
+		return nil
+	case treasurytransfer.FieldFee:
+		v, ok := value.(*big.Int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFee(v)
+		return nil
+	case treasurytransfer.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case treasurytransfer.FieldSendAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendAt(v)
+		return nil
+	case treasurytransfer.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TreasuryTransfer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TreasuryTransferMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TreasuryTransferMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TreasuryTransferMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TreasuryTransfer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TreasuryTransferMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(treasurytransfer.FieldFee) {
+		fields = append(fields, treasurytransfer.FieldFee)
+	}
+	if m.FieldCleared(treasurytransfer.FieldTxHash) {
+		fields = append(fields, treasurytransfer.FieldTxHash)
+	}
+	if m.FieldCleared(treasurytransfer.FieldSendAt) {
+		fields = append(fields, treasurytransfer.FieldSendAt)
+	}
+	if m.FieldCleared(treasurytransfer.FieldError) {
+		fields = append(fields, treasurytransfer.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TreasuryTransferMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TreasuryTransferMutation) ClearField(name string) error {
+	switch name {
+	case treasurytransfer.FieldFee:
+		m.ClearFee()
+		return nil
+	case treasurytransfer.FieldTxHash:
+		m.ClearTxHash()
+		return nil
+	case treasurytransfer.FieldSendAt:
+		m.ClearSendAt()
+		return nil
+	case treasurytransfer.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown TreasuryTransfer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TreasuryTransferMutation) ResetField(name string) error {
+	switch name {
+	case treasurytransfer.FieldAsset:
+		m.ResetAsset()
+		return nil
+	case treasurytransfer.FieldKind:
+		m.ResetKind()
+		return nil
+	case treasurytransfer.FieldTo:
+		m.ResetTo()
+		return nil
+	case treasurytransfer.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case treasurytransfer.FieldCreateAt:
+		m.ResetCreateAt()
+		return nil
+	case treasurytransfer.FieldFee:
+		m.ResetFee()
+		return nil
+	case treasurytransfer.FieldTxHash:
+		m.ResetTxHash()
+		return nil
+	case treasurytransfer.FieldSendAt:
+		m.ResetSendAt()
+		return nil
+	case treasurytransfer.FieldError:
+		m.ResetError()
+		return nil
+	}
+	return fmt.Errorf("unknown TreasuryTransfer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TreasuryTransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TreasuryTransferMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TreasuryTransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TreasuryTransferMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TreasuryTransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TreasuryTransferMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TreasuryTransferMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TreasuryTransfer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TreasuryTransferMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TreasuryTransfer edge %s", name)
+}
+
+// WalletAccountMutation represents an operation that mutates the WalletAccount nodes in the graph.
+type WalletAccountMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	next_index    *int64
+	addnext_index *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WalletAccount, error)
+	predicates    []predicate.WalletAccount
+}
+
+var _ ent.Mutation = (*WalletAccountMutation)(nil)
+
+// walletaccountOption allows management of the mutation configuration using functional options.
+type walletaccountOption func(*WalletAccountMutation)
+
+// newWalletAccountMutation creates new mutation for the WalletAccount entity.
+func newWalletAccountMutation(c config, op Op, opts ...walletaccountOption) *WalletAccountMutation {
+	m := &WalletAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWalletAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWalletAccountID sets the ID field of the mutation.
+func withWalletAccountID(id string) walletaccountOption {
+	return func(m *WalletAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WalletAccount
+		)
+		m.oldValue = func(ctx context.Context) (*WalletAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WalletAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWalletAccount sets the old WalletAccount of the mutation.
+func withWalletAccount(node *WalletAccount) walletaccountOption {
+	return func(m *WalletAccountMutation) {
+		m.oldValue = func(context.Context) (*WalletAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WalletAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WalletAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("database: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WalletAccount entities.
+func (m *WalletAccountMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WalletAccountMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WalletAccountMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WalletAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNextIndex sets the "next_index" field.
+func (m *WalletAccountMutation) SetNextIndex(i int64) {
+	m.next_index = &i
+	m.addnext_index = nil
+}
+
+// NextIndex returns the value of the "next_index" field in the mutation.
+func (m *WalletAccountMutation) NextIndex() (r int64, exists bool) {
+	v := m.next_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextIndex returns the old "next_index" field's value of the WalletAccount entity.
+// If the WalletAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletAccountMutation) OldNextIndex(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextIndex: %w", err)
+	}
+	return oldValue.NextIndex, nil
+}
+
+// AddNextIndex adds i to the "next_index" field.
+func (m *WalletAccountMutation) AddNextIndex(i int64) {
+	if m.addnext_index != nil {
+		*m.addnext_index += i
+	} else {
+		m.addnext_index = &i
+	}
+}
+
+// AddedNextIndex returns the value that was added to the "next_index" field in this mutation.
+func (m *WalletAccountMutation) AddedNextIndex() (r int64, exists bool) {
+	v := m.addnext_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNextIndex resets all changes to the "next_index" field.
+func (m *WalletAccountMutation) ResetNextIndex() {
+	m.next_index = nil
+	m.addnext_index = nil
+}
+
+// Where appends a list predicates to the WalletAccountMutation builder.
+func (m *WalletAccountMutation) Where(ps ...predicate.WalletAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WalletAccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WalletAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WalletAccount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WalletAccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WalletAccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WalletAccount).
+func (m *WalletAccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WalletAccountMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.next_index != nil {
+		fields = append(fields, walletaccount.FieldNextIndex)
 	}
 	return fields
 }
@@ -797,36 +7818,10 @@ func (m *InvoiceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *InvoiceMutation) Field(name string) (ent.Value, bool) {
+func (m *WalletAccountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case invoice.FieldMinAmount:
-		return m.MinAmount()
-	case invoice.FieldRecipient:
-		return m.Recipient()
-	case invoice.FieldBeneficiary:
-		return m.Beneficiary()
-	case invoice.FieldAsset:
-		return m.Asset()
-	case invoice.FieldMetadata:
-		return m.Metadata()
-	case invoice.FieldCreateAt:
-		return m.CreateAt()
-	case invoice.FieldDeadline:
-		return m.Deadline()
-	case invoice.FieldFillAt:
-		return m.FillAt()
-	case invoice.FieldLastCheckoutAt:
-		return m.LastCheckoutAt()
-	case invoice.FieldCheckoutRequestAt:
-		return m.CheckoutRequestAt()
-	case invoice.FieldAutoCheckout:
-		return m.AutoCheckout()
-	case invoice.FieldCancelAt:
-		return m.CancelAt()
-	case invoice.FieldWalletAddress:
-		return m.WalletAddress()
-	case invoice.FieldEncryptedSalt:
-		return m.EncryptedSalt()
+	case walletaccount.FieldNextIndex:
+		return m.NextIndex()
 	}
 	return nil, false
 }
@@ -834,309 +7829,141 @@ func (m *InvoiceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *InvoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WalletAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case invoice.FieldMinAmount:
-		return m.OldMinAmount(ctx)
-	case invoice.FieldRecipient:
-		return m.OldRecipient(ctx)
-	case invoice.FieldBeneficiary:
-		return m.OldBeneficiary(ctx)
-	case invoice.FieldAsset:
-		return m.OldAsset(ctx)
-	case invoice.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case invoice.FieldCreateAt:
-		return m.OldCreateAt(ctx)
-	case invoice.FieldDeadline:
-		return m.OldDeadline(ctx)
-	case invoice.FieldFillAt:
-		return m.OldFillAt(ctx)
-	case invoice.FieldLastCheckoutAt:
-		return m.OldLastCheckoutAt(ctx)
-	case invoice.FieldCheckoutRequestAt:
-		return m.OldCheckoutRequestAt(ctx)
-	case invoice.FieldAutoCheckout:
-		return m.OldAutoCheckout(ctx)
-	case invoice.FieldCancelAt:
-		return m.OldCancelAt(ctx)
-	case invoice.FieldWalletAddress:
-		return m.OldWalletAddress(ctx)
-	case invoice.FieldEncryptedSalt:
-		return m.OldEncryptedSalt(ctx)
+	case walletaccount.FieldNextIndex:
+		return m.OldNextIndex(ctx)
 	}
-	return nil, fmt.Errorf("unknown Invoice field %s", name)
+	return nil, fmt.Errorf("unknown WalletAccount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InvoiceMutation) SetField(name string, value ent.Value) error {
+func (m *WalletAccountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case invoice.FieldMinAmount:
-		v, ok := value.(*big.Int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMinAmount(v)
-		return nil
-	case invoice.FieldRecipient:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRecipient(v)
-		return nil
-	case invoice.FieldBeneficiary:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBeneficiary(v)
-		return nil
-	case invoice.FieldAsset:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAsset(v)
-		return nil
-	case invoice.FieldMetadata:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case invoice.FieldCreateAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateAt(v)
-		return nil
-	case invoice.FieldDeadline:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeadline(v)
-		return nil
-	case invoice.FieldFillAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFillAt(v)
-		return nil
-	case invoice.FieldLastCheckoutAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastCheckoutAt(v)
-		return nil
-	case invoice.FieldCheckoutRequestAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCheckoutRequestAt(v)
-		return nil
-	case invoice.FieldAutoCheckout:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAutoCheckout(v)
-		return nil
-	case invoice.FieldCancelAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCancelAt(v)
-		return nil
-	case invoice.FieldWalletAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWalletAddress(v)
-		return nil
-	case invoice.FieldEncryptedSalt:
-		v, ok := value.([]byte)
+	case walletaccount.FieldNextIndex:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptedSalt(v)
+		m.SetNextIndex(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Invoice field %s", name)
+	return fmt.Errorf("unknown WalletAccount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *InvoiceMutation) AddedFields() []string {
-	return nil
+func (m *WalletAccountMutation) AddedFields() []string {
+	var fields []string
+	if m.addnext_index != nil {
+		fields = append(fields, walletaccount.FieldNextIndex)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *InvoiceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WalletAccountMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case walletaccount.FieldNextIndex:
+		return m.AddedNextIndex()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InvoiceMutation) AddField(name string, value ent.Value) error {
+func (m *WalletAccountMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case walletaccount.FieldNextIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNextIndex(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Invoice numeric field %s", name)
+	return fmt.Errorf("unknown WalletAccount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *InvoiceMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(invoice.FieldFillAt) {
-		fields = append(fields, invoice.FieldFillAt)
-	}
-	if m.FieldCleared(invoice.FieldLastCheckoutAt) {
-		fields = append(fields, invoice.FieldLastCheckoutAt)
-	}
-	if m.FieldCleared(invoice.FieldCheckoutRequestAt) {
-		fields = append(fields, invoice.FieldCheckoutRequestAt)
-	}
-	if m.FieldCleared(invoice.FieldCancelAt) {
-		fields = append(fields, invoice.FieldCancelAt)
-	}
-	return fields
+func (m *WalletAccountMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *InvoiceMutation) FieldCleared(name string) bool {
+func (m *WalletAccountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *InvoiceMutation) ClearField(name string) error {
-	switch name {
-	case invoice.FieldFillAt:
-		m.ClearFillAt()
-		return nil
-	case invoice.FieldLastCheckoutAt:
-		m.ClearLastCheckoutAt()
-		return nil
-	case invoice.FieldCheckoutRequestAt:
-		m.ClearCheckoutRequestAt()
-		return nil
-	case invoice.FieldCancelAt:
-		m.ClearCancelAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Invoice nullable field %s", name)
+func (m *WalletAccountMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WalletAccount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *InvoiceMutation) ResetField(name string) error {
+func (m *WalletAccountMutation) ResetField(name string) error {
 	switch name {
-	case invoice.FieldMinAmount:
-		m.ResetMinAmount()
-		return nil
-	case invoice.FieldRecipient:
-		m.ResetRecipient()
-		return nil
-	case invoice.FieldBeneficiary:
-		m.ResetBeneficiary()
-		return nil
-	case invoice.FieldAsset:
-		m.ResetAsset()
-		return nil
-	case invoice.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case invoice.FieldCreateAt:
-		m.ResetCreateAt()
-		return nil
-	case invoice.FieldDeadline:
-		m.ResetDeadline()
-		return nil
-	case invoice.FieldFillAt:
-		m.ResetFillAt()
-		return nil
-	case invoice.FieldLastCheckoutAt:
-		m.ResetLastCheckoutAt()
-		return nil
-	case invoice.FieldCheckoutRequestAt:
-		m.ResetCheckoutRequestAt()
-		return nil
-	case invoice.FieldAutoCheckout:
-		m.ResetAutoCheckout()
-		return nil
-	case invoice.FieldCancelAt:
-		m.ResetCancelAt()
-		return nil
-	case invoice.FieldWalletAddress:
-		m.ResetWalletAddress()
-		return nil
-	case invoice.FieldEncryptedSalt:
-		m.ResetEncryptedSalt()
+	case walletaccount.FieldNextIndex:
+		m.ResetNextIndex()
 		return nil
 	}
-	return fmt.Errorf("unknown Invoice field %s", name)
+	return fmt.Errorf("unknown WalletAccount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *InvoiceMutation) AddedEdges() []string {
+func (m *WalletAccountMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *InvoiceMutation) AddedIDs(name string) []ent.Value {
+func (m *WalletAccountMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *InvoiceMutation) RemovedEdges() []string {
+func (m *WalletAccountMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *InvoiceMutation) RemovedIDs(name string) []ent.Value {
+func (m *WalletAccountMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *InvoiceMutation) ClearedEdges() []string {
+func (m *WalletAccountMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *InvoiceMutation) EdgeCleared(name string) bool {
+func (m *WalletAccountMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *InvoiceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Invoice unique edge %s", name)
+func (m *WalletAccountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WalletAccount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *InvoiceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Invoice edge %s", name)
+func (m *WalletAccountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WalletAccount edge %s", name)
 }
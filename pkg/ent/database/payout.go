@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"cpg/pkg/ent/database/payout"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Payout is the model entity for the Payout schema.
+type Payout struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// IdempotencyKey holds the value of the "idempotency_key" field.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	// Asset holds the value of the "asset" field.
+	Asset string `json:"asset,omitempty"`
+	// To holds the value of the "to" field.
+	To string `json:"to,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount *big.Int `json:"amount,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreateAt holds the value of the "create_at" field.
+	CreateAt time.Time `json:"create_at,omitempty"`
+	// Fee holds the value of the "fee" field.
+	Fee *big.Int `json:"fee,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// SendAt holds the value of the "send_at" field.
+	SendAt *time.Time `json:"send_at,omitempty"`
+	// ConfirmAt holds the value of the "confirm_at" field.
+	ConfirmAt *time.Time `json:"confirm_at,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// NotifiedStatus holds the value of the "notified_status" field.
+	NotifiedStatus string `json:"notified_status,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Payout) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payout.FieldID, payout.FieldIdempotencyKey, payout.FieldAsset, payout.FieldTo, payout.FieldReference, payout.FieldStatus, payout.FieldTxHash, payout.FieldError, payout.FieldNotifiedStatus:
+			values[i] = new(sql.NullString)
+		case payout.FieldCreateAt, payout.FieldSendAt, payout.FieldConfirmAt:
+			values[i] = new(sql.NullTime)
+		case payout.FieldAmount:
+			values[i] = payout.ValueScanner.Amount.ScanValue()
+		case payout.FieldFee:
+			values[i] = payout.ValueScanner.Fee.ScanValue()
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Payout fields.
+func (pa *Payout) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payout.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pa.ID = value.String
+			}
+		case payout.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				pa.IdempotencyKey = value.String
+			}
+		case payout.FieldAsset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset", values[i])
+			} else if value.Valid {
+				pa.Asset = value.String
+			}
+		case payout.FieldTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to", values[i])
+			} else if value.Valid {
+				pa.To = value.String
+			}
+		case payout.FieldAmount:
+			if value, err := payout.ValueScanner.Amount.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				pa.Amount = value
+			}
+		case payout.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				pa.Reference = value.String
+			}
+		case payout.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pa.Status = value.String
+			}
+		case payout.FieldCreateAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_at", values[i])
+			} else if value.Valid {
+				pa.CreateAt = value.Time
+			}
+		case payout.FieldFee:
+			if value, err := payout.ValueScanner.Fee.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				pa.Fee = value
+			}
+		case payout.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				pa.TxHash = value.String
+			}
+		case payout.FieldSendAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field send_at", values[i])
+			} else if value.Valid {
+				pa.SendAt = new(time.Time)
+				*pa.SendAt = value.Time
+			}
+		case payout.FieldConfirmAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field confirm_at", values[i])
+			} else if value.Valid {
+				pa.ConfirmAt = new(time.Time)
+				*pa.ConfirmAt = value.Time
+			}
+		case payout.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				pa.Error = value.String
+			}
+		case payout.FieldNotifiedStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notified_status", values[i])
+			} else if value.Valid {
+				pa.NotifiedStatus = value.String
+			}
+		default:
+			pa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Payout.
+// This includes values selected through modifiers, order, etc.
+func (pa *Payout) Value(name string) (ent.Value, error) {
+	return pa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Payout.
+// Note that you need to call Payout.Unwrap() before calling this method if this Payout
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *Payout) Update() *PayoutUpdateOne {
+	return NewPayoutClient(pa.config).UpdateOne(pa)
+}
+
+// Unwrap unwraps the Payout entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *Payout) Unwrap() *Payout {
+	_tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("database: Payout is not a transactional entity")
+	}
+	pa.config.driver = _tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *Payout) String() string {
+	var builder strings.Builder
+	builder.WriteString("Payout(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("idempotency_key=")
+	builder.WriteString(pa.IdempotencyKey)
+	builder.WriteString(", ")
+	builder.WriteString("asset=")
+	builder.WriteString(pa.Asset)
+	builder.WriteString(", ")
+	builder.WriteString("to=")
+	builder.WriteString(pa.To)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(pa.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(pa.Status)
+	builder.WriteString(", ")
+	builder.WriteString("create_at=")
+	builder.WriteString(pa.CreateAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("fee=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Fee))
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(pa.TxHash)
+	builder.WriteString(", ")
+	if v := pa.SendAt; v != nil {
+		builder.WriteString("send_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pa.ConfirmAt; v != nil {
+		builder.WriteString("confirm_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(pa.Error)
+	builder.WriteString(", ")
+	builder.WriteString("notified_status=")
+	builder.WriteString(pa.NotifiedStatus)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Payouts is a parsable slice of Payout.
+type Payouts []*Payout
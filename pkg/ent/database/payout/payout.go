@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payout
+
+import (
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+const (
+	// Label holds the string label denoting the payout type in the database.
+	Label = "payout"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
+	// FieldAsset holds the string denoting the asset field in the database.
+	FieldAsset = "asset"
+	// FieldTo holds the string denoting the to field in the database.
+	FieldTo = "to"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreateAt holds the string denoting the create_at field in the database.
+	FieldCreateAt = "create_at"
+	// FieldFee holds the string denoting the fee field in the database.
+	FieldFee = "fee"
+	// FieldTxHash holds the string denoting the tx_hash field in the database.
+	FieldTxHash = "tx_hash"
+	// FieldSendAt holds the string denoting the send_at field in the database.
+	FieldSendAt = "send_at"
+	// FieldConfirmAt holds the string denoting the confirm_at field in the database.
+	FieldConfirmAt = "confirm_at"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldNotifiedStatus holds the string denoting the notified_status field in the database.
+	FieldNotifiedStatus = "notified_status"
+	// Table holds the table name of the payout in the database.
+	Table = "payouts"
+)
+
+// Columns holds all SQL columns for payout fields.
+var Columns = []string{
+	FieldID,
+	FieldIdempotencyKey,
+	FieldAsset,
+	FieldTo,
+	FieldAmount,
+	FieldReference,
+	FieldStatus,
+	FieldCreateAt,
+	FieldFee,
+	FieldTxHash,
+	FieldSendAt,
+	FieldConfirmAt,
+	FieldError,
+	FieldNotifiedStatus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	IdempotencyKeyValidator func(string) error
+	// AssetValidator is a validator for the "asset" field. It is called by the builders before save.
+	AssetValidator func(string) error
+	// ToValidator is a validator for the "to" field. It is called by the builders before save.
+	ToValidator func(string) error
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(string) error
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultCreateAt holds the default value on creation for the "create_at" field.
+	DefaultCreateAt func() time.Time
+	// DefaultNotifiedStatus holds the default value on creation for the "notified_status" field.
+	DefaultNotifiedStatus string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+	// ValueScanner of all Payout fields.
+	ValueScanner struct {
+		Amount field.TypeValueScanner[*big.Int]
+		Fee    field.TypeValueScanner[*big.Int]
+	}
+)
+
+// OrderOption defines the ordering options for the Payout queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
+// ByAsset orders the results by the asset field.
+func ByAsset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAsset, opts...).ToFunc()
+}
+
+// ByTo orders the results by the to field.
+func ByTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTo, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreateAt orders the results by the create_at field.
+func ByCreateAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateAt, opts...).ToFunc()
+}
+
+// ByFee orders the results by the fee field.
+func ByFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFee, opts...).ToFunc()
+}
+
+// ByTxHash orders the results by the tx_hash field.
+func ByTxHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxHash, opts...).ToFunc()
+}
+
+// BySendAt orders the results by the send_at field.
+func BySendAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSendAt, opts...).ToFunc()
+}
+
+// ByConfirmAt orders the results by the confirm_at field.
+func ByConfirmAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfirmAt, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByNotifiedStatus orders the results by the notified_status field.
+func ByNotifiedStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifiedStatus, opts...).ToFunc()
+}
@@ -0,0 +1,1077 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payout
+
+import (
+	"cpg/pkg/ent/database/predicate"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Payout {
+	return predicate.Payout(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Payout {
+	return predicate.Payout(sql.FieldContainsFold(FieldID, id))
+}
+
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// Asset applies equality check predicate on the "asset" field. It's identical to AssetEQ.
+func Asset(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldAsset, v))
+}
+
+// To applies equality check predicate on the "to" field. It's identical to ToEQ.
+func To(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldTo, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.PayoutOrErr(sql.FieldEQ(FieldAmount, vc), err)
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldReference, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreateAt applies equality check predicate on the "create_at" field. It's identical to CreateAtEQ.
+func CreateAt(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// Fee applies equality check predicate on the "fee" field. It's identical to FeeEQ.
+func Fee(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.PayoutOrErr(sql.FieldEQ(FieldFee, vc), err)
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldTxHash, v))
+}
+
+// SendAt applies equality check predicate on the "send_at" field. It's identical to SendAtEQ.
+func SendAt(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldSendAt, v))
+}
+
+// ConfirmAt applies equality check predicate on the "confirm_at" field. It's identical to ConfirmAtEQ.
+func ConfirmAt(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldConfirmAt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldError, v))
+}
+
+// NotifiedStatus applies equality check predicate on the "notified_status" field. It's identical to NotifiedStatusEQ.
+func NotifiedStatus(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldNotifiedStatus, v))
+}
+
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
+// AssetEQ applies the EQ predicate on the "asset" field.
+func AssetEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldAsset, v))
+}
+
+// AssetNEQ applies the NEQ predicate on the "asset" field.
+func AssetNEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldAsset, v))
+}
+
+// AssetIn applies the In predicate on the "asset" field.
+func AssetIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldAsset, vs...))
+}
+
+// AssetNotIn applies the NotIn predicate on the "asset" field.
+func AssetNotIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldAsset, vs...))
+}
+
+// AssetGT applies the GT predicate on the "asset" field.
+func AssetGT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldAsset, v))
+}
+
+// AssetGTE applies the GTE predicate on the "asset" field.
+func AssetGTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldAsset, v))
+}
+
+// AssetLT applies the LT predicate on the "asset" field.
+func AssetLT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldAsset, v))
+}
+
+// AssetLTE applies the LTE predicate on the "asset" field.
+func AssetLTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldAsset, v))
+}
+
+// AssetContains applies the Contains predicate on the "asset" field.
+func AssetContains(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContains(FieldAsset, v))
+}
+
+// AssetHasPrefix applies the HasPrefix predicate on the "asset" field.
+func AssetHasPrefix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasPrefix(FieldAsset, v))
+}
+
+// AssetHasSuffix applies the HasSuffix predicate on the "asset" field.
+func AssetHasSuffix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasSuffix(FieldAsset, v))
+}
+
+// AssetEqualFold applies the EqualFold predicate on the "asset" field.
+func AssetEqualFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEqualFold(FieldAsset, v))
+}
+
+// AssetContainsFold applies the ContainsFold predicate on the "asset" field.
+func AssetContainsFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContainsFold(FieldAsset, v))
+}
+
+// ToEQ applies the EQ predicate on the "to" field.
+func ToEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldTo, v))
+}
+
+// ToNEQ applies the NEQ predicate on the "to" field.
+func ToNEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldTo, v))
+}
+
+// ToIn applies the In predicate on the "to" field.
+func ToIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldTo, vs...))
+}
+
+// ToNotIn applies the NotIn predicate on the "to" field.
+func ToNotIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldTo, vs...))
+}
+
+// ToGT applies the GT predicate on the "to" field.
+func ToGT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldTo, v))
+}
+
+// ToGTE applies the GTE predicate on the "to" field.
+func ToGTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldTo, v))
+}
+
+// ToLT applies the LT predicate on the "to" field.
+func ToLT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldTo, v))
+}
+
+// ToLTE applies the LTE predicate on the "to" field.
+func ToLTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldTo, v))
+}
+
+// ToContains applies the Contains predicate on the "to" field.
+func ToContains(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContains(FieldTo, v))
+}
+
+// ToHasPrefix applies the HasPrefix predicate on the "to" field.
+func ToHasPrefix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasPrefix(FieldTo, v))
+}
+
+// ToHasSuffix applies the HasSuffix predicate on the "to" field.
+func ToHasSuffix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasSuffix(FieldTo, v))
+}
+
+// ToEqualFold applies the EqualFold predicate on the "to" field.
+func ToEqualFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEqualFold(FieldTo, v))
+}
+
+// ToContainsFold applies the ContainsFold predicate on the "to" field.
+func ToContainsFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContainsFold(FieldTo, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.PayoutOrErr(sql.FieldEQ(FieldAmount, vc), err)
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.PayoutOrErr(sql.FieldNEQ(FieldAmount, vc), err)
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...*big.Int) predicate.Payout {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Amount.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.PayoutOrErr(sql.FieldIn(FieldAmount, v...), err)
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...*big.Int) predicate.Payout {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Amount.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.PayoutOrErr(sql.FieldNotIn(FieldAmount, v...), err)
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.PayoutOrErr(sql.FieldGT(FieldAmount, vc), err)
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.PayoutOrErr(sql.FieldGTE(FieldAmount, vc), err)
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.PayoutOrErr(sql.FieldLT(FieldAmount, vc), err)
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.PayoutOrErr(sql.FieldLTE(FieldAmount, vc), err)
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldContains(FieldAmount, vcs), err)
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldHasPrefix(FieldAmount, vcs), err)
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldHasSuffix(FieldAmount, vcs), err)
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldEqualFold(FieldAmount, vcs), err)
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldContainsFold(FieldAmount, vcs), err)
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.Payout {
+	return predicate.Payout(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.Payout {
+	return predicate.Payout(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContainsFold(FieldReference, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreateAtEQ applies the EQ predicate on the "create_at" field.
+func CreateAtEQ(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// CreateAtNEQ applies the NEQ predicate on the "create_at" field.
+func CreateAtNEQ(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldCreateAt, v))
+}
+
+// CreateAtIn applies the In predicate on the "create_at" field.
+func CreateAtIn(vs ...time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldCreateAt, vs...))
+}
+
+// CreateAtNotIn applies the NotIn predicate on the "create_at" field.
+func CreateAtNotIn(vs ...time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldCreateAt, vs...))
+}
+
+// CreateAtGT applies the GT predicate on the "create_at" field.
+func CreateAtGT(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldCreateAt, v))
+}
+
+// CreateAtGTE applies the GTE predicate on the "create_at" field.
+func CreateAtGTE(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldCreateAt, v))
+}
+
+// CreateAtLT applies the LT predicate on the "create_at" field.
+func CreateAtLT(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldCreateAt, v))
+}
+
+// CreateAtLTE applies the LTE predicate on the "create_at" field.
+func CreateAtLTE(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldCreateAt, v))
+}
+
+// FeeEQ applies the EQ predicate on the "fee" field.
+func FeeEQ(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.PayoutOrErr(sql.FieldEQ(FieldFee, vc), err)
+}
+
+// FeeNEQ applies the NEQ predicate on the "fee" field.
+func FeeNEQ(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.PayoutOrErr(sql.FieldNEQ(FieldFee, vc), err)
+}
+
+// FeeIn applies the In predicate on the "fee" field.
+func FeeIn(vs ...*big.Int) predicate.Payout {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Fee.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.PayoutOrErr(sql.FieldIn(FieldFee, v...), err)
+}
+
+// FeeNotIn applies the NotIn predicate on the "fee" field.
+func FeeNotIn(vs ...*big.Int) predicate.Payout {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Fee.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.PayoutOrErr(sql.FieldNotIn(FieldFee, v...), err)
+}
+
+// FeeGT applies the GT predicate on the "fee" field.
+func FeeGT(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.PayoutOrErr(sql.FieldGT(FieldFee, vc), err)
+}
+
+// FeeGTE applies the GTE predicate on the "fee" field.
+func FeeGTE(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.PayoutOrErr(sql.FieldGTE(FieldFee, vc), err)
+}
+
+// FeeLT applies the LT predicate on the "fee" field.
+func FeeLT(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.PayoutOrErr(sql.FieldLT(FieldFee, vc), err)
+}
+
+// FeeLTE applies the LTE predicate on the "fee" field.
+func FeeLTE(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.PayoutOrErr(sql.FieldLTE(FieldFee, vc), err)
+}
+
+// FeeContains applies the Contains predicate on the "fee" field.
+func FeeContains(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldContains(FieldFee, vcs), err)
+}
+
+// FeeHasPrefix applies the HasPrefix predicate on the "fee" field.
+func FeeHasPrefix(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldHasPrefix(FieldFee, vcs), err)
+}
+
+// FeeHasSuffix applies the HasSuffix predicate on the "fee" field.
+func FeeHasSuffix(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldHasSuffix(FieldFee, vcs), err)
+}
+
+// FeeIsNil applies the IsNil predicate on the "fee" field.
+func FeeIsNil() predicate.Payout {
+	return predicate.Payout(sql.FieldIsNull(FieldFee))
+}
+
+// FeeNotNil applies the NotNil predicate on the "fee" field.
+func FeeNotNil() predicate.Payout {
+	return predicate.Payout(sql.FieldNotNull(FieldFee))
+}
+
+// FeeEqualFold applies the EqualFold predicate on the "fee" field.
+func FeeEqualFold(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldEqualFold(FieldFee, vcs), err)
+}
+
+// FeeContainsFold applies the ContainsFold predicate on the "fee" field.
+func FeeContainsFold(v *big.Int) predicate.Payout {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.PayoutOrErr(sql.FieldContainsFold(FieldFee, vcs), err)
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashIsNil applies the IsNil predicate on the "tx_hash" field.
+func TxHashIsNil() predicate.Payout {
+	return predicate.Payout(sql.FieldIsNull(FieldTxHash))
+}
+
+// TxHashNotNil applies the NotNil predicate on the "tx_hash" field.
+func TxHashNotNil() predicate.Payout {
+	return predicate.Payout(sql.FieldNotNull(FieldTxHash))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// SendAtEQ applies the EQ predicate on the "send_at" field.
+func SendAtEQ(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldSendAt, v))
+}
+
+// SendAtNEQ applies the NEQ predicate on the "send_at" field.
+func SendAtNEQ(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldSendAt, v))
+}
+
+// SendAtIn applies the In predicate on the "send_at" field.
+func SendAtIn(vs ...time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldSendAt, vs...))
+}
+
+// SendAtNotIn applies the NotIn predicate on the "send_at" field.
+func SendAtNotIn(vs ...time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldSendAt, vs...))
+}
+
+// SendAtGT applies the GT predicate on the "send_at" field.
+func SendAtGT(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldSendAt, v))
+}
+
+// SendAtGTE applies the GTE predicate on the "send_at" field.
+func SendAtGTE(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldSendAt, v))
+}
+
+// SendAtLT applies the LT predicate on the "send_at" field.
+func SendAtLT(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldSendAt, v))
+}
+
+// SendAtLTE applies the LTE predicate on the "send_at" field.
+func SendAtLTE(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldSendAt, v))
+}
+
+// SendAtIsNil applies the IsNil predicate on the "send_at" field.
+func SendAtIsNil() predicate.Payout {
+	return predicate.Payout(sql.FieldIsNull(FieldSendAt))
+}
+
+// SendAtNotNil applies the NotNil predicate on the "send_at" field.
+func SendAtNotNil() predicate.Payout {
+	return predicate.Payout(sql.FieldNotNull(FieldSendAt))
+}
+
+// ConfirmAtEQ applies the EQ predicate on the "confirm_at" field.
+func ConfirmAtEQ(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldConfirmAt, v))
+}
+
+// ConfirmAtNEQ applies the NEQ predicate on the "confirm_at" field.
+func ConfirmAtNEQ(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldConfirmAt, v))
+}
+
+// ConfirmAtIn applies the In predicate on the "confirm_at" field.
+func ConfirmAtIn(vs ...time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldConfirmAt, vs...))
+}
+
+// ConfirmAtNotIn applies the NotIn predicate on the "confirm_at" field.
+func ConfirmAtNotIn(vs ...time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldConfirmAt, vs...))
+}
+
+// ConfirmAtGT applies the GT predicate on the "confirm_at" field.
+func ConfirmAtGT(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldConfirmAt, v))
+}
+
+// ConfirmAtGTE applies the GTE predicate on the "confirm_at" field.
+func ConfirmAtGTE(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldConfirmAt, v))
+}
+
+// ConfirmAtLT applies the LT predicate on the "confirm_at" field.
+func ConfirmAtLT(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldConfirmAt, v))
+}
+
+// ConfirmAtLTE applies the LTE predicate on the "confirm_at" field.
+func ConfirmAtLTE(v time.Time) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldConfirmAt, v))
+}
+
+// ConfirmAtIsNil applies the IsNil predicate on the "confirm_at" field.
+func ConfirmAtIsNil() predicate.Payout {
+	return predicate.Payout(sql.FieldIsNull(FieldConfirmAt))
+}
+
+// ConfirmAtNotNil applies the NotNil predicate on the "confirm_at" field.
+func ConfirmAtNotNil() predicate.Payout {
+	return predicate.Payout(sql.FieldNotNull(FieldConfirmAt))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.Payout {
+	return predicate.Payout(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.Payout {
+	return predicate.Payout(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContainsFold(FieldError, v))
+}
+
+// NotifiedStatusEQ applies the EQ predicate on the "notified_status" field.
+func NotifiedStatusEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEQ(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusNEQ applies the NEQ predicate on the "notified_status" field.
+func NotifiedStatusNEQ(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldNEQ(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusIn applies the In predicate on the "notified_status" field.
+func NotifiedStatusIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldIn(FieldNotifiedStatus, vs...))
+}
+
+// NotifiedStatusNotIn applies the NotIn predicate on the "notified_status" field.
+func NotifiedStatusNotIn(vs ...string) predicate.Payout {
+	return predicate.Payout(sql.FieldNotIn(FieldNotifiedStatus, vs...))
+}
+
+// NotifiedStatusGT applies the GT predicate on the "notified_status" field.
+func NotifiedStatusGT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGT(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusGTE applies the GTE predicate on the "notified_status" field.
+func NotifiedStatusGTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldGTE(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusLT applies the LT predicate on the "notified_status" field.
+func NotifiedStatusLT(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLT(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusLTE applies the LTE predicate on the "notified_status" field.
+func NotifiedStatusLTE(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldLTE(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusContains applies the Contains predicate on the "notified_status" field.
+func NotifiedStatusContains(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContains(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusHasPrefix applies the HasPrefix predicate on the "notified_status" field.
+func NotifiedStatusHasPrefix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasPrefix(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusHasSuffix applies the HasSuffix predicate on the "notified_status" field.
+func NotifiedStatusHasSuffix(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldHasSuffix(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusEqualFold applies the EqualFold predicate on the "notified_status" field.
+func NotifiedStatusEqualFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldEqualFold(FieldNotifiedStatus, v))
+}
+
+// NotifiedStatusContainsFold applies the ContainsFold predicate on the "notified_status" field.
+func NotifiedStatusContainsFold(v string) predicate.Payout {
+	return predicate.Payout(sql.FieldContainsFold(FieldNotifiedStatus, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Payout) predicate.Payout {
+	return predicate.Payout(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Payout) predicate.Payout {
+	return predicate.Payout(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Payout) predicate.Payout {
+	return predicate.Payout(sql.NotPredicates(p))
+}
@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/payout"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayoutCreate is the builder for creating a Payout entity.
+type PayoutCreate struct {
+	config
+	mutation *PayoutMutation
+	hooks    []Hook
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (pc *PayoutCreate) SetIdempotencyKey(s string) *PayoutCreate {
+	pc.mutation.SetIdempotencyKey(s)
+	return pc
+}
+
+// SetAsset sets the "asset" field.
+func (pc *PayoutCreate) SetAsset(s string) *PayoutCreate {
+	pc.mutation.SetAsset(s)
+	return pc
+}
+
+// SetTo sets the "to" field.
+func (pc *PayoutCreate) SetTo(s string) *PayoutCreate {
+	pc.mutation.SetTo(s)
+	return pc
+}
+
+// SetAmount sets the "amount" field.
+func (pc *PayoutCreate) SetAmount(b *big.Int) *PayoutCreate {
+	pc.mutation.SetAmount(b)
+	return pc
+}
+
+// SetReference sets the "reference" field.
+func (pc *PayoutCreate) SetReference(s string) *PayoutCreate {
+	pc.mutation.SetReference(s)
+	return pc
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (pc *PayoutCreate) SetNillableReference(s *string) *PayoutCreate {
+	if s != nil {
+		pc.SetReference(*s)
+	}
+	return pc
+}
+
+// SetStatus sets the "status" field.
+func (pc *PayoutCreate) SetStatus(s string) *PayoutCreate {
+	pc.mutation.SetStatus(s)
+	return pc
+}
+
+// SetCreateAt sets the "create_at" field.
+func (pc *PayoutCreate) SetCreateAt(t time.Time) *PayoutCreate {
+	pc.mutation.SetCreateAt(t)
+	return pc
+}
+
+// SetNillableCreateAt sets the "create_at" field if the given value is not nil.
+func (pc *PayoutCreate) SetNillableCreateAt(t *time.Time) *PayoutCreate {
+	if t != nil {
+		pc.SetCreateAt(*t)
+	}
+	return pc
+}
+
+// SetFee sets the "fee" field.
+func (pc *PayoutCreate) SetFee(b *big.Int) *PayoutCreate {
+	pc.mutation.SetFee(b)
+	return pc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (pc *PayoutCreate) SetTxHash(s string) *PayoutCreate {
+	pc.mutation.SetTxHash(s)
+	return pc
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (pc *PayoutCreate) SetNillableTxHash(s *string) *PayoutCreate {
+	if s != nil {
+		pc.SetTxHash(*s)
+	}
+	return pc
+}
+
+// SetSendAt sets the "send_at" field.
+func (pc *PayoutCreate) SetSendAt(t time.Time) *PayoutCreate {
+	pc.mutation.SetSendAt(t)
+	return pc
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (pc *PayoutCreate) SetNillableSendAt(t *time.Time) *PayoutCreate {
+	if t != nil {
+		pc.SetSendAt(*t)
+	}
+	return pc
+}
+
+// SetConfirmAt sets the "confirm_at" field.
+func (pc *PayoutCreate) SetConfirmAt(t time.Time) *PayoutCreate {
+	pc.mutation.SetConfirmAt(t)
+	return pc
+}
+
+// SetNillableConfirmAt sets the "confirm_at" field if the given value is not nil.
+func (pc *PayoutCreate) SetNillableConfirmAt(t *time.Time) *PayoutCreate {
+	if t != nil {
+		pc.SetConfirmAt(*t)
+	}
+	return pc
+}
+
+// SetError sets the "error" field.
+func (pc *PayoutCreate) SetError(s string) *PayoutCreate {
+	pc.mutation.SetError(s)
+	return pc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (pc *PayoutCreate) SetNillableError(s *string) *PayoutCreate {
+	if s != nil {
+		pc.SetError(*s)
+	}
+	return pc
+}
+
+// SetNotifiedStatus sets the "notified_status" field.
+func (pc *PayoutCreate) SetNotifiedStatus(s string) *PayoutCreate {
+	pc.mutation.SetNotifiedStatus(s)
+	return pc
+}
+
+// SetNillableNotifiedStatus sets the "notified_status" field if the given value is not nil.
+func (pc *PayoutCreate) SetNillableNotifiedStatus(s *string) *PayoutCreate {
+	if s != nil {
+		pc.SetNotifiedStatus(*s)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PayoutCreate) SetID(s string) *PayoutCreate {
+	pc.mutation.SetID(s)
+	return pc
+}
+
+// Mutation returns the PayoutMutation object of the builder.
+func (pc *PayoutCreate) Mutation() *PayoutMutation {
+	return pc.mutation
+}
+
+// Save creates the Payout in the database.
+func (pc *PayoutCreate) Save(ctx context.Context) (*Payout, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PayoutCreate) SaveX(ctx context.Context) *Payout {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PayoutCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PayoutCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PayoutCreate) defaults() {
+	if _, ok := pc.mutation.CreateAt(); !ok {
+		v := payout.DefaultCreateAt()
+		pc.mutation.SetCreateAt(v)
+	}
+	if _, ok := pc.mutation.NotifiedStatus(); !ok {
+		v := payout.DefaultNotifiedStatus
+		pc.mutation.SetNotifiedStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PayoutCreate) check() error {
+	if _, ok := pc.mutation.IdempotencyKey(); !ok {
+		return &ValidationError{Name: "idempotency_key", err: errors.New(`database: missing required field "Payout.idempotency_key"`)}
+	}
+	if v, ok := pc.mutation.IdempotencyKey(); ok {
+		if err := payout.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`database: validator failed for field "Payout.idempotency_key": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Asset(); !ok {
+		return &ValidationError{Name: "asset", err: errors.New(`database: missing required field "Payout.asset"`)}
+	}
+	if v, ok := pc.mutation.Asset(); ok {
+		if err := payout.AssetValidator(v); err != nil {
+			return &ValidationError{Name: "asset", err: fmt.Errorf(`database: validator failed for field "Payout.asset": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.To(); !ok {
+		return &ValidationError{Name: "to", err: errors.New(`database: missing required field "Payout.to"`)}
+	}
+	if v, ok := pc.mutation.To(); ok {
+		if err := payout.ToValidator(v); err != nil {
+			return &ValidationError{Name: "to", err: fmt.Errorf(`database: validator failed for field "Payout.to": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`database: missing required field "Payout.amount"`)}
+	}
+	if v, ok := pc.mutation.Amount(); ok {
+		if err := payout.AmountValidator(v.String()); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`database: validator failed for field "Payout.amount": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`database: missing required field "Payout.status"`)}
+	}
+	if v, ok := pc.mutation.Status(); ok {
+		if err := payout.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`database: validator failed for field "Payout.status": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CreateAt(); !ok {
+		return &ValidationError{Name: "create_at", err: errors.New(`database: missing required field "Payout.create_at"`)}
+	}
+	if _, ok := pc.mutation.NotifiedStatus(); !ok {
+		return &ValidationError{Name: "notified_status", err: errors.New(`database: missing required field "Payout.notified_status"`)}
+	}
+	if v, ok := pc.mutation.ID(); ok {
+		if err := payout.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`database: validator failed for field "Payout.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pc *PayoutCreate) sqlSave(ctx context.Context) (*Payout, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec, err := pc.createSpec()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Payout.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PayoutCreate) createSpec() (*Payout, *sqlgraph.CreateSpec, error) {
+	var (
+		_node = &Payout{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(payout.Table, sqlgraph.NewFieldSpec(payout.FieldID, field.TypeString))
+	)
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pc.mutation.IdempotencyKey(); ok {
+		_spec.SetField(payout.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = value
+	}
+	if value, ok := pc.mutation.Asset(); ok {
+		_spec.SetField(payout.FieldAsset, field.TypeString, value)
+		_node.Asset = value
+	}
+	if value, ok := pc.mutation.To(); ok {
+		_spec.SetField(payout.FieldTo, field.TypeString, value)
+		_node.To = value
+	}
+	if value, ok := pc.mutation.Amount(); ok {
+		vv, err := payout.ValueScanner.Amount.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(payout.FieldAmount, field.TypeString, vv)
+		_node.Amount = value
+	}
+	if value, ok := pc.mutation.Reference(); ok {
+		_spec.SetField(payout.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := pc.mutation.Status(); ok {
+		_spec.SetField(payout.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := pc.mutation.CreateAt(); ok {
+		_spec.SetField(payout.FieldCreateAt, field.TypeTime, value)
+		_node.CreateAt = value
+	}
+	if value, ok := pc.mutation.Fee(); ok {
+		vv, err := payout.ValueScanner.Fee.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(payout.FieldFee, field.TypeString, vv)
+		_node.Fee = value
+	}
+	if value, ok := pc.mutation.TxHash(); ok {
+		_spec.SetField(payout.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := pc.mutation.SendAt(); ok {
+		_spec.SetField(payout.FieldSendAt, field.TypeTime, value)
+		_node.SendAt = &value
+	}
+	if value, ok := pc.mutation.ConfirmAt(); ok {
+		_spec.SetField(payout.FieldConfirmAt, field.TypeTime, value)
+		_node.ConfirmAt = &value
+	}
+	if value, ok := pc.mutation.Error(); ok {
+		_spec.SetField(payout.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := pc.mutation.NotifiedStatus(); ok {
+		_spec.SetField(payout.FieldNotifiedStatus, field.TypeString, value)
+		_node.NotifiedStatus = value
+	}
+	return _node, _spec, nil
+}
+
+// PayoutCreateBulk is the builder for creating many Payout entities in bulk.
+type PayoutCreateBulk struct {
+	config
+	err      error
+	builders []*PayoutCreate
+}
+
+// Save creates the Payout entities in the database.
+func (pcb *PayoutCreateBulk) Save(ctx context.Context) ([]*Payout, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Payout, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayoutMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PayoutCreateBulk) SaveX(ctx context.Context) []*Payout {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PayoutCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PayoutCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
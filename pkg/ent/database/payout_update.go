@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/payout"
+	"cpg/pkg/ent/database/predicate"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayoutUpdate is the builder for updating Payout entities.
+type PayoutUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayoutMutation
+}
+
+// Where appends a list predicates to the PayoutUpdate builder.
+func (pu *PayoutUpdate) Where(ps ...predicate.Payout) *PayoutUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetStatus sets the "status" field.
+func (pu *PayoutUpdate) SetStatus(s string) *PayoutUpdate {
+	pu.mutation.SetStatus(s)
+	return pu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pu *PayoutUpdate) SetNillableStatus(s *string) *PayoutUpdate {
+	if s != nil {
+		pu.SetStatus(*s)
+	}
+	return pu
+}
+
+// SetFee sets the "fee" field.
+func (pu *PayoutUpdate) SetFee(b *big.Int) *PayoutUpdate {
+	pu.mutation.SetFee(b)
+	return pu
+}
+
+// ClearFee clears the value of the "fee" field.
+func (pu *PayoutUpdate) ClearFee() *PayoutUpdate {
+	pu.mutation.ClearFee()
+	return pu
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (pu *PayoutUpdate) SetTxHash(s string) *PayoutUpdate {
+	pu.mutation.SetTxHash(s)
+	return pu
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (pu *PayoutUpdate) SetNillableTxHash(s *string) *PayoutUpdate {
+	if s != nil {
+		pu.SetTxHash(*s)
+	}
+	return pu
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (pu *PayoutUpdate) ClearTxHash() *PayoutUpdate {
+	pu.mutation.ClearTxHash()
+	return pu
+}
+
+// SetSendAt sets the "send_at" field.
+func (pu *PayoutUpdate) SetSendAt(t time.Time) *PayoutUpdate {
+	pu.mutation.SetSendAt(t)
+	return pu
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (pu *PayoutUpdate) SetNillableSendAt(t *time.Time) *PayoutUpdate {
+	if t != nil {
+		pu.SetSendAt(*t)
+	}
+	return pu
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (pu *PayoutUpdate) ClearSendAt() *PayoutUpdate {
+	pu.mutation.ClearSendAt()
+	return pu
+}
+
+// SetConfirmAt sets the "confirm_at" field.
+func (pu *PayoutUpdate) SetConfirmAt(t time.Time) *PayoutUpdate {
+	pu.mutation.SetConfirmAt(t)
+	return pu
+}
+
+// SetNillableConfirmAt sets the "confirm_at" field if the given value is not nil.
+func (pu *PayoutUpdate) SetNillableConfirmAt(t *time.Time) *PayoutUpdate {
+	if t != nil {
+		pu.SetConfirmAt(*t)
+	}
+	return pu
+}
+
+// ClearConfirmAt clears the value of the "confirm_at" field.
+func (pu *PayoutUpdate) ClearConfirmAt() *PayoutUpdate {
+	pu.mutation.ClearConfirmAt()
+	return pu
+}
+
+// SetError sets the "error" field.
+func (pu *PayoutUpdate) SetError(s string) *PayoutUpdate {
+	pu.mutation.SetError(s)
+	return pu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (pu *PayoutUpdate) SetNillableError(s *string) *PayoutUpdate {
+	if s != nil {
+		pu.SetError(*s)
+	}
+	return pu
+}
+
+// ClearError clears the value of the "error" field.
+func (pu *PayoutUpdate) ClearError() *PayoutUpdate {
+	pu.mutation.ClearError()
+	return pu
+}
+
+// SetNotifiedStatus sets the "notified_status" field.
+func (pu *PayoutUpdate) SetNotifiedStatus(s string) *PayoutUpdate {
+	pu.mutation.SetNotifiedStatus(s)
+	return pu
+}
+
+// SetNillableNotifiedStatus sets the "notified_status" field if the given value is not nil.
+func (pu *PayoutUpdate) SetNillableNotifiedStatus(s *string) *PayoutUpdate {
+	if s != nil {
+		pu.SetNotifiedStatus(*s)
+	}
+	return pu
+}
+
+// Mutation returns the PayoutMutation object of the builder.
+func (pu *PayoutUpdate) Mutation() *PayoutMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PayoutUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PayoutUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PayoutUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PayoutUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PayoutUpdate) check() error {
+	if v, ok := pu.mutation.Status(); ok {
+		if err := payout.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`database: validator failed for field "Payout.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PayoutUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payout.Table, payout.Columns, sqlgraph.NewFieldSpec(payout.FieldID, field.TypeString))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pu.mutation.ReferenceCleared() {
+		_spec.ClearField(payout.FieldReference, field.TypeString)
+	}
+	if value, ok := pu.mutation.Status(); ok {
+		_spec.SetField(payout.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Fee(); ok {
+		vv, err := payout.ValueScanner.Fee.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(payout.FieldFee, field.TypeString, vv)
+	}
+	if pu.mutation.FeeCleared() {
+		_spec.ClearField(payout.FieldFee, field.TypeString)
+	}
+	if value, ok := pu.mutation.TxHash(); ok {
+		_spec.SetField(payout.FieldTxHash, field.TypeString, value)
+	}
+	if pu.mutation.TxHashCleared() {
+		_spec.ClearField(payout.FieldTxHash, field.TypeString)
+	}
+	if value, ok := pu.mutation.SendAt(); ok {
+		_spec.SetField(payout.FieldSendAt, field.TypeTime, value)
+	}
+	if pu.mutation.SendAtCleared() {
+		_spec.ClearField(payout.FieldSendAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.ConfirmAt(); ok {
+		_spec.SetField(payout.FieldConfirmAt, field.TypeTime, value)
+	}
+	if pu.mutation.ConfirmAtCleared() {
+		_spec.ClearField(payout.FieldConfirmAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.Error(); ok {
+		_spec.SetField(payout.FieldError, field.TypeString, value)
+	}
+	if pu.mutation.ErrorCleared() {
+		_spec.ClearField(payout.FieldError, field.TypeString)
+	}
+	if value, ok := pu.mutation.NotifiedStatus(); ok {
+		_spec.SetField(payout.FieldNotifiedStatus, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PayoutUpdateOne is the builder for updating a single Payout entity.
+type PayoutUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayoutMutation
+}
+
+// SetStatus sets the "status" field.
+func (puo *PayoutUpdateOne) SetStatus(s string) *PayoutUpdateOne {
+	puo.mutation.SetStatus(s)
+	return puo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (puo *PayoutUpdateOne) SetNillableStatus(s *string) *PayoutUpdateOne {
+	if s != nil {
+		puo.SetStatus(*s)
+	}
+	return puo
+}
+
+// SetFee sets the "fee" field.
+func (puo *PayoutUpdateOne) SetFee(b *big.Int) *PayoutUpdateOne {
+	puo.mutation.SetFee(b)
+	return puo
+}
+
+// ClearFee clears the value of the "fee" field.
+func (puo *PayoutUpdateOne) ClearFee() *PayoutUpdateOne {
+	puo.mutation.ClearFee()
+	return puo
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (puo *PayoutUpdateOne) SetTxHash(s string) *PayoutUpdateOne {
+	puo.mutation.SetTxHash(s)
+	return puo
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (puo *PayoutUpdateOne) SetNillableTxHash(s *string) *PayoutUpdateOne {
+	if s != nil {
+		puo.SetTxHash(*s)
+	}
+	return puo
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (puo *PayoutUpdateOne) ClearTxHash() *PayoutUpdateOne {
+	puo.mutation.ClearTxHash()
+	return puo
+}
+
+// SetSendAt sets the "send_at" field.
+func (puo *PayoutUpdateOne) SetSendAt(t time.Time) *PayoutUpdateOne {
+	puo.mutation.SetSendAt(t)
+	return puo
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (puo *PayoutUpdateOne) SetNillableSendAt(t *time.Time) *PayoutUpdateOne {
+	if t != nil {
+		puo.SetSendAt(*t)
+	}
+	return puo
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (puo *PayoutUpdateOne) ClearSendAt() *PayoutUpdateOne {
+	puo.mutation.ClearSendAt()
+	return puo
+}
+
+// SetConfirmAt sets the "confirm_at" field.
+func (puo *PayoutUpdateOne) SetConfirmAt(t time.Time) *PayoutUpdateOne {
+	puo.mutation.SetConfirmAt(t)
+	return puo
+}
+
+// SetNillableConfirmAt sets the "confirm_at" field if the given value is not nil.
+func (puo *PayoutUpdateOne) SetNillableConfirmAt(t *time.Time) *PayoutUpdateOne {
+	if t != nil {
+		puo.SetConfirmAt(*t)
+	}
+	return puo
+}
+
+// ClearConfirmAt clears the value of the "confirm_at" field.
+func (puo *PayoutUpdateOne) ClearConfirmAt() *PayoutUpdateOne {
+	puo.mutation.ClearConfirmAt()
+	return puo
+}
+
+// SetError sets the "error" field.
+func (puo *PayoutUpdateOne) SetError(s string) *PayoutUpdateOne {
+	puo.mutation.SetError(s)
+	return puo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (puo *PayoutUpdateOne) SetNillableError(s *string) *PayoutUpdateOne {
+	if s != nil {
+		puo.SetError(*s)
+	}
+	return puo
+}
+
+// ClearError clears the value of the "error" field.
+func (puo *PayoutUpdateOne) ClearError() *PayoutUpdateOne {
+	puo.mutation.ClearError()
+	return puo
+}
+
+// SetNotifiedStatus sets the "notified_status" field.
+func (puo *PayoutUpdateOne) SetNotifiedStatus(s string) *PayoutUpdateOne {
+	puo.mutation.SetNotifiedStatus(s)
+	return puo
+}
+
+// SetNillableNotifiedStatus sets the "notified_status" field if the given value is not nil.
+func (puo *PayoutUpdateOne) SetNillableNotifiedStatus(s *string) *PayoutUpdateOne {
+	if s != nil {
+		puo.SetNotifiedStatus(*s)
+	}
+	return puo
+}
+
+// Mutation returns the PayoutMutation object of the builder.
+func (puo *PayoutUpdateOne) Mutation() *PayoutMutation {
+	return puo.mutation
+}
+
+// Where appends a list predicates to the PayoutUpdate builder.
+func (puo *PayoutUpdateOne) Where(ps ...predicate.Payout) *PayoutUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PayoutUpdateOne) Select(field string, fields ...string) *PayoutUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Payout entity.
+func (puo *PayoutUpdateOne) Save(ctx context.Context) (*Payout, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PayoutUpdateOne) SaveX(ctx context.Context) *Payout {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PayoutUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PayoutUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PayoutUpdateOne) check() error {
+	if v, ok := puo.mutation.Status(); ok {
+		if err := payout.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`database: validator failed for field "Payout.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PayoutUpdateOne) sqlSave(ctx context.Context) (_node *Payout, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payout.Table, payout.Columns, sqlgraph.NewFieldSpec(payout.FieldID, field.TypeString))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`database: missing "Payout.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payout.FieldID)
+		for _, f := range fields {
+			if !payout.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+			}
+			if f != payout.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if puo.mutation.ReferenceCleared() {
+		_spec.ClearField(payout.FieldReference, field.TypeString)
+	}
+	if value, ok := puo.mutation.Status(); ok {
+		_spec.SetField(payout.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Fee(); ok {
+		vv, err := payout.ValueScanner.Fee.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(payout.FieldFee, field.TypeString, vv)
+	}
+	if puo.mutation.FeeCleared() {
+		_spec.ClearField(payout.FieldFee, field.TypeString)
+	}
+	if value, ok := puo.mutation.TxHash(); ok {
+		_spec.SetField(payout.FieldTxHash, field.TypeString, value)
+	}
+	if puo.mutation.TxHashCleared() {
+		_spec.ClearField(payout.FieldTxHash, field.TypeString)
+	}
+	if value, ok := puo.mutation.SendAt(); ok {
+		_spec.SetField(payout.FieldSendAt, field.TypeTime, value)
+	}
+	if puo.mutation.SendAtCleared() {
+		_spec.ClearField(payout.FieldSendAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.ConfirmAt(); ok {
+		_spec.SetField(payout.FieldConfirmAt, field.TypeTime, value)
+	}
+	if puo.mutation.ConfirmAtCleared() {
+		_spec.ClearField(payout.FieldConfirmAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.Error(); ok {
+		_spec.SetField(payout.FieldError, field.TypeString, value)
+	}
+	if puo.mutation.ErrorCleared() {
+		_spec.ClearField(payout.FieldError, field.TypeString)
+	}
+	if value, ok := puo.mutation.NotifiedStatus(); ok {
+		_spec.SetField(payout.FieldNotifiedStatus, field.TypeString, value)
+	}
+	_node = &Payout{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
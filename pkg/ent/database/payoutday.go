@@ -0,0 +1,103 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"cpg/pkg/ent/database/payoutday"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PayoutDay is the model entity for the PayoutDay schema.
+type PayoutDay struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Payouts holds the value of the "payouts" field.
+	Payouts      int64 `json:"payouts,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayoutDay) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payoutday.FieldPayouts:
+			values[i] = new(sql.NullInt64)
+		case payoutday.FieldID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayoutDay fields.
+func (pd *PayoutDay) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payoutday.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pd.ID = value.String
+			}
+		case payoutday.FieldPayouts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payouts", values[i])
+			} else if value.Valid {
+				pd.Payouts = value.Int64
+			}
+		default:
+			pd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayoutDay.
+// This includes values selected through modifiers, order, etc.
+func (pd *PayoutDay) Value(name string) (ent.Value, error) {
+	return pd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PayoutDay.
+// Note that you need to call PayoutDay.Unwrap() before calling this method if this PayoutDay
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pd *PayoutDay) Update() *PayoutDayUpdateOne {
+	return NewPayoutDayClient(pd.config).UpdateOne(pd)
+}
+
+// Unwrap unwraps the PayoutDay entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pd *PayoutDay) Unwrap() *PayoutDay {
+	_tx, ok := pd.config.driver.(*txDriver)
+	if !ok {
+		panic("database: PayoutDay is not a transactional entity")
+	}
+	pd.config.driver = _tx.drv
+	return pd
+}
+
+// String implements the fmt.Stringer.
+func (pd *PayoutDay) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayoutDay(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pd.ID))
+	builder.WriteString("payouts=")
+	builder.WriteString(fmt.Sprintf("%v", pd.Payouts))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayoutDays is a parsable slice of PayoutDay.
+type PayoutDays []*PayoutDay
@@ -0,0 +1,54 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payoutday
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the payoutday type in the database.
+	Label = "payout_day"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPayouts holds the string denoting the payouts field in the database.
+	FieldPayouts = "payouts"
+	// Table holds the table name of the payoutday in the database.
+	Table = "payout_days"
+)
+
+// Columns holds all SQL columns for payoutday fields.
+var Columns = []string{
+	FieldID,
+	FieldPayouts,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PayoutsValidator is a validator for the "payouts" field. It is called by the builders before save.
+	PayoutsValidator func(int64) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the PayoutDay queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPayouts orders the results by the payouts field.
+func ByPayouts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayouts, opts...).ToFunc()
+}
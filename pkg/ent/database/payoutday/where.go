@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payoutday
+
+import (
+	"cpg/pkg/ent/database/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldContainsFold(FieldID, id))
+}
+
+// Payouts applies equality check predicate on the "payouts" field. It's identical to PayoutsEQ.
+func Payouts(v int64) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldEQ(FieldPayouts, v))
+}
+
+// PayoutsEQ applies the EQ predicate on the "payouts" field.
+func PayoutsEQ(v int64) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldEQ(FieldPayouts, v))
+}
+
+// PayoutsNEQ applies the NEQ predicate on the "payouts" field.
+func PayoutsNEQ(v int64) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldNEQ(FieldPayouts, v))
+}
+
+// PayoutsIn applies the In predicate on the "payouts" field.
+func PayoutsIn(vs ...int64) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldIn(FieldPayouts, vs...))
+}
+
+// PayoutsNotIn applies the NotIn predicate on the "payouts" field.
+func PayoutsNotIn(vs ...int64) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldNotIn(FieldPayouts, vs...))
+}
+
+// PayoutsGT applies the GT predicate on the "payouts" field.
+func PayoutsGT(v int64) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldGT(FieldPayouts, v))
+}
+
+// PayoutsGTE applies the GTE predicate on the "payouts" field.
+func PayoutsGTE(v int64) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldGTE(FieldPayouts, v))
+}
+
+// PayoutsLT applies the LT predicate on the "payouts" field.
+func PayoutsLT(v int64) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldLT(FieldPayouts, v))
+}
+
+// PayoutsLTE applies the LTE predicate on the "payouts" field.
+func PayoutsLTE(v int64) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.FieldLTE(FieldPayouts, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayoutDay) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayoutDay) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayoutDay) predicate.PayoutDay {
+	return predicate.PayoutDay(sql.NotPredicates(p))
+}
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/payoutday"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayoutDayCreate is the builder for creating a PayoutDay entity.
+type PayoutDayCreate struct {
+	config
+	mutation *PayoutDayMutation
+	hooks    []Hook
+}
+
+// SetPayouts sets the "payouts" field.
+func (pdc *PayoutDayCreate) SetPayouts(i int64) *PayoutDayCreate {
+	pdc.mutation.SetPayouts(i)
+	return pdc
+}
+
+// SetID sets the "id" field.
+func (pdc *PayoutDayCreate) SetID(s string) *PayoutDayCreate {
+	pdc.mutation.SetID(s)
+	return pdc
+}
+
+// Mutation returns the PayoutDayMutation object of the builder.
+func (pdc *PayoutDayCreate) Mutation() *PayoutDayMutation {
+	return pdc.mutation
+}
+
+// Save creates the PayoutDay in the database.
+func (pdc *PayoutDayCreate) Save(ctx context.Context) (*PayoutDay, error) {
+	return withHooks(ctx, pdc.sqlSave, pdc.mutation, pdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pdc *PayoutDayCreate) SaveX(ctx context.Context) *PayoutDay {
+	v, err := pdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pdc *PayoutDayCreate) Exec(ctx context.Context) error {
+	_, err := pdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdc *PayoutDayCreate) ExecX(ctx context.Context) {
+	if err := pdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pdc *PayoutDayCreate) check() error {
+	if _, ok := pdc.mutation.Payouts(); !ok {
+		return &ValidationError{Name: "payouts", err: errors.New(`database: missing required field "PayoutDay.payouts"`)}
+	}
+	if v, ok := pdc.mutation.Payouts(); ok {
+		if err := payoutday.PayoutsValidator(v); err != nil {
+			return &ValidationError{Name: "payouts", err: fmt.Errorf(`database: validator failed for field "PayoutDay.payouts": %w`, err)}
+		}
+	}
+	if v, ok := pdc.mutation.ID(); ok {
+		if err := payoutday.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`database: validator failed for field "PayoutDay.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pdc *PayoutDayCreate) sqlSave(ctx context.Context) (*PayoutDay, error) {
+	if err := pdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PayoutDay.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pdc.mutation.id = &_node.ID
+	pdc.mutation.done = true
+	return _node, nil
+}
+
+func (pdc *PayoutDayCreate) createSpec() (*PayoutDay, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayoutDay{config: pdc.config}
+		_spec = sqlgraph.NewCreateSpec(payoutday.Table, sqlgraph.NewFieldSpec(payoutday.FieldID, field.TypeString))
+	)
+	if id, ok := pdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pdc.mutation.Payouts(); ok {
+		_spec.SetField(payoutday.FieldPayouts, field.TypeInt64, value)
+		_node.Payouts = value
+	}
+	return _node, _spec
+}
+
+// PayoutDayCreateBulk is the builder for creating many PayoutDay entities in bulk.
+type PayoutDayCreateBulk struct {
+	config
+	err      error
+	builders []*PayoutDayCreate
+}
+
+// Save creates the PayoutDay entities in the database.
+func (pdcb *PayoutDayCreateBulk) Save(ctx context.Context) ([]*PayoutDay, error) {
+	if pdcb.err != nil {
+		return nil, pdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pdcb.builders))
+	nodes := make([]*PayoutDay, len(pdcb.builders))
+	mutators := make([]Mutator, len(pdcb.builders))
+	for i := range pdcb.builders {
+		func(i int, root context.Context) {
+			builder := pdcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayoutDayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pdcb *PayoutDayCreateBulk) SaveX(ctx context.Context) []*PayoutDay {
+	v, err := pdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pdcb *PayoutDayCreateBulk) Exec(ctx context.Context) error {
+	_, err := pdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdcb *PayoutDayCreateBulk) ExecX(ctx context.Context) {
+	if err := pdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/payoutday"
+	"cpg/pkg/ent/database/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayoutDayDelete is the builder for deleting a PayoutDay entity.
+type PayoutDayDelete struct {
+	config
+	hooks    []Hook
+	mutation *PayoutDayMutation
+}
+
+// Where appends a list predicates to the PayoutDayDelete builder.
+func (pdd *PayoutDayDelete) Where(ps ...predicate.PayoutDay) *PayoutDayDelete {
+	pdd.mutation.Where(ps...)
+	return pdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pdd *PayoutDayDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pdd.sqlExec, pdd.mutation, pdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdd *PayoutDayDelete) ExecX(ctx context.Context) int {
+	n, err := pdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pdd *PayoutDayDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(payoutday.Table, sqlgraph.NewFieldSpec(payoutday.FieldID, field.TypeString))
+	if ps := pdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pdd.mutation.done = true
+	return affected, err
+}
+
+// PayoutDayDeleteOne is the builder for deleting a single PayoutDay entity.
+type PayoutDayDeleteOne struct {
+	pdd *PayoutDayDelete
+}
+
+// Where appends a list predicates to the PayoutDayDelete builder.
+func (pddo *PayoutDayDeleteOne) Where(ps ...predicate.PayoutDay) *PayoutDayDeleteOne {
+	pddo.pdd.mutation.Where(ps...)
+	return pddo
+}
+
+// Exec executes the deletion query.
+func (pddo *PayoutDayDeleteOne) Exec(ctx context.Context) error {
+	n, err := pddo.pdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{payoutday.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pddo *PayoutDayDeleteOne) ExecX(ctx context.Context) {
+	if err := pddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
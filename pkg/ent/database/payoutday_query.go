@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/payoutday"
+	"cpg/pkg/ent/database/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayoutDayQuery is the builder for querying PayoutDay entities.
+type PayoutDayQuery struct {
+	config
+	ctx        *QueryContext
+	order      []payoutday.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PayoutDay
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PayoutDayQuery builder.
+func (pdq *PayoutDayQuery) Where(ps ...predicate.PayoutDay) *PayoutDayQuery {
+	pdq.predicates = append(pdq.predicates, ps...)
+	return pdq
+}
+
+// Limit the number of records to be returned by this query.
+func (pdq *PayoutDayQuery) Limit(limit int) *PayoutDayQuery {
+	pdq.ctx.Limit = &limit
+	return pdq
+}
+
+// Offset to start from.
+func (pdq *PayoutDayQuery) Offset(offset int) *PayoutDayQuery {
+	pdq.ctx.Offset = &offset
+	return pdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pdq *PayoutDayQuery) Unique(unique bool) *PayoutDayQuery {
+	pdq.ctx.Unique = &unique
+	return pdq
+}
+
+// Order specifies how the records should be ordered.
+func (pdq *PayoutDayQuery) Order(o ...payoutday.OrderOption) *PayoutDayQuery {
+	pdq.order = append(pdq.order, o...)
+	return pdq
+}
+
+// First returns the first PayoutDay entity from the query.
+// Returns a *NotFoundError when no PayoutDay was found.
+func (pdq *PayoutDayQuery) First(ctx context.Context) (*PayoutDay, error) {
+	nodes, err := pdq.Limit(1).All(setContextOp(ctx, pdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{payoutday.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pdq *PayoutDayQuery) FirstX(ctx context.Context) *PayoutDay {
+	node, err := pdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PayoutDay ID from the query.
+// Returns a *NotFoundError when no PayoutDay ID was found.
+func (pdq *PayoutDayQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = pdq.Limit(1).IDs(setContextOp(ctx, pdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{payoutday.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pdq *PayoutDayQuery) FirstIDX(ctx context.Context) string {
+	id, err := pdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PayoutDay entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PayoutDay entity is found.
+// Returns a *NotFoundError when no PayoutDay entities are found.
+func (pdq *PayoutDayQuery) Only(ctx context.Context) (*PayoutDay, error) {
+	nodes, err := pdq.Limit(2).All(setContextOp(ctx, pdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{payoutday.Label}
+	default:
+		return nil, &NotSingularError{payoutday.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pdq *PayoutDayQuery) OnlyX(ctx context.Context) *PayoutDay {
+	node, err := pdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PayoutDay ID in the query.
+// Returns a *NotSingularError when more than one PayoutDay ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pdq *PayoutDayQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = pdq.Limit(2).IDs(setContextOp(ctx, pdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{payoutday.Label}
+	default:
+		err = &NotSingularError{payoutday.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pdq *PayoutDayQuery) OnlyIDX(ctx context.Context) string {
+	id, err := pdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PayoutDays.
+func (pdq *PayoutDayQuery) All(ctx context.Context) ([]*PayoutDay, error) {
+	ctx = setContextOp(ctx, pdq.ctx, ent.OpQueryAll)
+	if err := pdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PayoutDay, *PayoutDayQuery]()
+	return withInterceptors[[]*PayoutDay](ctx, pdq, qr, pdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pdq *PayoutDayQuery) AllX(ctx context.Context) []*PayoutDay {
+	nodes, err := pdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PayoutDay IDs.
+func (pdq *PayoutDayQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if pdq.ctx.Unique == nil && pdq.path != nil {
+		pdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pdq.ctx, ent.OpQueryIDs)
+	if err = pdq.Select(payoutday.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pdq *PayoutDayQuery) IDsX(ctx context.Context) []string {
+	ids, err := pdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pdq *PayoutDayQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pdq.ctx, ent.OpQueryCount)
+	if err := pdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pdq, querierCount[*PayoutDayQuery](), pdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pdq *PayoutDayQuery) CountX(ctx context.Context) int {
+	count, err := pdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pdq *PayoutDayQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pdq.ctx, ent.OpQueryExist)
+	switch _, err := pdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("database: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pdq *PayoutDayQuery) ExistX(ctx context.Context) bool {
+	exist, err := pdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PayoutDayQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pdq *PayoutDayQuery) Clone() *PayoutDayQuery {
+	if pdq == nil {
+		return nil
+	}
+	return &PayoutDayQuery{
+		config:     pdq.config,
+		ctx:        pdq.ctx.Clone(),
+		order:      append([]payoutday.OrderOption{}, pdq.order...),
+		inters:     append([]Interceptor{}, pdq.inters...),
+		predicates: append([]predicate.PayoutDay{}, pdq.predicates...),
+		// clone intermediate query.
+		sql:  pdq.sql.Clone(),
+		path: pdq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Payouts int64 `json:"payouts,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PayoutDay.Query().
+//		GroupBy(payoutday.FieldPayouts).
+//		Aggregate(database.Count()).
+//		Scan(ctx, &v)
+func (pdq *PayoutDayQuery) GroupBy(field string, fields ...string) *PayoutDayGroupBy {
+	pdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PayoutDayGroupBy{build: pdq}
+	grbuild.flds = &pdq.ctx.Fields
+	grbuild.label = payoutday.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Payouts int64 `json:"payouts,omitempty"`
+//	}
+//
+//	client.PayoutDay.Query().
+//		Select(payoutday.FieldPayouts).
+//		Scan(ctx, &v)
+func (pdq *PayoutDayQuery) Select(fields ...string) *PayoutDaySelect {
+	pdq.ctx.Fields = append(pdq.ctx.Fields, fields...)
+	sbuild := &PayoutDaySelect{PayoutDayQuery: pdq}
+	sbuild.label = payoutday.Label
+	sbuild.flds, sbuild.scan = &pdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PayoutDaySelect configured with the given aggregations.
+func (pdq *PayoutDayQuery) Aggregate(fns ...AggregateFunc) *PayoutDaySelect {
+	return pdq.Select().Aggregate(fns...)
+}
+
+func (pdq *PayoutDayQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pdq.inters {
+		if inter == nil {
+			return fmt.Errorf("database: uninitialized interceptor (forgotten import database/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pdq.ctx.Fields {
+		if !payoutday.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+		}
+	}
+	if pdq.path != nil {
+		prev, err := pdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pdq.sql = prev
+	}
+	return nil
+}
+
+func (pdq *PayoutDayQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PayoutDay, error) {
+	var (
+		nodes = []*PayoutDay{}
+		_spec = pdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PayoutDay).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PayoutDay{config: pdq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pdq *PayoutDayQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pdq.querySpec()
+	_spec.Node.Columns = pdq.ctx.Fields
+	if len(pdq.ctx.Fields) > 0 {
+		_spec.Unique = pdq.ctx.Unique != nil && *pdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pdq.driver, _spec)
+}
+
+func (pdq *PayoutDayQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(payoutday.Table, payoutday.Columns, sqlgraph.NewFieldSpec(payoutday.FieldID, field.TypeString))
+	_spec.From = pdq.sql
+	if unique := pdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payoutday.FieldID)
+		for i := range fields {
+			if fields[i] != payoutday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pdq *PayoutDayQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pdq.driver.Dialect())
+	t1 := builder.Table(payoutday.Table)
+	columns := pdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = payoutday.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pdq.sql != nil {
+		selector = pdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pdq.ctx.Unique != nil && *pdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pdq.predicates {
+		p(selector)
+	}
+	for _, p := range pdq.order {
+		p(selector)
+	}
+	if offset := pdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PayoutDayGroupBy is the group-by builder for PayoutDay entities.
+type PayoutDayGroupBy struct {
+	selector
+	build *PayoutDayQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pdgb *PayoutDayGroupBy) Aggregate(fns ...AggregateFunc) *PayoutDayGroupBy {
+	pdgb.fns = append(pdgb.fns, fns...)
+	return pdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pdgb *PayoutDayGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayoutDayQuery, *PayoutDayGroupBy](ctx, pdgb.build, pdgb, pdgb.build.inters, v)
+}
+
+func (pdgb *PayoutDayGroupBy) sqlScan(ctx context.Context, root *PayoutDayQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pdgb.fns))
+	for _, fn := range pdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pdgb.flds)+len(pdgb.fns))
+		for _, f := range *pdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PayoutDaySelect is the builder for selecting fields of PayoutDay entities.
+type PayoutDaySelect struct {
+	*PayoutDayQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pds *PayoutDaySelect) Aggregate(fns ...AggregateFunc) *PayoutDaySelect {
+	pds.fns = append(pds.fns, fns...)
+	return pds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pds *PayoutDaySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pds.ctx, ent.OpQuerySelect)
+	if err := pds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PayoutDayQuery, *PayoutDaySelect](ctx, pds.PayoutDayQuery, pds, pds.inters, v)
+}
+
+func (pds *PayoutDaySelect) sqlScan(ctx context.Context, root *PayoutDayQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pds.fns))
+	for _, fn := range pds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/payoutday"
+	"cpg/pkg/ent/database/predicate"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayoutDayUpdate is the builder for updating PayoutDay entities.
+type PayoutDayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayoutDayMutation
+}
+
+// Where appends a list predicates to the PayoutDayUpdate builder.
+func (pdu *PayoutDayUpdate) Where(ps ...predicate.PayoutDay) *PayoutDayUpdate {
+	pdu.mutation.Where(ps...)
+	return pdu
+}
+
+// SetPayouts sets the "payouts" field.
+func (pdu *PayoutDayUpdate) SetPayouts(i int64) *PayoutDayUpdate {
+	pdu.mutation.ResetPayouts()
+	pdu.mutation.SetPayouts(i)
+	return pdu
+}
+
+// SetNillablePayouts sets the "payouts" field if the given value is not nil.
+func (pdu *PayoutDayUpdate) SetNillablePayouts(i *int64) *PayoutDayUpdate {
+	if i != nil {
+		pdu.SetPayouts(*i)
+	}
+	return pdu
+}
+
+// AddPayouts adds i to the "payouts" field.
+func (pdu *PayoutDayUpdate) AddPayouts(i int64) *PayoutDayUpdate {
+	pdu.mutation.AddPayouts(i)
+	return pdu
+}
+
+// Mutation returns the PayoutDayMutation object of the builder.
+func (pdu *PayoutDayUpdate) Mutation() *PayoutDayMutation {
+	return pdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pdu *PayoutDayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pdu.sqlSave, pdu.mutation, pdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pdu *PayoutDayUpdate) SaveX(ctx context.Context) int {
+	affected, err := pdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pdu *PayoutDayUpdate) Exec(ctx context.Context) error {
+	_, err := pdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdu *PayoutDayUpdate) ExecX(ctx context.Context) {
+	if err := pdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pdu *PayoutDayUpdate) check() error {
+	if v, ok := pdu.mutation.Payouts(); ok {
+		if err := payoutday.PayoutsValidator(v); err != nil {
+			return &ValidationError{Name: "payouts", err: fmt.Errorf(`database: validator failed for field "PayoutDay.payouts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pdu *PayoutDayUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payoutday.Table, payoutday.Columns, sqlgraph.NewFieldSpec(payoutday.FieldID, field.TypeString))
+	if ps := pdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pdu.mutation.Payouts(); ok {
+		_spec.SetField(payoutday.FieldPayouts, field.TypeInt64, value)
+	}
+	if value, ok := pdu.mutation.AddedPayouts(); ok {
+		_spec.AddField(payoutday.FieldPayouts, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payoutday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pdu.mutation.done = true
+	return n, nil
+}
+
+// PayoutDayUpdateOne is the builder for updating a single PayoutDay entity.
+type PayoutDayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayoutDayMutation
+}
+
+// SetPayouts sets the "payouts" field.
+func (pduo *PayoutDayUpdateOne) SetPayouts(i int64) *PayoutDayUpdateOne {
+	pduo.mutation.ResetPayouts()
+	pduo.mutation.SetPayouts(i)
+	return pduo
+}
+
+// SetNillablePayouts sets the "payouts" field if the given value is not nil.
+func (pduo *PayoutDayUpdateOne) SetNillablePayouts(i *int64) *PayoutDayUpdateOne {
+	if i != nil {
+		pduo.SetPayouts(*i)
+	}
+	return pduo
+}
+
+// AddPayouts adds i to the "payouts" field.
+func (pduo *PayoutDayUpdateOne) AddPayouts(i int64) *PayoutDayUpdateOne {
+	pduo.mutation.AddPayouts(i)
+	return pduo
+}
+
+// Mutation returns the PayoutDayMutation object of the builder.
+func (pduo *PayoutDayUpdateOne) Mutation() *PayoutDayMutation {
+	return pduo.mutation
+}
+
+// Where appends a list predicates to the PayoutDayUpdate builder.
+func (pduo *PayoutDayUpdateOne) Where(ps ...predicate.PayoutDay) *PayoutDayUpdateOne {
+	pduo.mutation.Where(ps...)
+	return pduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pduo *PayoutDayUpdateOne) Select(field string, fields ...string) *PayoutDayUpdateOne {
+	pduo.fields = append([]string{field}, fields...)
+	return pduo
+}
+
+// Save executes the query and returns the updated PayoutDay entity.
+func (pduo *PayoutDayUpdateOne) Save(ctx context.Context) (*PayoutDay, error) {
+	return withHooks(ctx, pduo.sqlSave, pduo.mutation, pduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pduo *PayoutDayUpdateOne) SaveX(ctx context.Context) *PayoutDay {
+	node, err := pduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pduo *PayoutDayUpdateOne) Exec(ctx context.Context) error {
+	_, err := pduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pduo *PayoutDayUpdateOne) ExecX(ctx context.Context) {
+	if err := pduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pduo *PayoutDayUpdateOne) check() error {
+	if v, ok := pduo.mutation.Payouts(); ok {
+		if err := payoutday.PayoutsValidator(v); err != nil {
+			return &ValidationError{Name: "payouts", err: fmt.Errorf(`database: validator failed for field "PayoutDay.payouts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pduo *PayoutDayUpdateOne) sqlSave(ctx context.Context) (_node *PayoutDay, err error) {
+	if err := pduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payoutday.Table, payoutday.Columns, sqlgraph.NewFieldSpec(payoutday.FieldID, field.TypeString))
+	id, ok := pduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`database: missing "PayoutDay.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payoutday.FieldID)
+		for _, f := range fields {
+			if !payoutday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+			}
+			if f != payoutday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pduo.mutation.Payouts(); ok {
+		_spec.SetField(payoutday.FieldPayouts, field.TypeInt64, value)
+	}
+	if value, ok := pduo.mutation.AddedPayouts(); ok {
+		_spec.AddField(payoutday.FieldPayouts, field.TypeInt64, value)
+	}
+	_node = &PayoutDay{config: pduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payoutday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pduo.mutation.done = true
+	return _node, nil
+}
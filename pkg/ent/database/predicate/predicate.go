@@ -19,3 +19,71 @@ func InvoiceOrErr(p Invoice, err error) Invoice {
 		p(s)
 	}
 }
+
+// InvoiceEvent is the predicate function for invoiceevent builders.
+type InvoiceEvent func(*sql.Selector)
+
+// LedgerAccount is the predicate function for ledgeraccount builders.
+type LedgerAccount func(*sql.Selector)
+
+// LedgerEntry is the predicate function for ledgerentry builders.
+type LedgerEntry func(*sql.Selector)
+
+// LedgerEntryOrErr calls the predicate only if the error is not nit.
+func LedgerEntryOrErr(p LedgerEntry, err error) LedgerEntry {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// Payout is the predicate function for payout builders.
+type Payout func(*sql.Selector)
+
+// PayoutOrErr calls the predicate only if the error is not nit.
+func PayoutOrErr(p Payout, err error) Payout {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// PayoutDay is the predicate function for payoutday builders.
+type PayoutDay func(*sql.Selector)
+
+// TreasuryCredit is the predicate function for treasurycredit builders.
+type TreasuryCredit func(*sql.Selector)
+
+// TreasuryCreditOrErr calls the predicate only if the error is not nit.
+func TreasuryCreditOrErr(p TreasuryCredit, err error) TreasuryCredit {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// TreasuryTransfer is the predicate function for treasurytransfer builders.
+type TreasuryTransfer func(*sql.Selector)
+
+// TreasuryTransferOrErr calls the predicate only if the error is not nit.
+func TreasuryTransferOrErr(p TreasuryTransfer, err error) TreasuryTransfer {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// WalletAccount is the predicate function for walletaccount builders.
+type WalletAccount func(*sql.Selector)
@@ -4,6 +4,14 @@ package database
 
 import (
 	"cpg/pkg/ent/database/invoice"
+	"cpg/pkg/ent/database/invoiceevent"
+	"cpg/pkg/ent/database/ledgeraccount"
+	"cpg/pkg/ent/database/ledgerentry"
+	"cpg/pkg/ent/database/payout"
+	"cpg/pkg/ent/database/payoutday"
+	"cpg/pkg/ent/database/treasurycredit"
+	"cpg/pkg/ent/database/treasurytransfer"
+	"cpg/pkg/ent/database/walletaccount"
 	"cpg/pkg/ent/schema"
 	"math/big"
 	"time"
@@ -68,8 +76,218 @@ func init() {
 	invoiceDescEncryptedSalt := invoiceFields[14].Descriptor()
 	// invoice.EncryptedSaltValidator is a validator for the "encrypted_salt" field. It is called by the builders before save.
 	invoice.EncryptedSaltValidator = invoiceDescEncryptedSalt.Validators[0].(func([]byte) error)
+	// invoiceDescDerivationVersion is the schema descriptor for derivation_version field.
+	invoiceDescDerivationVersion := invoiceFields[15].Descriptor()
+	// invoice.DefaultDerivationVersion holds the default value on creation for the derivation_version field.
+	invoice.DefaultDerivationVersion = invoiceDescDerivationVersion.Default.(int)
+	// invoice.DerivationVersionValidator is a validator for the "derivation_version" field. It is called by the builders before save.
+	invoice.DerivationVersionValidator = invoiceDescDerivationVersion.Validators[0].(func(int) error)
+	// invoiceDescWalletIndex is the schema descriptor for wallet_index field.
+	invoiceDescWalletIndex := invoiceFields[16].Descriptor()
+	// invoice.WalletIndexValidator is a validator for the "wallet_index" field. It is called by the builders before save.
+	invoice.WalletIndexValidator = invoiceDescWalletIndex.Validators[0].(func(int64) error)
+	// invoiceDescEscrowPeriod is the schema descriptor for escrow_period field.
+	invoiceDescEscrowPeriod := invoiceFields[19].Descriptor()
+	// invoice.DefaultEscrowPeriod holds the default value on creation for the escrow_period field.
+	invoice.DefaultEscrowPeriod = invoiceDescEscrowPeriod.Default.(int64)
+	// invoice.EscrowPeriodValidator is a validator for the "escrow_period" field. It is called by the builders before save.
+	invoice.EscrowPeriodValidator = invoiceDescEscrowPeriod.Validators[0].(func(int64) error)
+	// invoiceDescFeeBasisPoints is the schema descriptor for fee_basis_points field.
+	invoiceDescFeeBasisPoints := invoiceFields[25].Descriptor()
+	// invoice.DefaultFeeBasisPoints holds the default value on creation for the fee_basis_points field.
+	invoice.DefaultFeeBasisPoints = invoiceDescFeeBasisPoints.Default.(int64)
+	// invoice.FeeBasisPointsValidator is a validator for the "fee_basis_points" field. It is called by the builders before save.
+	invoice.FeeBasisPointsValidator = invoiceDescFeeBasisPoints.Validators[0].(func(int64) error)
+	// invoiceDescFeeFlat is the schema descriptor for fee_flat field.
+	invoiceDescFeeFlat := invoiceFields[26].Descriptor()
+	invoice.ValueScanner.FeeFlat = invoiceDescFeeFlat.ValueScanner.(field.TypeValueScanner[*big.Int])
+	// invoiceDescFeeAmount is the schema descriptor for fee_amount field.
+	invoiceDescFeeAmount := invoiceFields[27].Descriptor()
+	invoice.ValueScanner.FeeAmount = invoiceDescFeeAmount.ValueScanner.(field.TypeValueScanner[*big.Int])
 	// invoiceDescID is the schema descriptor for id field.
 	invoiceDescID := invoiceFields[0].Descriptor()
 	// invoice.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	invoice.IDValidator = invoiceDescID.Validators[0].(func(string) error)
+	invoiceeventFields := schema.InvoiceEvent{}.Fields()
+	_ = invoiceeventFields
+	// invoiceeventDescInvoiceID is the schema descriptor for invoice_id field.
+	invoiceeventDescInvoiceID := invoiceeventFields[0].Descriptor()
+	// invoiceevent.InvoiceIDValidator is a validator for the "invoice_id" field. It is called by the builders before save.
+	invoiceevent.InvoiceIDValidator = invoiceeventDescInvoiceID.Validators[0].(func(string) error)
+	// invoiceeventDescKind is the schema descriptor for kind field.
+	invoiceeventDescKind := invoiceeventFields[1].Descriptor()
+	// invoiceevent.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	invoiceevent.KindValidator = invoiceeventDescKind.Validators[0].(func(string) error)
+	// invoiceeventDescCreateAt is the schema descriptor for create_at field.
+	invoiceeventDescCreateAt := invoiceeventFields[5].Descriptor()
+	// invoiceevent.DefaultCreateAt holds the default value on creation for the create_at field.
+	invoiceevent.DefaultCreateAt = invoiceeventDescCreateAt.Default.(func() time.Time)
+	ledgeraccountFields := schema.LedgerAccount{}.Fields()
+	_ = ledgeraccountFields
+	// ledgeraccountDescAsset is the schema descriptor for asset field.
+	ledgeraccountDescAsset := ledgeraccountFields[0].Descriptor()
+	// ledgeraccount.AssetValidator is a validator for the "asset" field. It is called by the builders before save.
+	ledgeraccount.AssetValidator = ledgeraccountDescAsset.Validators[0].(func(string) error)
+	// ledgeraccountDescName is the schema descriptor for name field.
+	ledgeraccountDescName := ledgeraccountFields[1].Descriptor()
+	// ledgeraccount.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ledgeraccount.NameValidator = ledgeraccountDescName.Validators[0].(func(string) error)
+	// ledgeraccountDescKind is the schema descriptor for kind field.
+	ledgeraccountDescKind := ledgeraccountFields[2].Descriptor()
+	// ledgeraccount.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	ledgeraccount.KindValidator = ledgeraccountDescKind.Validators[0].(func(string) error)
+	// ledgeraccountDescCreateAt is the schema descriptor for create_at field.
+	ledgeraccountDescCreateAt := ledgeraccountFields[3].Descriptor()
+	// ledgeraccount.DefaultCreateAt holds the default value on creation for the create_at field.
+	ledgeraccount.DefaultCreateAt = ledgeraccountDescCreateAt.Default.(func() time.Time)
+	ledgerentryFields := schema.LedgerEntry{}.Fields()
+	_ = ledgerentryFields
+	// ledgerentryDescJournal is the schema descriptor for journal field.
+	ledgerentryDescJournal := ledgerentryFields[0].Descriptor()
+	// ledgerentry.JournalValidator is a validator for the "journal" field. It is called by the builders before save.
+	ledgerentry.JournalValidator = ledgerentryDescJournal.Validators[0].(func(string) error)
+	// ledgerentryDescLine is the schema descriptor for line field.
+	ledgerentryDescLine := ledgerentryFields[1].Descriptor()
+	// ledgerentry.LineValidator is a validator for the "line" field. It is called by the builders before save.
+	ledgerentry.LineValidator = ledgerentryDescLine.Validators[0].(func(int) error)
+	// ledgerentryDescAsset is the schema descriptor for asset field.
+	ledgerentryDescAsset := ledgerentryFields[2].Descriptor()
+	// ledgerentry.AssetValidator is a validator for the "asset" field. It is called by the builders before save.
+	ledgerentry.AssetValidator = ledgerentryDescAsset.Validators[0].(func(string) error)
+	// ledgerentryDescKind is the schema descriptor for kind field.
+	ledgerentryDescKind := ledgerentryFields[3].Descriptor()
+	// ledgerentry.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	ledgerentry.KindValidator = ledgerentryDescKind.Validators[0].(func(string) error)
+	// ledgerentryDescDebit is the schema descriptor for debit field.
+	ledgerentryDescDebit := ledgerentryFields[4].Descriptor()
+	// ledgerentry.DebitValidator is a validator for the "debit" field. It is called by the builders before save.
+	ledgerentry.DebitValidator = ledgerentryDescDebit.Validators[0].(func(string) error)
+	// ledgerentryDescCredit is the schema descriptor for credit field.
+	ledgerentryDescCredit := ledgerentryFields[5].Descriptor()
+	// ledgerentry.CreditValidator is a validator for the "credit" field. It is called by the builders before save.
+	ledgerentry.CreditValidator = ledgerentryDescCredit.Validators[0].(func(string) error)
+	// ledgerentryDescAmount is the schema descriptor for amount field.
+	ledgerentryDescAmount := ledgerentryFields[6].Descriptor()
+	ledgerentry.ValueScanner.Amount = ledgerentryDescAmount.ValueScanner.(field.TypeValueScanner[*big.Int])
+	// ledgerentry.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	ledgerentry.AmountValidator = ledgerentryDescAmount.Validators[0].(func(string) error)
+	// ledgerentryDescCreateAt is the schema descriptor for create_at field.
+	ledgerentryDescCreateAt := ledgerentryFields[9].Descriptor()
+	// ledgerentry.DefaultCreateAt holds the default value on creation for the create_at field.
+	ledgerentry.DefaultCreateAt = ledgerentryDescCreateAt.Default.(func() time.Time)
+	payoutFields := schema.Payout{}.Fields()
+	_ = payoutFields
+	// payoutDescIdempotencyKey is the schema descriptor for idempotency_key field.
+	payoutDescIdempotencyKey := payoutFields[1].Descriptor()
+	// payout.IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	payout.IdempotencyKeyValidator = payoutDescIdempotencyKey.Validators[0].(func(string) error)
+	// payoutDescAsset is the schema descriptor for asset field.
+	payoutDescAsset := payoutFields[2].Descriptor()
+	// payout.AssetValidator is a validator for the "asset" field. It is called by the builders before save.
+	payout.AssetValidator = payoutDescAsset.Validators[0].(func(string) error)
+	// payoutDescTo is the schema descriptor for to field.
+	payoutDescTo := payoutFields[3].Descriptor()
+	// payout.ToValidator is a validator for the "to" field. It is called by the builders before save.
+	payout.ToValidator = payoutDescTo.Validators[0].(func(string) error)
+	// payoutDescAmount is the schema descriptor for amount field.
+	payoutDescAmount := payoutFields[4].Descriptor()
+	payout.ValueScanner.Amount = payoutDescAmount.ValueScanner.(field.TypeValueScanner[*big.Int])
+	// payout.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	payout.AmountValidator = payoutDescAmount.Validators[0].(func(string) error)
+	// payoutDescStatus is the schema descriptor for status field.
+	payoutDescStatus := payoutFields[6].Descriptor()
+	// payout.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	payout.StatusValidator = payoutDescStatus.Validators[0].(func(string) error)
+	// payoutDescCreateAt is the schema descriptor for create_at field.
+	payoutDescCreateAt := payoutFields[7].Descriptor()
+	// payout.DefaultCreateAt holds the default value on creation for the create_at field.
+	payout.DefaultCreateAt = payoutDescCreateAt.Default.(func() time.Time)
+	// payoutDescFee is the schema descriptor for fee field.
+	payoutDescFee := payoutFields[8].Descriptor()
+	payout.ValueScanner.Fee = payoutDescFee.ValueScanner.(field.TypeValueScanner[*big.Int])
+	// payoutDescNotifiedStatus is the schema descriptor for notified_status field.
+	payoutDescNotifiedStatus := payoutFields[13].Descriptor()
+	// payout.DefaultNotifiedStatus holds the default value on creation for the notified_status field.
+	payout.DefaultNotifiedStatus = payoutDescNotifiedStatus.Default.(string)
+	// payoutDescID is the schema descriptor for id field.
+	payoutDescID := payoutFields[0].Descriptor()
+	// payout.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	payout.IDValidator = payoutDescID.Validators[0].(func(string) error)
+	payoutdayFields := schema.PayoutDay{}.Fields()
+	_ = payoutdayFields
+	// payoutdayDescPayouts is the schema descriptor for payouts field.
+	payoutdayDescPayouts := payoutdayFields[1].Descriptor()
+	// payoutday.PayoutsValidator is a validator for the "payouts" field. It is called by the builders before save.
+	payoutday.PayoutsValidator = payoutdayDescPayouts.Validators[0].(func(int64) error)
+	// payoutdayDescID is the schema descriptor for id field.
+	payoutdayDescID := payoutdayFields[0].Descriptor()
+	// payoutday.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	payoutday.IDValidator = payoutdayDescID.Validators[0].(func(string) error)
+	treasurycreditFields := schema.TreasuryCredit{}.Fields()
+	_ = treasurycreditFields
+	// treasurycreditDescAsset is the schema descriptor for asset field.
+	treasurycreditDescAsset := treasurycreditFields[0].Descriptor()
+	// treasurycredit.AssetValidator is a validator for the "asset" field. It is called by the builders before save.
+	treasurycredit.AssetValidator = treasurycreditDescAsset.Validators[0].(func(string) error)
+	// treasurycreditDescAccount is the schema descriptor for account field.
+	treasurycreditDescAccount := treasurycreditFields[1].Descriptor()
+	// treasurycredit.AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	treasurycredit.AccountValidator = treasurycreditDescAccount.Validators[0].(func(string) error)
+	// treasurycreditDescInvoiceID is the schema descriptor for invoice_id field.
+	treasurycreditDescInvoiceID := treasurycreditFields[2].Descriptor()
+	// treasurycredit.InvoiceIDValidator is a validator for the "invoice_id" field. It is called by the builders before save.
+	treasurycredit.InvoiceIDValidator = treasurycreditDescInvoiceID.Validators[0].(func(string) error)
+	// treasurycreditDescTxHash is the schema descriptor for tx_hash field.
+	treasurycreditDescTxHash := treasurycreditFields[3].Descriptor()
+	// treasurycredit.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	treasurycredit.TxHashValidator = treasurycreditDescTxHash.Validators[0].(func(string) error)
+	// treasurycreditDescAmount is the schema descriptor for amount field.
+	treasurycreditDescAmount := treasurycreditFields[4].Descriptor()
+	treasurycredit.ValueScanner.Amount = treasurycreditDescAmount.ValueScanner.(field.TypeValueScanner[*big.Int])
+	// treasurycredit.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	treasurycredit.AmountValidator = treasurycreditDescAmount.Validators[0].(func(string) error)
+	// treasurycreditDescCreateAt is the schema descriptor for create_at field.
+	treasurycreditDescCreateAt := treasurycreditFields[5].Descriptor()
+	// treasurycredit.DefaultCreateAt holds the default value on creation for the create_at field.
+	treasurycredit.DefaultCreateAt = treasurycreditDescCreateAt.Default.(func() time.Time)
+	treasurytransferFields := schema.TreasuryTransfer{}.Fields()
+	_ = treasurytransferFields
+	// treasurytransferDescAsset is the schema descriptor for asset field.
+	treasurytransferDescAsset := treasurytransferFields[1].Descriptor()
+	// treasurytransfer.AssetValidator is a validator for the "asset" field. It is called by the builders before save.
+	treasurytransfer.AssetValidator = treasurytransferDescAsset.Validators[0].(func(string) error)
+	// treasurytransferDescKind is the schema descriptor for kind field.
+	treasurytransferDescKind := treasurytransferFields[2].Descriptor()
+	// treasurytransfer.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	treasurytransfer.KindValidator = treasurytransferDescKind.Validators[0].(func(string) error)
+	// treasurytransferDescTo is the schema descriptor for to field.
+	treasurytransferDescTo := treasurytransferFields[3].Descriptor()
+	// treasurytransfer.ToValidator is a validator for the "to" field. It is called by the builders before save.
+	treasurytransfer.ToValidator = treasurytransferDescTo.Validators[0].(func(string) error)
+	// treasurytransferDescAmount is the schema descriptor for amount field.
+	treasurytransferDescAmount := treasurytransferFields[4].Descriptor()
+	treasurytransfer.ValueScanner.Amount = treasurytransferDescAmount.ValueScanner.(field.TypeValueScanner[*big.Int])
+	// treasurytransfer.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	treasurytransfer.AmountValidator = treasurytransferDescAmount.Validators[0].(func(string) error)
+	// treasurytransferDescCreateAt is the schema descriptor for create_at field.
+	treasurytransferDescCreateAt := treasurytransferFields[5].Descriptor()
+	// treasurytransfer.DefaultCreateAt holds the default value on creation for the create_at field.
+	treasurytransfer.DefaultCreateAt = treasurytransferDescCreateAt.Default.(func() time.Time)
+	// treasurytransferDescFee is the schema descriptor for fee field.
+	treasurytransferDescFee := treasurytransferFields[6].Descriptor()
+	treasurytransfer.ValueScanner.Fee = treasurytransferDescFee.ValueScanner.(field.TypeValueScanner[*big.Int])
+	// treasurytransferDescID is the schema descriptor for id field.
+	treasurytransferDescID := treasurytransferFields[0].Descriptor()
+	// treasurytransfer.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	treasurytransfer.IDValidator = treasurytransferDescID.Validators[0].(func(string) error)
+	walletaccountFields := schema.WalletAccount{}.Fields()
+	_ = walletaccountFields
+	// walletaccountDescNextIndex is the schema descriptor for next_index field.
+	walletaccountDescNextIndex := walletaccountFields[1].Descriptor()
+	// walletaccount.NextIndexValidator is a validator for the "next_index" field. It is called by the builders before save.
+	walletaccount.NextIndexValidator = walletaccountDescNextIndex.Validators[0].(func(int64) error)
+	// walletaccountDescID is the schema descriptor for id field.
+	walletaccountDescID := walletaccountFields[0].Descriptor()
+	// walletaccount.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	walletaccount.IDValidator = walletaccountDescID.Validators[0].(func(string) error)
 }
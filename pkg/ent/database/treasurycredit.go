@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"cpg/pkg/ent/database/treasurycredit"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TreasuryCredit is the model entity for the TreasuryCredit schema.
+type TreasuryCredit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Asset holds the value of the "asset" field.
+	Asset string `json:"asset,omitempty"`
+	// Account holds the value of the "account" field.
+	Account string `json:"account,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID string `json:"invoice_id,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount *big.Int `json:"amount,omitempty"`
+	// CreateAt holds the value of the "create_at" field.
+	CreateAt time.Time `json:"create_at,omitempty"`
+	// SettlementID holds the value of the "settlement_id" field.
+	SettlementID string `json:"settlement_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TreasuryCredit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case treasurycredit.FieldID:
+			values[i] = new(sql.NullInt64)
+		case treasurycredit.FieldAsset, treasurycredit.FieldAccount, treasurycredit.FieldInvoiceID, treasurycredit.FieldTxHash, treasurycredit.FieldSettlementID:
+			values[i] = new(sql.NullString)
+		case treasurycredit.FieldCreateAt:
+			values[i] = new(sql.NullTime)
+		case treasurycredit.FieldAmount:
+			values[i] = treasurycredit.ValueScanner.Amount.ScanValue()
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TreasuryCredit fields.
+func (tc *TreasuryCredit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case treasurycredit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tc.ID = int(value.Int64)
+		case treasurycredit.FieldAsset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset", values[i])
+			} else if value.Valid {
+				tc.Asset = value.String
+			}
+		case treasurycredit.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				tc.Account = value.String
+			}
+		case treasurycredit.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				tc.InvoiceID = value.String
+			}
+		case treasurycredit.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				tc.TxHash = value.String
+			}
+		case treasurycredit.FieldAmount:
+			if value, err := treasurycredit.ValueScanner.Amount.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				tc.Amount = value
+			}
+		case treasurycredit.FieldCreateAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_at", values[i])
+			} else if value.Valid {
+				tc.CreateAt = value.Time
+			}
+		case treasurycredit.FieldSettlementID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_id", values[i])
+			} else if value.Valid {
+				tc.SettlementID = value.String
+			}
+		default:
+			tc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TreasuryCredit.
+// This includes values selected through modifiers, order, etc.
+func (tc *TreasuryCredit) Value(name string) (ent.Value, error) {
+	return tc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TreasuryCredit.
+// Note that you need to call TreasuryCredit.Unwrap() before calling this method if this TreasuryCredit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tc *TreasuryCredit) Update() *TreasuryCreditUpdateOne {
+	return NewTreasuryCreditClient(tc.config).UpdateOne(tc)
+}
+
+// Unwrap unwraps the TreasuryCredit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tc *TreasuryCredit) Unwrap() *TreasuryCredit {
+	_tx, ok := tc.config.driver.(*txDriver)
+	if !ok {
+		panic("database: TreasuryCredit is not a transactional entity")
+	}
+	tc.config.driver = _tx.drv
+	return tc
+}
+
+// String implements the fmt.Stringer.
+func (tc *TreasuryCredit) String() string {
+	var builder strings.Builder
+	builder.WriteString("TreasuryCredit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tc.ID))
+	builder.WriteString("asset=")
+	builder.WriteString(tc.Asset)
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(tc.Account)
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(tc.InvoiceID)
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(tc.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", tc.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("create_at=")
+	builder.WriteString(tc.CreateAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_id=")
+	builder.WriteString(tc.SettlementID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TreasuryCredits is a parsable slice of TreasuryCredit.
+type TreasuryCredits []*TreasuryCredit
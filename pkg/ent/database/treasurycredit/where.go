@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package treasurycredit
+
+import (
+	"cpg/pkg/ent/database/predicate"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLTE(FieldID, id))
+}
+
+// Asset applies equality check predicate on the "asset" field. It's identical to AssetEQ.
+func Asset(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldAsset, v))
+}
+
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldAccount, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldTxHash, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryCreditOrErr(sql.FieldEQ(FieldAmount, vc), err)
+}
+
+// CreateAt applies equality check predicate on the "create_at" field. It's identical to CreateAtEQ.
+func CreateAt(v time.Time) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// SettlementID applies equality check predicate on the "settlement_id" field. It's identical to SettlementIDEQ.
+func SettlementID(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// AssetEQ applies the EQ predicate on the "asset" field.
+func AssetEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldAsset, v))
+}
+
+// AssetNEQ applies the NEQ predicate on the "asset" field.
+func AssetNEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNEQ(FieldAsset, v))
+}
+
+// AssetIn applies the In predicate on the "asset" field.
+func AssetIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldIn(FieldAsset, vs...))
+}
+
+// AssetNotIn applies the NotIn predicate on the "asset" field.
+func AssetNotIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNotIn(FieldAsset, vs...))
+}
+
+// AssetGT applies the GT predicate on the "asset" field.
+func AssetGT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGT(FieldAsset, v))
+}
+
+// AssetGTE applies the GTE predicate on the "asset" field.
+func AssetGTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGTE(FieldAsset, v))
+}
+
+// AssetLT applies the LT predicate on the "asset" field.
+func AssetLT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLT(FieldAsset, v))
+}
+
+// AssetLTE applies the LTE predicate on the "asset" field.
+func AssetLTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLTE(FieldAsset, v))
+}
+
+// AssetContains applies the Contains predicate on the "asset" field.
+func AssetContains(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContains(FieldAsset, v))
+}
+
+// AssetHasPrefix applies the HasPrefix predicate on the "asset" field.
+func AssetHasPrefix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasPrefix(FieldAsset, v))
+}
+
+// AssetHasSuffix applies the HasSuffix predicate on the "asset" field.
+func AssetHasSuffix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasSuffix(FieldAsset, v))
+}
+
+// AssetEqualFold applies the EqualFold predicate on the "asset" field.
+func AssetEqualFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEqualFold(FieldAsset, v))
+}
+
+// AssetContainsFold applies the ContainsFold predicate on the "asset" field.
+func AssetContainsFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContainsFold(FieldAsset, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGT(FieldAccount, v))
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGTE(FieldAccount, v))
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLT(FieldAccount, v))
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLTE(FieldAccount, v))
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContains(FieldAccount, v))
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasPrefix(FieldAccount, v))
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasSuffix(FieldAccount, v))
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEqualFold(FieldAccount, v))
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContainsFold(FieldAccount, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGT(FieldInvoiceID, v))
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLT(FieldInvoiceID, v))
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDContains applies the Contains predicate on the "invoice_id" field.
+func InvoiceIDContains(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContains(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasPrefix applies the HasPrefix predicate on the "invoice_id" field.
+func InvoiceIDHasPrefix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasPrefix(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasSuffix applies the HasSuffix predicate on the "invoice_id" field.
+func InvoiceIDHasSuffix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasSuffix(FieldInvoiceID, v))
+}
+
+// InvoiceIDEqualFold applies the EqualFold predicate on the "invoice_id" field.
+func InvoiceIDEqualFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEqualFold(FieldInvoiceID, v))
+}
+
+// InvoiceIDContainsFold applies the ContainsFold predicate on the "invoice_id" field.
+func InvoiceIDContainsFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContainsFold(FieldInvoiceID, v))
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryCreditOrErr(sql.FieldEQ(FieldAmount, vc), err)
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryCreditOrErr(sql.FieldNEQ(FieldAmount, vc), err)
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...*big.Int) predicate.TreasuryCredit {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Amount.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.TreasuryCreditOrErr(sql.FieldIn(FieldAmount, v...), err)
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...*big.Int) predicate.TreasuryCredit {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Amount.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.TreasuryCreditOrErr(sql.FieldNotIn(FieldAmount, v...), err)
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryCreditOrErr(sql.FieldGT(FieldAmount, vc), err)
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryCreditOrErr(sql.FieldGTE(FieldAmount, vc), err)
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryCreditOrErr(sql.FieldLT(FieldAmount, vc), err)
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryCreditOrErr(sql.FieldLTE(FieldAmount, vc), err)
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryCreditOrErr(sql.FieldContains(FieldAmount, vcs), err)
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryCreditOrErr(sql.FieldHasPrefix(FieldAmount, vcs), err)
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryCreditOrErr(sql.FieldHasSuffix(FieldAmount, vcs), err)
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryCreditOrErr(sql.FieldEqualFold(FieldAmount, vcs), err)
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v *big.Int) predicate.TreasuryCredit {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryCreditOrErr(sql.FieldContainsFold(FieldAmount, vcs), err)
+}
+
+// CreateAtEQ applies the EQ predicate on the "create_at" field.
+func CreateAtEQ(v time.Time) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// CreateAtNEQ applies the NEQ predicate on the "create_at" field.
+func CreateAtNEQ(v time.Time) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNEQ(FieldCreateAt, v))
+}
+
+// CreateAtIn applies the In predicate on the "create_at" field.
+func CreateAtIn(vs ...time.Time) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldIn(FieldCreateAt, vs...))
+}
+
+// CreateAtNotIn applies the NotIn predicate on the "create_at" field.
+func CreateAtNotIn(vs ...time.Time) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNotIn(FieldCreateAt, vs...))
+}
+
+// CreateAtGT applies the GT predicate on the "create_at" field.
+func CreateAtGT(v time.Time) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGT(FieldCreateAt, v))
+}
+
+// CreateAtGTE applies the GTE predicate on the "create_at" field.
+func CreateAtGTE(v time.Time) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGTE(FieldCreateAt, v))
+}
+
+// CreateAtLT applies the LT predicate on the "create_at" field.
+func CreateAtLT(v time.Time) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLT(FieldCreateAt, v))
+}
+
+// CreateAtLTE applies the LTE predicate on the "create_at" field.
+func CreateAtLTE(v time.Time) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLTE(FieldCreateAt, v))
+}
+
+// SettlementIDEQ applies the EQ predicate on the "settlement_id" field.
+func SettlementIDEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// SettlementIDNEQ applies the NEQ predicate on the "settlement_id" field.
+func SettlementIDNEQ(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNEQ(FieldSettlementID, v))
+}
+
+// SettlementIDIn applies the In predicate on the "settlement_id" field.
+func SettlementIDIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldIn(FieldSettlementID, vs...))
+}
+
+// SettlementIDNotIn applies the NotIn predicate on the "settlement_id" field.
+func SettlementIDNotIn(vs ...string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNotIn(FieldSettlementID, vs...))
+}
+
+// SettlementIDGT applies the GT predicate on the "settlement_id" field.
+func SettlementIDGT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGT(FieldSettlementID, v))
+}
+
+// SettlementIDGTE applies the GTE predicate on the "settlement_id" field.
+func SettlementIDGTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldGTE(FieldSettlementID, v))
+}
+
+// SettlementIDLT applies the LT predicate on the "settlement_id" field.
+func SettlementIDLT(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLT(FieldSettlementID, v))
+}
+
+// SettlementIDLTE applies the LTE predicate on the "settlement_id" field.
+func SettlementIDLTE(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldLTE(FieldSettlementID, v))
+}
+
+// SettlementIDContains applies the Contains predicate on the "settlement_id" field.
+func SettlementIDContains(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContains(FieldSettlementID, v))
+}
+
+// SettlementIDHasPrefix applies the HasPrefix predicate on the "settlement_id" field.
+func SettlementIDHasPrefix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasPrefix(FieldSettlementID, v))
+}
+
+// SettlementIDHasSuffix applies the HasSuffix predicate on the "settlement_id" field.
+func SettlementIDHasSuffix(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldHasSuffix(FieldSettlementID, v))
+}
+
+// SettlementIDIsNil applies the IsNil predicate on the "settlement_id" field.
+func SettlementIDIsNil() predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldIsNull(FieldSettlementID))
+}
+
+// SettlementIDNotNil applies the NotNil predicate on the "settlement_id" field.
+func SettlementIDNotNil() predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldNotNull(FieldSettlementID))
+}
+
+// SettlementIDEqualFold applies the EqualFold predicate on the "settlement_id" field.
+func SettlementIDEqualFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldEqualFold(FieldSettlementID, v))
+}
+
+// SettlementIDContainsFold applies the ContainsFold predicate on the "settlement_id" field.
+func SettlementIDContainsFold(v string) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.FieldContainsFold(FieldSettlementID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TreasuryCredit) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TreasuryCredit) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TreasuryCredit) predicate.TreasuryCredit {
+	return predicate.TreasuryCredit(sql.NotPredicates(p))
+}
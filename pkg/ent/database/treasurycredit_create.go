@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/treasurycredit"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TreasuryCreditCreate is the builder for creating a TreasuryCredit entity.
+type TreasuryCreditCreate struct {
+	config
+	mutation *TreasuryCreditMutation
+	hooks    []Hook
+}
+
+// SetAsset sets the "asset" field.
+func (tcc *TreasuryCreditCreate) SetAsset(s string) *TreasuryCreditCreate {
+	tcc.mutation.SetAsset(s)
+	return tcc
+}
+
+// SetAccount sets the "account" field.
+func (tcc *TreasuryCreditCreate) SetAccount(s string) *TreasuryCreditCreate {
+	tcc.mutation.SetAccount(s)
+	return tcc
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (tcc *TreasuryCreditCreate) SetInvoiceID(s string) *TreasuryCreditCreate {
+	tcc.mutation.SetInvoiceID(s)
+	return tcc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (tcc *TreasuryCreditCreate) SetTxHash(s string) *TreasuryCreditCreate {
+	tcc.mutation.SetTxHash(s)
+	return tcc
+}
+
+// SetAmount sets the "amount" field.
+func (tcc *TreasuryCreditCreate) SetAmount(b *big.Int) *TreasuryCreditCreate {
+	tcc.mutation.SetAmount(b)
+	return tcc
+}
+
+// SetCreateAt sets the "create_at" field.
+func (tcc *TreasuryCreditCreate) SetCreateAt(t time.Time) *TreasuryCreditCreate {
+	tcc.mutation.SetCreateAt(t)
+	return tcc
+}
+
+// SetNillableCreateAt sets the "create_at" field if the given value is not nil.
+func (tcc *TreasuryCreditCreate) SetNillableCreateAt(t *time.Time) *TreasuryCreditCreate {
+	if t != nil {
+		tcc.SetCreateAt(*t)
+	}
+	return tcc
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (tcc *TreasuryCreditCreate) SetSettlementID(s string) *TreasuryCreditCreate {
+	tcc.mutation.SetSettlementID(s)
+	return tcc
+}
+
+// SetNillableSettlementID sets the "settlement_id" field if the given value is not nil.
+func (tcc *TreasuryCreditCreate) SetNillableSettlementID(s *string) *TreasuryCreditCreate {
+	if s != nil {
+		tcc.SetSettlementID(*s)
+	}
+	return tcc
+}
+
+// Mutation returns the TreasuryCreditMutation object of the builder.
+func (tcc *TreasuryCreditCreate) Mutation() *TreasuryCreditMutation {
+	return tcc.mutation
+}
+
+// Save creates the TreasuryCredit in the database.
+func (tcc *TreasuryCreditCreate) Save(ctx context.Context) (*TreasuryCredit, error) {
+	tcc.defaults()
+	return withHooks(ctx, tcc.sqlSave, tcc.mutation, tcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tcc *TreasuryCreditCreate) SaveX(ctx context.Context) *TreasuryCredit {
+	v, err := tcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcc *TreasuryCreditCreate) Exec(ctx context.Context) error {
+	_, err := tcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcc *TreasuryCreditCreate) ExecX(ctx context.Context) {
+	if err := tcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcc *TreasuryCreditCreate) defaults() {
+	if _, ok := tcc.mutation.CreateAt(); !ok {
+		v := treasurycredit.DefaultCreateAt()
+		tcc.mutation.SetCreateAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcc *TreasuryCreditCreate) check() error {
+	if _, ok := tcc.mutation.Asset(); !ok {
+		return &ValidationError{Name: "asset", err: errors.New(`database: missing required field "TreasuryCredit.asset"`)}
+	}
+	if v, ok := tcc.mutation.Asset(); ok {
+		if err := treasurycredit.AssetValidator(v); err != nil {
+			return &ValidationError{Name: "asset", err: fmt.Errorf(`database: validator failed for field "TreasuryCredit.asset": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`database: missing required field "TreasuryCredit.account"`)}
+	}
+	if v, ok := tcc.mutation.Account(); ok {
+		if err := treasurycredit.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`database: validator failed for field "TreasuryCredit.account": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`database: missing required field "TreasuryCredit.invoice_id"`)}
+	}
+	if v, ok := tcc.mutation.InvoiceID(); ok {
+		if err := treasurycredit.InvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_id", err: fmt.Errorf(`database: validator failed for field "TreasuryCredit.invoice_id": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.TxHash(); !ok {
+		return &ValidationError{Name: "tx_hash", err: errors.New(`database: missing required field "TreasuryCredit.tx_hash"`)}
+	}
+	if v, ok := tcc.mutation.TxHash(); ok {
+		if err := treasurycredit.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`database: validator failed for field "TreasuryCredit.tx_hash": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`database: missing required field "TreasuryCredit.amount"`)}
+	}
+	if v, ok := tcc.mutation.Amount(); ok {
+		if err := treasurycredit.AmountValidator(v.String()); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`database: validator failed for field "TreasuryCredit.amount": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.CreateAt(); !ok {
+		return &ValidationError{Name: "create_at", err: errors.New(`database: missing required field "TreasuryCredit.create_at"`)}
+	}
+	return nil
+}
+
+func (tcc *TreasuryCreditCreate) sqlSave(ctx context.Context) (*TreasuryCredit, error) {
+	if err := tcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec, err := tcc.createSpec()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlgraph.CreateNode(ctx, tcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tcc.mutation.id = &_node.ID
+	tcc.mutation.done = true
+	return _node, nil
+}
+
+func (tcc *TreasuryCreditCreate) createSpec() (*TreasuryCredit, *sqlgraph.CreateSpec, error) {
+	var (
+		_node = &TreasuryCredit{config: tcc.config}
+		_spec = sqlgraph.NewCreateSpec(treasurycredit.Table, sqlgraph.NewFieldSpec(treasurycredit.FieldID, field.TypeInt))
+	)
+	if value, ok := tcc.mutation.Asset(); ok {
+		_spec.SetField(treasurycredit.FieldAsset, field.TypeString, value)
+		_node.Asset = value
+	}
+	if value, ok := tcc.mutation.Account(); ok {
+		_spec.SetField(treasurycredit.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
+	if value, ok := tcc.mutation.InvoiceID(); ok {
+		_spec.SetField(treasurycredit.FieldInvoiceID, field.TypeString, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := tcc.mutation.TxHash(); ok {
+		_spec.SetField(treasurycredit.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := tcc.mutation.Amount(); ok {
+		vv, err := treasurycredit.ValueScanner.Amount.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(treasurycredit.FieldAmount, field.TypeString, vv)
+		_node.Amount = value
+	}
+	if value, ok := tcc.mutation.CreateAt(); ok {
+		_spec.SetField(treasurycredit.FieldCreateAt, field.TypeTime, value)
+		_node.CreateAt = value
+	}
+	if value, ok := tcc.mutation.SettlementID(); ok {
+		_spec.SetField(treasurycredit.FieldSettlementID, field.TypeString, value)
+		_node.SettlementID = value
+	}
+	return _node, _spec, nil
+}
+
+// TreasuryCreditCreateBulk is the builder for creating many TreasuryCredit entities in bulk.
+type TreasuryCreditCreateBulk struct {
+	config
+	err      error
+	builders []*TreasuryCreditCreate
+}
+
+// Save creates the TreasuryCredit entities in the database.
+func (tccb *TreasuryCreditCreateBulk) Save(ctx context.Context) ([]*TreasuryCredit, error) {
+	if tccb.err != nil {
+		return nil, tccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tccb.builders))
+	nodes := make([]*TreasuryCredit, len(tccb.builders))
+	mutators := make([]Mutator, len(tccb.builders))
+	for i := range tccb.builders {
+		func(i int, root context.Context) {
+			builder := tccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TreasuryCreditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tccb *TreasuryCreditCreateBulk) SaveX(ctx context.Context) []*TreasuryCredit {
+	v, err := tccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tccb *TreasuryCreditCreateBulk) Exec(ctx context.Context) error {
+	_, err := tccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tccb *TreasuryCreditCreateBulk) ExecX(ctx context.Context) {
+	if err := tccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/treasurycredit"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TreasuryCreditDelete is the builder for deleting a TreasuryCredit entity.
+type TreasuryCreditDelete struct {
+	config
+	hooks    []Hook
+	mutation *TreasuryCreditMutation
+}
+
+// Where appends a list predicates to the TreasuryCreditDelete builder.
+func (tcd *TreasuryCreditDelete) Where(ps ...predicate.TreasuryCredit) *TreasuryCreditDelete {
+	tcd.mutation.Where(ps...)
+	return tcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tcd *TreasuryCreditDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tcd.sqlExec, tcd.mutation, tcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcd *TreasuryCreditDelete) ExecX(ctx context.Context) int {
+	n, err := tcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tcd *TreasuryCreditDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(treasurycredit.Table, sqlgraph.NewFieldSpec(treasurycredit.FieldID, field.TypeInt))
+	if ps := tcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tcd.mutation.done = true
+	return affected, err
+}
+
+// TreasuryCreditDeleteOne is the builder for deleting a single TreasuryCredit entity.
+type TreasuryCreditDeleteOne struct {
+	tcd *TreasuryCreditDelete
+}
+
+// Where appends a list predicates to the TreasuryCreditDelete builder.
+func (tcdo *TreasuryCreditDeleteOne) Where(ps ...predicate.TreasuryCredit) *TreasuryCreditDeleteOne {
+	tcdo.tcd.mutation.Where(ps...)
+	return tcdo
+}
+
+// Exec executes the deletion query.
+func (tcdo *TreasuryCreditDeleteOne) Exec(ctx context.Context) error {
+	n, err := tcdo.tcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{treasurycredit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcdo *TreasuryCreditDeleteOne) ExecX(ctx context.Context) {
+	if err := tcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
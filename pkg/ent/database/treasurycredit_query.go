@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/treasurycredit"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TreasuryCreditQuery is the builder for querying TreasuryCredit entities.
+type TreasuryCreditQuery struct {
+	config
+	ctx        *QueryContext
+	order      []treasurycredit.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TreasuryCredit
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TreasuryCreditQuery builder.
+func (tcq *TreasuryCreditQuery) Where(ps ...predicate.TreasuryCredit) *TreasuryCreditQuery {
+	tcq.predicates = append(tcq.predicates, ps...)
+	return tcq
+}
+
+// Limit the number of records to be returned by this query.
+func (tcq *TreasuryCreditQuery) Limit(limit int) *TreasuryCreditQuery {
+	tcq.ctx.Limit = &limit
+	return tcq
+}
+
+// Offset to start from.
+func (tcq *TreasuryCreditQuery) Offset(offset int) *TreasuryCreditQuery {
+	tcq.ctx.Offset = &offset
+	return tcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tcq *TreasuryCreditQuery) Unique(unique bool) *TreasuryCreditQuery {
+	tcq.ctx.Unique = &unique
+	return tcq
+}
+
+// Order specifies how the records should be ordered.
+func (tcq *TreasuryCreditQuery) Order(o ...treasurycredit.OrderOption) *TreasuryCreditQuery {
+	tcq.order = append(tcq.order, o...)
+	return tcq
+}
+
+// First returns the first TreasuryCredit entity from the query.
+// Returns a *NotFoundError when no TreasuryCredit was found.
+func (tcq *TreasuryCreditQuery) First(ctx context.Context) (*TreasuryCredit, error) {
+	nodes, err := tcq.Limit(1).All(setContextOp(ctx, tcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{treasurycredit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tcq *TreasuryCreditQuery) FirstX(ctx context.Context) *TreasuryCredit {
+	node, err := tcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TreasuryCredit ID from the query.
+// Returns a *NotFoundError when no TreasuryCredit ID was found.
+func (tcq *TreasuryCreditQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tcq.Limit(1).IDs(setContextOp(ctx, tcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{treasurycredit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tcq *TreasuryCreditQuery) FirstIDX(ctx context.Context) int {
+	id, err := tcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TreasuryCredit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TreasuryCredit entity is found.
+// Returns a *NotFoundError when no TreasuryCredit entities are found.
+func (tcq *TreasuryCreditQuery) Only(ctx context.Context) (*TreasuryCredit, error) {
+	nodes, err := tcq.Limit(2).All(setContextOp(ctx, tcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{treasurycredit.Label}
+	default:
+		return nil, &NotSingularError{treasurycredit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tcq *TreasuryCreditQuery) OnlyX(ctx context.Context) *TreasuryCredit {
+	node, err := tcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TreasuryCredit ID in the query.
+// Returns a *NotSingularError when more than one TreasuryCredit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tcq *TreasuryCreditQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tcq.Limit(2).IDs(setContextOp(ctx, tcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{treasurycredit.Label}
+	default:
+		err = &NotSingularError{treasurycredit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tcq *TreasuryCreditQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TreasuryCredits.
+func (tcq *TreasuryCreditQuery) All(ctx context.Context) ([]*TreasuryCredit, error) {
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryAll)
+	if err := tcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TreasuryCredit, *TreasuryCreditQuery]()
+	return withInterceptors[[]*TreasuryCredit](ctx, tcq, qr, tcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tcq *TreasuryCreditQuery) AllX(ctx context.Context) []*TreasuryCredit {
+	nodes, err := tcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TreasuryCredit IDs.
+func (tcq *TreasuryCreditQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tcq.ctx.Unique == nil && tcq.path != nil {
+		tcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryIDs)
+	if err = tcq.Select(treasurycredit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tcq *TreasuryCreditQuery) IDsX(ctx context.Context) []int {
+	ids, err := tcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tcq *TreasuryCreditQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryCount)
+	if err := tcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tcq, querierCount[*TreasuryCreditQuery](), tcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tcq *TreasuryCreditQuery) CountX(ctx context.Context) int {
+	count, err := tcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tcq *TreasuryCreditQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryExist)
+	switch _, err := tcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("database: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tcq *TreasuryCreditQuery) ExistX(ctx context.Context) bool {
+	exist, err := tcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TreasuryCreditQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tcq *TreasuryCreditQuery) Clone() *TreasuryCreditQuery {
+	if tcq == nil {
+		return nil
+	}
+	return &TreasuryCreditQuery{
+		config:     tcq.config,
+		ctx:        tcq.ctx.Clone(),
+		order:      append([]treasurycredit.OrderOption{}, tcq.order...),
+		inters:     append([]Interceptor{}, tcq.inters...),
+		predicates: append([]predicate.TreasuryCredit{}, tcq.predicates...),
+		// clone intermediate query.
+		sql:  tcq.sql.Clone(),
+		path: tcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Asset string `json:"asset,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TreasuryCredit.Query().
+//		GroupBy(treasurycredit.FieldAsset).
+//		Aggregate(database.Count()).
+//		Scan(ctx, &v)
+func (tcq *TreasuryCreditQuery) GroupBy(field string, fields ...string) *TreasuryCreditGroupBy {
+	tcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TreasuryCreditGroupBy{build: tcq}
+	grbuild.flds = &tcq.ctx.Fields
+	grbuild.label = treasurycredit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Asset string `json:"asset,omitempty"`
+//	}
+//
+//	client.TreasuryCredit.Query().
+//		Select(treasurycredit.FieldAsset).
+//		Scan(ctx, &v)
+func (tcq *TreasuryCreditQuery) Select(fields ...string) *TreasuryCreditSelect {
+	tcq.ctx.Fields = append(tcq.ctx.Fields, fields...)
+	sbuild := &TreasuryCreditSelect{TreasuryCreditQuery: tcq}
+	sbuild.label = treasurycredit.Label
+	sbuild.flds, sbuild.scan = &tcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TreasuryCreditSelect configured with the given aggregations.
+func (tcq *TreasuryCreditQuery) Aggregate(fns ...AggregateFunc) *TreasuryCreditSelect {
+	return tcq.Select().Aggregate(fns...)
+}
+
+func (tcq *TreasuryCreditQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tcq.inters {
+		if inter == nil {
+			return fmt.Errorf("database: uninitialized interceptor (forgotten import database/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tcq.ctx.Fields {
+		if !treasurycredit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+		}
+	}
+	if tcq.path != nil {
+		prev, err := tcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tcq.sql = prev
+	}
+	return nil
+}
+
+func (tcq *TreasuryCreditQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TreasuryCredit, error) {
+	var (
+		nodes = []*TreasuryCredit{}
+		_spec = tcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TreasuryCredit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TreasuryCredit{config: tcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tcq *TreasuryCreditQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tcq.querySpec()
+	_spec.Node.Columns = tcq.ctx.Fields
+	if len(tcq.ctx.Fields) > 0 {
+		_spec.Unique = tcq.ctx.Unique != nil && *tcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tcq.driver, _spec)
+}
+
+func (tcq *TreasuryCreditQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(treasurycredit.Table, treasurycredit.Columns, sqlgraph.NewFieldSpec(treasurycredit.FieldID, field.TypeInt))
+	_spec.From = tcq.sql
+	if unique := tcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, treasurycredit.FieldID)
+		for i := range fields {
+			if fields[i] != treasurycredit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tcq *TreasuryCreditQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tcq.driver.Dialect())
+	t1 := builder.Table(treasurycredit.Table)
+	columns := tcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = treasurycredit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tcq.sql != nil {
+		selector = tcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tcq.ctx.Unique != nil && *tcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tcq.predicates {
+		p(selector)
+	}
+	for _, p := range tcq.order {
+		p(selector)
+	}
+	if offset := tcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TreasuryCreditGroupBy is the group-by builder for TreasuryCredit entities.
+type TreasuryCreditGroupBy struct {
+	selector
+	build *TreasuryCreditQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tcgb *TreasuryCreditGroupBy) Aggregate(fns ...AggregateFunc) *TreasuryCreditGroupBy {
+	tcgb.fns = append(tcgb.fns, fns...)
+	return tcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcgb *TreasuryCreditGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TreasuryCreditQuery, *TreasuryCreditGroupBy](ctx, tcgb.build, tcgb, tcgb.build.inters, v)
+}
+
+func (tcgb *TreasuryCreditGroupBy) sqlScan(ctx context.Context, root *TreasuryCreditQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tcgb.fns))
+	for _, fn := range tcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tcgb.flds)+len(tcgb.fns))
+		for _, f := range *tcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TreasuryCreditSelect is the builder for selecting fields of TreasuryCredit entities.
+type TreasuryCreditSelect struct {
+	*TreasuryCreditQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tcs *TreasuryCreditSelect) Aggregate(fns ...AggregateFunc) *TreasuryCreditSelect {
+	tcs.fns = append(tcs.fns, fns...)
+	return tcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcs *TreasuryCreditSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcs.ctx, ent.OpQuerySelect)
+	if err := tcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TreasuryCreditQuery, *TreasuryCreditSelect](ctx, tcs.TreasuryCreditQuery, tcs, tcs.inters, v)
+}
+
+func (tcs *TreasuryCreditSelect) sqlScan(ctx context.Context, root *TreasuryCreditQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tcs.fns))
+	for _, fn := range tcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
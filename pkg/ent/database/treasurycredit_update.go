@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/treasurycredit"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TreasuryCreditUpdate is the builder for updating TreasuryCredit entities.
+type TreasuryCreditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TreasuryCreditMutation
+}
+
+// Where appends a list predicates to the TreasuryCreditUpdate builder.
+func (tcu *TreasuryCreditUpdate) Where(ps ...predicate.TreasuryCredit) *TreasuryCreditUpdate {
+	tcu.mutation.Where(ps...)
+	return tcu
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (tcu *TreasuryCreditUpdate) SetSettlementID(s string) *TreasuryCreditUpdate {
+	tcu.mutation.SetSettlementID(s)
+	return tcu
+}
+
+// SetNillableSettlementID sets the "settlement_id" field if the given value is not nil.
+func (tcu *TreasuryCreditUpdate) SetNillableSettlementID(s *string) *TreasuryCreditUpdate {
+	if s != nil {
+		tcu.SetSettlementID(*s)
+	}
+	return tcu
+}
+
+// ClearSettlementID clears the value of the "settlement_id" field.
+func (tcu *TreasuryCreditUpdate) ClearSettlementID() *TreasuryCreditUpdate {
+	tcu.mutation.ClearSettlementID()
+	return tcu
+}
+
+// Mutation returns the TreasuryCreditMutation object of the builder.
+func (tcu *TreasuryCreditUpdate) Mutation() *TreasuryCreditMutation {
+	return tcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tcu *TreasuryCreditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tcu.sqlSave, tcu.mutation, tcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcu *TreasuryCreditUpdate) SaveX(ctx context.Context) int {
+	affected, err := tcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tcu *TreasuryCreditUpdate) Exec(ctx context.Context) error {
+	_, err := tcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcu *TreasuryCreditUpdate) ExecX(ctx context.Context) {
+	if err := tcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tcu *TreasuryCreditUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(treasurycredit.Table, treasurycredit.Columns, sqlgraph.NewFieldSpec(treasurycredit.FieldID, field.TypeInt))
+	if ps := tcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcu.mutation.SettlementID(); ok {
+		_spec.SetField(treasurycredit.FieldSettlementID, field.TypeString, value)
+	}
+	if tcu.mutation.SettlementIDCleared() {
+		_spec.ClearField(treasurycredit.FieldSettlementID, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{treasurycredit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tcu.mutation.done = true
+	return n, nil
+}
+
+// TreasuryCreditUpdateOne is the builder for updating a single TreasuryCredit entity.
+type TreasuryCreditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TreasuryCreditMutation
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (tcuo *TreasuryCreditUpdateOne) SetSettlementID(s string) *TreasuryCreditUpdateOne {
+	tcuo.mutation.SetSettlementID(s)
+	return tcuo
+}
+
+// SetNillableSettlementID sets the "settlement_id" field if the given value is not nil.
+func (tcuo *TreasuryCreditUpdateOne) SetNillableSettlementID(s *string) *TreasuryCreditUpdateOne {
+	if s != nil {
+		tcuo.SetSettlementID(*s)
+	}
+	return tcuo
+}
+
+// ClearSettlementID clears the value of the "settlement_id" field.
+func (tcuo *TreasuryCreditUpdateOne) ClearSettlementID() *TreasuryCreditUpdateOne {
+	tcuo.mutation.ClearSettlementID()
+	return tcuo
+}
+
+// Mutation returns the TreasuryCreditMutation object of the builder.
+func (tcuo *TreasuryCreditUpdateOne) Mutation() *TreasuryCreditMutation {
+	return tcuo.mutation
+}
+
+// Where appends a list predicates to the TreasuryCreditUpdate builder.
+func (tcuo *TreasuryCreditUpdateOne) Where(ps ...predicate.TreasuryCredit) *TreasuryCreditUpdateOne {
+	tcuo.mutation.Where(ps...)
+	return tcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tcuo *TreasuryCreditUpdateOne) Select(field string, fields ...string) *TreasuryCreditUpdateOne {
+	tcuo.fields = append([]string{field}, fields...)
+	return tcuo
+}
+
+// Save executes the query and returns the updated TreasuryCredit entity.
+func (tcuo *TreasuryCreditUpdateOne) Save(ctx context.Context) (*TreasuryCredit, error) {
+	return withHooks(ctx, tcuo.sqlSave, tcuo.mutation, tcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcuo *TreasuryCreditUpdateOne) SaveX(ctx context.Context) *TreasuryCredit {
+	node, err := tcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tcuo *TreasuryCreditUpdateOne) Exec(ctx context.Context) error {
+	_, err := tcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcuo *TreasuryCreditUpdateOne) ExecX(ctx context.Context) {
+	if err := tcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tcuo *TreasuryCreditUpdateOne) sqlSave(ctx context.Context) (_node *TreasuryCredit, err error) {
+	_spec := sqlgraph.NewUpdateSpec(treasurycredit.Table, treasurycredit.Columns, sqlgraph.NewFieldSpec(treasurycredit.FieldID, field.TypeInt))
+	id, ok := tcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`database: missing "TreasuryCredit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, treasurycredit.FieldID)
+		for _, f := range fields {
+			if !treasurycredit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+			}
+			if f != treasurycredit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcuo.mutation.SettlementID(); ok {
+		_spec.SetField(treasurycredit.FieldSettlementID, field.TypeString, value)
+	}
+	if tcuo.mutation.SettlementIDCleared() {
+		_spec.ClearField(treasurycredit.FieldSettlementID, field.TypeString)
+	}
+	_node = &TreasuryCredit{config: tcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{treasurycredit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tcuo.mutation.done = true
+	return _node, nil
+}
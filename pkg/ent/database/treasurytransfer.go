@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"cpg/pkg/ent/database/treasurytransfer"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TreasuryTransfer is the model entity for the TreasuryTransfer schema.
+type TreasuryTransfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Asset holds the value of the "asset" field.
+	Asset string `json:"asset,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// To holds the value of the "to" field.
+	To string `json:"to,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount *big.Int `json:"amount,omitempty"`
+	// CreateAt holds the value of the "create_at" field.
+	CreateAt time.Time `json:"create_at,omitempty"`
+	// Fee holds the value of the "fee" field.
+	Fee *big.Int `json:"fee,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// SendAt holds the value of the "send_at" field.
+	SendAt *time.Time `json:"send_at,omitempty"`
+	// Error holds the value of the "error" field.
+	Error        string `json:"error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TreasuryTransfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case treasurytransfer.FieldID, treasurytransfer.FieldAsset, treasurytransfer.FieldKind, treasurytransfer.FieldTo, treasurytransfer.FieldTxHash, treasurytransfer.FieldError:
+			values[i] = new(sql.NullString)
+		case treasurytransfer.FieldCreateAt, treasurytransfer.FieldSendAt:
+			values[i] = new(sql.NullTime)
+		case treasurytransfer.FieldAmount:
+			values[i] = treasurytransfer.ValueScanner.Amount.ScanValue()
+		case treasurytransfer.FieldFee:
+			values[i] = treasurytransfer.ValueScanner.Fee.ScanValue()
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TreasuryTransfer fields.
+func (tt *TreasuryTransfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case treasurytransfer.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				tt.ID = value.String
+			}
+		case treasurytransfer.FieldAsset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset", values[i])
+			} else if value.Valid {
+				tt.Asset = value.String
+			}
+		case treasurytransfer.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				tt.Kind = value.String
+			}
+		case treasurytransfer.FieldTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to", values[i])
+			} else if value.Valid {
+				tt.To = value.String
+			}
+		case treasurytransfer.FieldAmount:
+			if value, err := treasurytransfer.ValueScanner.Amount.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				tt.Amount = value
+			}
+		case treasurytransfer.FieldCreateAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_at", values[i])
+			} else if value.Valid {
+				tt.CreateAt = value.Time
+			}
+		case treasurytransfer.FieldFee:
+			if value, err := treasurytransfer.ValueScanner.Fee.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				tt.Fee = value
+			}
+		case treasurytransfer.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				tt.TxHash = value.String
+			}
+		case treasurytransfer.FieldSendAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field send_at", values[i])
+			} else if value.Valid {
+				tt.SendAt = new(time.Time)
+				*tt.SendAt = value.Time
+			}
+		case treasurytransfer.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				tt.Error = value.String
+			}
+		default:
+			tt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TreasuryTransfer.
+// This includes values selected through modifiers, order, etc.
+func (tt *TreasuryTransfer) Value(name string) (ent.Value, error) {
+	return tt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TreasuryTransfer.
+// Note that you need to call TreasuryTransfer.Unwrap() before calling this method if this TreasuryTransfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tt *TreasuryTransfer) Update() *TreasuryTransferUpdateOne {
+	return NewTreasuryTransferClient(tt.config).UpdateOne(tt)
+}
+
+// Unwrap unwraps the TreasuryTransfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tt *TreasuryTransfer) Unwrap() *TreasuryTransfer {
+	_tx, ok := tt.config.driver.(*txDriver)
+	if !ok {
+		panic("database: TreasuryTransfer is not a transactional entity")
+	}
+	tt.config.driver = _tx.drv
+	return tt
+}
+
+// String implements the fmt.Stringer.
+func (tt *TreasuryTransfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("TreasuryTransfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tt.ID))
+	builder.WriteString("asset=")
+	builder.WriteString(tt.Asset)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(tt.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("to=")
+	builder.WriteString(tt.To)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", tt.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("create_at=")
+	builder.WriteString(tt.CreateAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("fee=")
+	builder.WriteString(fmt.Sprintf("%v", tt.Fee))
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(tt.TxHash)
+	builder.WriteString(", ")
+	if v := tt.SendAt; v != nil {
+		builder.WriteString("send_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(tt.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TreasuryTransfers is a parsable slice of TreasuryTransfer.
+type TreasuryTransfers []*TreasuryTransfer
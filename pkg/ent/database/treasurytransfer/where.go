@@ -0,0 +1,802 @@
+// Code generated by ent, DO NOT EDIT.
+
+package treasurytransfer
+
+import (
+	"cpg/pkg/ent/database/predicate"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContainsFold(FieldID, id))
+}
+
+// Asset applies equality check predicate on the "asset" field. It's identical to AssetEQ.
+func Asset(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldAsset, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldKind, v))
+}
+
+// To applies equality check predicate on the "to" field. It's identical to ToEQ.
+func To(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldTo, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldEQ(FieldAmount, vc), err)
+}
+
+// CreateAt applies equality check predicate on the "create_at" field. It's identical to CreateAtEQ.
+func CreateAt(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// Fee applies equality check predicate on the "fee" field. It's identical to FeeEQ.
+func Fee(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldEQ(FieldFee, vc), err)
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldTxHash, v))
+}
+
+// SendAt applies equality check predicate on the "send_at" field. It's identical to SendAtEQ.
+func SendAt(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldSendAt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldError, v))
+}
+
+// AssetEQ applies the EQ predicate on the "asset" field.
+func AssetEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldAsset, v))
+}
+
+// AssetNEQ applies the NEQ predicate on the "asset" field.
+func AssetNEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNEQ(FieldAsset, v))
+}
+
+// AssetIn applies the In predicate on the "asset" field.
+func AssetIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIn(FieldAsset, vs...))
+}
+
+// AssetNotIn applies the NotIn predicate on the "asset" field.
+func AssetNotIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotIn(FieldAsset, vs...))
+}
+
+// AssetGT applies the GT predicate on the "asset" field.
+func AssetGT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGT(FieldAsset, v))
+}
+
+// AssetGTE applies the GTE predicate on the "asset" field.
+func AssetGTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGTE(FieldAsset, v))
+}
+
+// AssetLT applies the LT predicate on the "asset" field.
+func AssetLT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLT(FieldAsset, v))
+}
+
+// AssetLTE applies the LTE predicate on the "asset" field.
+func AssetLTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLTE(FieldAsset, v))
+}
+
+// AssetContains applies the Contains predicate on the "asset" field.
+func AssetContains(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContains(FieldAsset, v))
+}
+
+// AssetHasPrefix applies the HasPrefix predicate on the "asset" field.
+func AssetHasPrefix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasPrefix(FieldAsset, v))
+}
+
+// AssetHasSuffix applies the HasSuffix predicate on the "asset" field.
+func AssetHasSuffix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasSuffix(FieldAsset, v))
+}
+
+// AssetEqualFold applies the EqualFold predicate on the "asset" field.
+func AssetEqualFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEqualFold(FieldAsset, v))
+}
+
+// AssetContainsFold applies the ContainsFold predicate on the "asset" field.
+func AssetContainsFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContainsFold(FieldAsset, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ToEQ applies the EQ predicate on the "to" field.
+func ToEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldTo, v))
+}
+
+// ToNEQ applies the NEQ predicate on the "to" field.
+func ToNEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNEQ(FieldTo, v))
+}
+
+// ToIn applies the In predicate on the "to" field.
+func ToIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIn(FieldTo, vs...))
+}
+
+// ToNotIn applies the NotIn predicate on the "to" field.
+func ToNotIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotIn(FieldTo, vs...))
+}
+
+// ToGT applies the GT predicate on the "to" field.
+func ToGT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGT(FieldTo, v))
+}
+
+// ToGTE applies the GTE predicate on the "to" field.
+func ToGTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGTE(FieldTo, v))
+}
+
+// ToLT applies the LT predicate on the "to" field.
+func ToLT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLT(FieldTo, v))
+}
+
+// ToLTE applies the LTE predicate on the "to" field.
+func ToLTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLTE(FieldTo, v))
+}
+
+// ToContains applies the Contains predicate on the "to" field.
+func ToContains(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContains(FieldTo, v))
+}
+
+// ToHasPrefix applies the HasPrefix predicate on the "to" field.
+func ToHasPrefix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasPrefix(FieldTo, v))
+}
+
+// ToHasSuffix applies the HasSuffix predicate on the "to" field.
+func ToHasSuffix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasSuffix(FieldTo, v))
+}
+
+// ToEqualFold applies the EqualFold predicate on the "to" field.
+func ToEqualFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEqualFold(FieldTo, v))
+}
+
+// ToContainsFold applies the ContainsFold predicate on the "to" field.
+func ToContainsFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContainsFold(FieldTo, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldEQ(FieldAmount, vc), err)
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldNEQ(FieldAmount, vc), err)
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...*big.Int) predicate.TreasuryTransfer {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Amount.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldIn(FieldAmount, v...), err)
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...*big.Int) predicate.TreasuryTransfer {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Amount.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldNotIn(FieldAmount, v...), err)
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldGT(FieldAmount, vc), err)
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldGTE(FieldAmount, vc), err)
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldLT(FieldAmount, vc), err)
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldLTE(FieldAmount, vc), err)
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldContains(FieldAmount, vcs), err)
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldHasPrefix(FieldAmount, vcs), err)
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldHasSuffix(FieldAmount, vcs), err)
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldEqualFold(FieldAmount, vcs), err)
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Amount.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("amount value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldContainsFold(FieldAmount, vcs), err)
+}
+
+// CreateAtEQ applies the EQ predicate on the "create_at" field.
+func CreateAtEQ(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldCreateAt, v))
+}
+
+// CreateAtNEQ applies the NEQ predicate on the "create_at" field.
+func CreateAtNEQ(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNEQ(FieldCreateAt, v))
+}
+
+// CreateAtIn applies the In predicate on the "create_at" field.
+func CreateAtIn(vs ...time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIn(FieldCreateAt, vs...))
+}
+
+// CreateAtNotIn applies the NotIn predicate on the "create_at" field.
+func CreateAtNotIn(vs ...time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotIn(FieldCreateAt, vs...))
+}
+
+// CreateAtGT applies the GT predicate on the "create_at" field.
+func CreateAtGT(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGT(FieldCreateAt, v))
+}
+
+// CreateAtGTE applies the GTE predicate on the "create_at" field.
+func CreateAtGTE(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGTE(FieldCreateAt, v))
+}
+
+// CreateAtLT applies the LT predicate on the "create_at" field.
+func CreateAtLT(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLT(FieldCreateAt, v))
+}
+
+// CreateAtLTE applies the LTE predicate on the "create_at" field.
+func CreateAtLTE(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLTE(FieldCreateAt, v))
+}
+
+// FeeEQ applies the EQ predicate on the "fee" field.
+func FeeEQ(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldEQ(FieldFee, vc), err)
+}
+
+// FeeNEQ applies the NEQ predicate on the "fee" field.
+func FeeNEQ(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldNEQ(FieldFee, vc), err)
+}
+
+// FeeIn applies the In predicate on the "fee" field.
+func FeeIn(vs ...*big.Int) predicate.TreasuryTransfer {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Fee.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldIn(FieldFee, v...), err)
+}
+
+// FeeNotIn applies the NotIn predicate on the "fee" field.
+func FeeNotIn(vs ...*big.Int) predicate.TreasuryTransfer {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Fee.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldNotIn(FieldFee, v...), err)
+}
+
+// FeeGT applies the GT predicate on the "fee" field.
+func FeeGT(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldGT(FieldFee, vc), err)
+}
+
+// FeeGTE applies the GTE predicate on the "fee" field.
+func FeeGTE(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldGTE(FieldFee, vc), err)
+}
+
+// FeeLT applies the LT predicate on the "fee" field.
+func FeeLT(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldLT(FieldFee, vc), err)
+}
+
+// FeeLTE applies the LTE predicate on the "fee" field.
+func FeeLTE(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	return predicate.TreasuryTransferOrErr(sql.FieldLTE(FieldFee, vc), err)
+}
+
+// FeeContains applies the Contains predicate on the "fee" field.
+func FeeContains(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldContains(FieldFee, vcs), err)
+}
+
+// FeeHasPrefix applies the HasPrefix predicate on the "fee" field.
+func FeeHasPrefix(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldHasPrefix(FieldFee, vcs), err)
+}
+
+// FeeHasSuffix applies the HasSuffix predicate on the "fee" field.
+func FeeHasSuffix(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldHasSuffix(FieldFee, vcs), err)
+}
+
+// FeeIsNil applies the IsNil predicate on the "fee" field.
+func FeeIsNil() predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIsNull(FieldFee))
+}
+
+// FeeNotNil applies the NotNil predicate on the "fee" field.
+func FeeNotNil() predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotNull(FieldFee))
+}
+
+// FeeEqualFold applies the EqualFold predicate on the "fee" field.
+func FeeEqualFold(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldEqualFold(FieldFee, vcs), err)
+}
+
+// FeeContainsFold applies the ContainsFold predicate on the "fee" field.
+func FeeContainsFold(v *big.Int) predicate.TreasuryTransfer {
+	vc, err := ValueScanner.Fee.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("fee value is not a string: %T", vc)
+	}
+	return predicate.TreasuryTransferOrErr(sql.FieldContainsFold(FieldFee, vcs), err)
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashIsNil applies the IsNil predicate on the "tx_hash" field.
+func TxHashIsNil() predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIsNull(FieldTxHash))
+}
+
+// TxHashNotNil applies the NotNil predicate on the "tx_hash" field.
+func TxHashNotNil() predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotNull(FieldTxHash))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// SendAtEQ applies the EQ predicate on the "send_at" field.
+func SendAtEQ(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldSendAt, v))
+}
+
+// SendAtNEQ applies the NEQ predicate on the "send_at" field.
+func SendAtNEQ(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNEQ(FieldSendAt, v))
+}
+
+// SendAtIn applies the In predicate on the "send_at" field.
+func SendAtIn(vs ...time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIn(FieldSendAt, vs...))
+}
+
+// SendAtNotIn applies the NotIn predicate on the "send_at" field.
+func SendAtNotIn(vs ...time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotIn(FieldSendAt, vs...))
+}
+
+// SendAtGT applies the GT predicate on the "send_at" field.
+func SendAtGT(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGT(FieldSendAt, v))
+}
+
+// SendAtGTE applies the GTE predicate on the "send_at" field.
+func SendAtGTE(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGTE(FieldSendAt, v))
+}
+
+// SendAtLT applies the LT predicate on the "send_at" field.
+func SendAtLT(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLT(FieldSendAt, v))
+}
+
+// SendAtLTE applies the LTE predicate on the "send_at" field.
+func SendAtLTE(v time.Time) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLTE(FieldSendAt, v))
+}
+
+// SendAtIsNil applies the IsNil predicate on the "send_at" field.
+func SendAtIsNil() predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIsNull(FieldSendAt))
+}
+
+// SendAtNotNil applies the NotNil predicate on the "send_at" field.
+func SendAtNotNil() predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotNull(FieldSendAt))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.FieldContainsFold(FieldError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TreasuryTransfer) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TreasuryTransfer) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TreasuryTransfer) predicate.TreasuryTransfer {
+	return predicate.TreasuryTransfer(sql.NotPredicates(p))
+}
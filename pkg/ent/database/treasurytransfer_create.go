@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/treasurytransfer"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TreasuryTransferCreate is the builder for creating a TreasuryTransfer entity.
+type TreasuryTransferCreate struct {
+	config
+	mutation *TreasuryTransferMutation
+	hooks    []Hook
+}
+
+// SetAsset sets the "asset" field.
+func (ttc *TreasuryTransferCreate) SetAsset(s string) *TreasuryTransferCreate {
+	ttc.mutation.SetAsset(s)
+	return ttc
+}
+
+// SetKind sets the "kind" field.
+func (ttc *TreasuryTransferCreate) SetKind(s string) *TreasuryTransferCreate {
+	ttc.mutation.SetKind(s)
+	return ttc
+}
+
+// SetTo sets the "to" field.
+func (ttc *TreasuryTransferCreate) SetTo(s string) *TreasuryTransferCreate {
+	ttc.mutation.SetTo(s)
+	return ttc
+}
+
+// SetAmount sets the "amount" field.
+func (ttc *TreasuryTransferCreate) SetAmount(b *big.Int) *TreasuryTransferCreate {
+	ttc.mutation.SetAmount(b)
+	return ttc
+}
+
+// SetCreateAt sets the "create_at" field.
+func (ttc *TreasuryTransferCreate) SetCreateAt(t time.Time) *TreasuryTransferCreate {
+	ttc.mutation.SetCreateAt(t)
+	return ttc
+}
+
+// SetNillableCreateAt sets the "create_at" field if the given value is not nil.
+func (ttc *TreasuryTransferCreate) SetNillableCreateAt(t *time.Time) *TreasuryTransferCreate {
+	if t != nil {
+		ttc.SetCreateAt(*t)
+	}
+	return ttc
+}
+
+// SetFee sets the "fee" field.
+func (ttc *TreasuryTransferCreate) SetFee(b *big.Int) *TreasuryTransferCreate {
+	ttc.mutation.SetFee(b)
+	return ttc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (ttc *TreasuryTransferCreate) SetTxHash(s string) *TreasuryTransferCreate {
+	ttc.mutation.SetTxHash(s)
+	return ttc
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (ttc *TreasuryTransferCreate) SetNillableTxHash(s *string) *TreasuryTransferCreate {
+	if s != nil {
+		ttc.SetTxHash(*s)
+	}
+	return ttc
+}
+
+// SetSendAt sets the "send_at" field.
+func (ttc *TreasuryTransferCreate) SetSendAt(t time.Time) *TreasuryTransferCreate {
+	ttc.mutation.SetSendAt(t)
+	return ttc
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (ttc *TreasuryTransferCreate) SetNillableSendAt(t *time.Time) *TreasuryTransferCreate {
+	if t != nil {
+		ttc.SetSendAt(*t)
+	}
+	return ttc
+}
+
+// SetError sets the "error" field.
+func (ttc *TreasuryTransferCreate) SetError(s string) *TreasuryTransferCreate {
+	ttc.mutation.SetError(s)
+	return ttc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ttc *TreasuryTransferCreate) SetNillableError(s *string) *TreasuryTransferCreate {
+	if s != nil {
+		ttc.SetError(*s)
+	}
+	return ttc
+}
+
+// SetID sets the "id" field.
+func (ttc *TreasuryTransferCreate) SetID(s string) *TreasuryTransferCreate {
+	ttc.mutation.SetID(s)
+	return ttc
+}
+
+// Mutation returns the TreasuryTransferMutation object of the builder.
+func (ttc *TreasuryTransferCreate) Mutation() *TreasuryTransferMutation {
+	return ttc.mutation
+}
+
+// Save creates the TreasuryTransfer in the database.
+func (ttc *TreasuryTransferCreate) Save(ctx context.Context) (*TreasuryTransfer, error) {
+	ttc.defaults()
+	return withHooks(ctx, ttc.sqlSave, ttc.mutation, ttc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ttc *TreasuryTransferCreate) SaveX(ctx context.Context) *TreasuryTransfer {
+	v, err := ttc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttc *TreasuryTransferCreate) Exec(ctx context.Context) error {
+	_, err := ttc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttc *TreasuryTransferCreate) ExecX(ctx context.Context) {
+	if err := ttc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttc *TreasuryTransferCreate) defaults() {
+	if _, ok := ttc.mutation.CreateAt(); !ok {
+		v := treasurytransfer.DefaultCreateAt()
+		ttc.mutation.SetCreateAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttc *TreasuryTransferCreate) check() error {
+	if _, ok := ttc.mutation.Asset(); !ok {
+		return &ValidationError{Name: "asset", err: errors.New(`database: missing required field "TreasuryTransfer.asset"`)}
+	}
+	if v, ok := ttc.mutation.Asset(); ok {
+		if err := treasurytransfer.AssetValidator(v); err != nil {
+			return &ValidationError{Name: "asset", err: fmt.Errorf(`database: validator failed for field "TreasuryTransfer.asset": %w`, err)}
+		}
+	}
+	if _, ok := ttc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`database: missing required field "TreasuryTransfer.kind"`)}
+	}
+	if v, ok := ttc.mutation.Kind(); ok {
+		if err := treasurytransfer.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`database: validator failed for field "TreasuryTransfer.kind": %w`, err)}
+		}
+	}
+	if _, ok := ttc.mutation.To(); !ok {
+		return &ValidationError{Name: "to", err: errors.New(`database: missing required field "TreasuryTransfer.to"`)}
+	}
+	if v, ok := ttc.mutation.To(); ok {
+		if err := treasurytransfer.ToValidator(v); err != nil {
+			return &ValidationError{Name: "to", err: fmt.Errorf(`database: validator failed for field "TreasuryTransfer.to": %w`, err)}
+		}
+	}
+	if _, ok := ttc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`database: missing required field "TreasuryTransfer.amount"`)}
+	}
+	if v, ok := ttc.mutation.Amount(); ok {
+		if err := treasurytransfer.AmountValidator(v.String()); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`database: validator failed for field "TreasuryTransfer.amount": %w`, err)}
+		}
+	}
+	if _, ok := ttc.mutation.CreateAt(); !ok {
+		return &ValidationError{Name: "create_at", err: errors.New(`database: missing required field "TreasuryTransfer.create_at"`)}
+	}
+	if v, ok := ttc.mutation.ID(); ok {
+		if err := treasurytransfer.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`database: validator failed for field "TreasuryTransfer.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ttc *TreasuryTransferCreate) sqlSave(ctx context.Context) (*TreasuryTransfer, error) {
+	if err := ttc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec, err := ttc.createSpec()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlgraph.CreateNode(ctx, ttc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TreasuryTransfer.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ttc.mutation.id = &_node.ID
+	ttc.mutation.done = true
+	return _node, nil
+}
+
+func (ttc *TreasuryTransferCreate) createSpec() (*TreasuryTransfer, *sqlgraph.CreateSpec, error) {
+	var (
+		_node = &TreasuryTransfer{config: ttc.config}
+		_spec = sqlgraph.NewCreateSpec(treasurytransfer.Table, sqlgraph.NewFieldSpec(treasurytransfer.FieldID, field.TypeString))
+	)
+	if id, ok := ttc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ttc.mutation.Asset(); ok {
+		_spec.SetField(treasurytransfer.FieldAsset, field.TypeString, value)
+		_node.Asset = value
+	}
+	if value, ok := ttc.mutation.Kind(); ok {
+		_spec.SetField(treasurytransfer.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := ttc.mutation.To(); ok {
+		_spec.SetField(treasurytransfer.FieldTo, field.TypeString, value)
+		_node.To = value
+	}
+	if value, ok := ttc.mutation.Amount(); ok {
+		vv, err := treasurytransfer.ValueScanner.Amount.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(treasurytransfer.FieldAmount, field.TypeString, vv)
+		_node.Amount = value
+	}
+	if value, ok := ttc.mutation.CreateAt(); ok {
+		_spec.SetField(treasurytransfer.FieldCreateAt, field.TypeTime, value)
+		_node.CreateAt = value
+	}
+	if value, ok := ttc.mutation.Fee(); ok {
+		vv, err := treasurytransfer.ValueScanner.Fee.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(treasurytransfer.FieldFee, field.TypeString, vv)
+		_node.Fee = value
+	}
+	if value, ok := ttc.mutation.TxHash(); ok {
+		_spec.SetField(treasurytransfer.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := ttc.mutation.SendAt(); ok {
+		_spec.SetField(treasurytransfer.FieldSendAt, field.TypeTime, value)
+		_node.SendAt = &value
+	}
+	if value, ok := ttc.mutation.Error(); ok {
+		_spec.SetField(treasurytransfer.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	return _node, _spec, nil
+}
+
+// TreasuryTransferCreateBulk is the builder for creating many TreasuryTransfer entities in bulk.
+type TreasuryTransferCreateBulk struct {
+	config
+	err      error
+	builders []*TreasuryTransferCreate
+}
+
+// Save creates the TreasuryTransfer entities in the database.
+func (ttcb *TreasuryTransferCreateBulk) Save(ctx context.Context) ([]*TreasuryTransfer, error) {
+	if ttcb.err != nil {
+		return nil, ttcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ttcb.builders))
+	nodes := make([]*TreasuryTransfer, len(ttcb.builders))
+	mutators := make([]Mutator, len(ttcb.builders))
+	for i := range ttcb.builders {
+		func(i int, root context.Context) {
+			builder := ttcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TreasuryTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ttcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ttcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ttcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttcb *TreasuryTransferCreateBulk) SaveX(ctx context.Context) []*TreasuryTransfer {
+	v, err := ttcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttcb *TreasuryTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := ttcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttcb *TreasuryTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := ttcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/treasurytransfer"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TreasuryTransferDelete is the builder for deleting a TreasuryTransfer entity.
+type TreasuryTransferDelete struct {
+	config
+	hooks    []Hook
+	mutation *TreasuryTransferMutation
+}
+
+// Where appends a list predicates to the TreasuryTransferDelete builder.
+func (ttd *TreasuryTransferDelete) Where(ps ...predicate.TreasuryTransfer) *TreasuryTransferDelete {
+	ttd.mutation.Where(ps...)
+	return ttd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ttd *TreasuryTransferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ttd.sqlExec, ttd.mutation, ttd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttd *TreasuryTransferDelete) ExecX(ctx context.Context) int {
+	n, err := ttd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ttd *TreasuryTransferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(treasurytransfer.Table, sqlgraph.NewFieldSpec(treasurytransfer.FieldID, field.TypeString))
+	if ps := ttd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ttd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ttd.mutation.done = true
+	return affected, err
+}
+
+// TreasuryTransferDeleteOne is the builder for deleting a single TreasuryTransfer entity.
+type TreasuryTransferDeleteOne struct {
+	ttd *TreasuryTransferDelete
+}
+
+// Where appends a list predicates to the TreasuryTransferDelete builder.
+func (ttdo *TreasuryTransferDeleteOne) Where(ps ...predicate.TreasuryTransfer) *TreasuryTransferDeleteOne {
+	ttdo.ttd.mutation.Where(ps...)
+	return ttdo
+}
+
+// Exec executes the deletion query.
+func (ttdo *TreasuryTransferDeleteOne) Exec(ctx context.Context) error {
+	n, err := ttdo.ttd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{treasurytransfer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttdo *TreasuryTransferDeleteOne) ExecX(ctx context.Context) {
+	if err := ttdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
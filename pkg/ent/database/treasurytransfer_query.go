@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/treasurytransfer"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TreasuryTransferQuery is the builder for querying TreasuryTransfer entities.
+type TreasuryTransferQuery struct {
+	config
+	ctx        *QueryContext
+	order      []treasurytransfer.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TreasuryTransfer
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TreasuryTransferQuery builder.
+func (ttq *TreasuryTransferQuery) Where(ps ...predicate.TreasuryTransfer) *TreasuryTransferQuery {
+	ttq.predicates = append(ttq.predicates, ps...)
+	return ttq
+}
+
+// Limit the number of records to be returned by this query.
+func (ttq *TreasuryTransferQuery) Limit(limit int) *TreasuryTransferQuery {
+	ttq.ctx.Limit = &limit
+	return ttq
+}
+
+// Offset to start from.
+func (ttq *TreasuryTransferQuery) Offset(offset int) *TreasuryTransferQuery {
+	ttq.ctx.Offset = &offset
+	return ttq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ttq *TreasuryTransferQuery) Unique(unique bool) *TreasuryTransferQuery {
+	ttq.ctx.Unique = &unique
+	return ttq
+}
+
+// Order specifies how the records should be ordered.
+func (ttq *TreasuryTransferQuery) Order(o ...treasurytransfer.OrderOption) *TreasuryTransferQuery {
+	ttq.order = append(ttq.order, o...)
+	return ttq
+}
+
+// First returns the first TreasuryTransfer entity from the query.
+// Returns a *NotFoundError when no TreasuryTransfer was found.
+func (ttq *TreasuryTransferQuery) First(ctx context.Context) (*TreasuryTransfer, error) {
+	nodes, err := ttq.Limit(1).All(setContextOp(ctx, ttq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{treasurytransfer.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ttq *TreasuryTransferQuery) FirstX(ctx context.Context) *TreasuryTransfer {
+	node, err := ttq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TreasuryTransfer ID from the query.
+// Returns a *NotFoundError when no TreasuryTransfer ID was found.
+func (ttq *TreasuryTransferQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ttq.Limit(1).IDs(setContextOp(ctx, ttq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{treasurytransfer.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ttq *TreasuryTransferQuery) FirstIDX(ctx context.Context) string {
+	id, err := ttq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TreasuryTransfer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TreasuryTransfer entity is found.
+// Returns a *NotFoundError when no TreasuryTransfer entities are found.
+func (ttq *TreasuryTransferQuery) Only(ctx context.Context) (*TreasuryTransfer, error) {
+	nodes, err := ttq.Limit(2).All(setContextOp(ctx, ttq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{treasurytransfer.Label}
+	default:
+		return nil, &NotSingularError{treasurytransfer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ttq *TreasuryTransferQuery) OnlyX(ctx context.Context) *TreasuryTransfer {
+	node, err := ttq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TreasuryTransfer ID in the query.
+// Returns a *NotSingularError when more than one TreasuryTransfer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ttq *TreasuryTransferQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ttq.Limit(2).IDs(setContextOp(ctx, ttq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{treasurytransfer.Label}
+	default:
+		err = &NotSingularError{treasurytransfer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ttq *TreasuryTransferQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ttq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TreasuryTransfers.
+func (ttq *TreasuryTransferQuery) All(ctx context.Context) ([]*TreasuryTransfer, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryAll)
+	if err := ttq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TreasuryTransfer, *TreasuryTransferQuery]()
+	return withInterceptors[[]*TreasuryTransfer](ctx, ttq, qr, ttq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ttq *TreasuryTransferQuery) AllX(ctx context.Context) []*TreasuryTransfer {
+	nodes, err := ttq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TreasuryTransfer IDs.
+func (ttq *TreasuryTransferQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if ttq.ctx.Unique == nil && ttq.path != nil {
+		ttq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryIDs)
+	if err = ttq.Select(treasurytransfer.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ttq *TreasuryTransferQuery) IDsX(ctx context.Context) []string {
+	ids, err := ttq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ttq *TreasuryTransferQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryCount)
+	if err := ttq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ttq, querierCount[*TreasuryTransferQuery](), ttq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ttq *TreasuryTransferQuery) CountX(ctx context.Context) int {
+	count, err := ttq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ttq *TreasuryTransferQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryExist)
+	switch _, err := ttq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("database: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ttq *TreasuryTransferQuery) ExistX(ctx context.Context) bool {
+	exist, err := ttq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TreasuryTransferQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ttq *TreasuryTransferQuery) Clone() *TreasuryTransferQuery {
+	if ttq == nil {
+		return nil
+	}
+	return &TreasuryTransferQuery{
+		config:     ttq.config,
+		ctx:        ttq.ctx.Clone(),
+		order:      append([]treasurytransfer.OrderOption{}, ttq.order...),
+		inters:     append([]Interceptor{}, ttq.inters...),
+		predicates: append([]predicate.TreasuryTransfer{}, ttq.predicates...),
+		// clone intermediate query.
+		sql:  ttq.sql.Clone(),
+		path: ttq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Asset string `json:"asset,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TreasuryTransfer.Query().
+//		GroupBy(treasurytransfer.FieldAsset).
+//		Aggregate(database.Count()).
+//		Scan(ctx, &v)
+func (ttq *TreasuryTransferQuery) GroupBy(field string, fields ...string) *TreasuryTransferGroupBy {
+	ttq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TreasuryTransferGroupBy{build: ttq}
+	grbuild.flds = &ttq.ctx.Fields
+	grbuild.label = treasurytransfer.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Asset string `json:"asset,omitempty"`
+//	}
+//
+//	client.TreasuryTransfer.Query().
+//		Select(treasurytransfer.FieldAsset).
+//		Scan(ctx, &v)
+func (ttq *TreasuryTransferQuery) Select(fields ...string) *TreasuryTransferSelect {
+	ttq.ctx.Fields = append(ttq.ctx.Fields, fields...)
+	sbuild := &TreasuryTransferSelect{TreasuryTransferQuery: ttq}
+	sbuild.label = treasurytransfer.Label
+	sbuild.flds, sbuild.scan = &ttq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TreasuryTransferSelect configured with the given aggregations.
+func (ttq *TreasuryTransferQuery) Aggregate(fns ...AggregateFunc) *TreasuryTransferSelect {
+	return ttq.Select().Aggregate(fns...)
+}
+
+func (ttq *TreasuryTransferQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ttq.inters {
+		if inter == nil {
+			return fmt.Errorf("database: uninitialized interceptor (forgotten import database/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ttq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ttq.ctx.Fields {
+		if !treasurytransfer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+		}
+	}
+	if ttq.path != nil {
+		prev, err := ttq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ttq.sql = prev
+	}
+	return nil
+}
+
+func (ttq *TreasuryTransferQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TreasuryTransfer, error) {
+	var (
+		nodes = []*TreasuryTransfer{}
+		_spec = ttq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TreasuryTransfer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TreasuryTransfer{config: ttq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ttq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ttq *TreasuryTransferQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ttq.querySpec()
+	_spec.Node.Columns = ttq.ctx.Fields
+	if len(ttq.ctx.Fields) > 0 {
+		_spec.Unique = ttq.ctx.Unique != nil && *ttq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ttq.driver, _spec)
+}
+
+func (ttq *TreasuryTransferQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(treasurytransfer.Table, treasurytransfer.Columns, sqlgraph.NewFieldSpec(treasurytransfer.FieldID, field.TypeString))
+	_spec.From = ttq.sql
+	if unique := ttq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ttq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ttq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, treasurytransfer.FieldID)
+		for i := range fields {
+			if fields[i] != treasurytransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ttq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ttq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ttq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ttq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ttq *TreasuryTransferQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ttq.driver.Dialect())
+	t1 := builder.Table(treasurytransfer.Table)
+	columns := ttq.ctx.Fields
+	if len(columns) == 0 {
+		columns = treasurytransfer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ttq.sql != nil {
+		selector = ttq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ttq.ctx.Unique != nil && *ttq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ttq.predicates {
+		p(selector)
+	}
+	for _, p := range ttq.order {
+		p(selector)
+	}
+	if offset := ttq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ttq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TreasuryTransferGroupBy is the group-by builder for TreasuryTransfer entities.
+type TreasuryTransferGroupBy struct {
+	selector
+	build *TreasuryTransferQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ttgb *TreasuryTransferGroupBy) Aggregate(fns ...AggregateFunc) *TreasuryTransferGroupBy {
+	ttgb.fns = append(ttgb.fns, fns...)
+	return ttgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ttgb *TreasuryTransferGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ttgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ttgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TreasuryTransferQuery, *TreasuryTransferGroupBy](ctx, ttgb.build, ttgb, ttgb.build.inters, v)
+}
+
+func (ttgb *TreasuryTransferGroupBy) sqlScan(ctx context.Context, root *TreasuryTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ttgb.fns))
+	for _, fn := range ttgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ttgb.flds)+len(ttgb.fns))
+		for _, f := range *ttgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ttgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ttgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TreasuryTransferSelect is the builder for selecting fields of TreasuryTransfer entities.
+type TreasuryTransferSelect struct {
+	*TreasuryTransferQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tts *TreasuryTransferSelect) Aggregate(fns ...AggregateFunc) *TreasuryTransferSelect {
+	tts.fns = append(tts.fns, fns...)
+	return tts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tts *TreasuryTransferSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tts.ctx, ent.OpQuerySelect)
+	if err := tts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TreasuryTransferQuery, *TreasuryTransferSelect](ctx, tts.TreasuryTransferQuery, tts, tts.inters, v)
+}
+
+func (tts *TreasuryTransferSelect) sqlScan(ctx context.Context, root *TreasuryTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tts.fns))
+	for _, fn := range tts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
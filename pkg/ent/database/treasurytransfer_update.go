@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/treasurytransfer"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TreasuryTransferUpdate is the builder for updating TreasuryTransfer entities.
+type TreasuryTransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TreasuryTransferMutation
+}
+
+// Where appends a list predicates to the TreasuryTransferUpdate builder.
+func (ttu *TreasuryTransferUpdate) Where(ps ...predicate.TreasuryTransfer) *TreasuryTransferUpdate {
+	ttu.mutation.Where(ps...)
+	return ttu
+}
+
+// SetFee sets the "fee" field.
+func (ttu *TreasuryTransferUpdate) SetFee(b *big.Int) *TreasuryTransferUpdate {
+	ttu.mutation.SetFee(b)
+	return ttu
+}
+
+// ClearFee clears the value of the "fee" field.
+func (ttu *TreasuryTransferUpdate) ClearFee() *TreasuryTransferUpdate {
+	ttu.mutation.ClearFee()
+	return ttu
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (ttu *TreasuryTransferUpdate) SetTxHash(s string) *TreasuryTransferUpdate {
+	ttu.mutation.SetTxHash(s)
+	return ttu
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (ttu *TreasuryTransferUpdate) SetNillableTxHash(s *string) *TreasuryTransferUpdate {
+	if s != nil {
+		ttu.SetTxHash(*s)
+	}
+	return ttu
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (ttu *TreasuryTransferUpdate) ClearTxHash() *TreasuryTransferUpdate {
+	ttu.mutation.ClearTxHash()
+	return ttu
+}
+
+// SetSendAt sets the "send_at" field.
+func (ttu *TreasuryTransferUpdate) SetSendAt(t time.Time) *TreasuryTransferUpdate {
+	ttu.mutation.SetSendAt(t)
+	return ttu
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (ttu *TreasuryTransferUpdate) SetNillableSendAt(t *time.Time) *TreasuryTransferUpdate {
+	if t != nil {
+		ttu.SetSendAt(*t)
+	}
+	return ttu
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (ttu *TreasuryTransferUpdate) ClearSendAt() *TreasuryTransferUpdate {
+	ttu.mutation.ClearSendAt()
+	return ttu
+}
+
+// SetError sets the "error" field.
+func (ttu *TreasuryTransferUpdate) SetError(s string) *TreasuryTransferUpdate {
+	ttu.mutation.SetError(s)
+	return ttu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ttu *TreasuryTransferUpdate) SetNillableError(s *string) *TreasuryTransferUpdate {
+	if s != nil {
+		ttu.SetError(*s)
+	}
+	return ttu
+}
+
+// ClearError clears the value of the "error" field.
+func (ttu *TreasuryTransferUpdate) ClearError() *TreasuryTransferUpdate {
+	ttu.mutation.ClearError()
+	return ttu
+}
+
+// Mutation returns the TreasuryTransferMutation object of the builder.
+func (ttu *TreasuryTransferUpdate) Mutation() *TreasuryTransferMutation {
+	return ttu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ttu *TreasuryTransferUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ttu.sqlSave, ttu.mutation, ttu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttu *TreasuryTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := ttu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ttu *TreasuryTransferUpdate) Exec(ctx context.Context) error {
+	_, err := ttu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttu *TreasuryTransferUpdate) ExecX(ctx context.Context) {
+	if err := ttu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ttu *TreasuryTransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(treasurytransfer.Table, treasurytransfer.Columns, sqlgraph.NewFieldSpec(treasurytransfer.FieldID, field.TypeString))
+	if ps := ttu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttu.mutation.Fee(); ok {
+		vv, err := treasurytransfer.ValueScanner.Fee.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(treasurytransfer.FieldFee, field.TypeString, vv)
+	}
+	if ttu.mutation.FeeCleared() {
+		_spec.ClearField(treasurytransfer.FieldFee, field.TypeString)
+	}
+	if value, ok := ttu.mutation.TxHash(); ok {
+		_spec.SetField(treasurytransfer.FieldTxHash, field.TypeString, value)
+	}
+	if ttu.mutation.TxHashCleared() {
+		_spec.ClearField(treasurytransfer.FieldTxHash, field.TypeString)
+	}
+	if value, ok := ttu.mutation.SendAt(); ok {
+		_spec.SetField(treasurytransfer.FieldSendAt, field.TypeTime, value)
+	}
+	if ttu.mutation.SendAtCleared() {
+		_spec.ClearField(treasurytransfer.FieldSendAt, field.TypeTime)
+	}
+	if value, ok := ttu.mutation.Error(); ok {
+		_spec.SetField(treasurytransfer.FieldError, field.TypeString, value)
+	}
+	if ttu.mutation.ErrorCleared() {
+		_spec.ClearField(treasurytransfer.FieldError, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ttu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{treasurytransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ttu.mutation.done = true
+	return n, nil
+}
+
+// TreasuryTransferUpdateOne is the builder for updating a single TreasuryTransfer entity.
+type TreasuryTransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TreasuryTransferMutation
+}
+
+// SetFee sets the "fee" field.
+func (ttuo *TreasuryTransferUpdateOne) SetFee(b *big.Int) *TreasuryTransferUpdateOne {
+	ttuo.mutation.SetFee(b)
+	return ttuo
+}
+
+// ClearFee clears the value of the "fee" field.
+func (ttuo *TreasuryTransferUpdateOne) ClearFee() *TreasuryTransferUpdateOne {
+	ttuo.mutation.ClearFee()
+	return ttuo
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (ttuo *TreasuryTransferUpdateOne) SetTxHash(s string) *TreasuryTransferUpdateOne {
+	ttuo.mutation.SetTxHash(s)
+	return ttuo
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (ttuo *TreasuryTransferUpdateOne) SetNillableTxHash(s *string) *TreasuryTransferUpdateOne {
+	if s != nil {
+		ttuo.SetTxHash(*s)
+	}
+	return ttuo
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (ttuo *TreasuryTransferUpdateOne) ClearTxHash() *TreasuryTransferUpdateOne {
+	ttuo.mutation.ClearTxHash()
+	return ttuo
+}
+
+// SetSendAt sets the "send_at" field.
+func (ttuo *TreasuryTransferUpdateOne) SetSendAt(t time.Time) *TreasuryTransferUpdateOne {
+	ttuo.mutation.SetSendAt(t)
+	return ttuo
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (ttuo *TreasuryTransferUpdateOne) SetNillableSendAt(t *time.Time) *TreasuryTransferUpdateOne {
+	if t != nil {
+		ttuo.SetSendAt(*t)
+	}
+	return ttuo
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (ttuo *TreasuryTransferUpdateOne) ClearSendAt() *TreasuryTransferUpdateOne {
+	ttuo.mutation.ClearSendAt()
+	return ttuo
+}
+
+// SetError sets the "error" field.
+func (ttuo *TreasuryTransferUpdateOne) SetError(s string) *TreasuryTransferUpdateOne {
+	ttuo.mutation.SetError(s)
+	return ttuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ttuo *TreasuryTransferUpdateOne) SetNillableError(s *string) *TreasuryTransferUpdateOne {
+	if s != nil {
+		ttuo.SetError(*s)
+	}
+	return ttuo
+}
+
+// ClearError clears the value of the "error" field.
+func (ttuo *TreasuryTransferUpdateOne) ClearError() *TreasuryTransferUpdateOne {
+	ttuo.mutation.ClearError()
+	return ttuo
+}
+
+// Mutation returns the TreasuryTransferMutation object of the builder.
+func (ttuo *TreasuryTransferUpdateOne) Mutation() *TreasuryTransferMutation {
+	return ttuo.mutation
+}
+
+// Where appends a list predicates to the TreasuryTransferUpdate builder.
+func (ttuo *TreasuryTransferUpdateOne) Where(ps ...predicate.TreasuryTransfer) *TreasuryTransferUpdateOne {
+	ttuo.mutation.Where(ps...)
+	return ttuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ttuo *TreasuryTransferUpdateOne) Select(field string, fields ...string) *TreasuryTransferUpdateOne {
+	ttuo.fields = append([]string{field}, fields...)
+	return ttuo
+}
+
+// Save executes the query and returns the updated TreasuryTransfer entity.
+func (ttuo *TreasuryTransferUpdateOne) Save(ctx context.Context) (*TreasuryTransfer, error) {
+	return withHooks(ctx, ttuo.sqlSave, ttuo.mutation, ttuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttuo *TreasuryTransferUpdateOne) SaveX(ctx context.Context) *TreasuryTransfer {
+	node, err := ttuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ttuo *TreasuryTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := ttuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttuo *TreasuryTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := ttuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ttuo *TreasuryTransferUpdateOne) sqlSave(ctx context.Context) (_node *TreasuryTransfer, err error) {
+	_spec := sqlgraph.NewUpdateSpec(treasurytransfer.Table, treasurytransfer.Columns, sqlgraph.NewFieldSpec(treasurytransfer.FieldID, field.TypeString))
+	id, ok := ttuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`database: missing "TreasuryTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ttuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, treasurytransfer.FieldID)
+		for _, f := range fields {
+			if !treasurytransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+			}
+			if f != treasurytransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ttuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttuo.mutation.Fee(); ok {
+		vv, err := treasurytransfer.ValueScanner.Fee.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(treasurytransfer.FieldFee, field.TypeString, vv)
+	}
+	if ttuo.mutation.FeeCleared() {
+		_spec.ClearField(treasurytransfer.FieldFee, field.TypeString)
+	}
+	if value, ok := ttuo.mutation.TxHash(); ok {
+		_spec.SetField(treasurytransfer.FieldTxHash, field.TypeString, value)
+	}
+	if ttuo.mutation.TxHashCleared() {
+		_spec.ClearField(treasurytransfer.FieldTxHash, field.TypeString)
+	}
+	if value, ok := ttuo.mutation.SendAt(); ok {
+		_spec.SetField(treasurytransfer.FieldSendAt, field.TypeTime, value)
+	}
+	if ttuo.mutation.SendAtCleared() {
+		_spec.ClearField(treasurytransfer.FieldSendAt, field.TypeTime)
+	}
+	if value, ok := ttuo.mutation.Error(); ok {
+		_spec.SetField(treasurytransfer.FieldError, field.TypeString, value)
+	}
+	if ttuo.mutation.ErrorCleared() {
+		_spec.ClearField(treasurytransfer.FieldError, field.TypeString)
+	}
+	_node = &TreasuryTransfer{config: ttuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ttuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{treasurytransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ttuo.mutation.done = true
+	return _node, nil
+}
@@ -14,6 +14,22 @@ type Tx struct {
 	config
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
+	// InvoiceEvent is the client for interacting with the InvoiceEvent builders.
+	InvoiceEvent *InvoiceEventClient
+	// LedgerAccount is the client for interacting with the LedgerAccount builders.
+	LedgerAccount *LedgerAccountClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
+	// Payout is the client for interacting with the Payout builders.
+	Payout *PayoutClient
+	// PayoutDay is the client for interacting with the PayoutDay builders.
+	PayoutDay *PayoutDayClient
+	// TreasuryCredit is the client for interacting with the TreasuryCredit builders.
+	TreasuryCredit *TreasuryCreditClient
+	// TreasuryTransfer is the client for interacting with the TreasuryTransfer builders.
+	TreasuryTransfer *TreasuryTransferClient
+	// WalletAccount is the client for interacting with the WalletAccount builders.
+	WalletAccount *WalletAccountClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +162,14 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.InvoiceEvent = NewInvoiceEventClient(tx.config)
+	tx.LedgerAccount = NewLedgerAccountClient(tx.config)
+	tx.LedgerEntry = NewLedgerEntryClient(tx.config)
+	tx.Payout = NewPayoutClient(tx.config)
+	tx.PayoutDay = NewPayoutDayClient(tx.config)
+	tx.TreasuryCredit = NewTreasuryCreditClient(tx.config)
+	tx.TreasuryTransfer = NewTreasuryTransferClient(tx.config)
+	tx.WalletAccount = NewWalletAccountClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,103 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"cpg/pkg/ent/database/walletaccount"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WalletAccount is the model entity for the WalletAccount schema.
+type WalletAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// NextIndex holds the value of the "next_index" field.
+	NextIndex    int64 `json:"next_index,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WalletAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case walletaccount.FieldNextIndex:
+			values[i] = new(sql.NullInt64)
+		case walletaccount.FieldID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WalletAccount fields.
+func (wa *WalletAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case walletaccount.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				wa.ID = value.String
+			}
+		case walletaccount.FieldNextIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field next_index", values[i])
+			} else if value.Valid {
+				wa.NextIndex = value.Int64
+			}
+		default:
+			wa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WalletAccount.
+// This includes values selected through modifiers, order, etc.
+func (wa *WalletAccount) Value(name string) (ent.Value, error) {
+	return wa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WalletAccount.
+// Note that you need to call WalletAccount.Unwrap() before calling this method if this WalletAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wa *WalletAccount) Update() *WalletAccountUpdateOne {
+	return NewWalletAccountClient(wa.config).UpdateOne(wa)
+}
+
+// Unwrap unwraps the WalletAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wa *WalletAccount) Unwrap() *WalletAccount {
+	_tx, ok := wa.config.driver.(*txDriver)
+	if !ok {
+		panic("database: WalletAccount is not a transactional entity")
+	}
+	wa.config.driver = _tx.drv
+	return wa
+}
+
+// String implements the fmt.Stringer.
+func (wa *WalletAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("WalletAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wa.ID))
+	builder.WriteString("next_index=")
+	builder.WriteString(fmt.Sprintf("%v", wa.NextIndex))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WalletAccounts is a parsable slice of WalletAccount.
+type WalletAccounts []*WalletAccount
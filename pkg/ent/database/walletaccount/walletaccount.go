@@ -0,0 +1,54 @@
+// Code generated by ent, DO NOT EDIT.
+
+package walletaccount
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the walletaccount type in the database.
+	Label = "wallet_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNextIndex holds the string denoting the next_index field in the database.
+	FieldNextIndex = "next_index"
+	// Table holds the table name of the walletaccount in the database.
+	Table = "wallet_accounts"
+)
+
+// Columns holds all SQL columns for walletaccount fields.
+var Columns = []string{
+	FieldID,
+	FieldNextIndex,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NextIndexValidator is a validator for the "next_index" field. It is called by the builders before save.
+	NextIndexValidator func(int64) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the WalletAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNextIndex orders the results by the next_index field.
+func ByNextIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextIndex, opts...).ToFunc()
+}
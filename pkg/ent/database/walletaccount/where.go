@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package walletaccount
+
+import (
+	"cpg/pkg/ent/database/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldContainsFold(FieldID, id))
+}
+
+// NextIndex applies equality check predicate on the "next_index" field. It's identical to NextIndexEQ.
+func NextIndex(v int64) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldEQ(FieldNextIndex, v))
+}
+
+// NextIndexEQ applies the EQ predicate on the "next_index" field.
+func NextIndexEQ(v int64) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldEQ(FieldNextIndex, v))
+}
+
+// NextIndexNEQ applies the NEQ predicate on the "next_index" field.
+func NextIndexNEQ(v int64) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldNEQ(FieldNextIndex, v))
+}
+
+// NextIndexIn applies the In predicate on the "next_index" field.
+func NextIndexIn(vs ...int64) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldIn(FieldNextIndex, vs...))
+}
+
+// NextIndexNotIn applies the NotIn predicate on the "next_index" field.
+func NextIndexNotIn(vs ...int64) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldNotIn(FieldNextIndex, vs...))
+}
+
+// NextIndexGT applies the GT predicate on the "next_index" field.
+func NextIndexGT(v int64) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldGT(FieldNextIndex, v))
+}
+
+// NextIndexGTE applies the GTE predicate on the "next_index" field.
+func NextIndexGTE(v int64) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldGTE(FieldNextIndex, v))
+}
+
+// NextIndexLT applies the LT predicate on the "next_index" field.
+func NextIndexLT(v int64) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldLT(FieldNextIndex, v))
+}
+
+// NextIndexLTE applies the LTE predicate on the "next_index" field.
+func NextIndexLTE(v int64) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.FieldLTE(FieldNextIndex, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WalletAccount) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WalletAccount) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WalletAccount) predicate.WalletAccount {
+	return predicate.WalletAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/walletaccount"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WalletAccountCreate is the builder for creating a WalletAccount entity.
+type WalletAccountCreate struct {
+	config
+	mutation *WalletAccountMutation
+	hooks    []Hook
+}
+
+// SetNextIndex sets the "next_index" field.
+func (wac *WalletAccountCreate) SetNextIndex(i int64) *WalletAccountCreate {
+	wac.mutation.SetNextIndex(i)
+	return wac
+}
+
+// SetID sets the "id" field.
+func (wac *WalletAccountCreate) SetID(s string) *WalletAccountCreate {
+	wac.mutation.SetID(s)
+	return wac
+}
+
+// Mutation returns the WalletAccountMutation object of the builder.
+func (wac *WalletAccountCreate) Mutation() *WalletAccountMutation {
+	return wac.mutation
+}
+
+// Save creates the WalletAccount in the database.
+func (wac *WalletAccountCreate) Save(ctx context.Context) (*WalletAccount, error) {
+	return withHooks(ctx, wac.sqlSave, wac.mutation, wac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wac *WalletAccountCreate) SaveX(ctx context.Context) *WalletAccount {
+	v, err := wac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wac *WalletAccountCreate) Exec(ctx context.Context) error {
+	_, err := wac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wac *WalletAccountCreate) ExecX(ctx context.Context) {
+	if err := wac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wac *WalletAccountCreate) check() error {
+	if _, ok := wac.mutation.NextIndex(); !ok {
+		return &ValidationError{Name: "next_index", err: errors.New(`database: missing required field "WalletAccount.next_index"`)}
+	}
+	if v, ok := wac.mutation.NextIndex(); ok {
+		if err := walletaccount.NextIndexValidator(v); err != nil {
+			return &ValidationError{Name: "next_index", err: fmt.Errorf(`database: validator failed for field "WalletAccount.next_index": %w`, err)}
+		}
+	}
+	if v, ok := wac.mutation.ID(); ok {
+		if err := walletaccount.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`database: validator failed for field "WalletAccount.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wac *WalletAccountCreate) sqlSave(ctx context.Context) (*WalletAccount, error) {
+	if err := wac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WalletAccount.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wac.mutation.id = &_node.ID
+	wac.mutation.done = true
+	return _node, nil
+}
+
+func (wac *WalletAccountCreate) createSpec() (*WalletAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WalletAccount{config: wac.config}
+		_spec = sqlgraph.NewCreateSpec(walletaccount.Table, sqlgraph.NewFieldSpec(walletaccount.FieldID, field.TypeString))
+	)
+	if id, ok := wac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wac.mutation.NextIndex(); ok {
+		_spec.SetField(walletaccount.FieldNextIndex, field.TypeInt64, value)
+		_node.NextIndex = value
+	}
+	return _node, _spec
+}
+
+// WalletAccountCreateBulk is the builder for creating many WalletAccount entities in bulk.
+type WalletAccountCreateBulk struct {
+	config
+	err      error
+	builders []*WalletAccountCreate
+}
+
+// Save creates the WalletAccount entities in the database.
+func (wacb *WalletAccountCreateBulk) Save(ctx context.Context) ([]*WalletAccount, error) {
+	if wacb.err != nil {
+		return nil, wacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wacb.builders))
+	nodes := make([]*WalletAccount, len(wacb.builders))
+	mutators := make([]Mutator, len(wacb.builders))
+	for i := range wacb.builders {
+		func(i int, root context.Context) {
+			builder := wacb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wacb *WalletAccountCreateBulk) SaveX(ctx context.Context) []*WalletAccount {
+	v, err := wacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wacb *WalletAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := wacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacb *WalletAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := wacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
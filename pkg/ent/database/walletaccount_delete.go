@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/walletaccount"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WalletAccountDelete is the builder for deleting a WalletAccount entity.
+type WalletAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *WalletAccountMutation
+}
+
+// Where appends a list predicates to the WalletAccountDelete builder.
+func (wad *WalletAccountDelete) Where(ps ...predicate.WalletAccount) *WalletAccountDelete {
+	wad.mutation.Where(ps...)
+	return wad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wad *WalletAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wad.sqlExec, wad.mutation, wad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wad *WalletAccountDelete) ExecX(ctx context.Context) int {
+	n, err := wad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wad *WalletAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(walletaccount.Table, sqlgraph.NewFieldSpec(walletaccount.FieldID, field.TypeString))
+	if ps := wad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wad.mutation.done = true
+	return affected, err
+}
+
+// WalletAccountDeleteOne is the builder for deleting a single WalletAccount entity.
+type WalletAccountDeleteOne struct {
+	wad *WalletAccountDelete
+}
+
+// Where appends a list predicates to the WalletAccountDelete builder.
+func (wado *WalletAccountDeleteOne) Where(ps ...predicate.WalletAccount) *WalletAccountDeleteOne {
+	wado.wad.mutation.Where(ps...)
+	return wado
+}
+
+// Exec executes the deletion query.
+func (wado *WalletAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := wado.wad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{walletaccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wado *WalletAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := wado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
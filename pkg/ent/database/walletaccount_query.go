@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/walletaccount"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WalletAccountQuery is the builder for querying WalletAccount entities.
+type WalletAccountQuery struct {
+	config
+	ctx        *QueryContext
+	order      []walletaccount.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WalletAccount
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WalletAccountQuery builder.
+func (waq *WalletAccountQuery) Where(ps ...predicate.WalletAccount) *WalletAccountQuery {
+	waq.predicates = append(waq.predicates, ps...)
+	return waq
+}
+
+// Limit the number of records to be returned by this query.
+func (waq *WalletAccountQuery) Limit(limit int) *WalletAccountQuery {
+	waq.ctx.Limit = &limit
+	return waq
+}
+
+// Offset to start from.
+func (waq *WalletAccountQuery) Offset(offset int) *WalletAccountQuery {
+	waq.ctx.Offset = &offset
+	return waq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (waq *WalletAccountQuery) Unique(unique bool) *WalletAccountQuery {
+	waq.ctx.Unique = &unique
+	return waq
+}
+
+// Order specifies how the records should be ordered.
+func (waq *WalletAccountQuery) Order(o ...walletaccount.OrderOption) *WalletAccountQuery {
+	waq.order = append(waq.order, o...)
+	return waq
+}
+
+// First returns the first WalletAccount entity from the query.
+// Returns a *NotFoundError when no WalletAccount was found.
+func (waq *WalletAccountQuery) First(ctx context.Context) (*WalletAccount, error) {
+	nodes, err := waq.Limit(1).All(setContextOp(ctx, waq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{walletaccount.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (waq *WalletAccountQuery) FirstX(ctx context.Context) *WalletAccount {
+	node, err := waq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WalletAccount ID from the query.
+// Returns a *NotFoundError when no WalletAccount ID was found.
+func (waq *WalletAccountQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = waq.Limit(1).IDs(setContextOp(ctx, waq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{walletaccount.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (waq *WalletAccountQuery) FirstIDX(ctx context.Context) string {
+	id, err := waq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WalletAccount entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WalletAccount entity is found.
+// Returns a *NotFoundError when no WalletAccount entities are found.
+func (waq *WalletAccountQuery) Only(ctx context.Context) (*WalletAccount, error) {
+	nodes, err := waq.Limit(2).All(setContextOp(ctx, waq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{walletaccount.Label}
+	default:
+		return nil, &NotSingularError{walletaccount.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (waq *WalletAccountQuery) OnlyX(ctx context.Context) *WalletAccount {
+	node, err := waq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WalletAccount ID in the query.
+// Returns a *NotSingularError when more than one WalletAccount ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (waq *WalletAccountQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = waq.Limit(2).IDs(setContextOp(ctx, waq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{walletaccount.Label}
+	default:
+		err = &NotSingularError{walletaccount.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (waq *WalletAccountQuery) OnlyIDX(ctx context.Context) string {
+	id, err := waq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WalletAccounts.
+func (waq *WalletAccountQuery) All(ctx context.Context) ([]*WalletAccount, error) {
+	ctx = setContextOp(ctx, waq.ctx, ent.OpQueryAll)
+	if err := waq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WalletAccount, *WalletAccountQuery]()
+	return withInterceptors[[]*WalletAccount](ctx, waq, qr, waq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (waq *WalletAccountQuery) AllX(ctx context.Context) []*WalletAccount {
+	nodes, err := waq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WalletAccount IDs.
+func (waq *WalletAccountQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if waq.ctx.Unique == nil && waq.path != nil {
+		waq.Unique(true)
+	}
+	ctx = setContextOp(ctx, waq.ctx, ent.OpQueryIDs)
+	if err = waq.Select(walletaccount.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (waq *WalletAccountQuery) IDsX(ctx context.Context) []string {
+	ids, err := waq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (waq *WalletAccountQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, waq.ctx, ent.OpQueryCount)
+	if err := waq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, waq, querierCount[*WalletAccountQuery](), waq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (waq *WalletAccountQuery) CountX(ctx context.Context) int {
+	count, err := waq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (waq *WalletAccountQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, waq.ctx, ent.OpQueryExist)
+	switch _, err := waq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("database: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (waq *WalletAccountQuery) ExistX(ctx context.Context) bool {
+	exist, err := waq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WalletAccountQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (waq *WalletAccountQuery) Clone() *WalletAccountQuery {
+	if waq == nil {
+		return nil
+	}
+	return &WalletAccountQuery{
+		config:     waq.config,
+		ctx:        waq.ctx.Clone(),
+		order:      append([]walletaccount.OrderOption{}, waq.order...),
+		inters:     append([]Interceptor{}, waq.inters...),
+		predicates: append([]predicate.WalletAccount{}, waq.predicates...),
+		// clone intermediate query.
+		sql:  waq.sql.Clone(),
+		path: waq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		NextIndex int64 `json:"next_index,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WalletAccount.Query().
+//		GroupBy(walletaccount.FieldNextIndex).
+//		Aggregate(database.Count()).
+//		Scan(ctx, &v)
+func (waq *WalletAccountQuery) GroupBy(field string, fields ...string) *WalletAccountGroupBy {
+	waq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WalletAccountGroupBy{build: waq}
+	grbuild.flds = &waq.ctx.Fields
+	grbuild.label = walletaccount.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		NextIndex int64 `json:"next_index,omitempty"`
+//	}
+//
+//	client.WalletAccount.Query().
+//		Select(walletaccount.FieldNextIndex).
+//		Scan(ctx, &v)
+func (waq *WalletAccountQuery) Select(fields ...string) *WalletAccountSelect {
+	waq.ctx.Fields = append(waq.ctx.Fields, fields...)
+	sbuild := &WalletAccountSelect{WalletAccountQuery: waq}
+	sbuild.label = walletaccount.Label
+	sbuild.flds, sbuild.scan = &waq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WalletAccountSelect configured with the given aggregations.
+func (waq *WalletAccountQuery) Aggregate(fns ...AggregateFunc) *WalletAccountSelect {
+	return waq.Select().Aggregate(fns...)
+}
+
+func (waq *WalletAccountQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range waq.inters {
+		if inter == nil {
+			return fmt.Errorf("database: uninitialized interceptor (forgotten import database/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, waq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range waq.ctx.Fields {
+		if !walletaccount.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+		}
+	}
+	if waq.path != nil {
+		prev, err := waq.path(ctx)
+		if err != nil {
+			return err
+		}
+		waq.sql = prev
+	}
+	return nil
+}
+
+func (waq *WalletAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WalletAccount, error) {
+	var (
+		nodes = []*WalletAccount{}
+		_spec = waq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WalletAccount).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WalletAccount{config: waq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, waq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (waq *WalletAccountQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := waq.querySpec()
+	_spec.Node.Columns = waq.ctx.Fields
+	if len(waq.ctx.Fields) > 0 {
+		_spec.Unique = waq.ctx.Unique != nil && *waq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, waq.driver, _spec)
+}
+
+func (waq *WalletAccountQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(walletaccount.Table, walletaccount.Columns, sqlgraph.NewFieldSpec(walletaccount.FieldID, field.TypeString))
+	_spec.From = waq.sql
+	if unique := waq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if waq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := waq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, walletaccount.FieldID)
+		for i := range fields {
+			if fields[i] != walletaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := waq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := waq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := waq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := waq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (waq *WalletAccountQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(waq.driver.Dialect())
+	t1 := builder.Table(walletaccount.Table)
+	columns := waq.ctx.Fields
+	if len(columns) == 0 {
+		columns = walletaccount.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if waq.sql != nil {
+		selector = waq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if waq.ctx.Unique != nil && *waq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range waq.predicates {
+		p(selector)
+	}
+	for _, p := range waq.order {
+		p(selector)
+	}
+	if offset := waq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := waq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WalletAccountGroupBy is the group-by builder for WalletAccount entities.
+type WalletAccountGroupBy struct {
+	selector
+	build *WalletAccountQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wagb *WalletAccountGroupBy) Aggregate(fns ...AggregateFunc) *WalletAccountGroupBy {
+	wagb.fns = append(wagb.fns, fns...)
+	return wagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wagb *WalletAccountGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wagb.build.ctx, ent.OpQueryGroupBy)
+	if err := wagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WalletAccountQuery, *WalletAccountGroupBy](ctx, wagb.build, wagb, wagb.build.inters, v)
+}
+
+func (wagb *WalletAccountGroupBy) sqlScan(ctx context.Context, root *WalletAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wagb.fns))
+	for _, fn := range wagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wagb.flds)+len(wagb.fns))
+		for _, f := range *wagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WalletAccountSelect is the builder for selecting fields of WalletAccount entities.
+type WalletAccountSelect struct {
+	*WalletAccountQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (was *WalletAccountSelect) Aggregate(fns ...AggregateFunc) *WalletAccountSelect {
+	was.fns = append(was.fns, fns...)
+	return was
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (was *WalletAccountSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, was.ctx, ent.OpQuerySelect)
+	if err := was.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WalletAccountQuery, *WalletAccountSelect](ctx, was.WalletAccountQuery, was, was.inters, v)
+}
+
+func (was *WalletAccountSelect) sqlScan(ctx context.Context, root *WalletAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(was.fns))
+	for _, fn := range was.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*was.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := was.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
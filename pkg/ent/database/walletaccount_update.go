@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package database
+
+import (
+	"context"
+	"cpg/pkg/ent/database/predicate"
+	"cpg/pkg/ent/database/walletaccount"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WalletAccountUpdate is the builder for updating WalletAccount entities.
+type WalletAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WalletAccountMutation
+}
+
+// Where appends a list predicates to the WalletAccountUpdate builder.
+func (wau *WalletAccountUpdate) Where(ps ...predicate.WalletAccount) *WalletAccountUpdate {
+	wau.mutation.Where(ps...)
+	return wau
+}
+
+// SetNextIndex sets the "next_index" field.
+func (wau *WalletAccountUpdate) SetNextIndex(i int64) *WalletAccountUpdate {
+	wau.mutation.ResetNextIndex()
+	wau.mutation.SetNextIndex(i)
+	return wau
+}
+
+// SetNillableNextIndex sets the "next_index" field if the given value is not nil.
+func (wau *WalletAccountUpdate) SetNillableNextIndex(i *int64) *WalletAccountUpdate {
+	if i != nil {
+		wau.SetNextIndex(*i)
+	}
+	return wau
+}
+
+// AddNextIndex adds i to the "next_index" field.
+func (wau *WalletAccountUpdate) AddNextIndex(i int64) *WalletAccountUpdate {
+	wau.mutation.AddNextIndex(i)
+	return wau
+}
+
+// Mutation returns the WalletAccountMutation object of the builder.
+func (wau *WalletAccountUpdate) Mutation() *WalletAccountMutation {
+	return wau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wau *WalletAccountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wau.sqlSave, wau.mutation, wau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wau *WalletAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := wau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wau *WalletAccountUpdate) Exec(ctx context.Context) error {
+	_, err := wau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wau *WalletAccountUpdate) ExecX(ctx context.Context) {
+	if err := wau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wau *WalletAccountUpdate) check() error {
+	if v, ok := wau.mutation.NextIndex(); ok {
+		if err := walletaccount.NextIndexValidator(v); err != nil {
+			return &ValidationError{Name: "next_index", err: fmt.Errorf(`database: validator failed for field "WalletAccount.next_index": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wau *WalletAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(walletaccount.Table, walletaccount.Columns, sqlgraph.NewFieldSpec(walletaccount.FieldID, field.TypeString))
+	if ps := wau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wau.mutation.NextIndex(); ok {
+		_spec.SetField(walletaccount.FieldNextIndex, field.TypeInt64, value)
+	}
+	if value, ok := wau.mutation.AddedNextIndex(); ok {
+		_spec.AddField(walletaccount.FieldNextIndex, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{walletaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wau.mutation.done = true
+	return n, nil
+}
+
+// WalletAccountUpdateOne is the builder for updating a single WalletAccount entity.
+type WalletAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WalletAccountMutation
+}
+
+// SetNextIndex sets the "next_index" field.
+func (wauo *WalletAccountUpdateOne) SetNextIndex(i int64) *WalletAccountUpdateOne {
+	wauo.mutation.ResetNextIndex()
+	wauo.mutation.SetNextIndex(i)
+	return wauo
+}
+
+// SetNillableNextIndex sets the "next_index" field if the given value is not nil.
+func (wauo *WalletAccountUpdateOne) SetNillableNextIndex(i *int64) *WalletAccountUpdateOne {
+	if i != nil {
+		wauo.SetNextIndex(*i)
+	}
+	return wauo
+}
+
+// AddNextIndex adds i to the "next_index" field.
+func (wauo *WalletAccountUpdateOne) AddNextIndex(i int64) *WalletAccountUpdateOne {
+	wauo.mutation.AddNextIndex(i)
+	return wauo
+}
+
+// Mutation returns the WalletAccountMutation object of the builder.
+func (wauo *WalletAccountUpdateOne) Mutation() *WalletAccountMutation {
+	return wauo.mutation
+}
+
+// Where appends a list predicates to the WalletAccountUpdate builder.
+func (wauo *WalletAccountUpdateOne) Where(ps ...predicate.WalletAccount) *WalletAccountUpdateOne {
+	wauo.mutation.Where(ps...)
+	return wauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wauo *WalletAccountUpdateOne) Select(field string, fields ...string) *WalletAccountUpdateOne {
+	wauo.fields = append([]string{field}, fields...)
+	return wauo
+}
+
+// Save executes the query and returns the updated WalletAccount entity.
+func (wauo *WalletAccountUpdateOne) Save(ctx context.Context) (*WalletAccount, error) {
+	return withHooks(ctx, wauo.sqlSave, wauo.mutation, wauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wauo *WalletAccountUpdateOne) SaveX(ctx context.Context) *WalletAccount {
+	node, err := wauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wauo *WalletAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := wauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wauo *WalletAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := wauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wauo *WalletAccountUpdateOne) check() error {
+	if v, ok := wauo.mutation.NextIndex(); ok {
+		if err := walletaccount.NextIndexValidator(v); err != nil {
+			return &ValidationError{Name: "next_index", err: fmt.Errorf(`database: validator failed for field "WalletAccount.next_index": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wauo *WalletAccountUpdateOne) sqlSave(ctx context.Context) (_node *WalletAccount, err error) {
+	if err := wauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(walletaccount.Table, walletaccount.Columns, sqlgraph.NewFieldSpec(walletaccount.FieldID, field.TypeString))
+	id, ok := wauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`database: missing "WalletAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, walletaccount.FieldID)
+		for _, f := range fields {
+			if !walletaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("database: invalid field %q for query", f)}
+			}
+			if f != walletaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wauo.mutation.NextIndex(); ok {
+		_spec.SetField(walletaccount.FieldNextIndex, field.TypeInt64, value)
+	}
+	if value, ok := wauo.mutation.AddedNextIndex(); ok {
+		_spec.AddField(walletaccount.FieldNextIndex, field.TypeInt64, value)
+	}
+	_node = &WalletAccount{config: wauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{walletaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wauo.mutation.done = true
+	return _node, nil
+}
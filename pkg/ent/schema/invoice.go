@@ -28,10 +28,43 @@ func (Invoice) Fields() []ent.Field {
 		field.Bool("auto_checkout").Default(false).Immutable(),
 		field.Time("cancel_at").Optional().Nillable(),
 		field.String("wallet_address").Unique().NotEmpty().Immutable(),
-		field.Bytes("encrypted_salt").Sensitive().Unique().NotEmpty().Immutable(),
+		field.Bytes("encrypted_salt").Sensitive().Optional().Unique().NotEmpty(),
+		field.Int("derivation_version").Default(0).NonNegative().Immutable(),
+		field.Int64("wallet_index").Optional().Nillable().NonNegative().Immutable(),
+		field.Time("approval_request_at").Optional().Nillable(),
+		field.Time("approve_at").Optional().Nillable(),
+		// escrow_period is in seconds, a filled invoice with one is held until released or refunded, or it passed since fill_at
+		field.Int64("escrow_period").Default(0).NonNegative().Immutable(),
+		// buyer_key_hash is the sha256 of the key the buyer releases or disputes its escrow invoice with
+		field.Bytes("buyer_key_hash").Sensitive().Optional().Immutable(),
+		field.Time("release_at").Optional().Nillable(),
+		field.Time("refund_at").Optional().Nillable(),
+		field.Time("dispute_at").Optional().Nillable(),
+		// the platform fee terms are fixed at creation, fee_amount and fee_tx_hash record the fee leg once its tx is sent
+		field.String("fee_address").Optional().Immutable(),
+		field.Int64("fee_basis_points").Default(0).Range(0, 10000).Immutable(),
+		field.String("fee_flat").GoType(&big.Int{}).ValueScanner(field.TextValueScanner[*big.Int]{}).Optional().Immutable(),
+		field.String("fee_amount").GoType(&big.Int{}).ValueScanner(field.TextValueScanner[*big.Int]{}).Optional(),
+		field.String("fee_tx_hash").Optional(),
+		// split holds the recipients of a split invoice, the first one is the recipient, amounts and txs are filled in by the checkout
+		field.JSON("split", []SplitShare{}).Optional(),
+		field.String("split_gas_rule").Optional().Immutable(),
+		// treasury_address is set for invoices of treasury mode, their sweeps to the recipient go there and credit the merchant ledger instead
+		field.String("treasury_address").Optional().Immutable(),
+		// signer_mac is set when a signer prepared the invoice or by cpg signer mac for older ones,
+		// it only signs sweeps of invoices whose terms still match it
+		field.Bytes("signer_mac").Sensitive().Optional(),
 	}
 }
 
+// SplitShare is the stored share of a split recipient, amounts are decimal strings
+type SplitShare struct {
+	Address     string `json:"address"`
+	BasisPoints int64  `json:"basis_points"`
+	Amount      string `json:"amount,omitempty"`
+	TxHash      string `json:"tx_hash,omitempty"`
+}
+
 func validateMinAmount(minAmountStr string) error {
 	minAmount, ok := (&big.Int{}).SetString(minAmountStr, 10)
 	if !ok {
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// InvoiceEvent is the audit trail of admin actions on an invoice
+type InvoiceEvent struct {
+	ent.Schema
+}
+
+func (InvoiceEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("invoice_id").NotEmpty().Immutable(),
+		field.String("kind").NotEmpty().Immutable(),
+		field.String("asset").Optional().Immutable(),
+		field.String("tx_hash").Optional().Immutable(),
+		field.JSON("detail", map[string]string{}).Optional().Immutable(),
+		field.Time("create_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (InvoiceEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invoice_id", "create_at"),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// LedgerAccount is an account of the double-entry ledger of an asset, created by the first entry posted to it
+type LedgerAccount struct {
+	ent.Schema
+}
+
+func (LedgerAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("asset").NotEmpty().Immutable(),
+		field.String("name").NotEmpty().Immutable(),
+		field.String("kind").NotEmpty().Immutable(),
+		field.Time("create_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (LedgerAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("asset", "name").Unique(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"math/big"
+	"time"
+)
+
+// LedgerEntry is a line of a journal of the double-entry ledger, it debits one account and credits another by the same amount,
+// the lines of a journal are posted together once, a journal posted again violates its unique lines
+type LedgerEntry struct {
+	ent.Schema
+}
+
+func (LedgerEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("journal").NotEmpty().Immutable(),
+		field.Int("line").NonNegative().Immutable(),
+		field.String("asset").NotEmpty().Immutable(),
+		field.String("kind").NotEmpty().Immutable(),
+		field.String("debit").NotEmpty().Immutable(),
+		field.String("credit").NotEmpty().Immutable(),
+		field.String("amount").GoType(&big.Int{}).ValueScanner(field.TextValueScanner[*big.Int]{}).NotEmpty().Immutable(),
+		field.String("invoice_id").Optional().Immutable(),
+		field.String("tx_hash").Optional().Immutable(),
+		field.Time("create_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (LedgerEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("journal", "line").Unique(),
+		index.Fields("asset", "create_at"),
+		index.Fields("invoice_id"),
+	}
+}
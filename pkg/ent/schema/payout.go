@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"math/big"
+	"time"
+)
+
+// Payout is an outgoing transfer from the asset funding wallet to an allowlisted address, created once per idempotency key
+type Payout struct {
+	ent.Schema
+}
+
+func (Payout) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().NotEmpty().Immutable(),
+		field.String("idempotency_key").Unique().NotEmpty().Immutable(),
+		field.String("asset").NotEmpty().Immutable(),
+		field.String("to").NotEmpty().Immutable(),
+		field.String("amount").GoType(&big.Int{}).ValueScanner(field.TextValueScanner[*big.Int]{}).NotEmpty().Immutable(),
+		field.String("reference").Optional().Immutable(),
+		field.String("status").NotEmpty(),
+		field.Time("create_at").Default(time.Now).Immutable(),
+		// fee and tx_hash are set once the tx is signed, send_at once it is sent, confirm_at once it has the asset confirmations
+		// and error once it failed, a pending payout with a tx and an error is one whose send failed
+		field.String("fee").GoType(&big.Int{}).ValueScanner(field.TextValueScanner[*big.Int]{}).Optional(),
+		field.String("tx_hash").Optional(),
+		field.Time("send_at").Optional().Nillable(),
+		field.Time("confirm_at").Optional().Nillable(),
+		field.String("error").Optional(),
+		// notified_status is the last status the webhook was delivered for
+		field.String("notified_status").Default(""),
+	}
+}
+
+func (Payout) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("asset", "create_at"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,19 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// PayoutDay is the row of an asset and utc day the payouts of that day update first, so they check the daily limit one after another
+type PayoutDay struct {
+	ent.Schema
+}
+
+func (PayoutDay) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the asset name and the utc date
+		field.String("id").Unique().NotEmpty().Immutable(),
+		field.Int64("payouts").NonNegative(),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"math/big"
+	"time"
+)
+
+// TreasuryCredit is what an account is owed from a sweep into the asset treasury, settlement_id is set once a settlement claims it
+type TreasuryCredit struct {
+	ent.Schema
+}
+
+func (TreasuryCredit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("asset").NotEmpty().Immutable(),
+		field.String("account").NotEmpty().Immutable(),
+		field.String("invoice_id").NotEmpty().Immutable(),
+		field.String("tx_hash").NotEmpty().Immutable(),
+		field.String("amount").GoType(&big.Int{}).ValueScanner(field.TextValueScanner[*big.Int]{}).NotEmpty().Immutable(),
+		field.Time("create_at").Default(time.Now).Immutable(),
+		field.String("settlement_id").Optional(),
+	}
+}
+
+func (TreasuryCredit) Indexes() []ent.Index {
+	return []ent.Index{
+		// a sweep credits each account once
+		index.Fields("tx_hash", "account").Unique(),
+		index.Fields("asset", "account", "settlement_id"),
+	}
+}
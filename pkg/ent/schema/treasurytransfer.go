@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"math/big"
+	"time"
+)
+
+// TreasuryTransfer is a tx from the asset treasury, a settlement of the credits of an account or a forward of the excess to the cold address
+type TreasuryTransfer struct {
+	ent.Schema
+}
+
+func (TreasuryTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().NotEmpty().Immutable(),
+		field.String("asset").NotEmpty().Immutable(),
+		field.String("kind").NotEmpty().Immutable(),
+		field.String("to").NotEmpty().Immutable(),
+		field.String("amount").GoType(&big.Int{}).ValueScanner(field.TextValueScanner[*big.Int]{}).NotEmpty().Immutable(),
+		field.Time("create_at").Default(time.Now).Immutable(),
+		// fee and tx_hash are set once the tx is signed and send_at once it is sent or mined,
+		// error is set once it failed, a failure without tx_hash released its credits
+		field.String("fee").GoType(&big.Int{}).ValueScanner(field.TextValueScanner[*big.Int]{}).Optional(),
+		field.String("tx_hash").Optional(),
+		field.Time("send_at").Optional().Nillable(),
+		field.String("error").Optional(),
+	}
+}
+
+func (TreasuryTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("asset", "to", "create_at"),
+	}
+}
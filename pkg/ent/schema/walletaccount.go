@@ -0,0 +1,18 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// WalletAccount allocates the child indexes of a hd wallet account to invoices
+type WalletAccount struct {
+	ent.Schema
+}
+
+func (WalletAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().NotEmpty().Immutable(),
+		field.Int64("next_index").NonNegative(),
+	}
+}
@@ -0,0 +1,75 @@
+package hdwallet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"github.com/itsabgr/ge"
+	"math/big"
+	"strings"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var bigRadix = big.NewInt(58)
+
+func base58Encode(data []byte) string {
+	n := new(big.Int).SetBytes(data)
+	mod := new(big.Int)
+	out := make([]byte, 0, len(data)*138/100+1)
+	for n.Sign() > 0 {
+		n.DivMod(n, bigRadix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+func base58Decode(s string) ([]byte, error) {
+	n := new(big.Int)
+	for _, r := range s {
+		digit := strings.IndexRune(base58Alphabet, r)
+		if digit < 0 {
+			return nil, ge.New("invalid base58 character")
+		}
+		n.Mul(n, bigRadix)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+func checksum(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
+
+func base58CheckEncode(data []byte) string {
+	return base58Encode(append(append([]byte{}, data...), checksum(data)...))
+}
+
+func base58CheckDecode(s string) ([]byte, error) {
+	data, err := base58Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 4 {
+		return nil, ge.New("base58check data is too short")
+	}
+	payload, sum := data[:len(data)-4], data[len(data)-4:]
+	if !bytes.Equal(checksum(payload), sum) {
+		return nil, ge.New("invalid base58check checksum")
+	}
+	return payload, nil
+}
@@ -0,0 +1,263 @@
+// Package hdwallet implements bip32 hierarchical deterministic keys on secp256k1 with bip44 style paths
+package hdwallet
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/itsabgr/ge"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/ripemd160"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Hardened is added to a child index to derive a hardened child, which needs the private key
+const Hardened uint32 = 0x80000000
+
+// DefaultEthereumPath is the bip44 external chain of the first ethereum account, invoice indexes are appended to it
+const DefaultEthereumPath = "m/44'/60'/0'/0"
+
+var (
+	versionPrivate = []byte{0x04, 0x88, 0xad, 0xe4}
+	versionPublic  = []byte{0x04, 0x88, 0xb2, 0x1e}
+)
+
+// Key is an extended private or public key
+type Key struct {
+	private     []byte
+	public      []byte
+	chainCode   []byte
+	depth       uint8
+	fingerprint []byte
+	index       uint32
+}
+
+// NewMaster derives the master key of a seed, e.g. of a bip39 mnemonic
+func NewMaster(seed []byte) (*Key, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, ge.New("seed must be 16 to 64 bytes")
+	}
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	if _, err := crypto.ToECDSA(sum[:32]); err != nil {
+		return nil, ge.New("seed derives an invalid master key")
+	}
+	return &Key{private: sum[:32], chainCode: sum[32:], fingerprint: make([]byte, 4)}, nil
+}
+
+// ParseSeed takes a bip39 mnemonic with its optional passphrase or a hex seed
+func ParseSeed(text, passphrase string) ([]byte, error) {
+	text = strings.Join(strings.Fields(text), " ")
+	if bip39.IsMnemonicValid(text) {
+		return bip39.NewSeed(text, passphrase), nil
+	}
+	if strings.Contains(text, " ") {
+		return nil, ge.New("invalid mnemonic")
+	}
+	seed, err := hex.DecodeString(strings.TrimPrefix(text, "0x"))
+	if err != nil {
+		return nil, ge.New("seed is neither a mnemonic nor hex")
+	}
+	return seed, nil
+}
+
+// Parse decodes a serialized xprv or xpub
+func Parse(s string) (*Key, error) {
+	data, err := base58CheckDecode(strings.TrimSpace(s))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 78 {
+		return nil, ge.New("invalid extended key length")
+	}
+	key := &Key{
+		depth:       data[4],
+		fingerprint: bytes.Clone(data[5:9]),
+		index:       binary.BigEndian.Uint32(data[9:13]),
+		chainCode:   bytes.Clone(data[13:45]),
+	}
+	switch version := data[:4]; {
+	case bytes.Equal(version, versionPrivate):
+		if data[45] != 0 {
+			return nil, ge.New("invalid extended private key")
+		}
+		if _, err = crypto.ToECDSA(data[46:]); err != nil {
+			return nil, ge.Wrap(ge.New("invalid extended private key"), err)
+		}
+		key.private = bytes.Clone(data[46:])
+	case bytes.Equal(version, versionPublic):
+		if _, err = crypto.DecompressPubkey(data[45:]); err != nil {
+			return nil, ge.Wrap(ge.New("invalid extended public key"), err)
+		}
+		key.public = bytes.Clone(data[45:])
+	default:
+		return nil, ge.New("unknown extended key version")
+	}
+	return key, nil
+}
+
+// IsPrivate reports whether the key can sign and derive hardened children
+func (key *Key) IsPrivate() bool {
+	return key.private != nil
+}
+
+// Depth is the number of derivations from the master key
+func (key *Key) Depth() uint8 {
+	return key.depth
+}
+
+func (key *Key) publicKey() []byte {
+	if key.public == nil {
+		key.public = crypto.CompressPubkey(&ge.Must(crypto.ToECDSA(key.private)).PublicKey)
+	}
+	return key.public
+}
+
+// Child derives the child at an index, indexes from Hardened up are hardened
+func (key *Key) Child(index uint32) (*Key, error) {
+	hardened := index >= Hardened
+	if hardened && !key.IsPrivate() {
+		return nil, ge.New("hardened child of a public key")
+	}
+	if key.depth == 0xff {
+		return nil, ge.New("max key depth")
+	}
+
+	data := make([]byte, 0, 37)
+	if hardened {
+		data = append(append(data, 0), key.private...)
+	} else {
+		data = append(data, key.publicKey()...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, key.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	curve := crypto.S256()
+	tweak := new(big.Int).SetBytes(sum[:32])
+	if tweak.Cmp(curve.Params().N) >= 0 {
+		return nil, ge.Detail(ge.New("invalid child key, use the next index"), ge.D{"index": index})
+	}
+
+	child := &Key{
+		chainCode:   sum[32:],
+		depth:       key.depth + 1,
+		fingerprint: hash160(key.publicKey())[:4],
+		index:       index,
+	}
+
+	if key.IsPrivate() {
+		k := tweak.Add(tweak, new(big.Int).SetBytes(key.private))
+		k.Mod(k, curve.Params().N)
+		if k.Sign() == 0 {
+			return nil, ge.Detail(ge.New("invalid child key, use the next index"), ge.D{"index": index})
+		}
+		child.private = k.FillBytes(make([]byte, 32))
+		return child, nil
+	}
+
+	parent, err := crypto.DecompressPubkey(key.public)
+	if err != nil {
+		return nil, err
+	}
+	tx, ty := curve.ScalarBaseMult(sum[:32])
+	x, y := curve.Add(tx, ty, parent.X, parent.Y)
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil, ge.Detail(ge.New("invalid child key, use the next index"), ge.D{"index": index})
+	}
+	child.public = crypto.CompressPubkey(&ecdsa.PublicKey{Curve: curve, X: x, Y: y})
+	return child, nil
+}
+
+// Derive follows a path of child indexes
+func (key *Key) Derive(path []uint32) (*Key, error) {
+	var err error
+	for _, index := range path {
+		if key, err = key.Child(index); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// Neuter returns the public key of an extended key, it derives the same non-hardened children
+func (key *Key) Neuter() *Key {
+	return &Key{
+		public:      key.publicKey(),
+		chainCode:   key.chainCode,
+		depth:       key.depth,
+		fingerprint: key.fingerprint,
+		index:       key.index,
+	}
+}
+
+// ECDSA returns the private key
+func (key *Key) ECDSA() (*ecdsa.PrivateKey, error) {
+	if !key.IsPrivate() {
+		return nil, ge.New("not a private key")
+	}
+	return crypto.ToECDSA(key.private)
+}
+
+// PublicKey returns the public key
+func (key *Key) PublicKey() (*ecdsa.PublicKey, error) {
+	return crypto.DecompressPubkey(key.publicKey())
+}
+
+// String serializes the key as xprv or xpub
+func (key *Key) String() string {
+	data := make([]byte, 0, 78)
+	if key.IsPrivate() {
+		data = append(data, versionPrivate...)
+	} else {
+		data = append(data, versionPublic...)
+	}
+	data = append(data, key.depth)
+	data = append(data, key.fingerprint...)
+	data = binary.BigEndian.AppendUint32(data, key.index)
+	data = append(data, key.chainCode...)
+	if key.IsPrivate() {
+		data = append(append(data, 0), key.private...)
+	} else {
+		data = append(data, key.public...)
+	}
+	return base58CheckEncode(data)
+}
+
+// ParsePath parses a path like m/44'/60'/0'/0, h is accepted as the hardened mark too
+func ParsePath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if len(parts) == 0 || parts[0] != "m" {
+		return nil, ge.New("derivation path must start with m")
+	}
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		var offset uint32
+		if trimmed := strings.TrimRight(part, "'hH"); trimmed != part {
+			offset, part = Hardened, trimmed
+		}
+		index, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, ge.Detail(ge.New("invalid derivation path index"), ge.D{"index": part})
+		}
+		indexes = append(indexes, uint32(index)+offset)
+	}
+	return indexes, nil
+}
+
+func hash160(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	h := ripemd160.New()
+	h.Write(sum[:])
+	return h.Sum(nil)
+}
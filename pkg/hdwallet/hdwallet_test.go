@@ -0,0 +1,171 @@
+package hdwallet
+
+import (
+	"encoding/hex"
+	"github.com/ethereum/go-ethereum/crypto"
+	"slices"
+	"testing"
+)
+
+// bip32Vector1 is test vector 1 of bip32
+var bip32Vector1 = struct {
+	seed  string
+	chain []struct {
+		path string
+		xpub string
+		xprv string
+	}
+}{
+	seed: "000102030405060708090a0b0c0d0e0f",
+	chain: []struct {
+		path string
+		xpub string
+		xprv string
+	}{
+		{"m", "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"},
+		{"m/0'", "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw", "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7"},
+		{"m/0'/1", "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ", "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs"},
+		{"m/0'/1/2'", "xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5", "xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM"},
+		{"m/0'/1/2'/2", "xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV", "xprvA2JDeKCSNNZky6uBCviVfJSKyQ1mDYahRjijr5idH2WwLsEd4Hsb2Tyh8RfQMuPh7f7RtyzTtdrbdqqsunu5Mm3wDvUAKRHSC34sJ7in334"},
+		{"m/0'/1/2'/2/1000000000", "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy", "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76"},
+	},
+}
+
+func TestBIP32Vector1(t *testing.T) {
+	master, err := NewMaster(must(hex.DecodeString(bip32Vector1.seed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range bip32Vector1.chain {
+		t.Run(tc.path, func(t *testing.T) {
+			key, err := master.Derive(must(ParsePath(tc.path)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := key.String(); got != tc.xprv {
+				t.Errorf("xprv %s", got)
+			}
+			if got := key.Neuter().String(); got != tc.xpub {
+				t.Errorf("xpub %s", got)
+			}
+			for _, serialized := range []string{tc.xprv, tc.xpub} {
+				parsed, err := Parse(serialized)
+				if err != nil || parsed.String() != serialized {
+					t.Errorf("parse %s: %v", serialized, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPublicDerivation(t *testing.T) {
+	xpub, err := Parse(bip32Vector1.chain[1].xpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := xpub.Child(1)
+	if err != nil || child.String() != bip32Vector1.chain[2].xpub {
+		t.Fatalf("public child %v %v", child, err)
+	}
+	if _, err = xpub.Child(Hardened); err == nil {
+		t.Fatal("hardened child of a public key")
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	for _, tc := range []struct {
+		path    string
+		indexes []uint32
+	}{
+		{"m", []uint32{}},
+		{DefaultEthereumPath, []uint32{44 + Hardened, 60 + Hardened, Hardened, 0}},
+		{"m/0h/1", []uint32{Hardened, 1}},
+		{"0/1", nil},
+		{"m/x", nil},
+		{"m/2147483648", nil},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			indexes, err := ParsePath(tc.path)
+			if tc.indexes == nil {
+				if err == nil {
+					t.Fatalf("parsed %v", indexes)
+				}
+				return
+			}
+			if err != nil || !slices.Equal(indexes, tc.indexes) {
+				t.Fatalf("%v %v", indexes, err)
+			}
+		})
+	}
+}
+
+func TestParseSeed(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		text       string
+		passphrase string
+		seed       string
+	}{
+		// bip39 reference vector
+		{"mnemonic", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "TREZOR", "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04"},
+		{"hex", "0x000102030405060708090a0b0c0d0e0f", "", bip32Vector1.seed},
+		{"bad checksum", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", "", ""},
+		{"neither", "not a seed", "", ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			seed, err := ParseSeed(tc.text, tc.passphrase)
+			if tc.seed == "" {
+				if err == nil {
+					t.Fatalf("parsed %x", seed)
+				}
+				return
+			}
+			if err != nil || hex.EncodeToString(seed) != tc.seed {
+				t.Fatalf("%x %v", seed, err)
+			}
+		})
+	}
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// TestEthereumAddresses pins bip44 ethereum addresses of a well known test mnemonic, standard wallets derive the same ones
+func TestEthereumAddresses(t *testing.T) {
+	seed, err := ParseSeed("test test test test test test test test test test test junk", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	master, err := NewMaster(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, err := ParsePath(DefaultEthereumPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := master.Derive(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []string{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"} {
+		// the watch-only derivation from the account xpub gives the same addresses
+		for _, parent := range []*Key{account, account.Neuter()} {
+			child, err := parent.Child(uint32(i))
+			if err != nil {
+				t.Fatal(err)
+			}
+			public, err := child.PublicKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if address := crypto.PubkeyToAddress(*public).Hex(); address != want {
+				t.Errorf("index %d private %v: got %s want %s", i, parent.IsPrivate(), address, want)
+			}
+		}
+	}
+}
@@ -23,34 +23,98 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RecoverOutcome int32
+
+const (
+	RecoverOutcome_RECOVER_OUTCOME_FAILED    RecoverOutcome = 0
+	RecoverOutcome_RECOVER_OUTCOME_RECOVERED RecoverOutcome = 1
+	RecoverOutcome_RECOVER_OUTCOME_EXISTS    RecoverOutcome = 2
+)
+
+// Enum value maps for RecoverOutcome.
+var (
+	RecoverOutcome_name = map[int32]string{
+		0: "RECOVER_OUTCOME_FAILED",
+		1: "RECOVER_OUTCOME_RECOVERED",
+		2: "RECOVER_OUTCOME_EXISTS",
+	}
+	RecoverOutcome_value = map[string]int32{
+		"RECOVER_OUTCOME_FAILED":    0,
+		"RECOVER_OUTCOME_RECOVERED": 1,
+		"RECOVER_OUTCOME_EXISTS":    2,
+	}
+)
+
+func (x RecoverOutcome) Enum() *RecoverOutcome {
+	p := new(RecoverOutcome)
+	*p = x
+	return p
+}
+
+func (x RecoverOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecoverOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_cpg_proto_enumTypes[0].Descriptor()
+}
+
+func (RecoverOutcome) Type() protoreflect.EnumType {
+	return &file_cpg_proto_enumTypes[0]
+}
+
+func (x RecoverOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecoverOutcome.Descriptor instead.
+func (RecoverOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{0}
+}
+
 type InvoiceStatus int32
 
 const (
-	InvoiceStatus_INVOICE_STATUS_INVALID  InvoiceStatus = 0
-	InvoiceStatus_INVOICE_STATUS_PENDING  InvoiceStatus = 1
-	InvoiceStatus_INVOICE_STATUS_FILLED   InvoiceStatus = 2
-	InvoiceStatus_INVOICE_STATUS_CANCELED InvoiceStatus = 3
-	InvoiceStatus_INVOICE_STATUS_EXPIRED  InvoiceStatus = 4
-	InvoiceStatus_INVOICE_STATUS_CHECKOUT InvoiceStatus = 5
+	InvoiceStatus_INVOICE_STATUS_INVALID           InvoiceStatus = 0
+	InvoiceStatus_INVOICE_STATUS_PENDING           InvoiceStatus = 1
+	InvoiceStatus_INVOICE_STATUS_FILLED            InvoiceStatus = 2
+	InvoiceStatus_INVOICE_STATUS_CANCELED          InvoiceStatus = 3
+	InvoiceStatus_INVOICE_STATUS_EXPIRED           InvoiceStatus = 4
+	InvoiceStatus_INVOICE_STATUS_CHECKOUT          InvoiceStatus = 5
+	InvoiceStatus_INVOICE_STATUS_AWAITING_APPROVAL InvoiceStatus = 6
+	InvoiceStatus_INVOICE_STATUS_HELD              InvoiceStatus = 7
+	InvoiceStatus_INVOICE_STATUS_DISPUTED          InvoiceStatus = 8
+	InvoiceStatus_INVOICE_STATUS_RELEASED          InvoiceStatus = 9
+	InvoiceStatus_INVOICE_STATUS_REFUNDED          InvoiceStatus = 10
 )
 
 // Enum value maps for InvoiceStatus.
 var (
 	InvoiceStatus_name = map[int32]string{
-		0: "INVOICE_STATUS_INVALID",
-		1: "INVOICE_STATUS_PENDING",
-		2: "INVOICE_STATUS_FILLED",
-		3: "INVOICE_STATUS_CANCELED",
-		4: "INVOICE_STATUS_EXPIRED",
-		5: "INVOICE_STATUS_CHECKOUT",
+		0:  "INVOICE_STATUS_INVALID",
+		1:  "INVOICE_STATUS_PENDING",
+		2:  "INVOICE_STATUS_FILLED",
+		3:  "INVOICE_STATUS_CANCELED",
+		4:  "INVOICE_STATUS_EXPIRED",
+		5:  "INVOICE_STATUS_CHECKOUT",
+		6:  "INVOICE_STATUS_AWAITING_APPROVAL",
+		7:  "INVOICE_STATUS_HELD",
+		8:  "INVOICE_STATUS_DISPUTED",
+		9:  "INVOICE_STATUS_RELEASED",
+		10: "INVOICE_STATUS_REFUNDED",
 	}
 	InvoiceStatus_value = map[string]int32{
-		"INVOICE_STATUS_INVALID":  0,
-		"INVOICE_STATUS_PENDING":  1,
-		"INVOICE_STATUS_FILLED":   2,
-		"INVOICE_STATUS_CANCELED": 3,
-		"INVOICE_STATUS_EXPIRED":  4,
-		"INVOICE_STATUS_CHECKOUT": 5,
+		"INVOICE_STATUS_INVALID":           0,
+		"INVOICE_STATUS_PENDING":           1,
+		"INVOICE_STATUS_FILLED":            2,
+		"INVOICE_STATUS_CANCELED":          3,
+		"INVOICE_STATUS_EXPIRED":           4,
+		"INVOICE_STATUS_CHECKOUT":          5,
+		"INVOICE_STATUS_AWAITING_APPROVAL": 6,
+		"INVOICE_STATUS_HELD":              7,
+		"INVOICE_STATUS_DISPUTED":          8,
+		"INVOICE_STATUS_RELEASED":          9,
+		"INVOICE_STATUS_REFUNDED":          10,
 	}
 )
 
@@ -65,11 +129,11 @@ func (x InvoiceStatus) String() string {
 }
 
 func (InvoiceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_cpg_proto_enumTypes[0].Descriptor()
+	return file_cpg_proto_enumTypes[1].Descriptor()
 }
 
 func (InvoiceStatus) Type() protoreflect.EnumType {
-	return &file_cpg_proto_enumTypes[0]
+	return &file_cpg_proto_enumTypes[1]
 }
 
 func (x InvoiceStatus) Number() protoreflect.EnumNumber {
@@ -78,7 +142,7 @@ func (x InvoiceStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InvoiceStatus.Descriptor instead.
 func (InvoiceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_cpg_proto_rawDescGZIP(), []int{0}
+	return file_cpg_proto_rawDescGZIP(), []int{1}
 }
 
 type PingInput struct {
@@ -229,7 +293,8 @@ type RecoverInvoiceInput struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvoiceId     string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// invoice_backup is the raw backup, its word list or its qr part texts separated by newlines
 	InvoiceBackup []byte `protobuf:"bytes,2,opt,name=invoice_backup,json=invoiceBackup,proto3" json:"invoice_backup,omitempty"`
 }
 
@@ -322,34 +387,32 @@ func (x *RecoverInvoiceOutput) GetInvoiceId() string {
 	return ""
 }
 
-type CreateInvoiceInput struct {
+type RecoverInvoicesOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetName    string               `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
-	Metadata     string               `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Recipient    string               `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Beneficiary  string               `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
-	AutoCheckout bool                 `protobuf:"varint,7,opt,name=auto_checkout,json=autoCheckout,proto3" json:"auto_checkout,omitempty"`
-	MinAmount    string               `protobuf:"bytes,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
-	Deadline     *timestamp.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	InvoiceId     string         `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Outcome       RecoverOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=RecoverOutcome" json:"outcome,omitempty"`
+	Error         string         `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	WalletAddress string         `protobuf:"bytes,4,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Status        InvoiceStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=InvoiceStatus" json:"status,omitempty"`
 }
 
-func (x *CreateInvoiceInput) Reset() {
-	*x = CreateInvoiceInput{}
+func (x *RecoverInvoicesOutput) Reset() {
+	*x = RecoverInvoicesOutput{}
 	mi := &file_cpg_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateInvoiceInput) String() string {
+func (x *RecoverInvoicesOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvoiceInput) ProtoMessage() {}
+func (*RecoverInvoicesOutput) ProtoMessage() {}
 
-func (x *CreateInvoiceInput) ProtoReflect() protoreflect.Message {
+func (x *RecoverInvoicesOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_cpg_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -361,61 +424,47 @@ func (x *CreateInvoiceInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvoiceInput.ProtoReflect.Descriptor instead.
-func (*CreateInvoiceInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecoverInvoicesOutput.ProtoReflect.Descriptor instead.
+func (*RecoverInvoicesOutput) Descriptor() ([]byte, []int) {
 	return file_cpg_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateInvoiceInput) GetAssetName() string {
-	if x != nil {
-		return x.AssetName
-	}
-	return ""
-}
-
-func (x *CreateInvoiceInput) GetMetadata() string {
+func (x *RecoverInvoicesOutput) GetInvoiceId() string {
 	if x != nil {
-		return x.Metadata
+		return x.InvoiceId
 	}
 	return ""
 }
 
-func (x *CreateInvoiceInput) GetRecipient() string {
+func (x *RecoverInvoicesOutput) GetOutcome() RecoverOutcome {
 	if x != nil {
-		return x.Recipient
+		return x.Outcome
 	}
-	return ""
+	return RecoverOutcome_RECOVER_OUTCOME_FAILED
 }
 
-func (x *CreateInvoiceInput) GetBeneficiary() string {
+func (x *RecoverInvoicesOutput) GetError() string {
 	if x != nil {
-		return x.Beneficiary
+		return x.Error
 	}
 	return ""
 }
 
-func (x *CreateInvoiceInput) GetAutoCheckout() bool {
-	if x != nil {
-		return x.AutoCheckout
-	}
-	return false
-}
-
-func (x *CreateInvoiceInput) GetMinAmount() string {
+func (x *RecoverInvoicesOutput) GetWalletAddress() string {
 	if x != nil {
-		return x.MinAmount
+		return x.WalletAddress
 	}
 	return ""
 }
 
-func (x *CreateInvoiceInput) GetDeadline() *timestamp.Timestamp {
+func (x *RecoverInvoicesOutput) GetStatus() InvoiceStatus {
 	if x != nil {
-		return x.Deadline
+		return x.Status
 	}
-	return nil
+	return InvoiceStatus_INVOICE_STATUS_INVALID
 }
 
-type CreateInvoiceOutput struct {
+type VerifyBackupInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -424,20 +473,20 @@ type CreateInvoiceOutput struct {
 	InvoiceBackup []byte `protobuf:"bytes,2,opt,name=invoice_backup,json=invoiceBackup,proto3" json:"invoice_backup,omitempty"`
 }
 
-func (x *CreateInvoiceOutput) Reset() {
-	*x = CreateInvoiceOutput{}
+func (x *VerifyBackupInput) Reset() {
+	*x = VerifyBackupInput{}
 	mi := &file_cpg_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateInvoiceOutput) String() string {
+func (x *VerifyBackupInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvoiceOutput) ProtoMessage() {}
+func (*VerifyBackupInput) ProtoMessage() {}
 
-func (x *CreateInvoiceOutput) ProtoReflect() protoreflect.Message {
+func (x *VerifyBackupInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cpg_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -449,48 +498,57 @@ func (x *CreateInvoiceOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvoiceOutput.ProtoReflect.Descriptor instead.
-func (*CreateInvoiceOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyBackupInput.ProtoReflect.Descriptor instead.
+func (*VerifyBackupInput) Descriptor() ([]byte, []int) {
 	return file_cpg_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateInvoiceOutput) GetInvoiceId() string {
+func (x *VerifyBackupInput) GetInvoiceId() string {
 	if x != nil {
 		return x.InvoiceId
 	}
 	return ""
 }
 
-func (x *CreateInvoiceOutput) GetInvoiceBackup() []byte {
+func (x *VerifyBackupInput) GetInvoiceBackup() []byte {
 	if x != nil {
 		return x.InvoiceBackup
 	}
 	return nil
 }
 
-type CancelInvoiceInput struct {
+type VerifyBackupOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvoiceId     string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
-	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Format         uint32               `protobuf:"varint,1,opt,name=format,proto3" json:"format,omitempty"`
+	KeyId          string               `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Asset          string               `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	CreateAt       *timestamp.Timestamp `protobuf:"bytes,4,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	Deadline       *timestamp.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	MinAmount      string               `protobuf:"bytes,6,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	Recipient      string               `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Beneficiary    string               `protobuf:"bytes,8,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	WalletAddress  string               `protobuf:"bytes,9,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	AddressMatches bool                 `protobuf:"varint,10,opt,name=address_matches,json=addressMatches,proto3" json:"address_matches,omitempty"`
+	Exists         bool                 `protobuf:"varint,11,opt,name=exists,proto3" json:"exists,omitempty"`
 }
 
-func (x *CancelInvoiceInput) Reset() {
-	*x = CancelInvoiceInput{}
+func (x *VerifyBackupOutput) Reset() {
+	*x = VerifyBackupOutput{}
 	mi := &file_cpg_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelInvoiceInput) String() string {
+func (x *VerifyBackupOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelInvoiceInput) ProtoMessage() {}
+func (*VerifyBackupOutput) ProtoMessage() {}
 
-func (x *CancelInvoiceInput) ProtoReflect() protoreflect.Message {
+func (x *VerifyBackupOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_cpg_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,106 +560,127 @@ func (x *CancelInvoiceInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelInvoiceInput.ProtoReflect.Descriptor instead.
-func (*CancelInvoiceInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyBackupOutput.ProtoReflect.Descriptor instead.
+func (*VerifyBackupOutput) Descriptor() ([]byte, []int) {
 	return file_cpg_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CancelInvoiceInput) GetInvoiceId() string {
+func (x *VerifyBackupOutput) GetFormat() uint32 {
 	if x != nil {
-		return x.InvoiceId
+		return x.Format
+	}
+	return 0
+}
+
+func (x *VerifyBackupOutput) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
 	}
 	return ""
 }
 
-func (x *CancelInvoiceInput) GetWalletAddress() string {
+func (x *VerifyBackupOutput) GetAsset() string {
 	if x != nil {
-		return x.WalletAddress
+		return x.Asset
 	}
 	return ""
 }
 
-type GetInvoiceInput struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *VerifyBackupOutput) GetCreateAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateAt
+	}
+	return nil
+}
 
-	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+func (x *VerifyBackupOutput) GetDeadline() *timestamp.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
 }
 
-func (x *GetInvoiceInput) Reset() {
-	*x = GetInvoiceInput{}
-	mi := &file_cpg_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *VerifyBackupOutput) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
 }
 
-func (x *GetInvoiceInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *VerifyBackupOutput) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
 }
 
-func (*GetInvoiceInput) ProtoMessage() {}
+func (x *VerifyBackupOutput) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return ""
+}
 
-func (x *GetInvoiceInput) ProtoReflect() protoreflect.Message {
-	mi := &file_cpg_proto_msgTypes[8]
+func (x *VerifyBackupOutput) GetWalletAddress() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.WalletAddress
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetInvoiceInput.ProtoReflect.Descriptor instead.
-func (*GetInvoiceInput) Descriptor() ([]byte, []int) {
-	return file_cpg_proto_rawDescGZIP(), []int{8}
+func (x *VerifyBackupOutput) GetAddressMatches() bool {
+	if x != nil {
+		return x.AddressMatches
+	}
+	return false
 }
 
-func (x *GetInvoiceInput) GetInvoiceId() string {
+func (x *VerifyBackupOutput) GetExists() bool {
 	if x != nil {
-		return x.InvoiceId
+		return x.Exists
 	}
-	return ""
+	return false
 }
 
-type GetInvoiceOutput struct {
+type CreateInvoiceInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinAmount         string               `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
-	Recipient         string               `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Beneficiary       string               `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
-	Asset             string               `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`
-	CreateAt          *timestamp.Timestamp `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
-	Deadline          *timestamp.Timestamp `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	FillAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=fill_at,json=fillAt,proto3,oneof" json:"fill_at,omitempty"`
-	CancelAt          *timestamp.Timestamp `protobuf:"bytes,9,opt,name=cancel_at,json=cancelAt,proto3,oneof" json:"cancel_at,omitempty"`
-	LastCheckoutAt    *timestamp.Timestamp `protobuf:"bytes,14,opt,name=last_checkout_at,json=lastCheckoutAt,proto3,oneof" json:"last_checkout_at,omitempty"`
-	CheckoutRequestAt *timestamp.Timestamp `protobuf:"bytes,15,opt,name=checkout_request_at,json=checkoutRequestAt,proto3,oneof" json:"checkout_request_at,omitempty"`
-	WalletAddress     string               `protobuf:"bytes,10,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
-	AutoCheckout      bool                 `protobuf:"varint,16,opt,name=auto_checkout,json=autoCheckout,proto3" json:"auto_checkout,omitempty"`
-	Status            InvoiceStatus        `protobuf:"varint,11,opt,name=status,proto3,enum=InvoiceStatus" json:"status,omitempty"`
-	Metadata          string               `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	AssetName    string               `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	Metadata     string               `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Recipient    string               `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Beneficiary  string               `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	AutoCheckout bool                 `protobuf:"varint,7,opt,name=auto_checkout,json=autoCheckout,proto3" json:"auto_checkout,omitempty"`
+	MinAmount    string               `protobuf:"bytes,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	Deadline     *timestamp.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// backup_words also returns the backup as a checksummed word list
+	BackupWords bool `protobuf:"varint,8,opt,name=backup_words,json=backupWords,proto3" json:"backup_words,omitempty"`
+	// backup_qr also returns the backup as the texts of a multi-part qr sequence
+	BackupQr bool `protobuf:"varint,9,opt,name=backup_qr,json=backupQr,proto3" json:"backup_qr,omitempty"`
+	// escrow_period makes an escrow invoice, filled it is held until released, refunded or this period passed
+	EscrowPeriod *duration.Duration `protobuf:"bytes,10,opt,name=escrow_period,json=escrowPeriod,proto3" json:"escrow_period,omitempty"`
+	// split pays the payment to 2 to 16 recipients by shares summing to 10000 basis points, the first one is the recipient and takes the rounding remainder
+	Split []*SplitShare `protobuf:"bytes,11,rep,name=split,proto3" json:"split,omitempty"`
+	// split_gas_rule is proportional, each or first, empty is proportional
+	SplitGasRule string `protobuf:"bytes,12,opt,name=split_gas_rule,json=splitGasRule,proto3" json:"split_gas_rule,omitempty"`
 }
 
-func (x *GetInvoiceOutput) Reset() {
-	*x = GetInvoiceOutput{}
-	mi := &file_cpg_proto_msgTypes[9]
+func (x *CreateInvoiceInput) Reset() {
+	*x = CreateInvoiceInput{}
+	mi := &file_cpg_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInvoiceOutput) String() string {
+func (x *CreateInvoiceInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInvoiceOutput) ProtoMessage() {}
+func (*CreateInvoiceInput) ProtoMessage() {}
 
-func (x *GetInvoiceOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_cpg_proto_msgTypes[9]
+func (x *CreateInvoiceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,133 +691,2677 @@ func (x *GetInvoiceOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInvoiceOutput.ProtoReflect.Descriptor instead.
-func (*GetInvoiceOutput) Descriptor() ([]byte, []int) {
-	return file_cpg_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreateInvoiceInput.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetInvoiceOutput) GetMinAmount() string {
+func (x *CreateInvoiceInput) GetAssetName() string {
 	if x != nil {
-		return x.MinAmount
+		return x.AssetName
 	}
 	return ""
 }
 
-func (x *GetInvoiceOutput) GetRecipient() string {
+func (x *CreateInvoiceInput) GetMetadata() string {
 	if x != nil {
-		return x.Recipient
+		return x.Metadata
 	}
 	return ""
 }
 
-func (x *GetInvoiceOutput) GetBeneficiary() string {
+func (x *CreateInvoiceInput) GetRecipient() string {
 	if x != nil {
-		return x.Beneficiary
+		return x.Recipient
 	}
 	return ""
 }
 
-func (x *GetInvoiceOutput) GetAsset() string {
+func (x *CreateInvoiceInput) GetBeneficiary() string {
 	if x != nil {
-		return x.Asset
+		return x.Beneficiary
 	}
 	return ""
 }
 
-func (x *GetInvoiceOutput) GetCreateAt() *timestamp.Timestamp {
+func (x *CreateInvoiceInput) GetAutoCheckout() bool {
 	if x != nil {
-		return x.CreateAt
+		return x.AutoCheckout
 	}
-	return nil
+	return false
 }
 
-func (x *GetInvoiceOutput) GetDeadline() *timestamp.Timestamp {
+func (x *CreateInvoiceInput) GetMinAmount() string {
 	if x != nil {
-		return x.Deadline
+		return x.MinAmount
 	}
-	return nil
+	return ""
 }
 
-func (x *GetInvoiceOutput) GetFillAt() *timestamp.Timestamp {
+func (x *CreateInvoiceInput) GetDeadline() *timestamp.Timestamp {
 	if x != nil {
-		return x.FillAt
+		return x.Deadline
 	}
 	return nil
 }
 
-func (x *GetInvoiceOutput) GetCancelAt() *timestamp.Timestamp {
+func (x *CreateInvoiceInput) GetBackupWords() bool {
 	if x != nil {
-		return x.CancelAt
+		return x.BackupWords
 	}
-	return nil
+	return false
 }
 
-func (x *GetInvoiceOutput) GetLastCheckoutAt() *timestamp.Timestamp {
+func (x *CreateInvoiceInput) GetBackupQr() bool {
 	if x != nil {
-		return x.LastCheckoutAt
+		return x.BackupQr
 	}
-	return nil
+	return false
 }
 
-func (x *GetInvoiceOutput) GetCheckoutRequestAt() *timestamp.Timestamp {
+func (x *CreateInvoiceInput) GetEscrowPeriod() *duration.Duration {
 	if x != nil {
-		return x.CheckoutRequestAt
+		return x.EscrowPeriod
 	}
 	return nil
 }
 
-func (x *GetInvoiceOutput) GetWalletAddress() string {
+func (x *CreateInvoiceInput) GetSplit() []*SplitShare {
 	if x != nil {
-		return x.WalletAddress
+		return x.Split
 	}
-	return ""
+	return nil
 }
 
-func (x *GetInvoiceOutput) GetAutoCheckout() bool {
+func (x *CreateInvoiceInput) GetSplitGasRule() string {
 	if x != nil {
-		return x.AutoCheckout
+		return x.SplitGasRule
 	}
-	return false
+	return ""
+}
+
+// SplitShare is a split recipient, amount and tx_hash are its leg of the checkout sweep once planned and sent
+type SplitShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	BasisPoints int64  `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	Amount      string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TxHash      string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *SplitShare) Reset() {
+	*x = SplitShare{}
+	mi := &file_cpg_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitShare) ProtoMessage() {}
+
+func (x *SplitShare) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitShare.ProtoReflect.Descriptor instead.
+func (*SplitShare) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SplitShare) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SplitShare) GetBasisPoints() int64 {
+	if x != nil {
+		return x.BasisPoints
+	}
+	return 0
+}
+
+func (x *SplitShare) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SplitShare) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type CreateInvoiceOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId          string   `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	InvoiceBackup      []byte   `protobuf:"bytes,2,opt,name=invoice_backup,json=invoiceBackup,proto3" json:"invoice_backup,omitempty"`
+	InvoiceBackupWords string   `protobuf:"bytes,3,opt,name=invoice_backup_words,json=invoiceBackupWords,proto3" json:"invoice_backup_words,omitempty"`
+	InvoiceBackupQr    []string `protobuf:"bytes,4,rep,name=invoice_backup_qr,json=invoiceBackupQr,proto3" json:"invoice_backup_qr,omitempty"`
+	// escrow_buyer_key lets the buyer release or dispute an escrow invoice, it is returned only here
+	EscrowBuyerKey string `protobuf:"bytes,5,opt,name=escrow_buyer_key,json=escrowBuyerKey,proto3" json:"escrow_buyer_key,omitempty"`
+}
+
+func (x *CreateInvoiceOutput) Reset() {
+	*x = CreateInvoiceOutput{}
+	mi := &file_cpg_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvoiceOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceOutput) ProtoMessage() {}
+
+func (x *CreateInvoiceOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceOutput.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateInvoiceOutput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *CreateInvoiceOutput) GetInvoiceBackup() []byte {
+	if x != nil {
+		return x.InvoiceBackup
+	}
+	return nil
+}
+
+func (x *CreateInvoiceOutput) GetInvoiceBackupWords() string {
+	if x != nil {
+		return x.InvoiceBackupWords
+	}
+	return ""
+}
+
+func (x *CreateInvoiceOutput) GetInvoiceBackupQr() []string {
+	if x != nil {
+		return x.InvoiceBackupQr
+	}
+	return nil
+}
+
+func (x *CreateInvoiceOutput) GetEscrowBuyerKey() string {
+	if x != nil {
+		return x.EscrowBuyerKey
+	}
+	return ""
+}
+
+type CancelInvoiceInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId     string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+}
+
+func (x *CancelInvoiceInput) Reset() {
+	*x = CancelInvoiceInput{}
+	mi := &file_cpg_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvoiceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceInput) ProtoMessage() {}
+
+func (x *CancelInvoiceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvoiceInput.ProtoReflect.Descriptor instead.
+func (*CancelInvoiceInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelInvoiceInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *CancelInvoiceInput) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+type GetInvoiceInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+}
+
+func (x *GetInvoiceInput) Reset() {
+	*x = GetInvoiceInput{}
+	mi := &file_cpg_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceInput) ProtoMessage() {}
+
+func (x *GetInvoiceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceInput.ProtoReflect.Descriptor instead.
+func (*GetInvoiceInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetInvoiceInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+type GetInvoiceOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinAmount         string               `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	Recipient         string               `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Beneficiary       string               `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Asset             string               `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`
+	CreateAt          *timestamp.Timestamp `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	Deadline          *timestamp.Timestamp `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	FillAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=fill_at,json=fillAt,proto3,oneof" json:"fill_at,omitempty"`
+	CancelAt          *timestamp.Timestamp `protobuf:"bytes,9,opt,name=cancel_at,json=cancelAt,proto3,oneof" json:"cancel_at,omitempty"`
+	LastCheckoutAt    *timestamp.Timestamp `protobuf:"bytes,14,opt,name=last_checkout_at,json=lastCheckoutAt,proto3,oneof" json:"last_checkout_at,omitempty"`
+	CheckoutRequestAt *timestamp.Timestamp `protobuf:"bytes,15,opt,name=checkout_request_at,json=checkoutRequestAt,proto3,oneof" json:"checkout_request_at,omitempty"`
+	WalletAddress     string               `protobuf:"bytes,10,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	AutoCheckout      bool                 `protobuf:"varint,16,opt,name=auto_checkout,json=autoCheckout,proto3" json:"auto_checkout,omitempty"`
+	Status            InvoiceStatus        `protobuf:"varint,11,opt,name=status,proto3,enum=InvoiceStatus" json:"status,omitempty"`
+	Metadata          string               `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ApprovalRequestAt *timestamp.Timestamp `protobuf:"bytes,17,opt,name=approval_request_at,json=approvalRequestAt,proto3,oneof" json:"approval_request_at,omitempty"`
+	ApproveAt         *timestamp.Timestamp `protobuf:"bytes,18,opt,name=approve_at,json=approveAt,proto3,oneof" json:"approve_at,omitempty"`
+	EscrowPeriod      *duration.Duration   `protobuf:"bytes,19,opt,name=escrow_period,json=escrowPeriod,proto3" json:"escrow_period,omitempty"`
+	AutoReleaseAt     *timestamp.Timestamp `protobuf:"bytes,20,opt,name=auto_release_at,json=autoReleaseAt,proto3,oneof" json:"auto_release_at,omitempty"`
+	ReleaseAt         *timestamp.Timestamp `protobuf:"bytes,21,opt,name=release_at,json=releaseAt,proto3,oneof" json:"release_at,omitempty"`
+	RefundAt          *timestamp.Timestamp `protobuf:"bytes,22,opt,name=refund_at,json=refundAt,proto3,oneof" json:"refund_at,omitempty"`
+	DisputeAt         *timestamp.Timestamp `protobuf:"bytes,23,opt,name=dispute_at,json=disputeAt,proto3,oneof" json:"dispute_at,omitempty"`
+	// fee_address is empty for invoices without a platform fee, fee_amount and fee_tx_hash are set once the fee leg is sent
+	FeeAddress     string        `protobuf:"bytes,24,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	FeeBasisPoints int64         `protobuf:"varint,25,opt,name=fee_basis_points,json=feeBasisPoints,proto3" json:"fee_basis_points,omitempty"`
+	FeeFlat        string        `protobuf:"bytes,26,opt,name=fee_flat,json=feeFlat,proto3" json:"fee_flat,omitempty"`
+	FeeAmount      string        `protobuf:"bytes,27,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	FeeTxHash      string        `protobuf:"bytes,28,opt,name=fee_tx_hash,json=feeTxHash,proto3" json:"fee_tx_hash,omitempty"`
+	Split          []*SplitShare `protobuf:"bytes,29,rep,name=split,proto3" json:"split,omitempty"`
+	SplitGasRule   string        `protobuf:"bytes,30,opt,name=split_gas_rule,json=splitGasRule,proto3" json:"split_gas_rule,omitempty"`
+	// treasury_address is set for invoices of treasury mode, their sweeps credit the merchant ledger
+	TreasuryAddress string `protobuf:"bytes,31,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+}
+
+func (x *GetInvoiceOutput) Reset() {
+	*x = GetInvoiceOutput{}
+	mi := &file_cpg_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceOutput) ProtoMessage() {}
+
+func (x *GetInvoiceOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceOutput.ProtoReflect.Descriptor instead.
+func (*GetInvoiceOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetInvoiceOutput) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetCreateAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetDeadline() *timestamp.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetFillAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.FillAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetCancelAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CancelAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetLastCheckoutAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastCheckoutAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetCheckoutRequestAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CheckoutRequestAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetAutoCheckout() bool {
+	if x != nil {
+		return x.AutoCheckout
+	}
+	return false
 }
 
 func (x *GetInvoiceOutput) GetStatus() InvoiceStatus {
 	if x != nil {
-		return x.Status
+		return x.Status
+	}
+	return InvoiceStatus_INVOICE_STATUS_INVALID
+}
+
+func (x *GetInvoiceOutput) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetApprovalRequestAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ApprovalRequestAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetApproveAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ApproveAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetEscrowPeriod() *duration.Duration {
+	if x != nil {
+		return x.EscrowPeriod
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetAutoReleaseAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.AutoReleaseAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetReleaseAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetRefundAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RefundAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetDisputeAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DisputeAt
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetFeeAddress() string {
+	if x != nil {
+		return x.FeeAddress
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetFeeBasisPoints() int64 {
+	if x != nil {
+		return x.FeeBasisPoints
+	}
+	return 0
+}
+
+func (x *GetInvoiceOutput) GetFeeFlat() string {
+	if x != nil {
+		return x.FeeFlat
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetFeeAmount() string {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetFeeTxHash() string {
+	if x != nil {
+		return x.FeeTxHash
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetSplit() []*SplitShare {
+	if x != nil {
+		return x.Split
+	}
+	return nil
+}
+
+func (x *GetInvoiceOutput) GetSplitGasRule() string {
+	if x != nil {
+		return x.SplitGasRule
+	}
+	return ""
+}
+
+func (x *GetInvoiceOutput) GetTreasuryAddress() string {
+	if x != nil {
+		return x.TreasuryAddress
+	}
+	return ""
+}
+
+type CheckInvoiceInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId     string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+}
+
+func (x *CheckInvoiceInput) Reset() {
+	*x = CheckInvoiceInput{}
+	mi := &file_cpg_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInvoiceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInvoiceInput) ProtoMessage() {}
+
+func (x *CheckInvoiceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInvoiceInput.ProtoReflect.Descriptor instead.
+func (*CheckInvoiceInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckInvoiceInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *CheckInvoiceInput) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+type CheckInvoiceOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceStatus InvoiceStatus `protobuf:"varint,1,opt,name=invoice_status,json=invoiceStatus,proto3,enum=InvoiceStatus" json:"invoice_status,omitempty"`
+}
+
+func (x *CheckInvoiceOutput) Reset() {
+	*x = CheckInvoiceOutput{}
+	mi := &file_cpg_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInvoiceOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInvoiceOutput) ProtoMessage() {}
+
+func (x *CheckInvoiceOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInvoiceOutput.ProtoReflect.Descriptor instead.
+func (*CheckInvoiceOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckInvoiceOutput) GetInvoiceStatus() InvoiceStatus {
+	if x != nil {
+		return x.InvoiceStatus
+	}
+	return InvoiceStatus_INVOICE_STATUS_INVALID
+}
+
+type RecoverCrossChainInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	DryRun    bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *RecoverCrossChainInput) Reset() {
+	*x = RecoverCrossChainInput{}
+	mi := &file_cpg_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoverCrossChainInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverCrossChainInput) ProtoMessage() {}
+
+func (x *RecoverCrossChainInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverCrossChainInput.ProtoReflect.Descriptor instead.
+func (*RecoverCrossChainInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RecoverCrossChainInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *RecoverCrossChainInput) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type AssetSweep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset       string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Balance     string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee         string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	TxHash      string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Error       string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AssetSweep) Reset() {
+	*x = AssetSweep{}
+	mi := &file_cpg_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetSweep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetSweep) ProtoMessage() {}
+
+func (x *AssetSweep) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetSweep.ProtoReflect.Descriptor instead.
+func (*AssetSweep) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AssetSweep) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AssetSweep) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *AssetSweep) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *AssetSweep) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AssetSweep) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *AssetSweep) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *AssetSweep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RecoverCrossChainOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sweeps []*AssetSweep `protobuf:"bytes,1,rep,name=sweeps,proto3" json:"sweeps,omitempty"`
+}
+
+func (x *RecoverCrossChainOutput) Reset() {
+	*x = RecoverCrossChainOutput{}
+	mi := &file_cpg_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoverCrossChainOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverCrossChainOutput) ProtoMessage() {}
+
+func (x *RecoverCrossChainOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverCrossChainOutput.ProtoReflect.Descriptor instead.
+func (*RecoverCrossChainOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecoverCrossChainOutput) GetSweeps() []*AssetSweep {
+	if x != nil {
+		return x.Sweeps
+	}
+	return nil
+}
+
+type RescueTokenInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// to defaults to the invoice destination
+	To      string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	FundGas bool   `protobuf:"varint,4,opt,name=fund_gas,json=fundGas,proto3" json:"fund_gas,omitempty"`
+	DryRun  bool   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *RescueTokenInput) Reset() {
+	*x = RescueTokenInput{}
+	mi := &file_cpg_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescueTokenInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescueTokenInput) ProtoMessage() {}
+
+func (x *RescueTokenInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescueTokenInput.ProtoReflect.Descriptor instead.
+func (*RescueTokenInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RescueTokenInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *RescueTokenInput) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RescueTokenInput) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *RescueTokenInput) GetFundGas() bool {
+	if x != nil {
+		return x.FundGas
+	}
+	return false
+}
+
+func (x *RescueTokenInput) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RescueTokenOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenBalance  string `protobuf:"bytes,1,opt,name=token_balance,json=tokenBalance,proto3" json:"token_balance,omitempty"`
+	Fee           string `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	FundingAmount string `protobuf:"bytes,3,opt,name=funding_amount,json=fundingAmount,proto3" json:"funding_amount,omitempty"`
+	FundingTxHash string `protobuf:"bytes,4,opt,name=funding_tx_hash,json=fundingTxHash,proto3" json:"funding_tx_hash,omitempty"`
+	TxHash        string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *RescueTokenOutput) Reset() {
+	*x = RescueTokenOutput{}
+	mi := &file_cpg_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescueTokenOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescueTokenOutput) ProtoMessage() {}
+
+func (x *RescueTokenOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescueTokenOutput.ProtoReflect.Descriptor instead.
+func (*RescueTokenOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RescueTokenOutput) GetTokenBalance() string {
+	if x != nil {
+		return x.TokenBalance
+	}
+	return ""
+}
+
+func (x *RescueTokenOutput) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *RescueTokenOutput) GetFundingAmount() string {
+	if x != nil {
+		return x.FundingAmount
+	}
+	return ""
+}
+
+func (x *RescueTokenOutput) GetFundingTxHash() string {
+	if x != nil {
+		return x.FundingTxHash
+	}
+	return ""
+}
+
+func (x *RescueTokenOutput) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type ListInvoiceEventsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+}
+
+func (x *ListInvoiceEventsInput) Reset() {
+	*x = ListInvoiceEventsInput{}
+	mi := &file_cpg_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoiceEventsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoiceEventsInput) ProtoMessage() {}
+
+func (x *ListInvoiceEventsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoiceEventsInput.ProtoReflect.Descriptor instead.
+func (*ListInvoiceEventsInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListInvoiceEventsInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+type InvoiceEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     string               `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Asset    string               `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	TxHash   string               `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Detail   map[string]string    `protobuf:"bytes,4,rep,name=detail,proto3" json:"detail,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreateAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+}
+
+func (x *InvoiceEvent) Reset() {
+	*x = InvoiceEvent{}
+	mi := &file_cpg_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceEvent) ProtoMessage() {}
+
+func (x *InvoiceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceEvent.ProtoReflect.Descriptor instead.
+func (*InvoiceEvent) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InvoiceEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InvoiceEvent) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *InvoiceEvent) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *InvoiceEvent) GetDetail() map[string]string {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+func (x *InvoiceEvent) GetCreateAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateAt
+	}
+	return nil
+}
+
+type ListInvoiceEventsOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*InvoiceEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListInvoiceEventsOutput) Reset() {
+	*x = ListInvoiceEventsOutput{}
+	mi := &file_cpg_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoiceEventsOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoiceEventsOutput) ProtoMessage() {}
+
+func (x *ListInvoiceEventsOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoiceEventsOutput.ProtoReflect.Descriptor instead.
+func (*ListInvoiceEventsOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListInvoiceEventsOutput) GetEvents() []*InvoiceEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TryCheckoutInvoiceInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+}
+
+func (x *TryCheckoutInvoiceInput) Reset() {
+	*x = TryCheckoutInvoiceInput{}
+	mi := &file_cpg_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TryCheckoutInvoiceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TryCheckoutInvoiceInput) ProtoMessage() {}
+
+func (x *TryCheckoutInvoiceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TryCheckoutInvoiceInput.ProtoReflect.Descriptor instead.
+func (*TryCheckoutInvoiceInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TryCheckoutInvoiceInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+type TryCheckoutInvoiceOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sweep_bundle is the unsigned sweep json of a cold signed checkout, to be signed by `cpg sign` on an air-gapped host
+	SweepBundle []byte `protobuf:"bytes,1,opt,name=sweep_bundle,json=sweepBundle,proto3" json:"sweep_bundle,omitempty"`
+}
+
+func (x *TryCheckoutInvoiceOutput) Reset() {
+	*x = TryCheckoutInvoiceOutput{}
+	mi := &file_cpg_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TryCheckoutInvoiceOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TryCheckoutInvoiceOutput) ProtoMessage() {}
+
+func (x *TryCheckoutInvoiceOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TryCheckoutInvoiceOutput.ProtoReflect.Descriptor instead.
+func (*TryCheckoutInvoiceOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TryCheckoutInvoiceOutput) GetSweepBundle() []byte {
+	if x != nil {
+		return x.SweepBundle
+	}
+	return nil
+}
+
+type SubmitSweepBundleInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// bundle is the signed bundle json or its qr part texts separated by newlines
+	Bundle []byte `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
+}
+
+func (x *SubmitSweepBundleInput) Reset() {
+	*x = SubmitSweepBundleInput{}
+	mi := &file_cpg_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSweepBundleInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSweepBundleInput) ProtoMessage() {}
+
+func (x *SubmitSweepBundleInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSweepBundleInput.ProtoReflect.Descriptor instead.
+func (*SubmitSweepBundleInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SubmitSweepBundleInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *SubmitSweepBundleInput) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type SubmitSweepBundleOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	TxHash    string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *SubmitSweepBundleOutput) Reset() {
+	*x = SubmitSweepBundleOutput{}
+	mi := &file_cpg_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSweepBundleOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSweepBundleOutput) ProtoMessage() {}
+
+func (x *SubmitSweepBundleOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSweepBundleOutput.ProtoReflect.Descriptor instead.
+func (*SubmitSweepBundleOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SubmitSweepBundleOutput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *SubmitSweepBundleOutput) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type ApproveCheckoutInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Note      string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *ApproveCheckoutInput) Reset() {
+	*x = ApproveCheckoutInput{}
+	mi := &file_cpg_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCheckoutInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCheckoutInput) ProtoMessage() {}
+
+func (x *ApproveCheckoutInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCheckoutInput.ProtoReflect.Descriptor instead.
+func (*ApproveCheckoutInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApproveCheckoutInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *ApproveCheckoutInput) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ApproveCheckoutOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approver  string `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	Approvals int32  `protobuf:"varint,2,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	Required  int32  `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Approved  bool   `protobuf:"varint,4,opt,name=approved,proto3" json:"approved,omitempty"`
+}
+
+func (x *ApproveCheckoutOutput) Reset() {
+	*x = ApproveCheckoutOutput{}
+	mi := &file_cpg_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCheckoutOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCheckoutOutput) ProtoMessage() {}
+
+func (x *ApproveCheckoutOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCheckoutOutput.ProtoReflect.Descriptor instead.
+func (*ApproveCheckoutOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ApproveCheckoutOutput) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *ApproveCheckoutOutput) GetApprovals() int32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *ApproveCheckoutOutput) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *ApproveCheckoutOutput) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+type RejectCheckoutInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RejectCheckoutInput) Reset() {
+	*x = RejectCheckoutInput{}
+	mi := &file_cpg_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCheckoutInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCheckoutInput) ProtoMessage() {}
+
+func (x *RejectCheckoutInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCheckoutInput.ProtoReflect.Descriptor instead.
+func (*RejectCheckoutInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RejectCheckoutInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *RejectCheckoutInput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectCheckoutOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approver string `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+}
+
+func (x *RejectCheckoutOutput) Reset() {
+	*x = RejectCheckoutOutput{}
+	mi := &file_cpg_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCheckoutOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCheckoutOutput) ProtoMessage() {}
+
+func (x *RejectCheckoutOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCheckoutOutput.ProtoReflect.Descriptor instead.
+func (*RejectCheckoutOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RejectCheckoutOutput) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+type EscrowActionInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// buyer_key releases or disputes as the buyer when the call has no x-arbiter-key header
+	BuyerKey string `protobuf:"bytes,2,opt,name=buyer_key,json=buyerKey,proto3" json:"buyer_key,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EscrowActionInput) Reset() {
+	*x = EscrowActionInput{}
+	mi := &file_cpg_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscrowActionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscrowActionInput) ProtoMessage() {}
+
+func (x *EscrowActionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscrowActionInput.ProtoReflect.Descriptor instead.
+func (*EscrowActionInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EscrowActionInput) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *EscrowActionInput) GetBuyerKey() string {
+	if x != nil {
+		return x.BuyerKey
+	}
+	return ""
+}
+
+func (x *EscrowActionInput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetMerchantBalanceInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset   string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *GetMerchantBalanceInput) Reset() {
+	*x = GetMerchantBalanceInput{}
+	mi := &file_cpg_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantBalanceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantBalanceInput) ProtoMessage() {}
+
+func (x *GetMerchantBalanceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantBalanceInput.ProtoReflect.Descriptor instead.
+func (*GetMerchantBalanceInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetMerchantBalanceInput) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetMerchantBalanceInput) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type GetMerchantBalanceOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance        string               `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Credits        int32                `protobuf:"varint,2,opt,name=credits,proto3" json:"credits,omitempty"`
+	OldestCreditAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=oldest_credit_at,json=oldestCreditAt,proto3,oneof" json:"oldest_credit_at,omitempty"`
+	Transfers      []*TreasuryTransfer  `protobuf:"bytes,4,rep,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (x *GetMerchantBalanceOutput) Reset() {
+	*x = GetMerchantBalanceOutput{}
+	mi := &file_cpg_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantBalanceOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantBalanceOutput) ProtoMessage() {}
+
+func (x *GetMerchantBalanceOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantBalanceOutput.ProtoReflect.Descriptor instead.
+func (*GetMerchantBalanceOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMerchantBalanceOutput) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *GetMerchantBalanceOutput) GetCredits() int32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *GetMerchantBalanceOutput) GetOldestCreditAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.OldestCreditAt
+	}
+	return nil
+}
+
+func (x *GetMerchantBalanceOutput) GetTransfers() []*TreasuryTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type SettleTreasuryInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *SettleTreasuryInput) Reset() {
+	*x = SettleTreasuryInput{}
+	mi := &file_cpg_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleTreasuryInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleTreasuryInput) ProtoMessage() {}
+
+func (x *SettleTreasuryInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleTreasuryInput.ProtoReflect.Descriptor instead.
+func (*SettleTreasuryInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SettleTreasuryInput) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SettleTreasuryInput) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type SettleTreasuryOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers []*TreasuryTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (x *SettleTreasuryOutput) Reset() {
+	*x = SettleTreasuryOutput{}
+	mi := &file_cpg_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleTreasuryOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleTreasuryOutput) ProtoMessage() {}
+
+func (x *SettleTreasuryOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleTreasuryOutput.ProtoReflect.Descriptor instead.
+func (*SettleTreasuryOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SettleTreasuryOutput) GetTransfers() []*TreasuryTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+// TreasuryTransfer is a settlement or a forward to the cold address, the receiver gets amount minus fee,
+// fee and tx_hash are set once it is sent and error once it failed
+type TreasuryTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind     string               `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	To       string               `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount   string               `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee      string               `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	TxHash   string               `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Error    string               `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	CreateAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	SendAt   *timestamp.Timestamp `protobuf:"bytes,9,opt,name=send_at,json=sendAt,proto3,oneof" json:"send_at,omitempty"`
+}
+
+func (x *TreasuryTransfer) Reset() {
+	*x = TreasuryTransfer{}
+	mi := &file_cpg_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreasuryTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreasuryTransfer) ProtoMessage() {}
+
+func (x *TreasuryTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreasuryTransfer.ProtoReflect.Descriptor instead.
+func (*TreasuryTransfer) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TreasuryTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TreasuryTransfer) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TreasuryTransfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TreasuryTransfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TreasuryTransfer) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *TreasuryTransfer) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TreasuryTransfer) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TreasuryTransfer) GetCreateAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateAt
+	}
+	return nil
+}
+
+func (x *TreasuryTransfer) GetSendAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+type CreatePayoutInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset          string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	To             string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Reference      string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *CreatePayoutInput) Reset() {
+	*x = CreatePayoutInput{}
+	mi := &file_cpg_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayoutInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutInput) ProtoMessage() {}
+
+func (x *CreatePayoutInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutInput.ProtoReflect.Descriptor instead.
+func (*CreatePayoutInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreatePayoutInput) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *CreatePayoutInput) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CreatePayoutInput) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreatePayoutInput) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreatePayoutInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type CreatePayoutOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payout *Payout `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+	// replayed is set when the idempotency key returned an existing payout
+	Replayed bool `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+}
+
+func (x *CreatePayoutOutput) Reset() {
+	*x = CreatePayoutOutput{}
+	mi := &file_cpg_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayoutOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutOutput) ProtoMessage() {}
+
+func (x *CreatePayoutOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutOutput.ProtoReflect.Descriptor instead.
+func (*CreatePayoutOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreatePayoutOutput) GetPayout() *Payout {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
+
+func (x *CreatePayoutOutput) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type GetPayoutInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayoutId string `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+}
+
+func (x *GetPayoutInput) Reset() {
+	*x = GetPayoutInput{}
+	mi := &file_cpg_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutInput) ProtoMessage() {}
+
+func (x *GetPayoutInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutInput.ProtoReflect.Descriptor instead.
+func (*GetPayoutInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetPayoutInput) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+type ListPayoutsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// before pages back from the create_at of the last payout of the previous page
+	Before *timestamp.Timestamp `protobuf:"bytes,3,opt,name=before,proto3,oneof" json:"before,omitempty"`
+	Limit  int32                `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListPayoutsInput) Reset() {
+	*x = ListPayoutsInput{}
+	mi := &file_cpg_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsInput) ProtoMessage() {}
+
+func (x *ListPayoutsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsInput.ProtoReflect.Descriptor instead.
+func (*ListPayoutsInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListPayoutsInput) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ListPayoutsInput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPayoutsInput) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListPayoutsInput) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPayoutsOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payouts []*Payout `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+}
+
+func (x *ListPayoutsOutput) Reset() {
+	*x = ListPayoutsOutput{}
+	mi := &file_cpg_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutsOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsOutput) ProtoMessage() {}
+
+func (x *ListPayoutsOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsOutput.ProtoReflect.Descriptor instead.
+func (*ListPayoutsOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListPayoutsOutput) GetPayouts() []*Payout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+// Payout status is pending, sent, confirmed or failed, the receiver gets amount and the funding wallet pays fee apart
+type Payout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdempotencyKey string               `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Asset          string               `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	To             string               `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount         string               `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference      string               `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Status         string               `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Fee            string               `protobuf:"bytes,8,opt,name=fee,proto3" json:"fee,omitempty"`
+	TxHash         string               `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Error          string               `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	CreateAt       *timestamp.Timestamp `protobuf:"bytes,11,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	SendAt         *timestamp.Timestamp `protobuf:"bytes,12,opt,name=send_at,json=sendAt,proto3,oneof" json:"send_at,omitempty"`
+	ConfirmAt      *timestamp.Timestamp `protobuf:"bytes,13,opt,name=confirm_at,json=confirmAt,proto3,oneof" json:"confirm_at,omitempty"`
+}
+
+func (x *Payout) Reset() {
+	*x = Payout{}
+	mi := &file_cpg_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payout) ProtoMessage() {}
+
+func (x *Payout) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payout.ProtoReflect.Descriptor instead.
+func (*Payout) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Payout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payout) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *Payout) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *Payout) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Payout) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Payout) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Payout) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payout) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *Payout) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *Payout) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Payout) GetCreateAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateAt
+	}
+	return nil
+}
+
+func (x *Payout) GetSendAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *Payout) GetConfirmAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ConfirmAt
+	}
+	return nil
+}
+
+type GetLedgerBalancesInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// asset is an asset name, or an asset name, a slash and a token contract for rescued tokens
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// at defaults to now
+	At *timestamp.Timestamp `protobuf:"bytes,2,opt,name=at,proto3,oneof" json:"at,omitempty"`
+	// prefix filters the accounts by name, e.g. wallet or recipient:0x...
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *GetLedgerBalancesInput) Reset() {
+	*x = GetLedgerBalancesInput{}
+	mi := &file_cpg_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerBalancesInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerBalancesInput) ProtoMessage() {}
+
+func (x *GetLedgerBalancesInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerBalancesInput.ProtoReflect.Descriptor instead.
+func (*GetLedgerBalancesInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetLedgerBalancesInput) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetLedgerBalancesInput) GetAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *GetLedgerBalancesInput) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type GetLedgerBalancesOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balances []*LedgerBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+func (x *GetLedgerBalancesOutput) Reset() {
+	*x = GetLedgerBalancesOutput{}
+	mi := &file_cpg_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerBalancesOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerBalancesOutput) ProtoMessage() {}
+
+func (x *GetLedgerBalancesOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerBalancesOutput.ProtoReflect.Descriptor instead.
+func (*GetLedgerBalancesOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetLedgerBalancesOutput) GetBalances() []*LedgerBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// LedgerBalance kind is holding, source, external or expense, balance is debits minus credits
+type LedgerBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Kind    string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Debits  string `protobuf:"bytes,3,opt,name=debits,proto3" json:"debits,omitempty"`
+	Credits string `protobuf:"bytes,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	Balance string `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *LedgerBalance) Reset() {
+	*x = LedgerBalance{}
+	mi := &file_cpg_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerBalance) ProtoMessage() {}
+
+func (x *LedgerBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerBalance.ProtoReflect.Descriptor instead.
+func (*LedgerBalance) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LedgerBalance) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *LedgerBalance) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerBalance) GetDebits() string {
+	if x != nil {
+		return x.Debits
 	}
-	return InvoiceStatus_INVOICE_STATUS_INVALID
+	return ""
 }
 
-func (x *GetInvoiceOutput) GetMetadata() string {
+func (x *LedgerBalance) GetCredits() string {
 	if x != nil {
-		return x.Metadata
+		return x.Credits
 	}
 	return ""
 }
 
-type CheckInvoiceInput struct {
+func (x *LedgerBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type RequestCheckoutInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvoiceId     string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
-	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
 }
 
-func (x *CheckInvoiceInput) Reset() {
-	*x = CheckInvoiceInput{}
-	mi := &file_cpg_proto_msgTypes[10]
+func (x *RequestCheckoutInput) Reset() {
+	*x = RequestCheckoutInput{}
+	mi := &file_cpg_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInvoiceInput) String() string {
+func (x *RequestCheckoutInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInvoiceInput) ProtoMessage() {}
+func (*RequestCheckoutInput) ProtoMessage() {}
 
-func (x *CheckInvoiceInput) ProtoReflect() protoreflect.Message {
-	mi := &file_cpg_proto_msgTypes[10]
+func (x *RequestCheckoutInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,48 +3372,126 @@ func (x *CheckInvoiceInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInvoiceInput.ProtoReflect.Descriptor instead.
-func (*CheckInvoiceInput) Descriptor() ([]byte, []int) {
-	return file_cpg_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RequestCheckoutInput.ProtoReflect.Descriptor instead.
+func (*RequestCheckoutInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CheckInvoiceInput) GetInvoiceId() string {
+func (x *RequestCheckoutInput) GetInvoiceId() string {
 	if x != nil {
 		return x.InvoiceId
 	}
 	return ""
 }
 
-func (x *CheckInvoiceInput) GetWalletAddress() string {
+type AssetInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinDelay *duration.Duration `protobuf:"bytes,2,opt,name=min_delay,json=minDelay,proto3" json:"min_delay,omitempty"`
+	Decimals uint32             `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Symbol   string             `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// watch_only assets create and check invoices but can not checkout, sweep or rescue
+	WatchOnly bool `protobuf:"varint,5,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
+}
+
+func (x *AssetInfo) Reset() {
+	*x = AssetInfo{}
+	mi := &file_cpg_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetInfo) ProtoMessage() {}
+
+func (x *AssetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[48]
 	if x != nil {
-		return x.WalletAddress
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetInfo.ProtoReflect.Descriptor instead.
+func (*AssetInfo) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AssetInfo) GetMinDelay() *duration.Duration {
+	if x != nil {
+		return x.MinDelay
+	}
+	return nil
+}
+
+func (x *AssetInfo) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *AssetInfo) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
 	}
 	return ""
 }
 
-type CheckInvoiceOutput struct {
+func (x *AssetInfo) GetWatchOnly() bool {
+	if x != nil {
+		return x.WatchOnly
+	}
+	return false
+}
+
+type SignerPrepareInvoiceInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvoiceStatus InvoiceStatus `protobuf:"varint,1,opt,name=invoice_status,json=invoiceStatus,proto3,enum=InvoiceStatus" json:"invoice_status,omitempty"`
+	InvoiceId         string   `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Asset             string   `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	MinAmount         string   `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	Recipient         string   `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Beneficiary       string   `protobuf:"bytes,5,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	DerivationVersion int32    `protobuf:"varint,6,opt,name=derivation_version,json=derivationVersion,proto3" json:"derivation_version,omitempty"`
+	HasWalletIndex    bool     `protobuf:"varint,7,opt,name=has_wallet_index,json=hasWalletIndex,proto3" json:"has_wallet_index,omitempty"`
+	WalletIndex       int64    `protobuf:"varint,8,opt,name=wallet_index,json=walletIndex,proto3" json:"wallet_index,omitempty"`
+	TreasuryAddress   string   `protobuf:"bytes,10,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	FeeAddress        string   `protobuf:"bytes,11,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	SplitAddresses    []string `protobuf:"bytes,12,rep,name=split_addresses,json=splitAddresses,proto3" json:"split_addresses,omitempty"`
+	FeeBasisPoints    int64    `protobuf:"varint,13,opt,name=fee_basis_points,json=feeBasisPoints,proto3" json:"fee_basis_points,omitempty"`
+	FeeFlat           string   `protobuf:"bytes,14,opt,name=fee_flat,json=feeFlat,proto3" json:"fee_flat,omitempty"`
+	// split_basis_points are the shares of split_addresses
+	SplitBasisPoints []int64 `protobuf:"varint,15,rep,packed,name=split_basis_points,json=splitBasisPoints,proto3" json:"split_basis_points,omitempty"`
+	SplitGasRule     string  `protobuf:"bytes,16,opt,name=split_gas_rule,json=splitGasRule,proto3" json:"split_gas_rule,omitempty"`
 }
 
-func (x *CheckInvoiceOutput) Reset() {
-	*x = CheckInvoiceOutput{}
-	mi := &file_cpg_proto_msgTypes[11]
+func (x *SignerPrepareInvoiceInput) Reset() {
+	*x = SignerPrepareInvoiceInput{}
+	mi := &file_cpg_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInvoiceOutput) String() string {
+func (x *SignerPrepareInvoiceInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInvoiceOutput) ProtoMessage() {}
+func (*SignerPrepareInvoiceInput) ProtoMessage() {}
 
-func (x *CheckInvoiceOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_cpg_proto_msgTypes[11]
+func (x *SignerPrepareInvoiceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,41 +3502,141 @@ func (x *CheckInvoiceOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInvoiceOutput.ProtoReflect.Descriptor instead.
-func (*CheckInvoiceOutput) Descriptor() ([]byte, []int) {
-	return file_cpg_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SignerPrepareInvoiceInput.ProtoReflect.Descriptor instead.
+func (*SignerPrepareInvoiceInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CheckInvoiceOutput) GetInvoiceStatus() InvoiceStatus {
+func (x *SignerPrepareInvoiceInput) GetInvoiceId() string {
 	if x != nil {
-		return x.InvoiceStatus
+		return x.InvoiceId
 	}
-	return InvoiceStatus_INVOICE_STATUS_INVALID
+	return ""
 }
 
-type TryCheckoutInvoiceInput struct {
+func (x *SignerPrepareInvoiceInput) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SignerPrepareInvoiceInput) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *SignerPrepareInvoiceInput) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *SignerPrepareInvoiceInput) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return ""
+}
+
+func (x *SignerPrepareInvoiceInput) GetDerivationVersion() int32 {
+	if x != nil {
+		return x.DerivationVersion
+	}
+	return 0
+}
+
+func (x *SignerPrepareInvoiceInput) GetHasWalletIndex() bool {
+	if x != nil {
+		return x.HasWalletIndex
+	}
+	return false
+}
+
+func (x *SignerPrepareInvoiceInput) GetWalletIndex() int64 {
+	if x != nil {
+		return x.WalletIndex
+	}
+	return 0
+}
+
+func (x *SignerPrepareInvoiceInput) GetTreasuryAddress() string {
+	if x != nil {
+		return x.TreasuryAddress
+	}
+	return ""
+}
+
+func (x *SignerPrepareInvoiceInput) GetFeeAddress() string {
+	if x != nil {
+		return x.FeeAddress
+	}
+	return ""
+}
+
+func (x *SignerPrepareInvoiceInput) GetSplitAddresses() []string {
+	if x != nil {
+		return x.SplitAddresses
+	}
+	return nil
+}
+
+func (x *SignerPrepareInvoiceInput) GetFeeBasisPoints() int64 {
+	if x != nil {
+		return x.FeeBasisPoints
+	}
+	return 0
+}
+
+func (x *SignerPrepareInvoiceInput) GetFeeFlat() string {
+	if x != nil {
+		return x.FeeFlat
+	}
+	return ""
+}
+
+func (x *SignerPrepareInvoiceInput) GetSplitBasisPoints() []int64 {
+	if x != nil {
+		return x.SplitBasisPoints
+	}
+	return nil
+}
+
+func (x *SignerPrepareInvoiceInput) GetSplitGasRule() string {
+	if x != nil {
+		return x.SplitGasRule
+	}
+	return ""
+}
+
+type SignerPrepareInvoiceOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	EncryptedSalt []byte `protobuf:"bytes,1,opt,name=encrypted_salt,json=encryptedSalt,proto3" json:"encrypted_salt,omitempty"`
+	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	SignerMac     []byte `protobuf:"bytes,3,opt,name=signer_mac,json=signerMac,proto3" json:"signer_mac,omitempty"`
 }
 
-func (x *TryCheckoutInvoiceInput) Reset() {
-	*x = TryCheckoutInvoiceInput{}
-	mi := &file_cpg_proto_msgTypes[12]
+func (x *SignerPrepareInvoiceOutput) Reset() {
+	*x = SignerPrepareInvoiceOutput{}
+	mi := &file_cpg_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TryCheckoutInvoiceInput) String() string {
+func (x *SignerPrepareInvoiceOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TryCheckoutInvoiceInput) ProtoMessage() {}
+func (*SignerPrepareInvoiceOutput) ProtoMessage() {}
 
-func (x *TryCheckoutInvoiceInput) ProtoReflect() protoreflect.Message {
-	mi := &file_cpg_proto_msgTypes[12]
+func (x *SignerPrepareInvoiceOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,41 +3647,56 @@ func (x *TryCheckoutInvoiceInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TryCheckoutInvoiceInput.ProtoReflect.Descriptor instead.
-func (*TryCheckoutInvoiceInput) Descriptor() ([]byte, []int) {
-	return file_cpg_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SignerPrepareInvoiceOutput.ProtoReflect.Descriptor instead.
+func (*SignerPrepareInvoiceOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *TryCheckoutInvoiceInput) GetInvoiceId() string {
+func (x *SignerPrepareInvoiceOutput) GetEncryptedSalt() []byte {
 	if x != nil {
-		return x.InvoiceId
+		return x.EncryptedSalt
+	}
+	return nil
+}
+
+func (x *SignerPrepareInvoiceOutput) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
 	}
 	return ""
 }
 
-type RequestCheckoutInput struct {
+func (x *SignerPrepareInvoiceOutput) GetSignerMac() []byte {
+	if x != nil {
+		return x.SignerMac
+	}
+	return nil
+}
+
+type SignSweepInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	InvoiceId  string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	UnsignedTx []byte `protobuf:"bytes,2,opt,name=unsigned_tx,json=unsignedTx,proto3" json:"unsigned_tx,omitempty"`
 }
 
-func (x *RequestCheckoutInput) Reset() {
-	*x = RequestCheckoutInput{}
-	mi := &file_cpg_proto_msgTypes[13]
+func (x *SignSweepInput) Reset() {
+	*x = SignSweepInput{}
+	mi := &file_cpg_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestCheckoutInput) String() string {
+func (x *SignSweepInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestCheckoutInput) ProtoMessage() {}
+func (*SignSweepInput) ProtoMessage() {}
 
-func (x *RequestCheckoutInput) ProtoReflect() protoreflect.Message {
-	mi := &file_cpg_proto_msgTypes[13]
+func (x *SignSweepInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,41 +3707,48 @@ func (x *RequestCheckoutInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestCheckoutInput.ProtoReflect.Descriptor instead.
-func (*RequestCheckoutInput) Descriptor() ([]byte, []int) {
-	return file_cpg_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SignSweepInput.ProtoReflect.Descriptor instead.
+func (*SignSweepInput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *RequestCheckoutInput) GetInvoiceId() string {
+func (x *SignSweepInput) GetInvoiceId() string {
 	if x != nil {
 		return x.InvoiceId
 	}
 	return ""
 }
 
-type AssetInfo struct {
+func (x *SignSweepInput) GetUnsignedTx() []byte {
+	if x != nil {
+		return x.UnsignedTx
+	}
+	return nil
+}
+
+type SignSweepOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinDelay *duration.Duration `protobuf:"bytes,2,opt,name=min_delay,json=minDelay,proto3" json:"min_delay,omitempty"`
+	SignedTx []byte `protobuf:"bytes,1,opt,name=signed_tx,json=signedTx,proto3" json:"signed_tx,omitempty"`
 }
 
-func (x *AssetInfo) Reset() {
-	*x = AssetInfo{}
-	mi := &file_cpg_proto_msgTypes[14]
+func (x *SignSweepOutput) Reset() {
+	*x = SignSweepOutput{}
+	mi := &file_cpg_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssetInfo) String() string {
+func (x *SignSweepOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetInfo) ProtoMessage() {}
+func (*SignSweepOutput) ProtoMessage() {}
 
-func (x *AssetInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cpg_proto_msgTypes[14]
+func (x *SignSweepOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cpg_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,14 +3759,14 @@ func (x *AssetInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetInfo.ProtoReflect.Descriptor instead.
-func (*AssetInfo) Descriptor() ([]byte, []int) {
-	return file_cpg_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SignSweepOutput.ProtoReflect.Descriptor instead.
+func (*SignSweepOutput) Descriptor() ([]byte, []int) {
+	return file_cpg_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AssetInfo) GetMinDelay() *duration.Duration {
+func (x *SignSweepOutput) GetSignedTx() []byte {
 	if x != nil {
-		return x.MinDelay
+		return x.SignedTx
 	}
 	return nil
 }
@@ -983,152 +3806,661 @@ var file_cpg_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70,
 	0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49,
-	0x64, 0x22, 0x8b, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69,
-	0x61, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69,
-	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22,
-	0x5b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x5a, 0x0a, 0x12,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x64, 0x22, 0xc6, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
 	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xe3, 0x05, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x36, 0x0a,
-	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61,
-	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x61, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x6c, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x3c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01,
-	0x52, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a,
-	0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x61,
-	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x6f, 0x75, 0x74, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x13, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x59, 0x0a, 0x11, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x91, 0x03, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
+	0x79, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0xd4, 0x03, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x75, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x5f, 0x71, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x51, 0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x47, 0x61, 0x73, 0x52, 0x75, 0x6c, 0x65,
+	0x22, 0x7a, 0x0a, 0x0a, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x69,
+	0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0xe3, 0x01, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x71,
+	0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x51, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x5f, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x42, 0x75, 0x79, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x22, 0x5a, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x30,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x22, 0xbb, 0x0c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x48, 0x03, 0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
-	0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69,
-	0x6c, 0x6c, 0x5f, 0x61, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x5f, 0x61, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74,
-	0x22, 0x59, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x12, 0x35, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x38, 0x0a, 0x17, 0x54, 0x72, 0x79, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e,
+	0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x6c, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x6c,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4f,
+	0x0a, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
+	0x75, 0x74, 0x6f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x4f, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x05, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x3e, 0x0a, 0x0d, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x47, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x06, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x07, 0x52, 0x09, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x08, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x09, 0x52, 0x09, 0x64, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x65,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f,
+	0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x65, 0x46, 0x6c, 0x61, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0b,
+	0x66, 0x65, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x65, 0x65, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x05,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12,
+	0x24, 0x0a, 0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x47, 0x61,
+	0x73, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x61, 0x74, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x61, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x74, 0x42,
+	0x16, 0x0a, 0x14, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x61, 0x74, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61,
+	0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x22, 0x59,
+	0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e,
 	0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x49, 0x64, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x09, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x2a, 0xb8,
-	0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
-	0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
-	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x4f,
-	0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x03,
-	0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17,
-	0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
-	0x48, 0x45, 0x43, 0x4b, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x32, 0x8f, 0x04, 0x0a, 0x03, 0x43, 0x50,
-	0x47, 0x12, 0x1f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0a, 0x2e, 0x50, 0x69, 0x6e, 0x67,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0b, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3e, 0x0a, 0x0e, 0x52,
-	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x14, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x1a, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x35, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x50, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x0a, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x23, 0x0a,
+	0x06, 0x73, 0x77, 0x65, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x52, 0x06, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x19, 0x0a, 0x08,
+	0x66, 0x75, 0x6e, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x66, 0x75, 0x6e, 0x64, 0x47, 0x61, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0xb2, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x37, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xf8,
+	0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x31, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x1a, 0x39,
+	0x0a, 0x0b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x40, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x17, 0x54,
+	0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x18, 0x54, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x77, 0x65, 0x65, 0x70, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x22, 0x4f, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x77,
+	0x65, 0x65, 0x70, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x51, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53,
+	0x77, 0x65, 0x65, 0x70, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x49, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x22,
+	0x4c, 0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x32, 0x0a,
+	0x14, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x22, 0x67, 0x0a, 0x11, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x79, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72,
+	0x63, 0x68, 0x61, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0e,
+	0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x74, 0x22, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x47, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x22, 0x9e, 0x02, 0x0a, 0x10, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x61, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0x51, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x06,
+	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x22, 0x2d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49,
+	0x64, 0x22, 0x9a, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x36,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x22, 0xc4, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x37, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x5f, 0x61, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x5f, 0x61, 0x74, 0x22, 0x7e, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2f, 0x0a,
+	0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x02, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x61, 0x74, 0x22, 0x45, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0xbf, 0x04, 0x0a, 0x19, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
+	0x72, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x29,
+	0x0a, 0x10, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66,
+	0x65, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x66, 0x65, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x66, 0x65, 0x65, 0x46, 0x6c, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x61, 0x73, 0x69, 0x73,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x47, 0x61, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x4a, 0x04, 0x08, 0x09,
+	0x10, 0x0a, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x61, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4d, 0x61, 0x63, 0x22, 0x50,
+	0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78,
+	0x22, 0x2e, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78,
+	0x2a, 0x67, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4f, 0x55,
+	0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d,
+	0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
+	0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x02, 0x2a, 0xce, 0x02, 0x0a, 0x0d, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x49,
+	0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x4f, 0x49,
+	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x49,
+	0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x4f, 0x49,
+	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x4f,
+	0x55, 0x54, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e,
+	0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x4c,
+	0x44, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45, 0x44, 0x10, 0x08,
+	0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x09, 0x12, 0x1b, 0x0a,
+	0x17, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x0a, 0x32, 0xbd, 0x0c, 0x0a, 0x03, 0x43,
+	0x50, 0x47, 0x12, 0x1f, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0a, 0x2e, 0x50, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0b, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3e, 0x0a, 0x0e,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x14,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0f,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x14, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x37, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x12, 0x12, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x13, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x13, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x40, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x46, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x18, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x52, 0x65,
+	0x73, 0x63, 0x75, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x73, 0x63,
+	0x75, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x12, 0x2e, 0x52,
+	0x65, 0x73, 0x63, 0x75, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x46, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x18,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x54, 0x72, 0x79, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x18,
+	0x2e, 0x54, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x19, 0x2e, 0x54, 0x72, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x77, 0x65,
+	0x65, 0x70, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x18, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x0f, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x15,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3d, 0x0a,
+	0x0e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12,
+	0x14, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3b, 0x0a, 0x0d,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x2e,
+	0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x2e, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x13, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x12, 0x40, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x6f, 0x75, 0x74, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x12, 0x54, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f,
-	0x75, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x54, 0x72, 0x79, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
-	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x49, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3d, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12,
+	0x14, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x15, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x72,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x12, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x07, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x12,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x32, 0x86, 0x01, 0x0a, 0x09, 0x43,
+	0x50, 0x47, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x77, 0x65, 0x65, 0x70,
+	0x12, 0x0f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x10, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1143,67 +4475,176 @@ func file_cpg_proto_rawDescGZIP() []byte {
 	return file_cpg_proto_rawDescData
 }
 
-var file_cpg_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cpg_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_cpg_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cpg_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_cpg_proto_goTypes = []any{
-	(InvoiceStatus)(0),              // 0: InvoiceStatus
-	(*PingInput)(nil),               // 1: PingInput
-	(*PingOutput)(nil),              // 2: PingOutput
-	(*ListAssetsOutput)(nil),        // 3: ListAssetsOutput
-	(*RecoverInvoiceInput)(nil),     // 4: RecoverInvoiceInput
-	(*RecoverInvoiceOutput)(nil),    // 5: RecoverInvoiceOutput
-	(*CreateInvoiceInput)(nil),      // 6: CreateInvoiceInput
-	(*CreateInvoiceOutput)(nil),     // 7: CreateInvoiceOutput
-	(*CancelInvoiceInput)(nil),      // 8: CancelInvoiceInput
-	(*GetInvoiceInput)(nil),         // 9: GetInvoiceInput
-	(*GetInvoiceOutput)(nil),        // 10: GetInvoiceOutput
-	(*CheckInvoiceInput)(nil),       // 11: CheckInvoiceInput
-	(*CheckInvoiceOutput)(nil),      // 12: CheckInvoiceOutput
-	(*TryCheckoutInvoiceInput)(nil), // 13: TryCheckoutInvoiceInput
-	(*RequestCheckoutInput)(nil),    // 14: RequestCheckoutInput
-	(*AssetInfo)(nil),               // 15: AssetInfo
-	nil,                             // 16: ListAssetsOutput.AssetsEntry
-	(*timestamp.Timestamp)(nil),     // 17: google.protobuf.Timestamp
-	(*duration.Duration)(nil),       // 18: google.protobuf.Duration
-	(*empty.Empty)(nil),             // 19: google.protobuf.Empty
+	(RecoverOutcome)(0),                // 0: RecoverOutcome
+	(InvoiceStatus)(0),                 // 1: InvoiceStatus
+	(*PingInput)(nil),                  // 2: PingInput
+	(*PingOutput)(nil),                 // 3: PingOutput
+	(*ListAssetsOutput)(nil),           // 4: ListAssetsOutput
+	(*RecoverInvoiceInput)(nil),        // 5: RecoverInvoiceInput
+	(*RecoverInvoiceOutput)(nil),       // 6: RecoverInvoiceOutput
+	(*RecoverInvoicesOutput)(nil),      // 7: RecoverInvoicesOutput
+	(*VerifyBackupInput)(nil),          // 8: VerifyBackupInput
+	(*VerifyBackupOutput)(nil),         // 9: VerifyBackupOutput
+	(*CreateInvoiceInput)(nil),         // 10: CreateInvoiceInput
+	(*SplitShare)(nil),                 // 11: SplitShare
+	(*CreateInvoiceOutput)(nil),        // 12: CreateInvoiceOutput
+	(*CancelInvoiceInput)(nil),         // 13: CancelInvoiceInput
+	(*GetInvoiceInput)(nil),            // 14: GetInvoiceInput
+	(*GetInvoiceOutput)(nil),           // 15: GetInvoiceOutput
+	(*CheckInvoiceInput)(nil),          // 16: CheckInvoiceInput
+	(*CheckInvoiceOutput)(nil),         // 17: CheckInvoiceOutput
+	(*RecoverCrossChainInput)(nil),     // 18: RecoverCrossChainInput
+	(*AssetSweep)(nil),                 // 19: AssetSweep
+	(*RecoverCrossChainOutput)(nil),    // 20: RecoverCrossChainOutput
+	(*RescueTokenInput)(nil),           // 21: RescueTokenInput
+	(*RescueTokenOutput)(nil),          // 22: RescueTokenOutput
+	(*ListInvoiceEventsInput)(nil),     // 23: ListInvoiceEventsInput
+	(*InvoiceEvent)(nil),               // 24: InvoiceEvent
+	(*ListInvoiceEventsOutput)(nil),    // 25: ListInvoiceEventsOutput
+	(*TryCheckoutInvoiceInput)(nil),    // 26: TryCheckoutInvoiceInput
+	(*TryCheckoutInvoiceOutput)(nil),   // 27: TryCheckoutInvoiceOutput
+	(*SubmitSweepBundleInput)(nil),     // 28: SubmitSweepBundleInput
+	(*SubmitSweepBundleOutput)(nil),    // 29: SubmitSweepBundleOutput
+	(*ApproveCheckoutInput)(nil),       // 30: ApproveCheckoutInput
+	(*ApproveCheckoutOutput)(nil),      // 31: ApproveCheckoutOutput
+	(*RejectCheckoutInput)(nil),        // 32: RejectCheckoutInput
+	(*RejectCheckoutOutput)(nil),       // 33: RejectCheckoutOutput
+	(*EscrowActionInput)(nil),          // 34: EscrowActionInput
+	(*GetMerchantBalanceInput)(nil),    // 35: GetMerchantBalanceInput
+	(*GetMerchantBalanceOutput)(nil),   // 36: GetMerchantBalanceOutput
+	(*SettleTreasuryInput)(nil),        // 37: SettleTreasuryInput
+	(*SettleTreasuryOutput)(nil),       // 38: SettleTreasuryOutput
+	(*TreasuryTransfer)(nil),           // 39: TreasuryTransfer
+	(*CreatePayoutInput)(nil),          // 40: CreatePayoutInput
+	(*CreatePayoutOutput)(nil),         // 41: CreatePayoutOutput
+	(*GetPayoutInput)(nil),             // 42: GetPayoutInput
+	(*ListPayoutsInput)(nil),           // 43: ListPayoutsInput
+	(*ListPayoutsOutput)(nil),          // 44: ListPayoutsOutput
+	(*Payout)(nil),                     // 45: Payout
+	(*GetLedgerBalancesInput)(nil),     // 46: GetLedgerBalancesInput
+	(*GetLedgerBalancesOutput)(nil),    // 47: GetLedgerBalancesOutput
+	(*LedgerBalance)(nil),              // 48: LedgerBalance
+	(*RequestCheckoutInput)(nil),       // 49: RequestCheckoutInput
+	(*AssetInfo)(nil),                  // 50: AssetInfo
+	(*SignerPrepareInvoiceInput)(nil),  // 51: SignerPrepareInvoiceInput
+	(*SignerPrepareInvoiceOutput)(nil), // 52: SignerPrepareInvoiceOutput
+	(*SignSweepInput)(nil),             // 53: SignSweepInput
+	(*SignSweepOutput)(nil),            // 54: SignSweepOutput
+	nil,                                // 55: ListAssetsOutput.AssetsEntry
+	nil,                                // 56: InvoiceEvent.DetailEntry
+	(*timestamp.Timestamp)(nil),        // 57: google.protobuf.Timestamp
+	(*duration.Duration)(nil),          // 58: google.protobuf.Duration
+	(*empty.Empty)(nil),                // 59: google.protobuf.Empty
 }
 var file_cpg_proto_depIdxs = []int32{
-	17, // 0: PingOutput.now:type_name -> google.protobuf.Timestamp
-	16, // 1: ListAssetsOutput.assets:type_name -> ListAssetsOutput.AssetsEntry
-	17, // 2: CreateInvoiceInput.deadline:type_name -> google.protobuf.Timestamp
-	17, // 3: GetInvoiceOutput.create_at:type_name -> google.protobuf.Timestamp
-	17, // 4: GetInvoiceOutput.deadline:type_name -> google.protobuf.Timestamp
-	17, // 5: GetInvoiceOutput.fill_at:type_name -> google.protobuf.Timestamp
-	17, // 6: GetInvoiceOutput.cancel_at:type_name -> google.protobuf.Timestamp
-	17, // 7: GetInvoiceOutput.last_checkout_at:type_name -> google.protobuf.Timestamp
-	17, // 8: GetInvoiceOutput.checkout_request_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: GetInvoiceOutput.status:type_name -> InvoiceStatus
-	0,  // 10: CheckInvoiceOutput.invoice_status:type_name -> InvoiceStatus
-	18, // 11: AssetInfo.min_delay:type_name -> google.protobuf.Duration
-	15, // 12: ListAssetsOutput.AssetsEntry.value:type_name -> AssetInfo
-	1,  // 13: CPG.Ping:input_type -> PingInput
-	19, // 14: CPG.ListAssets:input_type -> google.protobuf.Empty
-	4,  // 15: CPG.RecoverInvoice:input_type -> RecoverInvoiceInput
-	6,  // 16: CPG.CreateInvoice:input_type -> CreateInvoiceInput
-	8,  // 17: CPG.CancelInvoice:input_type -> CancelInvoiceInput
-	9,  // 18: CPG.GetInvoice:input_type -> GetInvoiceInput
-	11, // 19: CPG.CheckInvoice:input_type -> CheckInvoiceInput
-	14, // 20: CPG.RequestCheckout:input_type -> RequestCheckoutInput
-	13, // 21: CPG.TryCheckoutInvoice:input_type -> TryCheckoutInvoiceInput
-	2,  // 22: CPG.Ping:output_type -> PingOutput
-	3,  // 23: CPG.ListAssets:output_type -> ListAssetsOutput
-	19, // 24: CPG.RecoverInvoice:output_type -> google.protobuf.Empty
-	7,  // 25: CPG.CreateInvoice:output_type -> CreateInvoiceOutput
-	19, // 26: CPG.CancelInvoice:output_type -> google.protobuf.Empty
-	10, // 27: CPG.GetInvoice:output_type -> GetInvoiceOutput
-	12, // 28: CPG.CheckInvoice:output_type -> CheckInvoiceOutput
-	19, // 29: CPG.RequestCheckout:output_type -> google.protobuf.Empty
-	19, // 30: CPG.TryCheckoutInvoice:output_type -> google.protobuf.Empty
-	22, // [22:31] is the sub-list for method output_type
-	13, // [13:22] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	57, // 0: PingOutput.now:type_name -> google.protobuf.Timestamp
+	55, // 1: ListAssetsOutput.assets:type_name -> ListAssetsOutput.AssetsEntry
+	0,  // 2: RecoverInvoicesOutput.outcome:type_name -> RecoverOutcome
+	1,  // 3: RecoverInvoicesOutput.status:type_name -> InvoiceStatus
+	57, // 4: VerifyBackupOutput.create_at:type_name -> google.protobuf.Timestamp
+	57, // 5: VerifyBackupOutput.deadline:type_name -> google.protobuf.Timestamp
+	57, // 6: CreateInvoiceInput.deadline:type_name -> google.protobuf.Timestamp
+	58, // 7: CreateInvoiceInput.escrow_period:type_name -> google.protobuf.Duration
+	11, // 8: CreateInvoiceInput.split:type_name -> SplitShare
+	57, // 9: GetInvoiceOutput.create_at:type_name -> google.protobuf.Timestamp
+	57, // 10: GetInvoiceOutput.deadline:type_name -> google.protobuf.Timestamp
+	57, // 11: GetInvoiceOutput.fill_at:type_name -> google.protobuf.Timestamp
+	57, // 12: GetInvoiceOutput.cancel_at:type_name -> google.protobuf.Timestamp
+	57, // 13: GetInvoiceOutput.last_checkout_at:type_name -> google.protobuf.Timestamp
+	57, // 14: GetInvoiceOutput.checkout_request_at:type_name -> google.protobuf.Timestamp
+	1,  // 15: GetInvoiceOutput.status:type_name -> InvoiceStatus
+	57, // 16: GetInvoiceOutput.approval_request_at:type_name -> google.protobuf.Timestamp
+	57, // 17: GetInvoiceOutput.approve_at:type_name -> google.protobuf.Timestamp
+	58, // 18: GetInvoiceOutput.escrow_period:type_name -> google.protobuf.Duration
+	57, // 19: GetInvoiceOutput.auto_release_at:type_name -> google.protobuf.Timestamp
+	57, // 20: GetInvoiceOutput.release_at:type_name -> google.protobuf.Timestamp
+	57, // 21: GetInvoiceOutput.refund_at:type_name -> google.protobuf.Timestamp
+	57, // 22: GetInvoiceOutput.dispute_at:type_name -> google.protobuf.Timestamp
+	11, // 23: GetInvoiceOutput.split:type_name -> SplitShare
+	1,  // 24: CheckInvoiceOutput.invoice_status:type_name -> InvoiceStatus
+	19, // 25: RecoverCrossChainOutput.sweeps:type_name -> AssetSweep
+	56, // 26: InvoiceEvent.detail:type_name -> InvoiceEvent.DetailEntry
+	57, // 27: InvoiceEvent.create_at:type_name -> google.protobuf.Timestamp
+	24, // 28: ListInvoiceEventsOutput.events:type_name -> InvoiceEvent
+	57, // 29: GetMerchantBalanceOutput.oldest_credit_at:type_name -> google.protobuf.Timestamp
+	39, // 30: GetMerchantBalanceOutput.transfers:type_name -> TreasuryTransfer
+	39, // 31: SettleTreasuryOutput.transfers:type_name -> TreasuryTransfer
+	57, // 32: TreasuryTransfer.create_at:type_name -> google.protobuf.Timestamp
+	57, // 33: TreasuryTransfer.send_at:type_name -> google.protobuf.Timestamp
+	45, // 34: CreatePayoutOutput.payout:type_name -> Payout
+	57, // 35: ListPayoutsInput.before:type_name -> google.protobuf.Timestamp
+	45, // 36: ListPayoutsOutput.payouts:type_name -> Payout
+	57, // 37: Payout.create_at:type_name -> google.protobuf.Timestamp
+	57, // 38: Payout.send_at:type_name -> google.protobuf.Timestamp
+	57, // 39: Payout.confirm_at:type_name -> google.protobuf.Timestamp
+	57, // 40: GetLedgerBalancesInput.at:type_name -> google.protobuf.Timestamp
+	48, // 41: GetLedgerBalancesOutput.balances:type_name -> LedgerBalance
+	58, // 42: AssetInfo.min_delay:type_name -> google.protobuf.Duration
+	50, // 43: ListAssetsOutput.AssetsEntry.value:type_name -> AssetInfo
+	2,  // 44: CPG.Ping:input_type -> PingInput
+	59, // 45: CPG.ListAssets:input_type -> google.protobuf.Empty
+	5,  // 46: CPG.RecoverInvoice:input_type -> RecoverInvoiceInput
+	5,  // 47: CPG.RecoverInvoices:input_type -> RecoverInvoiceInput
+	8,  // 48: CPG.VerifyBackup:input_type -> VerifyBackupInput
+	10, // 49: CPG.CreateInvoice:input_type -> CreateInvoiceInput
+	13, // 50: CPG.CancelInvoice:input_type -> CancelInvoiceInput
+	14, // 51: CPG.GetInvoice:input_type -> GetInvoiceInput
+	16, // 52: CPG.CheckInvoice:input_type -> CheckInvoiceInput
+	49, // 53: CPG.RequestCheckout:input_type -> RequestCheckoutInput
+	18, // 54: CPG.RecoverCrossChain:input_type -> RecoverCrossChainInput
+	21, // 55: CPG.RescueToken:input_type -> RescueTokenInput
+	23, // 56: CPG.ListInvoiceEvents:input_type -> ListInvoiceEventsInput
+	26, // 57: CPG.TryCheckoutInvoice:input_type -> TryCheckoutInvoiceInput
+	28, // 58: CPG.SubmitSweepBundle:input_type -> SubmitSweepBundleInput
+	30, // 59: CPG.ApproveCheckout:input_type -> ApproveCheckoutInput
+	32, // 60: CPG.RejectCheckout:input_type -> RejectCheckoutInput
+	34, // 61: CPG.ReleaseEscrow:input_type -> EscrowActionInput
+	34, // 62: CPG.RefundEscrow:input_type -> EscrowActionInput
+	34, // 63: CPG.DisputeEscrow:input_type -> EscrowActionInput
+	35, // 64: CPG.GetMerchantBalance:input_type -> GetMerchantBalanceInput
+	37, // 65: CPG.SettleTreasury:input_type -> SettleTreasuryInput
+	40, // 66: CPG.CreatePayout:input_type -> CreatePayoutInput
+	42, // 67: CPG.GetPayout:input_type -> GetPayoutInput
+	43, // 68: CPG.ListPayouts:input_type -> ListPayoutsInput
+	46, // 69: CPG.GetLedgerBalances:input_type -> GetLedgerBalancesInput
+	51, // 70: CPGSigner.PrepareInvoice:input_type -> SignerPrepareInvoiceInput
+	53, // 71: CPGSigner.SignSweep:input_type -> SignSweepInput
+	3,  // 72: CPG.Ping:output_type -> PingOutput
+	4,  // 73: CPG.ListAssets:output_type -> ListAssetsOutput
+	59, // 74: CPG.RecoverInvoice:output_type -> google.protobuf.Empty
+	7,  // 75: CPG.RecoverInvoices:output_type -> RecoverInvoicesOutput
+	9,  // 76: CPG.VerifyBackup:output_type -> VerifyBackupOutput
+	12, // 77: CPG.CreateInvoice:output_type -> CreateInvoiceOutput
+	59, // 78: CPG.CancelInvoice:output_type -> google.protobuf.Empty
+	15, // 79: CPG.GetInvoice:output_type -> GetInvoiceOutput
+	17, // 80: CPG.CheckInvoice:output_type -> CheckInvoiceOutput
+	59, // 81: CPG.RequestCheckout:output_type -> google.protobuf.Empty
+	20, // 82: CPG.RecoverCrossChain:output_type -> RecoverCrossChainOutput
+	22, // 83: CPG.RescueToken:output_type -> RescueTokenOutput
+	25, // 84: CPG.ListInvoiceEvents:output_type -> ListInvoiceEventsOutput
+	27, // 85: CPG.TryCheckoutInvoice:output_type -> TryCheckoutInvoiceOutput
+	29, // 86: CPG.SubmitSweepBundle:output_type -> SubmitSweepBundleOutput
+	31, // 87: CPG.ApproveCheckout:output_type -> ApproveCheckoutOutput
+	33, // 88: CPG.RejectCheckout:output_type -> RejectCheckoutOutput
+	59, // 89: CPG.ReleaseEscrow:output_type -> google.protobuf.Empty
+	59, // 90: CPG.RefundEscrow:output_type -> google.protobuf.Empty
+	59, // 91: CPG.DisputeEscrow:output_type -> google.protobuf.Empty
+	36, // 92: CPG.GetMerchantBalance:output_type -> GetMerchantBalanceOutput
+	38, // 93: CPG.SettleTreasury:output_type -> SettleTreasuryOutput
+	41, // 94: CPG.CreatePayout:output_type -> CreatePayoutOutput
+	45, // 95: CPG.GetPayout:output_type -> Payout
+	44, // 96: CPG.ListPayouts:output_type -> ListPayoutsOutput
+	47, // 97: CPG.GetLedgerBalances:output_type -> GetLedgerBalancesOutput
+	52, // 98: CPGSigner.PrepareInvoice:output_type -> SignerPrepareInvoiceOutput
+	54, // 99: CPGSigner.SignSweep:output_type -> SignSweepOutput
+	72, // [72:100] is the sub-list for method output_type
+	44, // [44:72] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_cpg_proto_init() }
@@ -1211,16 +4652,21 @@ func file_cpg_proto_init() {
 	if File_cpg_proto != nil {
 		return
 	}
-	file_cpg_proto_msgTypes[9].OneofWrappers = []any{}
+	file_cpg_proto_msgTypes[13].OneofWrappers = []any{}
+	file_cpg_proto_msgTypes[34].OneofWrappers = []any{}
+	file_cpg_proto_msgTypes[37].OneofWrappers = []any{}
+	file_cpg_proto_msgTypes[41].OneofWrappers = []any{}
+	file_cpg_proto_msgTypes[43].OneofWrappers = []any{}
+	file_cpg_proto_msgTypes[44].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cpg_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      2,
+			NumMessages:   55,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_cpg_proto_goTypes,
 		DependencyIndexes: file_cpg_proto_depIdxs,
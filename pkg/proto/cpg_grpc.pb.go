@@ -23,12 +23,29 @@ const (
 	CPG_Ping_FullMethodName               = "/CPG/Ping"
 	CPG_ListAssets_FullMethodName         = "/CPG/ListAssets"
 	CPG_RecoverInvoice_FullMethodName     = "/CPG/RecoverInvoice"
+	CPG_RecoverInvoices_FullMethodName    = "/CPG/RecoverInvoices"
+	CPG_VerifyBackup_FullMethodName       = "/CPG/VerifyBackup"
 	CPG_CreateInvoice_FullMethodName      = "/CPG/CreateInvoice"
 	CPG_CancelInvoice_FullMethodName      = "/CPG/CancelInvoice"
 	CPG_GetInvoice_FullMethodName         = "/CPG/GetInvoice"
 	CPG_CheckInvoice_FullMethodName       = "/CPG/CheckInvoice"
 	CPG_RequestCheckout_FullMethodName    = "/CPG/RequestCheckout"
+	CPG_RecoverCrossChain_FullMethodName  = "/CPG/RecoverCrossChain"
+	CPG_RescueToken_FullMethodName        = "/CPG/RescueToken"
+	CPG_ListInvoiceEvents_FullMethodName  = "/CPG/ListInvoiceEvents"
 	CPG_TryCheckoutInvoice_FullMethodName = "/CPG/TryCheckoutInvoice"
+	CPG_SubmitSweepBundle_FullMethodName  = "/CPG/SubmitSweepBundle"
+	CPG_ApproveCheckout_FullMethodName    = "/CPG/ApproveCheckout"
+	CPG_RejectCheckout_FullMethodName     = "/CPG/RejectCheckout"
+	CPG_ReleaseEscrow_FullMethodName      = "/CPG/ReleaseEscrow"
+	CPG_RefundEscrow_FullMethodName       = "/CPG/RefundEscrow"
+	CPG_DisputeEscrow_FullMethodName      = "/CPG/DisputeEscrow"
+	CPG_GetMerchantBalance_FullMethodName = "/CPG/GetMerchantBalance"
+	CPG_SettleTreasury_FullMethodName     = "/CPG/SettleTreasury"
+	CPG_CreatePayout_FullMethodName       = "/CPG/CreatePayout"
+	CPG_GetPayout_FullMethodName          = "/CPG/GetPayout"
+	CPG_ListPayouts_FullMethodName        = "/CPG/ListPayouts"
+	CPG_GetLedgerBalances_FullMethodName  = "/CPG/GetLedgerBalances"
 )
 
 // CPGClient is the client API for CPG service.
@@ -41,6 +58,10 @@ type CPGClient interface {
 	ListAssets(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListAssetsOutput, error)
 	// RecoverInvoice is used to recover lost but payed invoices
 	RecoverInvoice(ctx context.Context, in *RecoverInvoiceInput, opts ...grpc.CallOption) (*empty.Empty, error)
+	// RecoverInvoices recovers a stream of backups and reports each one, existing invoices are skipped and recovered ones get their balance re-checked
+	RecoverInvoices(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[RecoverInvoiceInput, RecoverInvoicesOutput], error)
+	// VerifyBackup validates a backup and re-derives its wallet without recovering it
+	VerifyBackup(ctx context.Context, in *VerifyBackupInput, opts ...grpc.CallOption) (*VerifyBackupOutput, error)
 	// CreateInvoice creates a new invoice with custom metadata attached to it and an assigned random uuid
 	CreateInvoice(ctx context.Context, in *CreateInvoiceInput, opts ...grpc.CallOption) (*CreateInvoiceOutput, error)
 	// CancelInvoice cancel a pending invoice that is not filled, expired and checked out or already canceled
@@ -51,8 +72,38 @@ type CPGClient interface {
 	CheckInvoice(ctx context.Context, in *CheckInvoiceInput, opts ...grpc.CallOption) (*CheckInvoiceOutput, error)
 	// RequestCheckout set a non-pending invoice to too check out as soon as possible
 	RequestCheckout(ctx context.Context, in *RequestCheckoutInput, opts ...grpc.CallOption) (*empty.Empty, error)
+	// RecoverCrossChain sweeps funds sent to an invoice wallet on the other chains of its wallet family to the invoice destination on that chain
+	RecoverCrossChain(ctx context.Context, in *RecoverCrossChainInput, opts ...grpc.CallOption) (*RecoverCrossChainOutput, error)
+	// RescueToken sweeps the balance of a token contract sent to an invoice wallet, funding its gas if allowed
+	RescueToken(ctx context.Context, in *RescueTokenInput, opts ...grpc.CallOption) (*RescueTokenOutput, error)
+	// ListInvoiceEvents returns the audit trail of an invoice
+	ListInvoiceEvents(ctx context.Context, in *ListInvoiceEventsInput, opts ...grpc.CallOption) (*ListInvoiceEventsOutput, error)
 	// TryCheckoutInvoice try to checkout an invoice only if its not pending, it may take tool long and should be used async by admin
-	TryCheckoutInvoice(ctx context.Context, in *TryCheckoutInvoiceInput, opts ...grpc.CallOption) (*empty.Empty, error)
+	TryCheckoutInvoice(ctx context.Context, in *TryCheckoutInvoiceInput, opts ...grpc.CallOption) (*TryCheckoutInvoiceOutput, error)
+	// SubmitSweepBundle broadcasts the signed tx of a sweep bundle exported by a cold signed checkout and links it to the invoice
+	SubmitSweepBundle(ctx context.Context, in *SubmitSweepBundleInput, opts ...grpc.CallOption) (*SubmitSweepBundleOutput, error)
+	// ApproveCheckout approves a checkout awaiting approval as the approver of the x-approver-key header, it is approved once enough distinct approvers did
+	ApproveCheckout(ctx context.Context, in *ApproveCheckoutInput, opts ...grpc.CallOption) (*ApproveCheckoutOutput, error)
+	// RejectCheckout rejects a checkout awaiting approval as the approver of the x-approver-key header, it drops the checkout request
+	RejectCheckout(ctx context.Context, in *RejectCheckoutInput, opts ...grpc.CallOption) (*RejectCheckoutOutput, error)
+	// ReleaseEscrow releases a held or disputed escrow invoice to its recipient
+	ReleaseEscrow(ctx context.Context, in *EscrowActionInput, opts ...grpc.CallOption) (*empty.Empty, error)
+	// RefundEscrow refunds a held or disputed escrow invoice to its beneficiary
+	RefundEscrow(ctx context.Context, in *EscrowActionInput, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DisputeEscrow stops the auto-release of a held escrow invoice until it is released or refunded
+	DisputeEscrow(ctx context.Context, in *EscrowActionInput, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetMerchantBalance returns the unsettled treasury balance of an account and its latest settlements
+	GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceInput, opts ...grpc.CallOption) (*GetMerchantBalanceOutput, error)
+	// SettleTreasury settles the due accounts of a treasury mode asset now and forwards the treasury excess, force settles every balance
+	SettleTreasury(ctx context.Context, in *SettleTreasuryInput, opts ...grpc.CallOption) (*SettleTreasuryOutput, error)
+	// CreatePayout sends an amount from the asset funding wallet to an allowlisted address, a retried call with the same idempotency key returns the first payout
+	CreatePayout(ctx context.Context, in *CreatePayoutInput, opts ...grpc.CallOption) (*CreatePayoutOutput, error)
+	// GetPayout returns a payout with its tx and status
+	GetPayout(ctx context.Context, in *GetPayoutInput, opts ...grpc.CallOption) (*Payout, error)
+	// ListPayouts returns payouts newest first
+	ListPayouts(ctx context.Context, in *ListPayoutsInput, opts ...grpc.CallOption) (*ListPayoutsOutput, error)
+	// GetLedgerBalances returns the double-entry ledger balances of the accounts of an asset at a point in time
+	GetLedgerBalances(ctx context.Context, in *GetLedgerBalancesInput, opts ...grpc.CallOption) (*GetLedgerBalancesOutput, error)
 }
 
 type cPGClient struct {
@@ -93,6 +144,29 @@ func (c *cPGClient) RecoverInvoice(ctx context.Context, in *RecoverInvoiceInput,
 	return out, nil
 }
 
+func (c *cPGClient) RecoverInvoices(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[RecoverInvoiceInput, RecoverInvoicesOutput], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CPG_ServiceDesc.Streams[0], CPG_RecoverInvoices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RecoverInvoiceInput, RecoverInvoicesOutput]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CPG_RecoverInvoicesClient = grpc.BidiStreamingClient[RecoverInvoiceInput, RecoverInvoicesOutput]
+
+func (c *cPGClient) VerifyBackup(ctx context.Context, in *VerifyBackupInput, opts ...grpc.CallOption) (*VerifyBackupOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyBackupOutput)
+	err := c.cc.Invoke(ctx, CPG_VerifyBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cPGClient) CreateInvoice(ctx context.Context, in *CreateInvoiceInput, opts ...grpc.CallOption) (*CreateInvoiceOutput, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateInvoiceOutput)
@@ -143,9 +217,39 @@ func (c *cPGClient) RequestCheckout(ctx context.Context, in *RequestCheckoutInpu
 	return out, nil
 }
 
-func (c *cPGClient) TryCheckoutInvoice(ctx context.Context, in *TryCheckoutInvoiceInput, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *cPGClient) RecoverCrossChain(ctx context.Context, in *RecoverCrossChainInput, opts ...grpc.CallOption) (*RecoverCrossChainOutput, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(empty.Empty)
+	out := new(RecoverCrossChainOutput)
+	err := c.cc.Invoke(ctx, CPG_RecoverCrossChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) RescueToken(ctx context.Context, in *RescueTokenInput, opts ...grpc.CallOption) (*RescueTokenOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescueTokenOutput)
+	err := c.cc.Invoke(ctx, CPG_RescueToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) ListInvoiceEvents(ctx context.Context, in *ListInvoiceEventsInput, opts ...grpc.CallOption) (*ListInvoiceEventsOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvoiceEventsOutput)
+	err := c.cc.Invoke(ctx, CPG_ListInvoiceEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) TryCheckoutInvoice(ctx context.Context, in *TryCheckoutInvoiceInput, opts ...grpc.CallOption) (*TryCheckoutInvoiceOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TryCheckoutInvoiceOutput)
 	err := c.cc.Invoke(ctx, CPG_TryCheckoutInvoice_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -153,6 +257,126 @@ func (c *cPGClient) TryCheckoutInvoice(ctx context.Context, in *TryCheckoutInvoi
 	return out, nil
 }
 
+func (c *cPGClient) SubmitSweepBundle(ctx context.Context, in *SubmitSweepBundleInput, opts ...grpc.CallOption) (*SubmitSweepBundleOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitSweepBundleOutput)
+	err := c.cc.Invoke(ctx, CPG_SubmitSweepBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) ApproveCheckout(ctx context.Context, in *ApproveCheckoutInput, opts ...grpc.CallOption) (*ApproveCheckoutOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveCheckoutOutput)
+	err := c.cc.Invoke(ctx, CPG_ApproveCheckout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) RejectCheckout(ctx context.Context, in *RejectCheckoutInput, opts ...grpc.CallOption) (*RejectCheckoutOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectCheckoutOutput)
+	err := c.cc.Invoke(ctx, CPG_RejectCheckout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) ReleaseEscrow(ctx context.Context, in *EscrowActionInput, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, CPG_ReleaseEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) RefundEscrow(ctx context.Context, in *EscrowActionInput, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, CPG_RefundEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) DisputeEscrow(ctx context.Context, in *EscrowActionInput, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, CPG_DisputeEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceInput, opts ...grpc.CallOption) (*GetMerchantBalanceOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMerchantBalanceOutput)
+	err := c.cc.Invoke(ctx, CPG_GetMerchantBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) SettleTreasury(ctx context.Context, in *SettleTreasuryInput, opts ...grpc.CallOption) (*SettleTreasuryOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleTreasuryOutput)
+	err := c.cc.Invoke(ctx, CPG_SettleTreasury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) CreatePayout(ctx context.Context, in *CreatePayoutInput, opts ...grpc.CallOption) (*CreatePayoutOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePayoutOutput)
+	err := c.cc.Invoke(ctx, CPG_CreatePayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) GetPayout(ctx context.Context, in *GetPayoutInput, opts ...grpc.CallOption) (*Payout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payout)
+	err := c.cc.Invoke(ctx, CPG_GetPayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) ListPayouts(ctx context.Context, in *ListPayoutsInput, opts ...grpc.CallOption) (*ListPayoutsOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPayoutsOutput)
+	err := c.cc.Invoke(ctx, CPG_ListPayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGClient) GetLedgerBalances(ctx context.Context, in *GetLedgerBalancesInput, opts ...grpc.CallOption) (*GetLedgerBalancesOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLedgerBalancesOutput)
+	err := c.cc.Invoke(ctx, CPG_GetLedgerBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CPGServer is the server API for CPG service.
 // All implementations must embed UnimplementedCPGServer
 // for forward compatibility.
@@ -163,6 +387,10 @@ type CPGServer interface {
 	ListAssets(context.Context, *empty.Empty) (*ListAssetsOutput, error)
 	// RecoverInvoice is used to recover lost but payed invoices
 	RecoverInvoice(context.Context, *RecoverInvoiceInput) (*empty.Empty, error)
+	// RecoverInvoices recovers a stream of backups and reports each one, existing invoices are skipped and recovered ones get their balance re-checked
+	RecoverInvoices(grpc.BidiStreamingServer[RecoverInvoiceInput, RecoverInvoicesOutput]) error
+	// VerifyBackup validates a backup and re-derives its wallet without recovering it
+	VerifyBackup(context.Context, *VerifyBackupInput) (*VerifyBackupOutput, error)
 	// CreateInvoice creates a new invoice with custom metadata attached to it and an assigned random uuid
 	CreateInvoice(context.Context, *CreateInvoiceInput) (*CreateInvoiceOutput, error)
 	// CancelInvoice cancel a pending invoice that is not filled, expired and checked out or already canceled
@@ -173,8 +401,38 @@ type CPGServer interface {
 	CheckInvoice(context.Context, *CheckInvoiceInput) (*CheckInvoiceOutput, error)
 	// RequestCheckout set a non-pending invoice to too check out as soon as possible
 	RequestCheckout(context.Context, *RequestCheckoutInput) (*empty.Empty, error)
+	// RecoverCrossChain sweeps funds sent to an invoice wallet on the other chains of its wallet family to the invoice destination on that chain
+	RecoverCrossChain(context.Context, *RecoverCrossChainInput) (*RecoverCrossChainOutput, error)
+	// RescueToken sweeps the balance of a token contract sent to an invoice wallet, funding its gas if allowed
+	RescueToken(context.Context, *RescueTokenInput) (*RescueTokenOutput, error)
+	// ListInvoiceEvents returns the audit trail of an invoice
+	ListInvoiceEvents(context.Context, *ListInvoiceEventsInput) (*ListInvoiceEventsOutput, error)
 	// TryCheckoutInvoice try to checkout an invoice only if its not pending, it may take tool long and should be used async by admin
-	TryCheckoutInvoice(context.Context, *TryCheckoutInvoiceInput) (*empty.Empty, error)
+	TryCheckoutInvoice(context.Context, *TryCheckoutInvoiceInput) (*TryCheckoutInvoiceOutput, error)
+	// SubmitSweepBundle broadcasts the signed tx of a sweep bundle exported by a cold signed checkout and links it to the invoice
+	SubmitSweepBundle(context.Context, *SubmitSweepBundleInput) (*SubmitSweepBundleOutput, error)
+	// ApproveCheckout approves a checkout awaiting approval as the approver of the x-approver-key header, it is approved once enough distinct approvers did
+	ApproveCheckout(context.Context, *ApproveCheckoutInput) (*ApproveCheckoutOutput, error)
+	// RejectCheckout rejects a checkout awaiting approval as the approver of the x-approver-key header, it drops the checkout request
+	RejectCheckout(context.Context, *RejectCheckoutInput) (*RejectCheckoutOutput, error)
+	// ReleaseEscrow releases a held or disputed escrow invoice to its recipient
+	ReleaseEscrow(context.Context, *EscrowActionInput) (*empty.Empty, error)
+	// RefundEscrow refunds a held or disputed escrow invoice to its beneficiary
+	RefundEscrow(context.Context, *EscrowActionInput) (*empty.Empty, error)
+	// DisputeEscrow stops the auto-release of a held escrow invoice until it is released or refunded
+	DisputeEscrow(context.Context, *EscrowActionInput) (*empty.Empty, error)
+	// GetMerchantBalance returns the unsettled treasury balance of an account and its latest settlements
+	GetMerchantBalance(context.Context, *GetMerchantBalanceInput) (*GetMerchantBalanceOutput, error)
+	// SettleTreasury settles the due accounts of a treasury mode asset now and forwards the treasury excess, force settles every balance
+	SettleTreasury(context.Context, *SettleTreasuryInput) (*SettleTreasuryOutput, error)
+	// CreatePayout sends an amount from the asset funding wallet to an allowlisted address, a retried call with the same idempotency key returns the first payout
+	CreatePayout(context.Context, *CreatePayoutInput) (*CreatePayoutOutput, error)
+	// GetPayout returns a payout with its tx and status
+	GetPayout(context.Context, *GetPayoutInput) (*Payout, error)
+	// ListPayouts returns payouts newest first
+	ListPayouts(context.Context, *ListPayoutsInput) (*ListPayoutsOutput, error)
+	// GetLedgerBalances returns the double-entry ledger balances of the accounts of an asset at a point in time
+	GetLedgerBalances(context.Context, *GetLedgerBalancesInput) (*GetLedgerBalancesOutput, error)
 	mustEmbedUnimplementedCPGServer()
 }
 
@@ -194,6 +452,12 @@ func (UnimplementedCPGServer) ListAssets(context.Context, *empty.Empty) (*ListAs
 func (UnimplementedCPGServer) RecoverInvoice(context.Context, *RecoverInvoiceInput) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoverInvoice not implemented")
 }
+func (UnimplementedCPGServer) RecoverInvoices(grpc.BidiStreamingServer[RecoverInvoiceInput, RecoverInvoicesOutput]) error {
+	return status.Errorf(codes.Unimplemented, "method RecoverInvoices not implemented")
+}
+func (UnimplementedCPGServer) VerifyBackup(context.Context, *VerifyBackupInput) (*VerifyBackupOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBackup not implemented")
+}
 func (UnimplementedCPGServer) CreateInvoice(context.Context, *CreateInvoiceInput) (*CreateInvoiceOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
 }
@@ -209,9 +473,54 @@ func (UnimplementedCPGServer) CheckInvoice(context.Context, *CheckInvoiceInput)
 func (UnimplementedCPGServer) RequestCheckout(context.Context, *RequestCheckoutInput) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestCheckout not implemented")
 }
-func (UnimplementedCPGServer) TryCheckoutInvoice(context.Context, *TryCheckoutInvoiceInput) (*empty.Empty, error) {
+func (UnimplementedCPGServer) RecoverCrossChain(context.Context, *RecoverCrossChainInput) (*RecoverCrossChainOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverCrossChain not implemented")
+}
+func (UnimplementedCPGServer) RescueToken(context.Context, *RescueTokenInput) (*RescueTokenOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescueToken not implemented")
+}
+func (UnimplementedCPGServer) ListInvoiceEvents(context.Context, *ListInvoiceEventsInput) (*ListInvoiceEventsOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvoiceEvents not implemented")
+}
+func (UnimplementedCPGServer) TryCheckoutInvoice(context.Context, *TryCheckoutInvoiceInput) (*TryCheckoutInvoiceOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TryCheckoutInvoice not implemented")
 }
+func (UnimplementedCPGServer) SubmitSweepBundle(context.Context, *SubmitSweepBundleInput) (*SubmitSweepBundleOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSweepBundle not implemented")
+}
+func (UnimplementedCPGServer) ApproveCheckout(context.Context, *ApproveCheckoutInput) (*ApproveCheckoutOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCheckout not implemented")
+}
+func (UnimplementedCPGServer) RejectCheckout(context.Context, *RejectCheckoutInput) (*RejectCheckoutOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCheckout not implemented")
+}
+func (UnimplementedCPGServer) ReleaseEscrow(context.Context, *EscrowActionInput) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseEscrow not implemented")
+}
+func (UnimplementedCPGServer) RefundEscrow(context.Context, *EscrowActionInput) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundEscrow not implemented")
+}
+func (UnimplementedCPGServer) DisputeEscrow(context.Context, *EscrowActionInput) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeEscrow not implemented")
+}
+func (UnimplementedCPGServer) GetMerchantBalance(context.Context, *GetMerchantBalanceInput) (*GetMerchantBalanceOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantBalance not implemented")
+}
+func (UnimplementedCPGServer) SettleTreasury(context.Context, *SettleTreasuryInput) (*SettleTreasuryOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleTreasury not implemented")
+}
+func (UnimplementedCPGServer) CreatePayout(context.Context, *CreatePayoutInput) (*CreatePayoutOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePayout not implemented")
+}
+func (UnimplementedCPGServer) GetPayout(context.Context, *GetPayoutInput) (*Payout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayout not implemented")
+}
+func (UnimplementedCPGServer) ListPayouts(context.Context, *ListPayoutsInput) (*ListPayoutsOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayouts not implemented")
+}
+func (UnimplementedCPGServer) GetLedgerBalances(context.Context, *GetLedgerBalancesInput) (*GetLedgerBalancesOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLedgerBalances not implemented")
+}
 func (UnimplementedCPGServer) mustEmbedUnimplementedCPGServer() {}
 func (UnimplementedCPGServer) testEmbeddedByValue()             {}
 
@@ -287,6 +596,31 @@ func _CPG_RecoverInvoice_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CPG_RecoverInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CPGServer).RecoverInvoices(&grpc.GenericServerStream[RecoverInvoiceInput, RecoverInvoicesOutput]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CPG_RecoverInvoicesServer = grpc.BidiStreamingServer[RecoverInvoiceInput, RecoverInvoicesOutput]
+
+func _CPG_VerifyBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBackupInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).VerifyBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_VerifyBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).VerifyBackup(ctx, req.(*VerifyBackupInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CPG_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateInvoiceInput)
 	if err := dec(in); err != nil {
@@ -377,6 +711,60 @@ func _CPG_RequestCheckout_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CPG_RecoverCrossChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverCrossChainInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).RecoverCrossChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_RecoverCrossChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).RecoverCrossChain(ctx, req.(*RecoverCrossChainInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_RescueToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescueTokenInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).RescueToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_RescueToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).RescueToken(ctx, req.(*RescueTokenInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_ListInvoiceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoiceEventsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).ListInvoiceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_ListInvoiceEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).ListInvoiceEvents(ctx, req.(*ListInvoiceEventsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CPG_TryCheckoutInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TryCheckoutInvoiceInput)
 	if err := dec(in); err != nil {
@@ -395,6 +783,222 @@ func _CPG_TryCheckoutInvoice_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CPG_SubmitSweepBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSweepBundleInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).SubmitSweepBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_SubmitSweepBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).SubmitSweepBundle(ctx, req.(*SubmitSweepBundleInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_ApproveCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCheckoutInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).ApproveCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_ApproveCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).ApproveCheckout(ctx, req.(*ApproveCheckoutInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_RejectCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectCheckoutInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).RejectCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_RejectCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).RejectCheckout(ctx, req.(*RejectCheckoutInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_ReleaseEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EscrowActionInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).ReleaseEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_ReleaseEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).ReleaseEscrow(ctx, req.(*EscrowActionInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_RefundEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EscrowActionInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).RefundEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_RefundEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).RefundEscrow(ctx, req.(*EscrowActionInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_DisputeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EscrowActionInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).DisputeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_DisputeEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).DisputeEscrow(ctx, req.(*EscrowActionInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_GetMerchantBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantBalanceInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).GetMerchantBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_GetMerchantBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).GetMerchantBalance(ctx, req.(*GetMerchantBalanceInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_SettleTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleTreasuryInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).SettleTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_SettleTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).SettleTreasury(ctx, req.(*SettleTreasuryInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_CreatePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayoutInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).CreatePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_CreatePayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).CreatePayout(ctx, req.(*CreatePayoutInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_GetPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).GetPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_GetPayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).GetPayout(ctx, req.(*GetPayoutInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_ListPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayoutsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).ListPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_ListPayouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).ListPayouts(ctx, req.(*ListPayoutsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPG_GetLedgerBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerBalancesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGServer).GetLedgerBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPG_GetLedgerBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGServer).GetLedgerBalances(ctx, req.(*GetLedgerBalancesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CPG_ServiceDesc is the grpc.ServiceDesc for CPG service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -414,6 +1018,10 @@ var CPG_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecoverInvoice",
 			Handler:    _CPG_RecoverInvoice_Handler,
 		},
+		{
+			MethodName: "VerifyBackup",
+			Handler:    _CPG_VerifyBackup_Handler,
+		},
 		{
 			MethodName: "CreateInvoice",
 			Handler:    _CPG_CreateInvoice_Handler,
@@ -434,10 +1042,225 @@ var CPG_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestCheckout",
 			Handler:    _CPG_RequestCheckout_Handler,
 		},
+		{
+			MethodName: "RecoverCrossChain",
+			Handler:    _CPG_RecoverCrossChain_Handler,
+		},
+		{
+			MethodName: "RescueToken",
+			Handler:    _CPG_RescueToken_Handler,
+		},
+		{
+			MethodName: "ListInvoiceEvents",
+			Handler:    _CPG_ListInvoiceEvents_Handler,
+		},
 		{
 			MethodName: "TryCheckoutInvoice",
 			Handler:    _CPG_TryCheckoutInvoice_Handler,
 		},
+		{
+			MethodName: "SubmitSweepBundle",
+			Handler:    _CPG_SubmitSweepBundle_Handler,
+		},
+		{
+			MethodName: "ApproveCheckout",
+			Handler:    _CPG_ApproveCheckout_Handler,
+		},
+		{
+			MethodName: "RejectCheckout",
+			Handler:    _CPG_RejectCheckout_Handler,
+		},
+		{
+			MethodName: "ReleaseEscrow",
+			Handler:    _CPG_ReleaseEscrow_Handler,
+		},
+		{
+			MethodName: "RefundEscrow",
+			Handler:    _CPG_RefundEscrow_Handler,
+		},
+		{
+			MethodName: "DisputeEscrow",
+			Handler:    _CPG_DisputeEscrow_Handler,
+		},
+		{
+			MethodName: "GetMerchantBalance",
+			Handler:    _CPG_GetMerchantBalance_Handler,
+		},
+		{
+			MethodName: "SettleTreasury",
+			Handler:    _CPG_SettleTreasury_Handler,
+		},
+		{
+			MethodName: "CreatePayout",
+			Handler:    _CPG_CreatePayout_Handler,
+		},
+		{
+			MethodName: "GetPayout",
+			Handler:    _CPG_GetPayout_Handler,
+		},
+		{
+			MethodName: "ListPayouts",
+			Handler:    _CPG_ListPayouts_Handler,
+		},
+		{
+			MethodName: "GetLedgerBalances",
+			Handler:    _CPG_GetLedgerBalances_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RecoverInvoices",
+			Handler:       _CPG_RecoverInvoices_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "cpg.proto",
+}
+
+const (
+	CPGSigner_PrepareInvoice_FullMethodName = "/CPGSigner/PrepareInvoice"
+	CPGSigner_SignSweep_FullMethodName      = "/CPGSigner/SignSweep"
+)
+
+// CPGSignerClient is the client API for CPGSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CPGSigner is served by cpg-signer, which holds the invoice salts apart from the public service
+type CPGSignerClient interface {
+	// PrepareInvoice seals a fresh salt for a new invoice, derives its wallet and sums its terms
+	PrepareInvoice(ctx context.Context, in *SignerPrepareInvoiceInput, opts ...grpc.CallOption) (*SignerPrepareInvoiceOutput, error)
+	// SignSweep signs an unsigned sweep tx of an invoice in the signer database after its policy checks
+	SignSweep(ctx context.Context, in *SignSweepInput, opts ...grpc.CallOption) (*SignSweepOutput, error)
+}
+
+type cPGSignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCPGSignerClient(cc grpc.ClientConnInterface) CPGSignerClient {
+	return &cPGSignerClient{cc}
+}
+
+func (c *cPGSignerClient) PrepareInvoice(ctx context.Context, in *SignerPrepareInvoiceInput, opts ...grpc.CallOption) (*SignerPrepareInvoiceOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignerPrepareInvoiceOutput)
+	err := c.cc.Invoke(ctx, CPGSigner_PrepareInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cPGSignerClient) SignSweep(ctx context.Context, in *SignSweepInput, opts ...grpc.CallOption) (*SignSweepOutput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignSweepOutput)
+	err := c.cc.Invoke(ctx, CPGSigner_SignSweep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CPGSignerServer is the server API for CPGSigner service.
+// All implementations must embed UnimplementedCPGSignerServer
+// for forward compatibility.
+//
+// CPGSigner is served by cpg-signer, which holds the invoice salts apart from the public service
+type CPGSignerServer interface {
+	// PrepareInvoice seals a fresh salt for a new invoice, derives its wallet and sums its terms
+	PrepareInvoice(context.Context, *SignerPrepareInvoiceInput) (*SignerPrepareInvoiceOutput, error)
+	// SignSweep signs an unsigned sweep tx of an invoice in the signer database after its policy checks
+	SignSweep(context.Context, *SignSweepInput) (*SignSweepOutput, error)
+	mustEmbedUnimplementedCPGSignerServer()
+}
+
+// UnimplementedCPGSignerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCPGSignerServer struct{}
+
+func (UnimplementedCPGSignerServer) PrepareInvoice(context.Context, *SignerPrepareInvoiceInput) (*SignerPrepareInvoiceOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareInvoice not implemented")
+}
+func (UnimplementedCPGSignerServer) SignSweep(context.Context, *SignSweepInput) (*SignSweepOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSweep not implemented")
+}
+func (UnimplementedCPGSignerServer) mustEmbedUnimplementedCPGSignerServer() {}
+func (UnimplementedCPGSignerServer) testEmbeddedByValue()                   {}
+
+// UnsafeCPGSignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CPGSignerServer will
+// result in compilation errors.
+type UnsafeCPGSignerServer interface {
+	mustEmbedUnimplementedCPGSignerServer()
+}
+
+func RegisterCPGSignerServer(s grpc.ServiceRegistrar, srv CPGSignerServer) {
+	// If the following call pancis, it indicates UnimplementedCPGSignerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CPGSigner_ServiceDesc, srv)
+}
+
+func _CPGSigner_PrepareInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerPrepareInvoiceInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGSignerServer).PrepareInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPGSigner_PrepareInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGSignerServer).PrepareInvoice(ctx, req.(*SignerPrepareInvoiceInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CPGSigner_SignSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSweepInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CPGSignerServer).SignSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CPGSigner_SignSweep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CPGSignerServer).SignSweep(ctx, req.(*SignSweepInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CPGSigner_ServiceDesc is the grpc.ServiceDesc for CPGSigner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CPGSigner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "CPGSigner",
+	HandlerType: (*CPGSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PrepareInvoice",
+			Handler:    _CPGSigner_PrepareInvoice_Handler,
+		},
+		{
+			MethodName: "SignSweep",
+			Handler:    _CPGSigner_SignSweep_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cpg.proto",